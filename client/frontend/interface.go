@@ -93,5 +93,13 @@ type Client interface {
 	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) (*types.PauseScheduleResponse, error)
 	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) (*types.UnpauseScheduleResponse, error)
 	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) (*types.BackfillScheduleResponse, error)
+	TriggerSchedule(context.Context, *types.TriggerScheduleRequest, ...yarpc.CallOption) (*types.TriggerScheduleResponse, error)
 	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
+	DescribeAsyncWorkflowRequest(context.Context, *types.DescribeAsyncWorkflowRequestRequest, ...yarpc.CallOption) (*types.DescribeAsyncWorkflowRequestResponse, error)
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseActivity(context.Context, *types.PauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest, ...yarpc.CallOption) error
+	ResetActivity(context.Context, *types.ResetActivityRequest, ...yarpc.CallOption) error
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest, ...yarpc.CallOption) error
 }
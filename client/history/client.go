@@ -1010,6 +1010,66 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetPauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.PauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetUnpauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UnpauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetResetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ResetActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetUpdateRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UpdateActivityOptions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	return c.executeWithRedirect(ctx, peer, op)
+}
+
 func (c *clientImpl) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
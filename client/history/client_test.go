@@ -1108,6 +1108,66 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "PauseActivity",
+			op: func(c Client) error {
+				return c.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+					PauseRequest: &types.PauseActivityRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().PauseActivity(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UnpauseActivity",
+			op: func(c Client) error {
+				return c.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+					UnpauseRequest: &types.UnpauseActivityRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "ResetActivity",
+			op: func(c Client) error {
+				return c.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+					ResetRequest: &types.ResetActivityRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().ResetActivity(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UpdateActivityOptions",
+			op: func(c Client) error {
+				return c.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+					UpdateRequest: &types.UpdateActivityOptionsRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "RemoveSignalMutableState",
 			op: func(c Client) error {
@@ -44,6 +44,10 @@ func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Deprecat
 	return proto.ToError(err)
 }
 
+func (g frontendClient) DescribeAsyncWorkflowRequest(ctx context.Context, dp1 *types.DescribeAsyncWorkflowRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncWorkflowRequestResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	response, err := g.c.DescribeDomain(ctx, proto.FromDescribeDomainRequest(dp1), p1...)
 	return proto.ToDescribeDomainResponse(response), proto.ToError(err)
@@ -134,11 +138,19 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	response, err := g.c.PauseSchedule(ctx, proto.FromPauseScheduleRequest(pp1), p1...)
 	return proto.ToPauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
 	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
@@ -179,6 +191,10 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -274,11 +290,27 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	response, err := g.c.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(up1), p1...)
 	return proto.ToUnpauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
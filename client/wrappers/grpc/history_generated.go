@@ -78,6 +78,11 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return proto.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, proto.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return proto.ToHistoryPollMutableStateResponse(response), proto.ToError(err)
@@ -153,6 +158,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivity(ctx, proto.FromHistoryResetActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -232,3 +242,13 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateActivityOptions(ctx, proto.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return proto.ToError(err)
+}
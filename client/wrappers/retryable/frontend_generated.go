@@ -89,6 +89,17 @@ func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Depreca
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) DescribeAsyncWorkflowRequest(ctx context.Context, dp1 *types.DescribeAsyncWorkflowRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncWorkflowRequestResponse, err error) {
+	var resp *types.DescribeAsyncWorkflowRequestResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeAsyncWorkflowRequest(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	var resp *types.DescribeDomainResponse
 	op := func(ctx context.Context) error {
@@ -287,6 +298,13 @@ func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.
 	return resp, err
 }
 
+func (c *frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseActivity(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	var resp *types.PauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -298,6 +316,13 @@ func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseSche
 	return resp, err
 }
 
+func (c *frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.PauseWorkflowExecution(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var resp *types.PollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -374,6 +399,13 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.ResetActivity(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var resp *types.ResetStickyTaskListResponse
 	op := func(ctx context.Context) error {
@@ -543,6 +575,24 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	var resp *types.TriggerScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.TriggerSchedule(ctx, tp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseActivity(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	var resp *types.UnpauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -554,6 +604,20 @@ func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.Unpause
 	return resp, err
 }
 
+func (c *frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UnpauseWorkflowExecution(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UpdateActivityOptions(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func(ctx context.Context) error {
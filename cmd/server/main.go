@@ -28,11 +28,15 @@ import (
 	"github.com/uber/cadence/tools/common/commoncli"
 
 	_ "github.com/uber/cadence/common/archiver/gcloud"                                      // needed to load the optional gcloud archiver plugin
+	_ "github.com/uber/cadence/common/asyncworkflow/queue/database"                         // needed to load database asyncworkflow queue
 	_ "github.com/uber/cadence/common/asyncworkflow/queue/kafka"                            // needed to load kafka asyncworkflow queue
 	_ "github.com/uber/cadence/common/dynamicconfig/openfeatureprovider/unleash"            // needed to load the optional unleash openfeature provider plugin
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"              // needed to load cassandra plugin
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public" // needed to load the default gocql client
+	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"               // needed to load dynamodb plugin
+	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/mongodb"                // needed to load mongodb plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/cloudsql-mysql"             // needed to load cloudsql-mysql plugin
+	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/cockroachdb"                // needed to load cockroachdb plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"                      // needed to load mysql plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"                   // needed to load postgres plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"                     // needed to load sqlite plugin
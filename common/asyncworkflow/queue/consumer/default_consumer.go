@@ -37,12 +37,15 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
@@ -66,6 +69,9 @@ type DefaultConsumer struct {
 	startWFTimeout  time.Duration
 	msgDecoder      codec.BinaryEncoder
 	concurrency     int
+	statusManager   persistence.AsyncWorkflowRequestStatusManager
+	domainCache     cache.DomainCache
+	timeSrc         clock.TimeSource
 }
 
 type Option func(*DefaultConsumer)
@@ -76,6 +82,23 @@ func WithConcurrency(concurrency int) Option {
 	}
 }
 
+// WithRequestStatusManager makes the consumer record the outcome of requests which were tracked by the frontend
+func WithRequestStatusManager(statusManager persistence.AsyncWorkflowRequestStatusManager, domainCache cache.DomainCache) Option {
+	return func(c *DefaultConsumer) {
+		c.statusManager = statusManager
+		c.domainCache = domainCache
+	}
+}
+
+// WithTimeSource sets the time source used to timestamp request status updates
+func WithTimeSource(timeSrc clock.TimeSource) Option {
+	return func(c *DefaultConsumer) {
+		if timeSrc != nil {
+			c.timeSrc = timeSrc
+		}
+	}
+}
+
 func New(
 	queueID string,
 	innerConsumer messaging.Consumer,
@@ -97,6 +120,7 @@ func New(
 		startWFTimeout:  defaultStartWFTimeout,
 		msgDecoder:      codec.NewThriftRWEncoder(),
 		concurrency:     defaultConcurrency,
+		timeSrc:         clock.NewRealTimeSource(),
 	}
 
 	for _, opt := range options {
@@ -206,12 +230,12 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 		logTags = append(logTags, tag.WorkflowDomainName(startWFReq.GetDomain()), tag.WorkflowID(startWFReq.GetWorkflowID()))
 
 		var resp *types.StartWorkflowExecutionResponse
+		var startedError *types.WorkflowExecutionAlreadyStartedError
 		op := func(ctx1 context.Context) error {
 			ctx, cancel := context.WithTimeout(ctx1, c.startWFTimeout)
 			defer cancel()
 			resp, err = c.frontendClient.StartWorkflowExecution(ctx, startWFReq, yarpcCallOpts...)
 
-			var startedError *types.WorkflowExecutionAlreadyStartedError
 			if errors.As(err, &startedError) {
 				logger.Info("Received WorkflowExecutionAlreadyStartedError, treating it as a success", tag.WorkflowID(startWFReq.GetWorkflowID()), tag.WorkflowRunID(startedError.RunID))
 				return nil
@@ -221,9 +245,11 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 
 		if err := callFrontendWithRetries(c.ctx, op); err != nil {
 			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
+			c.recordRequestFailed(logger, startWFReq.GetDomain(), startWFReq.GetRequestID(), err.Error())
 			return logTags, fmt.Errorf("start workflow execution failed after all attempts: %w", err)
 		}
 
+		c.recordRequestOutcome(logger, startWFReq.GetDomain(), startWFReq.GetRequestID(), resp, startedError)
 		logTags = append(logTags, tag.WorkflowRunID(resp.GetRunID()))
 		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
 	case sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest:
@@ -237,12 +263,12 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 		scope := c.scope.Tagged(metrics.DomainTag(startWFReq.GetDomain()))
 		logTags = append(logTags, tag.WorkflowDomainName(startWFReq.GetDomain()), tag.WorkflowID(startWFReq.GetWorkflowID()))
 		var resp *types.StartWorkflowExecutionResponse
+		var startedError *types.WorkflowExecutionAlreadyStartedError
 		op := func(ctx1 context.Context) error {
 			ctx, cancel := context.WithTimeout(ctx1, c.startWFTimeout)
 			defer cancel()
 			resp, err = c.frontendClient.SignalWithStartWorkflowExecution(ctx, startWFReq, yarpcCallOpts...)
 
-			var startedError *types.WorkflowExecutionAlreadyStartedError
 			if errors.As(err, &startedError) {
 				logger.Info("Received WorkflowExecutionAlreadyStartedError, treating it as a success", tag.WorkflowID(startWFReq.GetWorkflowID()), tag.WorkflowRunID(startedError.RunID))
 				return nil
@@ -252,9 +278,11 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 
 		if err := callFrontendWithRetries(c.ctx, op); err != nil {
 			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
+			c.recordRequestFailed(logger, startWFReq.GetDomain(), startWFReq.GetRequestID(), err.Error())
 			return logTags, fmt.Errorf("signal with start workflow execution failed after all attempts: %w", err)
 		}
 
+		c.recordRequestOutcome(logger, startWFReq.GetDomain(), startWFReq.GetRequestID(), resp, startedError)
 		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
 		logTags = append(logTags, tag.WorkflowRunID(resp.GetRunID()))
 	default:
@@ -265,6 +293,77 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 	return logTags, nil
 }
 
+// recordRequestOutcome records a request as started, unless the workflow was already started by a different request
+func (c *DefaultConsumer) recordRequestOutcome(
+	logger log.Logger,
+	domainName string,
+	requestID string,
+	resp *types.StartWorkflowExecutionResponse,
+	startedError *types.WorkflowExecutionAlreadyStartedError,
+) {
+	if startedError == nil {
+		c.recordRequestStatus(logger, domainName, requestID, persistence.AsyncWorkflowRequestStateStarted, resp.GetRunID(), "")
+		return
+	}
+	// the same request may be delivered more than once, in which case the workflow was started by it
+	if startedError.StartRequestID == requestID {
+		c.recordRequestStatus(logger, domainName, requestID, persistence.AsyncWorkflowRequestStateStarted, startedError.RunID, "")
+		return
+	}
+	c.recordRequestFailed(logger, domainName, requestID, startedError.Error())
+}
+
+func (c *DefaultConsumer) recordRequestFailed(logger log.Logger, domainName, requestID, reason string) {
+	c.recordRequestStatus(logger, domainName, requestID, persistence.AsyncWorkflowRequestStateFailed, "", reason)
+}
+
+// recordRequestStatus updates the status of a request if the frontend recorded it when it was queued.
+// Failures are logged and never affect processing of the message.
+func (c *DefaultConsumer) recordRequestStatus(
+	logger log.Logger,
+	domainName string,
+	requestID string,
+	state persistence.AsyncWorkflowRequestState,
+	runID string,
+	reason string,
+) {
+	if c.statusManager == nil || requestID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, c.startWFTimeout)
+	defer cancel()
+
+	err := c.updateRequestStatus(ctx, domainName, requestID, state, runID, reason)
+	var notExistsErr *types.EntityNotExistsError
+	if err != nil && !errors.As(err, &notExistsErr) {
+		logger.Warn("Failed to record async workflow request status", tag.WorkflowRequestID(requestID), tag.Error(err))
+	}
+}
+
+func (c *DefaultConsumer) updateRequestStatus(
+	ctx context.Context,
+	domainName string,
+	requestID string,
+	state persistence.AsyncWorkflowRequestState,
+	runID string,
+	reason string,
+) error {
+	domainID, err := c.domainCache.GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+	// requests are only tracked when enabled for the domain. The update only applies to records which exist
+	// and returns EntityNotExistsError otherwise, so untracked requests are never recorded.
+	return c.statusManager.UpdateAsyncWorkflowRequestStatus(ctx, &persistence.UpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        domainID,
+		RequestID:       requestID,
+		RunID:           runID,
+		State:           state,
+		FailureReason:   reason,
+		LastUpdatedTime: c.timeSrc.Now(),
+	})
+}
+
 func callFrontendWithRetries(ctx context.Context, op func(ctx context.Context) error) error {
 	throttleRetry := backoff.NewThrottleRetry(
 		backoff.WithRetryPolicy(common.CreateFrontendServiceRetryPolicy()),
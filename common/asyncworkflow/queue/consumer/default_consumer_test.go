@@ -23,10 +23,13 @@
 package consumer
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc"
 
@@ -34,11 +37,14 @@ import (
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
@@ -383,3 +389,69 @@ func fakeHeaders() *shared.Header {
 		},
 	}
 }
+
+func TestRecordRequestOutcome(t *testing.T) {
+	testTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		resp         *types.StartWorkflowExecutionResponse
+		startedError *types.WorkflowExecutionAlreadyStartedError
+		mockFn       func(*persistence.MockAsyncWorkflowRequestStatusManager)
+	}{
+		"started": {
+			resp: &types.StartWorkflowExecutionResponse{RunID: "run1"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.UpdateAsyncWorkflowRequestStatusRequest) error {
+						assert.Equal(t, "test-domain-id", req.DomainID)
+						assert.Equal(t, "request1", req.RequestID)
+						assert.Equal(t, persistence.AsyncWorkflowRequestStateStarted, req.State)
+						assert.Equal(t, "run1", req.RunID)
+						assert.Equal(t, testTime, req.LastUpdatedTime)
+						return nil
+					})
+			},
+		},
+		"already started by the same request": {
+			startedError: &types.WorkflowExecutionAlreadyStartedError{StartRequestID: "request1", RunID: "run1"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.UpdateAsyncWorkflowRequestStatusRequest) error {
+						assert.Equal(t, persistence.AsyncWorkflowRequestStateStarted, req.State)
+						assert.Equal(t, "run1", req.RunID)
+						return nil
+					})
+			},
+		},
+		"already started by another request": {
+			startedError: &types.WorkflowExecutionAlreadyStartedError{Message: "already started", StartRequestID: "request2", RunID: "run2"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.UpdateAsyncWorkflowRequestStatusRequest) error {
+						assert.Equal(t, persistence.AsyncWorkflowRequestStateFailed, req.State)
+						assert.Empty(t, req.RunID)
+						assert.Contains(t, req.FailureReason, "already started")
+						return nil
+					})
+			},
+		},
+		"request not tracked": {
+			resp: &types.StartWorkflowExecutionResponse{RunID: "run1"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			statusManager := persistence.NewMockAsyncWorkflowRequestStatusManager(ctrl)
+			domainCache := cache.NewMockDomainCache(ctrl)
+			domainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			tc.mockFn(statusManager)
+
+			c := New("queueid1", &fakeMessageConsumer{}, testlogger.New(t), metrics.NewNoopMetricsClient(), frontend.NewMockClient(ctrl),
+				WithRequestStatusManager(statusManager, domainCache), WithTimeSource(clock.NewMockedTimeSourceAt(testTime)))
+			c.recordRequestOutcome(c.logger, "test-domain", "request1", tc.resp, tc.startedError)
+		})
+	}
+}
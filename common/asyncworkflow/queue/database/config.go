@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"time"
+)
+
+const (
+	// queueID is shared by all database queues since they are all stored under persistence.AsyncWorkflowQueueType.
+	// Having a single ID means the worker runs a single consumer no matter how many domains point at the database queue.
+	queueID = "database::asyncworkflow"
+
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 100
+)
+
+type (
+	queueConfig struct {
+		// PollInterval is how often the owning worker host reads new messages from the database
+		PollInterval time.Duration `yaml:"pollInterval"`
+		// BatchSize is the maximum number of messages read at once, it also caps the number of in-flight messages
+		BatchSize int `yaml:"batchSize"`
+	}
+)
+
+func (c *queueConfig) ID() string {
+	return queueID
+}
+
+func (c *queueConfig) setDefaults() {
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultPollInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	// ackLevelName is the key under which the consumer ack level is stored in the queue metadata
+	ackLevelName = "async-workflow-consumer"
+
+	defaultShutdownTimeout = 5 * time.Second
+	dlqEnqueueTimeout      = 5 * time.Second
+)
+
+type (
+	// consumerImpl polls the async workflow queue table and hands messages to the default consumer.
+	// Every worker host runs one, but only the host owning queueID in the worker ring reads messages,
+	// so requests are processed at least once and, outside of ownership changes, exactly once.
+	consumerImpl struct {
+		queueID         string
+		config          *queueConfig
+		queueManager    persistence.QueueManager
+		resolver        membership.Resolver
+		logger          log.Logger
+		timeSrc         clock.TimeSource
+		msgChan         chan messaging.Message
+		ctx             context.Context
+		cancelFn        context.CancelFunc
+		wg              sync.WaitGroup
+		shutdownTimeout time.Duration
+
+		// accessed only by the poll loop
+		owner             bool
+		tracker           *ackTracker
+		persistedAckLevel int64
+	}
+
+	// ackTracker computes the ack level of a consumer while messages are processed out of order.
+	// A new tracker is created whenever the host takes ownership of the queue so acks of
+	// messages read during a previous ownership do not move the new ack level.
+	ackTracker struct {
+		sync.Mutex
+		readLevel int64
+		// pending maps message IDs read above ackLevel to whether they were acked
+		pending map[int64]bool
+	}
+
+	message struct {
+		id       int64
+		payload  []byte
+		tracker  *ackTracker
+		consumer *consumerImpl
+	}
+)
+
+var _ messaging.Consumer = (*consumerImpl)(nil)
+
+func newConsumer(
+	queueID string,
+	config *queueConfig,
+	queueManager persistence.QueueManager,
+	resolver membership.Resolver,
+	logger log.Logger,
+) *consumerImpl {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	return &consumerImpl{
+		queueID:         queueID,
+		config:          config,
+		queueManager:    queueManager,
+		resolver:        resolver,
+		logger:          logger.WithTags(tag.AsyncWFQueueID(queueID)),
+		timeSrc:         clock.NewRealTimeSource(),
+		msgChan:         make(chan messaging.Message),
+		ctx:             ctx,
+		cancelFn:        cancelFn,
+		shutdownTimeout: defaultShutdownTimeout,
+	}
+}
+
+func (c *consumerImpl) Start() error {
+	c.wg.Add(1)
+	go c.run()
+	c.logger.Info("Started database consumer", tag.Dynamic("poll-interval", c.config.PollInterval), tag.Dynamic("batch-size", c.config.BatchSize))
+	return nil
+}
+
+func (c *consumerImpl) Stop() {
+	c.logger.Info("Stopping database consumer")
+	c.cancelFn()
+	if !common.AwaitWaitGroup(&c.wg, c.shutdownTimeout) {
+		c.logger.Warn("Database consumer timed out on shutdown", tag.Dynamic("timeout", c.shutdownTimeout))
+		return
+	}
+	c.logger.Info("Stopped database consumer")
+}
+
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgChan
+}
+
+func (c *consumerImpl) run() {
+	defer c.wg.Done()
+	defer close(c.msgChan)
+
+	ticker := c.timeSrc.NewTicker(c.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.poll()
+
+		select {
+		case <-ticker.Chan():
+		case <-c.ctx.Done():
+			if c.owner {
+				c.persistAckLevel(context.Background())
+			}
+			return
+		}
+	}
+}
+
+func (c *consumerImpl) poll() {
+	if !c.ownsQueue() {
+		if c.owner {
+			c.logger.Info("Database consumer lost queue ownership")
+			c.owner = false
+		}
+		return
+	}
+
+	if !c.owner {
+		if err := c.acquire(); err != nil {
+			c.logger.Error("Failed to load ack level of database queue", tag.Error(err))
+			return
+		}
+	}
+
+	c.persistAckLevel(c.ctx)
+
+	inflight := c.tracker.inflight()
+	if inflight >= c.config.BatchSize {
+		return
+	}
+
+	resp, err := c.queueManager.ReadMessages(c.ctx, &persistence.ReadMessagesRequest{
+		LastMessageID: c.tracker.getReadLevel(),
+		MaxCount:      c.config.BatchSize - inflight,
+	})
+	if err != nil {
+		c.logger.Error("Failed to read messages from database queue", tag.Error(err))
+		return
+	}
+
+	for _, msg := range resp.Messages {
+		c.tracker.add(msg.ID)
+		select {
+		case c.msgChan <- &message{id: msg.ID, payload: msg.Payload, tracker: c.tracker, consumer: c}:
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// acquire starts a new ownership period from the last persisted ack level
+func (c *consumerImpl) acquire() error {
+	resp, err := c.queueManager.GetAckLevels(c.ctx, &persistence.GetAckLevelsRequest{})
+	if err != nil {
+		return err
+	}
+
+	ackLevel, ok := resp.AckLevels[ackLevelName]
+	if !ok {
+		ackLevel = constants.EmptyMessageID
+	}
+	c.tracker = newAckTracker(ackLevel)
+	c.persistedAckLevel = ackLevel
+	c.owner = true
+	c.logger.Info("Database consumer acquired queue ownership", tag.Dynamic("ack-level", ackLevel))
+	return nil
+}
+
+func (c *consumerImpl) persistAckLevel(ctx context.Context) {
+	ackLevel := c.tracker.getAckLevel()
+	if ackLevel <= c.persistedAckLevel {
+		return
+	}
+
+	if err := c.queueManager.UpdateAckLevel(ctx, &persistence.UpdateAckLevelRequest{
+		MessageID:   ackLevel,
+		ClusterName: ackLevelName,
+	}); err != nil {
+		c.logger.Error("Failed to update ack level of database queue", tag.Error(err))
+		return
+	}
+	c.persistedAckLevel = ackLevel
+
+	// the last acked message is kept so that message IDs keep growing after the queue drains
+	if err := c.queueManager.DeleteMessagesBefore(ctx, &persistence.DeleteMessagesBeforeRequest{
+		MessageID: ackLevel,
+	}); err != nil {
+		c.logger.Warn("Failed to delete acked messages from database queue", tag.Error(err))
+	}
+}
+
+func (c *consumerImpl) ownsQueue() bool {
+	if c.resolver == nil {
+		return true
+	}
+
+	owner, err := c.resolver.Lookup(service.Worker, c.queueID)
+	if err != nil {
+		c.logger.Warn("Failed to lookup owner of database queue", tag.Error(err))
+		return false
+	}
+	self, err := c.resolver.WhoAmI()
+	if err != nil {
+		c.logger.Warn("Failed to lookup current host", tag.Error(err))
+		return false
+	}
+	return owner.Identity() == self.Identity()
+}
+
+func newAckTracker(ackLevel int64) *ackTracker {
+	return &ackTracker{
+		readLevel: ackLevel,
+		pending:   make(map[int64]bool),
+	}
+}
+
+func (t *ackTracker) add(id int64) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.pending[id] = false
+	if id > t.readLevel {
+		t.readLevel = id
+	}
+}
+
+func (t *ackTracker) ack(id int64) {
+	t.Lock()
+	defer t.Unlock()
+
+	if _, ok := t.pending[id]; ok {
+		t.pending[id] = true
+	}
+}
+
+func (t *ackTracker) inflight() int {
+	t.Lock()
+	defer t.Unlock()
+
+	count := 0
+	for _, acked := range t.pending {
+		if !acked {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *ackTracker) getReadLevel() int64 {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.readLevel
+}
+
+// getAckLevel moves the ack level up to right before the smallest message which is still being processed
+func (t *ackTracker) getAckLevel() int64 {
+	t.Lock()
+	defer t.Unlock()
+
+	ackLevel := t.readLevel
+	for id, acked := range t.pending {
+		if !acked && id-1 < ackLevel {
+			ackLevel = id - 1
+		}
+	}
+	for id := range t.pending {
+		if id <= ackLevel {
+			delete(t.pending, id)
+		}
+	}
+	return ackLevel
+}
+
+func (m *message) Value() []byte {
+	return m.payload
+}
+
+func (m *message) Partition() int32 {
+	return 0
+}
+
+func (m *message) Offset() int64 {
+	return m.id
+}
+
+func (m *message) Ack() error {
+	m.tracker.ack(m.id)
+	return nil
+}
+
+// Nack moves the message to the DLQ of the async workflow queue so it does not block the ack level
+func (m *message) Nack() error {
+	ctx, cancel := context.WithTimeout(context.Background(), dlqEnqueueTimeout)
+	defer cancel()
+
+	if err := m.consumer.queueManager.EnqueueMessageToDLQ(ctx, &persistence.EnqueueMessageToDLQRequest{
+		MessagePayload: m.payload,
+	}); err != nil {
+		return err
+	}
+	m.tracker.ack(m.id)
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+func TestAckTracker(t *testing.T) {
+	tracker := newAckTracker(0)
+	for _, id := range []int64{1, 2, 3} {
+		tracker.add(id)
+	}
+	assert.Equal(t, int64(3), tracker.getReadLevel())
+	assert.Equal(t, 3, tracker.inflight())
+
+	tracker.ack(2)
+	assert.Equal(t, int64(0), tracker.getAckLevel())
+	assert.Equal(t, 2, tracker.inflight())
+
+	tracker.ack(1)
+	assert.Equal(t, int64(2), tracker.getAckLevel())
+
+	tracker.ack(42) // unknown messages are ignored
+	assert.Equal(t, int64(2), tracker.getAckLevel())
+
+	tracker.ack(3)
+	assert.Equal(t, int64(3), tracker.getAckLevel())
+	assert.Equal(t, 0, tracker.inflight())
+	assert.Empty(t, tracker.pending)
+}
+
+func TestConsumerPoll(t *testing.T) {
+	self := membership.NewHostInfo("self:1234")
+	other := membership.NewHostInfo("other:1234")
+
+	tests := []struct {
+		name  string
+		setup func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver)
+		// run polls the consumer and returns the messages it received
+		run     func(t *testing.T, c *consumerImpl) []messaging.Message
+		wantIDs []int64
+	}{
+		{
+			name: "not owner does not read",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				r.EXPECT().Lookup(service.Worker, queueID).Return(other, nil)
+				r.EXPECT().WhoAmI().Return(self, nil)
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				return drain(c)
+			},
+		},
+		{
+			name: "lookup failure does not read",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				r.EXPECT().Lookup(service.Worker, queueID).Return(membership.HostInfo{}, errors.New("ring not ready"))
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				return drain(c)
+			},
+		},
+		{
+			name: "owner reads from persisted ack level",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				r.EXPECT().Lookup(service.Worker, queueID).Return(self, nil)
+				r.EXPECT().WhoAmI().Return(self, nil)
+				m.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&persistence.GetAckLevelsResponse{
+					AckLevels: map[string]int64{ackLevelName: 5},
+				}, nil)
+				m.EXPECT().ReadMessages(gomock.Any(), &persistence.ReadMessagesRequest{LastMessageID: 5, MaxCount: 10}).Return(&persistence.ReadMessagesResponse{
+					Messages: persistence.QueueMessageList{{ID: 6, Payload: []byte("6")}, {ID: 7, Payload: []byte("7")}},
+				}, nil)
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				return drain(c)
+			},
+			wantIDs: []int64{6, 7},
+		},
+		{
+			name: "acked messages move and persist the ack level",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				r.EXPECT().Lookup(service.Worker, queueID).Return(self, nil).Times(2)
+				r.EXPECT().WhoAmI().Return(self, nil).Times(2)
+				m.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&persistence.GetAckLevelsResponse{}, nil)
+				gomock.InOrder(
+					m.EXPECT().ReadMessages(gomock.Any(), &persistence.ReadMessagesRequest{LastMessageID: -1, MaxCount: 10}).Return(&persistence.ReadMessagesResponse{
+						Messages: persistence.QueueMessageList{{ID: 0}, {ID: 1}, {ID: 2}},
+					}, nil),
+					m.EXPECT().UpdateAckLevel(gomock.Any(), &persistence.UpdateAckLevelRequest{MessageID: 1, ClusterName: ackLevelName}).Return(nil),
+					m.EXPECT().DeleteMessagesBefore(gomock.Any(), &persistence.DeleteMessagesBeforeRequest{MessageID: 1}).Return(nil),
+					m.EXPECT().ReadMessages(gomock.Any(), &persistence.ReadMessagesRequest{LastMessageID: 2, MaxCount: 9}).Return(&persistence.ReadMessagesResponse{}, nil),
+				)
+				m.EXPECT().EnqueueMessageToDLQ(gomock.Any(), &persistence.EnqueueMessageToDLQRequest{}).Return(nil)
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				msgs := drain(c)
+				require.Len(t, msgs, 3)
+				assert.NoError(t, msgs[0].Ack())
+				assert.NoError(t, msgs[1].Nack())
+				c.poll()
+				return msgs
+			},
+			wantIDs: []int64{0, 1, 2},
+		},
+		{
+			name: "queue is full",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				c.config.BatchSize = 1
+				r.EXPECT().Lookup(service.Worker, queueID).Return(self, nil).Times(2)
+				r.EXPECT().WhoAmI().Return(self, nil).Times(2)
+				m.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&persistence.GetAckLevelsResponse{}, nil)
+				m.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadMessagesResponse{
+					Messages: persistence.QueueMessageList{{ID: 0}},
+				}, nil).Times(1)
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				c.poll()
+				return drain(c)
+			},
+			wantIDs: []int64{0},
+		},
+		{
+			name: "regaining ownership reloads the ack level",
+			setup: func(c *consumerImpl, m *persistence.MockQueueManager, r *membership.MockResolver) {
+				gomock.InOrder(
+					r.EXPECT().Lookup(service.Worker, queueID).Return(self, nil),
+					r.EXPECT().Lookup(service.Worker, queueID).Return(other, nil),
+					r.EXPECT().Lookup(service.Worker, queueID).Return(self, nil),
+				)
+				r.EXPECT().WhoAmI().Return(self, nil).Times(3)
+				m.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&persistence.GetAckLevelsResponse{
+					AckLevels: map[string]int64{ackLevelName: 3},
+				}, nil).Times(2)
+				m.EXPECT().ReadMessages(gomock.Any(), &persistence.ReadMessagesRequest{LastMessageID: 3, MaxCount: 10}).Return(&persistence.ReadMessagesResponse{
+					Messages: persistence.QueueMessageList{{ID: 4}},
+				}, nil).Times(2)
+			},
+			run: func(t *testing.T, c *consumerImpl) []messaging.Message {
+				c.poll()
+				c.poll()
+				assert.False(t, c.owner)
+				c.poll()
+				assert.True(t, c.owner)
+				return drain(c)
+			},
+			wantIDs: []int64{4, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queueManager := persistence.NewMockQueueManager(ctrl)
+			resolver := membership.NewMockResolver(ctrl)
+			c := newConsumer(queueID, &queueConfig{PollInterval: time.Second, BatchSize: 10}, queueManager, resolver, testlogger.New(t))
+			c.msgChan = make(chan messaging.Message, 10)
+			tt.setup(c, queueManager, resolver)
+
+			var gotIDs []int64
+			for _, msg := range tt.run(t, c) {
+				gotIDs = append(gotIDs, msg.Offset())
+			}
+			assert.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestConsumerStartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queueManager := persistence.NewMockQueueManager(ctrl)
+	queueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&persistence.GetAckLevelsResponse{}, nil).Times(1)
+	queueManager.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadMessagesResponse{}, nil).MinTimes(1)
+
+	// without a resolver the consumer always owns the queue
+	c := newConsumer(queueID, &queueConfig{PollInterval: time.Millisecond, BatchSize: 10}, queueManager, nil, testlogger.New(t))
+	require.NoError(t, c.Start())
+	c.Stop()
+
+	_, ok := <-c.Messages()
+	assert.False(t, ok, "messages channel should be closed after stop")
+}
+
+func drain(c *consumerImpl) []messaging.Message {
+	var msgs []messaging.Message
+	for {
+		select {
+		case msg := <-c.msgChan:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	decoderImpl struct {
+		blob *types.DataBlob
+	}
+)
+
+func newDecoder(blob *types.DataBlob) provider.Decoder {
+	return &decoderImpl{
+		blob: blob,
+	}
+}
+
+// Decode leaves out untouched when no config is provided since every field of the database queue config has a default
+func (d *decoderImpl) Decode(out any) error {
+	if d.blob == nil || len(d.blob.Data) == 0 {
+		return nil
+	}
+	if d.blob.GetEncodingType() != types.EncodingTypeJSON {
+		return fmt.Errorf("unsupported encoding type %v", d.blob.GetEncodingType())
+	}
+	return json.Unmarshal(d.blob.Data, out)
+}
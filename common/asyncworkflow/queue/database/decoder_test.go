@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name           string
+		blob           *types.DataBlob
+		want           queueConfig
+		wantErr        bool
+		expectedErrMsg string
+	}{
+		{
+			name: "valid JSON encoding",
+			blob: &types.DataBlob{
+				Data:         []byte(`{"batchSize":10}`),
+				EncodingType: types.EncodingTypeJSON.Ptr(),
+			},
+			want: queueConfig{BatchSize: 10},
+		},
+		{
+			name: "nil blob",
+			blob: nil,
+			want: queueConfig{},
+		},
+		{
+			name: "empty data",
+			blob: &types.DataBlob{
+				EncodingType: types.EncodingTypeThriftRW.Ptr(),
+			},
+			want: queueConfig{},
+		},
+		{
+			name: "unsupported encoding type",
+			blob: &types.DataBlob{
+				Data:         []byte("aa"),
+				EncodingType: types.EncodingTypeThriftRW.Ptr(),
+			},
+			wantErr:        true,
+			expectedErrMsg: "unsupported encoding type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder := newDecoder(tt.blob)
+			var got queueConfig
+			err := decoder.Decode(&got)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, tt.expectedErrMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
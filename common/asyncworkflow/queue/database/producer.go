@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	enqueueRetryInitialInterval = 10 * time.Millisecond
+	enqueueRetryMaxAttempts     = 5
+)
+
+type (
+	producerImpl struct {
+		queueManager  persistence.QueueManager
+		msgEncoder    codec.BinaryEncoder
+		throttleRetry *backoff.ThrottleRetry
+		logger        log.Logger
+	}
+)
+
+var _ messaging.Producer = (*producerImpl)(nil)
+
+func newProducer(queueManager persistence.QueueManager, logger log.Logger) messaging.Producer {
+	retryPolicy := backoff.NewExponentialRetryPolicy(enqueueRetryInitialInterval)
+	retryPolicy.SetMaximumAttempts(enqueueRetryMaxAttempts)
+	return &producerImpl{
+		queueManager: queueManager,
+		msgEncoder:   codec.NewThriftRWEncoder(),
+		throttleRetry: backoff.NewThrottleRetry(
+			backoff.WithRetryPolicy(retryPolicy),
+			backoff.WithRetryableError(isEnqueueConflict),
+		),
+		logger: logger.WithTags(tag.AsyncWFQueueID(queueID)),
+	}
+}
+
+// Publish stores the async request in the queue table.
+// Concurrent frontend hosts may race for the same message ID on some databases, those conflicts are retried.
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	request, ok := msg.(*sqlblobs.AsyncRequestMessage)
+	if !ok {
+		return fmt.Errorf("unknown producer message type %T", msg)
+	}
+	payload, err := p.msgEncoder.Encode(request)
+	if err != nil {
+		p.logger.Error("Failed to serialize async request message", tag.Error(err))
+		return err
+	}
+
+	err = p.throttleRetry.Do(ctx, func(ctx context.Context) error {
+		return p.queueManager.EnqueueMessage(ctx, &persistence.EnqueueMessageRequest{MessagePayload: payload})
+	})
+	if err != nil {
+		p.logger.Warn("Failed to enqueue async request message", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func isEnqueueConflict(err error) bool {
+	var conditionFailed *persistence.ConditionFailedError
+	return errors.As(err, &conditionFailed)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestProducerPublish(t *testing.T) {
+	msg := &sqlblobs.AsyncRequestMessage{
+		PartitionKey: common.StringPtr("wid"),
+		Payload:      []byte("payload"),
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(msg)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		msg       interface{}
+		mockSetup func(m *persistence.MockQueueManager)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			msg:  msg,
+			mockSetup: func(m *persistence.MockQueueManager) {
+				m.EXPECT().EnqueueMessage(gomock.Any(), &persistence.EnqueueMessageRequest{MessagePayload: payload}).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "message ID conflict is retried",
+			msg:  msg,
+			mockSetup: func(m *persistence.MockQueueManager) {
+				gomock.InOrder(
+					m.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{Msg: "message ID 1 exists in queue"}).Times(1),
+					m.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name: "other errors are not retried",
+			msg:  msg,
+			mockSetup: func(m *persistence.MockQueueManager) {
+				m.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(errors.New("db down")).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "unknown message type",
+			msg:       "not a request",
+			mockSetup: func(m *persistence.MockQueueManager) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queueManager := persistence.NewMockQueueManager(ctrl)
+			tt.mockSetup(queueManager)
+
+			err := newProducer(queueManager, testlogger.New(t)).Publish(context.Background(), tt.msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
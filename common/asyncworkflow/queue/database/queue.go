@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/consumer"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	queueImpl struct {
+		config *queueConfig
+	}
+)
+
+var errNoQueueManager = errors.New("database async workflow queue requires a persistence queue manager")
+
+func newQueue(decoder provider.Decoder) (provider.Queue, error) {
+	var out queueConfig
+	if err := decoder.Decode(&out); err != nil {
+		return nil, fmt.Errorf("bad config: %w", err)
+	}
+	out.setDefaults()
+	return &queueImpl{
+		config: &out,
+	}, nil
+}
+
+func (q *queueImpl) ID() string {
+	return q.config.ID()
+}
+
+func (q *queueImpl) CreateConsumer(p *provider.Params) (provider.Consumer, error) {
+	if p.QueueManager == nil {
+		return nil, errNoQueueManager
+	}
+	p.Logger.Info("Creating async wf consumer", tag.AsyncWFQueueID(q.ID()))
+	dbConsumer := newConsumer(q.ID(), q.config, p.QueueManager, p.MembershipResolver, p.Logger)
+	return consumer.New(
+		q.ID(),
+		dbConsumer,
+		p.Logger,
+		p.MetricsClient,
+		p.FrontendClient,
+		consumer.WithRequestStatusManager(p.RequestStatusManager, p.DomainCache),
+		consumer.WithTimeSource(p.TimeSource),
+	), nil
+}
+
+func (q *queueImpl) CreateProducer(p *provider.Params) (messaging.Producer, error) {
+	if p.QueueManager == nil {
+		return nil, errNoQueueManager
+	}
+	p.Logger.Info("Creating async wf producer", tag.AsyncWFQueueID(q.ID()))
+	return newProducer(p.QueueManager, p.Logger), nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type mockDecoder struct {
+	decodeFunc func(v any) error
+}
+
+func (m *mockDecoder) Decode(v any) error {
+	return m.decodeFunc(v)
+}
+
+func TestNewQueue(t *testing.T) {
+	tests := []struct {
+		name      string
+		decoder   *mockDecoder
+		want      *queueImpl
+		errString string
+	}{
+		{
+			name: "defaults",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error { return nil },
+			},
+			want: &queueImpl{
+				config: &queueConfig{PollInterval: defaultPollInterval, BatchSize: defaultBatchSize},
+			},
+		},
+		{
+			name: "overrides",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					out := v.(*queueConfig)
+					out.PollInterval = time.Minute
+					out.BatchSize = 5
+					return nil
+				},
+			},
+			want: &queueImpl{
+				config: &queueConfig{PollInterval: time.Minute, BatchSize: 5},
+			},
+		},
+		{
+			name: "decoding failure",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error { return errors.New("decoding error") },
+			},
+			errString: "bad config: decoding error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newQueue(tt.decoder)
+			if tt.errString != "" {
+				assert.EqualError(t, err, tt.errString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, queueID, got.ID())
+			}
+		})
+	}
+}
+
+func TestCreateConsumerAndProducer(t *testing.T) {
+	tests := []struct {
+		name         string
+		queueManager bool
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			queueManager: true,
+		},
+		{
+			name:    "no queue manager",
+			wantErr: errNoQueueManager,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			p := &provider.Params{
+				Logger:        testlogger.New(t),
+				MetricsClient: metrics.NewNoopMetricsClient(),
+			}
+			if tt.queueManager {
+				p.QueueManager = persistence.NewMockQueueManager(ctrl)
+			}
+			q := &queueImpl{config: &queueConfig{PollInterval: defaultPollInterval, BatchSize: defaultBatchSize}}
+
+			consumer, err := q.CreateConsumer(p)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantErr == nil, consumer != nil)
+
+			producer, err := q.CreateProducer(p)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantErr == nil, producer != nil)
+		})
+	}
+}
@@ -28,9 +28,13 @@ import (
 	"fmt"
 
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/syncmap"
 	"github.com/uber/cadence/common/types"
 )
@@ -40,6 +44,19 @@ type (
 		Logger         log.Logger
 		MetricsClient  metrics.Client
 		FrontendClient frontend.Client
+		// QueueManager is the persistence queue used by queue implementations backed by the Cadence database.
+		// It is nil when the host is not configured with one.
+		QueueManager persistence.QueueManager
+		// MembershipResolver is used by consumers which need to pick a single owner among worker hosts.
+		// It is nil for producers.
+		MembershipResolver membership.Resolver
+		// RequestStatusManager is used by consumers to record the outcome of tracked requests.
+		// It is nil for producers and when request tracking is not available.
+		RequestStatusManager persistence.AsyncWorkflowRequestStatusManager
+		// DomainCache resolves domain IDs for RequestStatusManager. It is nil for producers.
+		DomainCache cache.DomainCache
+		// TimeSource is used by consumers to timestamp request status updates. The real clock is used when nil.
+		TimeSource clock.TimeSource
 	}
 
 	Decoder interface {
@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+const (
+	// apiSetPrefix marks an entry of APIPolicy.APIs as the name of a predefined API set
+	apiSetPrefix = "@"
+
+	// apiPolicyCacheSize bounds the number of distinct policy lists kept parsed
+	apiPolicyCacheSize = 1000
+)
+
+// APISets are predefined groups of frontend APIs that can be referenced in an APIPolicy as "@<name>"
+var APISets = map[string][]string{
+	"signal": {
+		"SignalWorkflowExecution",
+		"SignalWithStartWorkflowExecution",
+		"SignalWithStartWorkflowExecutionAsync",
+	},
+	"query": {
+		"QueryWorkflow",
+	},
+	"terminate": {
+		"RequestCancelWorkflowExecution",
+		"TerminateWorkflowExecution",
+	},
+	"reset": {
+		"ResetWorkflowExecution",
+		"RefreshWorkflowTasks",
+	},
+}
+
+// FrontendAPIs are the access controlled frontend APIs that can be referenced by name in an APIPolicy.
+// Domain admin APIs such as RegisterDomain, UpdateDomain and DeleteDomain are left out: a domain policy
+// must not grant access to the APIs that change the domain policies.
+var FrontendAPIs = map[string]struct{}{
+	"BackfillSchedule":                      {},
+	"CountWorkflowExecutions":               {},
+	"CreateSchedule":                        {},
+	"DeleteSchedule":                        {},
+	"DeprecateDomain":                       {},
+	"DescribeAsyncWorkflowRequest":          {},
+	"DescribeDomain":                        {},
+	"DescribeSchedule":                      {},
+	"DescribeTaskList":                      {},
+	"DescribeWorkflowExecution":             {},
+	"FailoverDomain":                        {},
+	"GetTaskListsByDomain":                  {},
+	"GetWorkflowExecutionHistory":           {},
+	"ListArchivedWorkflowExecutions":        {},
+	"ListClosedWorkflowExecutions":          {},
+	"ListOpenWorkflowExecutions":            {},
+	"ListSchedules":                         {},
+	"ListTaskListPartitions":                {},
+	"ListWorkflowExecutions":                {},
+	"PauseActivity":                         {},
+	"PauseSchedule":                         {},
+	"PauseWorkflowExecution":                {},
+	"PollForActivityTask":                   {},
+	"PollForDecisionTask":                   {},
+	"QueryWorkflow":                         {},
+	"RefreshWorkflowTasks":                  {},
+	"RequestCancelWorkflowExecution":        {},
+	"ResetActivity":                         {},
+	"ResetWorkflowExecution":                {},
+	"RestartWorkflowExecution":              {},
+	"ScanWorkflowExecutions":                {},
+	"SignalWithStartWorkflowExecution":      {},
+	"SignalWithStartWorkflowExecutionAsync": {},
+	"SignalWorkflowExecution":               {},
+	"StartWorkflowExecution":                {},
+	"StartWorkflowExecutionAsync":           {},
+	"TerminateWorkflowExecution":            {},
+	"TriggerSchedule":                       {},
+	"UnpauseActivity":                       {},
+	"UnpauseSchedule":                       {},
+	"UnpauseWorkflowExecution":              {},
+	"UpdateActivityOptions":                 {},
+	"UpdateSchedule":                        {},
+}
+
+// APIPolicy grants Groups access to APIs of a domain on top of the read/write/process groups of the domain.
+// Policies are stored as a JSON list in the domain data under constants.DomainDataKeyForAPIPolicies.
+// When Exclusive is set, the APIs of the policy are only accessible to groups granted by policies,
+// even if the caller belongs to the domain's write groups.
+type APIPolicy struct {
+	Groups    []string `json:"groups"`
+	APIs      []string `json:"apis"`
+	Exclusive bool     `json:"exclusive,omitempty"`
+}
+
+// ParseAPIPolicies parses and validates the API policies stored in the domain data
+func ParseAPIPolicies(data map[string]string) ([]APIPolicy, error) {
+	raw, ok := data[constants.DomainDataKeyForAPIPolicies]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var policies []APIPolicy
+	if err := json.Unmarshal([]byte(raw), &policies); err != nil {
+		return nil, fmt.Errorf("invalid %s domain data: %w", constants.DomainDataKeyForAPIPolicies, err)
+	}
+
+	for i, policy := range policies {
+		if len(policy.Groups) == 0 {
+			return nil, fmt.Errorf("invalid %s domain data: policy %d has no groups", constants.DomainDataKeyForAPIPolicies, i)
+		}
+		for _, g := range policy.Groups {
+			if strings.TrimSpace(g) == "" {
+				return nil, fmt.Errorf("invalid %s domain data: policy %d has an empty group", constants.DomainDataKeyForAPIPolicies, i)
+			}
+		}
+		if len(policy.APIs) == 0 {
+			return nil, fmt.Errorf("invalid %s domain data: policy %d has no apis", constants.DomainDataKeyForAPIPolicies, i)
+		}
+		for _, api := range policy.APIs {
+			if !strings.HasPrefix(api, apiSetPrefix) {
+				if _, ok := FrontendAPIs[api]; !ok {
+					return nil, fmt.Errorf("invalid %s domain data: policy %d references unknown api %q", constants.DomainDataKeyForAPIPolicies, i, api)
+				}
+				continue
+			}
+			if _, ok := APISets[strings.TrimPrefix(api, apiSetPrefix)]; !ok {
+				return nil, fmt.Errorf("invalid %s domain data: policy %d references unknown api set %q", constants.DomainDataKeyForAPIPolicies, i, api)
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+// apiPolicyCache keeps the parsed API policies by the raw policies of the domain data,
+// so that they are only parsed again when the domain data changes
+type apiPolicyCache struct {
+	mu      sync.RWMutex
+	entries map[string]parsedAPIPolicies
+}
+
+type parsedAPIPolicies struct {
+	policies []APIPolicy
+	err      error
+}
+
+func newAPIPolicyCache() *apiPolicyCache {
+	return &apiPolicyCache{entries: map[string]parsedAPIPolicies{}}
+}
+
+// get returns the API policies of the domain data, see ParseAPIPolicies
+func (c *apiPolicyCache) get(data map[string]string) ([]APIPolicy, error) {
+	raw := data[constants.DomainDataKeyForAPIPolicies]
+	c.mu.RLock()
+	parsed, ok := c.entries[raw]
+	c.mu.RUnlock()
+	if ok {
+		return parsed.policies, parsed.err
+	}
+
+	parsed.policies, parsed.err = ParseAPIPolicies(data)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= apiPolicyCacheSize {
+		// policies of updated domains are never read again, start over rather than tracking their use
+		c.entries = map[string]parsedAPIPolicies{}
+	}
+	c.entries[raw] = parsed
+	return parsed.policies, parsed.err
+}
+
+// covers returns true if the policy applies to the given API
+func (p APIPolicy) covers(apiName string) bool {
+	for _, api := range p.APIs {
+		if api == apiName {
+			return true
+		}
+		if !strings.HasPrefix(api, apiSetPrefix) {
+			continue
+		}
+		for _, setAPI := range APISets[strings.TrimPrefix(api, apiSetPrefix)] {
+			if setAPI == apiName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evaluateAPIPolicies checks the policies covering the API.
+// granted is true if one of the caller groups is allowed by a policy covering the API,
+// restricted is true if the API is covered by an exclusive policy and must not fall back to domain groups.
+func evaluateAPIPolicies(policies []APIPolicy, apiName string, groups []string) (granted bool, restricted bool) {
+	if apiName == "" {
+		return false, false
+	}
+
+	callerGroups := make(map[string]struct{}, len(groups))
+	for _, g := range groups {
+		callerGroups[g] = struct{}{}
+	}
+
+	for _, policy := range policies {
+		if !policy.covers(apiName) {
+			continue
+		}
+		restricted = restricted || policy.Exclusive
+		for _, g := range policy.Groups {
+			if _, ok := callerGroups[g]; ok {
+				return true, restricted
+			}
+		}
+	}
+
+	return false, restricted
+}
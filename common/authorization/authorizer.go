@@ -145,11 +145,25 @@ func NewFilteredRequestBody(request interface{}) FilteredRequestBody {
 	return &simpleRequestLogWrapper{request}
 }
 
-func validatePermission(claims *JWTClaims, attributes *Attributes, data domainData) error {
+func validatePermission(claims *JWTClaims, attributes *Attributes, data domainData, apiPolicies *apiPolicyCache) error {
 	if (attributes.Permission < PermissionRead) || (attributes.Permission > PermissionProcess) {
 		return fmt.Errorf("permission %v is not supported", attributes.Permission)
 	}
 
+	// per-API policies are checked first: they can grant access to groups without the domain permission level
+	// or restrict an API to specific groups
+	policies, err := apiPolicies.get(data)
+	if err != nil {
+		return err
+	}
+	granted, restricted := evaluateAPIPolicies(policies, attributes.APIName, claims.GetGroups())
+	if granted {
+		return nil
+	}
+	if restricted {
+		return fmt.Errorf("token doesn't have the right permission, api %v is restricted by domain policies, jwt groups: %v", attributes.APIName, claims.GetGroups())
+	}
+
 	allowedGroups := map[string]bool{}
 	// groups that allowed by domain configuration(in domainData)
 	// write groups are always checked
@@ -24,6 +24,7 @@ package authorization
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,16 @@ func Test_validatePermission(t *testing.T) {
 
 	emptyDomainData := domainData{}
 
+	policyDomainData := domainData{
+		constants.DomainDataKeyForReadGroups:  "read1",
+		constants.DomainDataKeyForWriteGroups: "write1",
+		constants.DomainDataKeyForAPIPolicies: `[
+			{"groups": ["oncall"], "apis": ["@terminate", "ResetWorkflowExecution"]},
+			{"groups": ["signaler"], "apis": ["@signal"]},
+			{"groups": ["ops"], "apis": ["TerminateWorkflowExecution"], "exclusive": true}
+		]`,
+	}
+
 	tests := []struct {
 		name       string
 		claims     *JWTClaims
@@ -109,14 +120,165 @@ func Test_validatePermission(t *testing.T) {
 			data:       readWriteDomainData,
 			wantErr:    assert.NoError,
 		},
+		{
+			name:       "Policy grants api to group without write permission",
+			claims:     &JWTClaims{Groups: "oncall"},
+			attributes: &Attributes{APIName: "ResetWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Policy grants api from a predefined set",
+			claims:     &JWTClaims{Groups: "signaler"},
+			attributes: &Attributes{APIName: "SignalWithStartWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Policy doesn't grant apis outside of the policy",
+			claims:     &JWTClaims{Groups: "signaler"},
+			attributes: &Attributes{APIName: "StartWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.Error,
+		},
+		{
+			name:       "Domain groups still apply to apis not restricted by policies",
+			claims:     &JWTClaims{Groups: "write1"},
+			attributes: &Attributes{APIName: "SignalWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Exclusive policy denies write groups",
+			claims:     &JWTClaims{Groups: "write1"},
+			attributes: &Attributes{APIName: "TerminateWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.Error,
+		},
+		{
+			name:       "Exclusive policy allows its groups",
+			claims:     &JWTClaims{Groups: "ops"},
+			attributes: &Attributes{APIName: "TerminateWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Non exclusive policy grants api restricted by another policy",
+			claims:     &JWTClaims{Groups: "oncall"},
+			attributes: &Attributes{APIName: "TerminateWorkflowExecution", Permission: PermissionWrite},
+			data:       policyDomainData,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Invalid policies deny the request",
+			claims:     &JWTClaims{Groups: "write1"},
+			attributes: writeRequestAttr,
+			data:       domainData{constants.DomainDataKeyForWriteGroups: "write1", constants.DomainDataKeyForAPIPolicies: "{"},
+			wantErr:    assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.wantErr(t, validatePermission(tt.claims, tt.attributes, tt.data))
+			tt.wantErr(t, validatePermission(tt.claims, tt.attributes, tt.data, newAPIPolicyCache()))
 		})
 	}
 }
 
+func TestParseAPIPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    []APIPolicy
+		wantErr string
+	}{
+		{
+			name: "no policies",
+			data: map[string]string{},
+		},
+		{
+			name: "valid policies",
+			data: map[string]string{
+				constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["@terminate","ResetWorkflowExecution"],"exclusive":true}]`,
+			},
+			want: []APIPolicy{
+				{Groups: []string{"ops"}, APIs: []string{"@terminate", "ResetWorkflowExecution"}, Exclusive: true},
+			},
+		},
+		{
+			name:    "invalid json",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `{"groups":["ops"]}`},
+			wantErr: "invalid API_POLICIES domain data: json: cannot unmarshal object into Go value of type []authorization.APIPolicy",
+		},
+		{
+			name:    "missing groups",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"apis":["QueryWorkflow"]}]`},
+			wantErr: "invalid API_POLICIES domain data: policy 0 has no groups",
+		},
+		{
+			name:    "empty group",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":[""],"apis":["QueryWorkflow"]}]`},
+			wantErr: "invalid API_POLICIES domain data: policy 0 has an empty group",
+		},
+		{
+			name:    "missing apis",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"]}]`},
+			wantErr: "invalid API_POLICIES domain data: policy 0 has no apis",
+		},
+		{
+			name:    "unknown api set",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["@unknown"]}]`},
+			wantErr: `invalid API_POLICIES domain data: policy 0 references unknown api set "@unknown"`,
+		},
+		{
+			name:    "unknown api",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["TerminateWorkflow"]}]`},
+			wantErr: `invalid API_POLICIES domain data: policy 0 references unknown api "TerminateWorkflow"`,
+		},
+		{
+			name:    "domain admin api",
+			data:    map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["RegisterDomain","DeleteDomain"]}]`},
+			wantErr: `invalid API_POLICIES domain data: policy 0 references unknown api "RegisterDomain"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAPIPolicies(tt.data)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIPolicyCache(t *testing.T) {
+	c := newAPIPolicyCache()
+	data := map[string]string{constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["QueryWorkflow"]}]`}
+	want := []APIPolicy{{Groups: []string{"ops"}, APIs: []string{"QueryWorkflow"}}}
+
+	got, err := c.get(data)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+	got, err = c.get(data)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Len(t, c.entries, 1)
+
+	_, err = c.get(map[string]string{constants.DomainDataKeyForAPIPolicies: "{"})
+	assert.Error(t, err)
+	_, err = c.get(map[string]string{constants.DomainDataKeyForAPIPolicies: "{"})
+	assert.Error(t, err)
+	assert.Len(t, c.entries, 2)
+
+	for i := 0; i < apiPolicyCacheSize; i++ {
+		_, err = c.get(map[string]string{constants.DomainDataKeyForAPIPolicies: fmt.Sprintf(`[{"groups":["g%d"],"apis":["QueryWorkflow"]}]`, i)})
+		assert.NoError(t, err)
+	}
+	assert.LessOrEqual(t, len(c.entries), apiPolicyCacheSize)
+}
+
 func TestSignalWithStartWorkflowExecutionRequestSerializeForLogging(t *testing.T) {
 	tests := map[string]struct {
 		input               interface{}
@@ -71,6 +71,17 @@ func cfgOAuth() config.Authorization {
 	}
 }
 
+func cfgMTLS() config.Authorization {
+	return config.Authorization{
+		MTLSAuthorizer: config.MTLSAuthorizer{
+			Enable: true,
+			Rules: []config.MTLSRule{
+				{Identity: "cadence-worker", Permissions: []string{"write"}, Domains: []string{"*"}},
+			},
+		},
+	}
+}
+
 func (s *factorySuite) TestFactoryNoopAuthorizer() {
 	cfgOAuthVar := cfgOAuth()
 
@@ -83,10 +94,16 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 	}{
 		{cfgNoop(), &nopAuthority{}, nil},
 		{cfgOAuthVar, &oauthAuthority{
-			config:    cfgOAuthVar.OAuthAuthorizer,
-			log:       s.logger,
-			publicKey: publicKey,
-			parser:    jwt.NewParser(jwt.WithValidMethods([]string{cfgOAuthVar.OAuthAuthorizer.JwtCredentials.Algorithm}), jwt.WithIssuedAt()),
+			config:      cfgOAuthVar.OAuthAuthorizer,
+			log:         s.logger,
+			publicKey:   publicKey,
+			parser:      jwt.NewParser(jwt.WithValidMethods([]string{cfgOAuthVar.OAuthAuthorizer.JwtCredentials.Algorithm}), jwt.WithIssuedAt()),
+			apiPolicies: newAPIPolicyCache(),
+		}, nil},
+		{cfgMTLS(), &mtlsAuthority{
+			rules:       cfgMTLS().MTLSAuthorizer.Rules,
+			log:         s.logger,
+			apiPolicies: newAPIPolicyCache(),
 		}, nil},
 	}
 
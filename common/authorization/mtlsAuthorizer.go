@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const allDomains = "*"
+
+type mtlsAuthority struct {
+	rules       []config.MTLSRule
+	domainCache cache.DomainCache
+	log         log.Logger
+	apiPolicies *apiPolicyCache
+}
+
+// NewMTLSAuthorizer creates an Authorizer that derives the caller identity from the verified client certificate.
+// The certificate is only available on gRPC inbounds with TLS enabled, other calls are denied.
+func NewMTLSAuthorizer(
+	mtlsConfig config.MTLSAuthorizer,
+	log log.Logger,
+	domainCache cache.DomainCache,
+) (Authorizer, error) {
+	return &mtlsAuthority{
+		rules:       mtlsConfig.Rules,
+		domainCache: domainCache,
+		log:         log,
+		apiPolicies: newAPIPolicyCache(),
+	}, nil
+}
+
+// Authorize defines the logic to verify the certificate identity against the configured rules
+func (a *mtlsAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	cert, err := peerCertificate(ctx)
+	if err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	rules := a.matchingRules(certificateIdentities(cert))
+	if len(rules) == 0 {
+		a.log.Debug("request is not authorized", tag.Error(errors.New("no rule matches the certificate identity")))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	var groups []string
+	for _, rule := range rules {
+		if rule.Admin || grantsPermission(rule, attributes) {
+			return Result{Decision: DecisionAllow}, nil
+		}
+		groups = append(groups, rule.Groups...)
+	}
+
+	if len(groups) == 0 || attributes.DomainName == "" {
+		a.log.Debug("request is not authorized", tag.Error(errors.New("certificate identity doesn't have the right permission")))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	domain, err := a.domainCache.GetDomain(attributes.DomainName)
+	if err != nil {
+		return Result{Decision: DecisionDeny}, err
+	}
+
+	claims := &JWTClaims{Groups: strings.Join(groups, groupSeparator)}
+	if err := validatePermission(claims, attributes, domain.GetInfo().Data, a.apiPolicies); err != nil {
+		a.log.Debug("request is not authorized", tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+
+	return Result{Decision: DecisionAllow}, nil
+}
+
+func (a *mtlsAuthority) matchingRules(identities []string) []config.MTLSRule {
+	var rules []config.MTLSRule
+	for _, rule := range a.rules {
+		for _, identity := range identities {
+			if matched, _ := path.Match(rule.Identity, identity); matched {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// peerCertificate returns the leaf of the verified client certificate chain of the inbound call
+func peerCertificate(ctx context.Context) (*x509.Certificate, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no peer in the request context")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, errors.New("request is not sent over TLS")
+	}
+
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, errors.New("no verified client certificate")
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0], nil
+}
+
+// certificateIdentities returns the URI SANs, DNS SANs and the subject common name of the certificate
+func certificateIdentities(cert *x509.Certificate) []string {
+	var identities []string
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	identities = append(identities, cert.DNSNames...)
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	return identities
+}
+
+// grantsPermission returns true if the rule directly grants the permission required by the call on its domain.
+// Like domain write groups, write also grants read and process, while admin grants everything.
+func grantsPermission(rule config.MTLSRule, attributes *Attributes) bool {
+	if !ruleAppliesToDomain(rule, attributes.DomainName) {
+		return false
+	}
+
+	for _, p := range rule.Permissions {
+		granted := NewPermission(p)
+		switch {
+		case granted == attributes.Permission:
+			return true
+		case granted == PermissionAdmin:
+			return true
+		case granted == PermissionWrite && attributes.Permission != PermissionAdmin:
+			return true
+		}
+	}
+	return false
+}
+
+func ruleAppliesToDomain(rule config.MTLSRule, domainName string) bool {
+	for _, d := range rule.Domains {
+		if d == allDomains || (domainName != "" && d == domainName) {
+			return true
+		}
+	}
+	return false
+}
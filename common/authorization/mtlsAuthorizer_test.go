@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func contextWithPeerCertificate(cert *x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+				VerifiedChains:   [][]*x509.Certificate{{cert}},
+			},
+		},
+	})
+}
+
+func TestMTLSAuthorizer(t *testing.T) {
+	workerURI, err := url.Parse("spiffe://cluster.local/ns/payments/sa/cadence-worker")
+	require.NoError(t, err)
+
+	workerCert := &x509.Certificate{URIs: []*url.URL{workerURI}}
+	opsCert := &x509.Certificate{Subject: pkix.Name{CommonName: "ops-tool"}}
+	adminCert := &x509.Certificate{DNSNames: []string{"cadence-admin.internal"}}
+	unknownCert := &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}}
+
+	rules := []config.MTLSRule{
+		{Identity: "spiffe://cluster.local/ns/*/sa/cadence-worker", Permissions: []string{"write"}, Domains: []string{"payments"}},
+		{Identity: "ops-tool", Groups: []string{"ops"}},
+		{Identity: "cadence-admin.internal", Admin: true},
+	}
+
+	domainData := map[string]string{
+		constants.DomainDataKeyForReadGroups:  "ops",
+		constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["@terminate"]}]`,
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		attributes     *Attributes
+		domainCacheErr error
+		expectDomain   bool
+		want           Decision
+		wantErr        bool
+	}{
+		{
+			name:       "no peer is denied",
+			ctx:        context.Background(),
+			attributes: &Attributes{DomainName: "payments", Permission: PermissionRead},
+			want:       DecisionDeny,
+		},
+		{
+			name: "unverified certificate is denied",
+			ctx: peer.NewContext(context.Background(), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{adminCert}}},
+			}),
+			attributes: &Attributes{DomainName: "payments", Permission: PermissionRead},
+			want:       DecisionDeny,
+		},
+		{
+			name:       "unknown identity is denied",
+			ctx:        contextWithPeerCertificate(unknownCert),
+			attributes: &Attributes{DomainName: "payments", Permission: PermissionRead},
+			want:       DecisionDeny,
+		},
+		{
+			name:       "admin identity is allowed",
+			ctx:        contextWithPeerCertificate(adminCert),
+			attributes: &Attributes{APIName: "UpdateDomain", DomainName: "payments", Permission: PermissionAdmin},
+			want:       DecisionAllow,
+		},
+		{
+			name:       "write permission allows process on its domain",
+			ctx:        contextWithPeerCertificate(workerCert),
+			attributes: &Attributes{APIName: "PollForDecisionTask", DomainName: "payments", Permission: PermissionProcess},
+			want:       DecisionAllow,
+		},
+		{
+			name:       "write permission doesn't allow admin",
+			ctx:        contextWithPeerCertificate(workerCert),
+			attributes: &Attributes{APIName: "UpdateDomain", DomainName: "payments", Permission: PermissionAdmin},
+			want:       DecisionDeny,
+		},
+		{
+			name:       "permission doesn't apply to other domains",
+			ctx:        contextWithPeerCertificate(workerCert),
+			attributes: &Attributes{APIName: "StartWorkflowExecution", DomainName: "orders", Permission: PermissionWrite},
+			want:       DecisionDeny,
+		},
+		{
+			name:         "groups are checked against domain groups",
+			ctx:          contextWithPeerCertificate(opsCert),
+			attributes:   &Attributes{APIName: "DescribeWorkflowExecution", DomainName: "payments", Permission: PermissionRead},
+			expectDomain: true,
+			want:         DecisionAllow,
+		},
+		{
+			name:         "groups are checked against domain api policies",
+			ctx:          contextWithPeerCertificate(opsCert),
+			attributes:   &Attributes{APIName: "TerminateWorkflowExecution", DomainName: "payments", Permission: PermissionWrite},
+			expectDomain: true,
+			want:         DecisionAllow,
+		},
+		{
+			name:         "groups without permission are denied",
+			ctx:          contextWithPeerCertificate(opsCert),
+			attributes:   &Attributes{APIName: "StartWorkflowExecution", DomainName: "payments", Permission: PermissionWrite},
+			expectDomain: true,
+			want:         DecisionDeny,
+		},
+		{
+			name:           "domain cache error is returned",
+			ctx:            contextWithPeerCertificate(opsCert),
+			attributes:     &Attributes{APIName: "DescribeWorkflowExecution", DomainName: "payments", Permission: PermissionRead},
+			expectDomain:   true,
+			domainCacheErr: errors.New("domain cache error"),
+			want:           DecisionDeny,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domainCache := cache.NewMockDomainCache(gomock.NewController(t))
+			if tt.expectDomain {
+				domainCache.EXPECT().GetDomain(tt.attributes.DomainName).Return(
+					cache.NewLocalDomainCacheEntryForTest(
+						&persistence.DomainInfo{Name: tt.attributes.DomainName, Data: domainData},
+						&persistence.DomainConfig{},
+						"",
+					), tt.domainCacheErr)
+			}
+
+			authorizer, err := NewMTLSAuthorizer(config.MTLSAuthorizer{Enable: true, Rules: rules}, testlogger.New(t), domainCache)
+			require.NoError(t, err)
+
+			result, err := authorizer.Authorize(tt.ctx, tt.attributes)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, result.Decision)
+		})
+	}
+}
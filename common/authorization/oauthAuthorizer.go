@@ -44,6 +44,8 @@ var _ jwt.Claims = (*JWTClaims)(nil)
 const (
 	groupSeparator    = " "
 	jwtInternalIssuer = "internal-jwt"
+
+	jwksRefreshTimeout = 10 * time.Second
 )
 
 type oauthAuthority struct {
@@ -53,6 +55,7 @@ type oauthAuthority struct {
 	parser      *jwt.Parser
 	publicKey   interface{}
 	jwks        *keyfunc.JWKS
+	apiPolicies *apiPolicyCache
 }
 
 // JWTClaims is a Cadence specific claim with embeded Claims defined https://datatracker.ietf.org/doc/html/rfc7519#section-4.1
@@ -94,7 +97,17 @@ func NewOAuthAuthorizer(
 			return nil, fmt.Errorf("JWKSURL is not set")
 		}
 		// Create the JWKS from the resource at the given URL.
-		if jwks, err = keyfunc.Get(oauthConfig.Provider.JWKSURL, keyfunc.Options{}); err != nil {
+		// Keys are re-fetched periodically and whenever a token signed with an unknown kid shows up,
+		// so that keys rotated by the provider are picked up without a restart.
+		if jwks, err = keyfunc.Get(oauthConfig.Provider.JWKSURL, keyfunc.Options{
+			RefreshInterval:   oauthConfig.Provider.GetKeysRefreshInterval(),
+			RefreshRateLimit:  oauthConfig.Provider.GetKeysRefreshRateLimit(),
+			RefreshTimeout:    jwksRefreshTimeout,
+			RefreshUnknownKID: true,
+			RefreshErrorHandler: func(err error) {
+				log.Warn("failed to refresh JWKS", tag.Error(err))
+			},
+		}); err != nil {
 			return nil, fmt.Errorf("creating JWKS from resource: %s error: %w", oauthConfig.Provider.JWKSURL, err)
 		}
 	}
@@ -104,14 +117,29 @@ func NewOAuthAuthorizer(
 		domainCache: domainCache,
 		log:         log,
 		parser: jwt.NewParser(
-			jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Name}),
+			jwt.WithValidMethods(validMethods(oauthConfig)),
 			jwt.WithIssuedAt(),
 		),
-		publicKey: key,
-		jwks:      jwks,
+		publicKey:   key,
+		jwks:        jwks,
+		apiPolicies: newAPIPolicyCache(),
 	}, nil
 }
 
+// validMethods returns signing algorithms accepted for either internal or provider issued tokens
+func validMethods(oauthConfig config.OAuthAuthorizer) []string {
+	methods := []string{jwt.SigningMethodRS256.Name}
+	if oauthConfig.Provider == nil {
+		return methods
+	}
+	for _, alg := range oauthConfig.Provider.GetAlgorithms() {
+		if alg != jwt.SigningMethodRS256.Name {
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
 // Authorize defines the logic to verify get claims from token
 func (a *oauthAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
 	call := yarpc.CallFromContext(ctx)
@@ -156,7 +184,7 @@ func (a *oauthAuthority) Authorize(ctx context.Context, attributes *Attributes)
 		return Result{Decision: DecisionDeny}, err
 	}
 
-	if err := validatePermission(&claims, attributes, domain.GetInfo().Data); err != nil {
+	if err := validatePermission(&claims, attributes, domain.GetInfo().Data, a.apiPolicies); err != nil {
 		a.log.Debug("request is not authorized", tag.Error(err))
 		return Result{Decision: DecisionDeny}, nil
 	}
@@ -172,12 +200,24 @@ func (a *oauthAuthority) keyFunc(token *jwt.Token) (interface{}, error) {
 	// External provider with JWKS provided
 	// https://datatracker.ietf.org/doc/html/rfc7517
 	if a.jwks != nil {
+		if !a.isProviderAlgorithm(token.Method.Alg()) {
+			return nil, fmt.Errorf("algorithm %q is not allowed for provider tokens", token.Method.Alg())
+		}
 		return a.jwks.Keyfunc(token)
 	}
 
 	return nil, errors.New("no public key for verification")
 }
 
+func (a *oauthAuthority) isProviderAlgorithm(alg string) bool {
+	for _, allowed := range a.config.Provider.GetAlgorithms() {
+		if alg == allowed {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
 	// Fill ExpiresAt when TTL is passed
 	if claims.TTL > 0 {
@@ -21,13 +21,25 @@
 package authorization
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc/api/encoding"
@@ -41,6 +53,7 @@ import (
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -468,3 +481,198 @@ func Test_oauthAuthority_parseExternal(t *testing.T) {
 		})
 	}
 }
+
+// testJWKSServer is a local stand-in for an identity provider JWKS endpoint
+type testJWKSServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     []map[string]interface{}
+	requests int
+}
+
+func newTestJWKSServer(t *testing.T) *testJWKSServer {
+	s := &testJWKSServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests++
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"keys": s.keys}))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testJWKSServer) setKeys(keys ...map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *testJWKSServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+type testSigningKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    interface{}
+	jwk    map[string]interface{}
+}
+
+func newTestSigningKey(t *testing.T, kid string, method jwt.SigningMethod) testSigningKey {
+	b64 := base64.RawURLEncoding.EncodeToString
+	jwk := map[string]interface{}{"kid": kid, "alg": method.Alg(), "use": "sig"}
+	var key interface{}
+
+	switch method {
+	case jwt.SigningMethodRS256:
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		jwk["kty"] = "RSA"
+		jwk["n"] = b64(rsaKey.N.Bytes())
+		jwk["e"] = b64(big.NewInt(int64(rsaKey.E)).Bytes())
+		key = rsaKey
+	case jwt.SigningMethodES256:
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		jwk["kty"] = "EC"
+		jwk["crv"] = "P-256"
+		jwk["x"] = b64(ecKey.X.FillBytes(make([]byte, 32)))
+		jwk["y"] = b64(ecKey.Y.FillBytes(make([]byte, 32)))
+		key = ecKey
+	case jwt.SigningMethodEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		jwk["kty"] = "OKP"
+		jwk["crv"] = "Ed25519"
+		jwk["x"] = b64(pub)
+		key = priv
+	default:
+		t.Fatalf("unsupported signing method %v", method.Alg())
+	}
+
+	return testSigningKey{kid: kid, method: method, key: key, jwk: jwk}
+}
+
+func (k testSigningKey) sign(t *testing.T) string {
+	token := jwt.NewWithClaims(k.method, jwt.MapClaims{
+		"sub":       "1234567890",
+		"iss":       "external-idp",
+		"iat":       time.Now().Unix(),
+		"exp":       time.Now().Add(time.Minute).Unix(),
+		"tst_group": "a",
+		"tst_admin": true,
+	})
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func newProviderAuthorizer(t *testing.T, provider *config.OAuthProvider) Authorizer {
+	provider.GroupsAttributePath = "tst_group"
+	provider.AdminAttributePath = "tst_admin"
+	authorizer, err := NewOAuthAuthorizer(config.OAuthAuthorizer{
+		Enable:    true,
+		MaxJwtTTL: 3600,
+		Provider:  provider,
+	}, testlogger.New(t), cache.NewMockDomainCache(gomock.NewController(t)))
+	require.NoError(t, err)
+	t.Cleanup(authorizer.(*oauthAuthority).jwks.EndBackground)
+	return authorizer
+}
+
+func authorizeWithToken(t *testing.T, authorizer Authorizer, token string) Decision {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+	}))
+	result, err := authorizer.Authorize(ctx, &Attributes{DomainName: "test-domain", Permission: PermissionRead})
+	require.NoError(t, err)
+	return result.Decision
+}
+
+func TestOAuthProviderJWKSAlgorithms(t *testing.T) {
+	tests := []struct {
+		name       string
+		algorithms []string
+		method     jwt.SigningMethod
+		want       Decision
+	}{
+		{
+			name:   "RS256 is accepted by default",
+			method: jwt.SigningMethodRS256,
+			want:   DecisionAllow,
+		},
+		{
+			name:       "ES256 is accepted when configured",
+			algorithms: []string{jwt.SigningMethodES256.Name},
+			method:     jwt.SigningMethodES256,
+			want:       DecisionAllow,
+		},
+		{
+			name:       "EdDSA is accepted when configured",
+			algorithms: []string{jwt.SigningMethodEdDSA.Alg()},
+			method:     jwt.SigningMethodEdDSA,
+			want:       DecisionAllow,
+		},
+		{
+			name:   "ES256 is denied when not configured",
+			method: jwt.SigningMethodES256,
+			want:   DecisionDeny,
+		},
+		{
+			name:       "RS256 is denied when only EdDSA is configured",
+			algorithms: []string{jwt.SigningMethodEdDSA.Alg()},
+			method:     jwt.SigningMethodRS256,
+			want:       DecisionDeny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := newTestSigningKey(t, "key-1", tt.method)
+			server := newTestJWKSServer(t)
+			server.setKeys(key.jwk)
+
+			authorizer := newProviderAuthorizer(t, &config.OAuthProvider{
+				JWKSURL:    server.URL,
+				Algorithms: tt.algorithms,
+			})
+
+			assert.Equal(t, tt.want, authorizeWithToken(t, authorizer, key.sign(t)))
+		})
+	}
+}
+
+func TestOAuthProviderJWKSKeyRotation(t *testing.T) {
+	oldKey := newTestSigningKey(t, "key-old", jwt.SigningMethodRS256)
+	newKey := newTestSigningKey(t, "key-new", jwt.SigningMethodES256)
+	unknownKey := newTestSigningKey(t, "key-unknown", jwt.SigningMethodRS256)
+
+	server := newTestJWKSServer(t)
+	server.setKeys(oldKey.jwk)
+
+	authorizer := newProviderAuthorizer(t, &config.OAuthProvider{
+		JWKSURL:              server.URL,
+		Algorithms:           []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name},
+		KeysRefreshRateLimit: time.Millisecond,
+	})
+	assert.Equal(t, 1, server.requestCount())
+	assert.Equal(t, DecisionAllow, authorizeWithToken(t, authorizer, oldKey.sign(t)))
+
+	// provider rotates keys: a token with a kid that is not cached triggers a refresh
+	server.setKeys(newKey.jwk)
+	assert.Equal(t, DecisionAllow, authorizeWithToken(t, authorizer, newKey.sign(t)))
+	assert.Equal(t, 2, server.requestCount())
+
+	// keys removed from the provider are no longer accepted
+	assert.Equal(t, DecisionDeny, authorizeWithToken(t, authorizer, oldKey.sign(t)))
+
+	// keys unknown to the provider are denied
+	assert.Equal(t, DecisionDeny, authorizeWithToken(t, authorizer, unknownKey.sign(t)))
+}
@@ -23,13 +23,19 @@ package config
 import (
 	"errors"
 	"fmt"
+	pathpkg "path"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/yarpc/transport/grpc"
+
+	"github.com/uber/cadence/common/service"
 )
 
 type (
 	Authorization struct {
 		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
+		MTLSAuthorizer  MTLSAuthorizer  `yaml:"mtlsAuthorizer"`
 		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
 	}
 
@@ -47,6 +53,28 @@ type (
 		Provider *OAuthProvider `yaml:"provider"`
 	}
 
+	// MTLSAuthorizer authorizes callers by the identity of their verified client certificate
+	MTLSAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// Rules map certificate identities to permissions, all rules matching the identity are applied
+		Rules []MTLSRule `yaml:"rules"`
+	}
+
+	// MTLSRule maps a certificate identity to permissions
+	MTLSRule struct {
+		// Identity is matched against URI SANs, DNS SANs and the subject common name of the certificate.
+		// Supports path.Match patterns, e.g. "spiffe://cluster.local/ns/*/sa/cadence-worker"
+		Identity string `yaml:"identity"`
+		// Admin grants access to all APIs of all domains
+		Admin bool `yaml:"admin"`
+		// Groups are checked against the groups and API policies stored in the domain data, as JWT groups are
+		Groups []string `yaml:"groups"`
+		// Permissions granted directly on Domains: read, write, process, admin
+		Permissions []string `yaml:"permissions"`
+		// Domains Permissions apply to, "*" means all domains
+		Domains []string `yaml:"domains"`
+	}
+
 	JwtCredentials struct {
 		// support: RS256 (RSA using SHA256)
 		Algorithm string `yaml:"algorithm"`
@@ -59,12 +87,61 @@ type (
 		JWKSURL             string `yaml:"jwksURL"`
 		GroupsAttributePath string `yaml:"groupsAttributePath"`
 		AdminAttributePath  string `yaml:"adminAttributePath"`
+		// Algorithms accepted for tokens signed by the provider
+		// support: RS256, ES256, EdDSA. Defaults to RS256
+		Algorithms []string `yaml:"algorithms"`
+		// KeysRefreshInterval is how often keys are re-fetched from JWKSURL. Defaults to 1h
+		KeysRefreshInterval time.Duration `yaml:"keysRefreshInterval"`
+		// KeysRefreshRateLimit is the minimum time between two fetches triggered by an unknown kid. Defaults to 1m
+		KeysRefreshRateLimit time.Duration `yaml:"keysRefreshRateLimit"`
 	}
 )
 
+const (
+	defaultKeysRefreshInterval  = time.Hour
+	defaultKeysRefreshRateLimit = time.Minute
+)
+
+// SupportedProviderAlgorithms are the signing algorithms accepted for tokens issued by an OAuthProvider
+var SupportedProviderAlgorithms = []string{
+	jwt.SigningMethodRS256.Name,
+	jwt.SigningMethodES256.Name,
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
+// GetAlgorithms returns the configured algorithms or RS256 if none are set
+func (p *OAuthProvider) GetAlgorithms() []string {
+	if len(p.Algorithms) == 0 {
+		return []string{jwt.SigningMethodRS256.Name}
+	}
+	return p.Algorithms
+}
+
+// GetKeysRefreshInterval returns the configured refresh interval or its default
+func (p *OAuthProvider) GetKeysRefreshInterval() time.Duration {
+	if p.KeysRefreshInterval <= 0 {
+		return defaultKeysRefreshInterval
+	}
+	return p.KeysRefreshInterval
+}
+
+// GetKeysRefreshRateLimit returns the configured refresh rate limit or its default
+func (p *OAuthProvider) GetKeysRefreshRateLimit() time.Duration {
+	if p.KeysRefreshRateLimit <= 0 {
+		return defaultKeysRefreshRateLimit
+	}
+	return p.KeysRefreshRateLimit
+}
+
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	for _, enable := range []bool{a.OAuthAuthorizer.Enable, a.MTLSAuthorizer.Enable, a.NoopAuthorizer.Enable} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -74,6 +151,12 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.MTLSAuthorizer.Enable {
+		if err := a.validateMTLS(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -102,7 +185,76 @@ func (a *Authorization) validateOAuth() error {
 		if oauthConfig.Provider.JWKSURL == "" {
 			return fmt.Errorf("[OAuthConfig] JWKSURL is not set")
 		}
+
+		for _, alg := range oauthConfig.Provider.Algorithms {
+			if !isSupportedProviderAlgorithm(alg) {
+				return fmt.Errorf("[OAuthConfig] Provider algorithm %q is not supported, supported: %v", alg, SupportedProviderAlgorithms)
+			}
+		}
 	}
 
 	return nil
 }
+
+func (a *Authorization) validateMTLS() error {
+	for i, rule := range a.MTLSAuthorizer.Rules {
+		if rule.Identity == "" {
+			return fmt.Errorf("[MTLSConfig] rule %d: identity can't be empty", i)
+		}
+		if _, err := pathpkg.Match(rule.Identity, ""); err != nil {
+			return fmt.Errorf("[MTLSConfig] rule %d: invalid identity pattern %q: %w", i, rule.Identity, err)
+		}
+		for _, permission := range rule.Permissions {
+			switch permission {
+			case "read", "write", "process", "admin":
+			default:
+				return fmt.Errorf("[MTLSConfig] rule %d: unknown permission %q", i, permission)
+			}
+		}
+		if len(rule.Permissions) > 0 && len(rule.Domains) == 0 {
+			return fmt.Errorf("[MTLSConfig] rule %d: domains must be set when permissions are granted", i)
+		}
+	}
+	return nil
+}
+
+// validateMTLSTransports rejects configs where the frontend is reached without a client certificate.
+// The mTLS authorizer reads the certificate of gRPC calls only: TChannel and HTTP inbounds don't support TLS,
+// so every call they receive would be denied.
+func (c *Config) validateMTLSTransports() error {
+	if !c.Authorization.MTLSAuthorizer.Enable {
+		return nil
+	}
+
+	if frontend, ok := c.Services[service.ShortName(service.Frontend)]; ok {
+		if !frontend.RPC.TLS.Enabled || !frontend.RPC.TLS.RequireClientAuth {
+			return fmt.Errorf("[MTLSConfig] frontend rpc.tls must be enabled with requireClientAuth")
+		}
+		if frontend.RPC.HTTP != nil {
+			return fmt.Errorf("[MTLSConfig] frontend rpc.http is not supported, client certificates are only read from gRPC calls")
+		}
+	}
+
+	if c.PublicClient.Transport != grpc.TransportName {
+		return fmt.Errorf("[MTLSConfig] publicClient must use the grpc transport, TChannel calls carry no client certificate")
+	}
+
+	if c.ClusterGroupMetadata != nil {
+		for name, cluster := range c.ClusterGroupMetadata.ClusterGroup {
+			if cluster.Enabled && cluster.RPCTransport != grpc.TransportName {
+				return fmt.Errorf("[MTLSConfig] cluster %s must use the grpc rpcTransport, TChannel calls carry no client certificate", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isSupportedProviderAlgorithm(alg string) bool {
+	for _, supported := range SupportedProviderAlgorithms {
+		if alg == supported {
+			return true
+		}
+	}
+	return false
+}
@@ -22,6 +22,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +113,201 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestProviderAlgorithmValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		algorithms []string
+		wantErr    string
+	}{
+		{
+			name: "default algorithms",
+		},
+		{
+			name:       "all supported algorithms",
+			algorithms: []string{"RS256", "ES256", "EdDSA"},
+		},
+		{
+			name:       "unsupported algorithm",
+			algorithms: []string{"ES256", "HS256"},
+			wantErr:    `[OAuthConfig] Provider algorithm "HS256" is not supported, supported: [RS256 ES256 EdDSA]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Authorization{
+				OAuthAuthorizer: OAuthAuthorizer{
+					Enable:    true,
+					MaxJwtTTL: 1000000,
+					Provider: &OAuthProvider{
+						JWKSURL:    "http://localhost/jwks",
+						Algorithms: tt.algorithms,
+					},
+				},
+			}
+
+			err := cfg.Validate()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOAuthProviderDefaults(t *testing.T) {
+	provider := OAuthProvider{}
+	assert.Equal(t, []string{"RS256"}, provider.GetAlgorithms())
+	assert.Equal(t, time.Hour, provider.GetKeysRefreshInterval())
+	assert.Equal(t, time.Minute, provider.GetKeysRefreshRateLimit())
+
+	provider = OAuthProvider{
+		Algorithms:           []string{"EdDSA"},
+		KeysRefreshInterval:  time.Minute,
+		KeysRefreshRateLimit: time.Second,
+	}
+	assert.Equal(t, []string{"EdDSA"}, provider.GetAlgorithms())
+	assert.Equal(t, time.Minute, provider.GetKeysRefreshInterval())
+	assert.Equal(t, time.Second, provider.GetKeysRefreshRateLimit())
+}
+
+func TestMTLSValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Authorization
+		wantErr string
+	}{
+		{
+			name: "valid rules",
+			cfg: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true, Rules: []MTLSRule{
+				{Identity: "spiffe://cluster.local/ns/*/sa/worker", Permissions: []string{"read", "write"}, Domains: []string{"*"}},
+				{Identity: "ops", Groups: []string{"ops"}},
+				{Identity: "admin", Admin: true},
+			}}},
+		},
+		{
+			name: "more than one authorizer",
+			cfg: Authorization{
+				MTLSAuthorizer: MTLSAuthorizer{Enable: true},
+				NoopAuthorizer: NoopAuthorizer{Enable: true},
+			},
+			wantErr: "[AuthorizationConfig] More than one authorizer is enabled",
+		},
+		{
+			name:    "empty identity",
+			cfg:     Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true, Rules: []MTLSRule{{Admin: true}}}},
+			wantErr: "[MTLSConfig] rule 0: identity can't be empty",
+		},
+		{
+			name:    "invalid identity pattern",
+			cfg:     Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true, Rules: []MTLSRule{{Identity: "[", Admin: true}}}},
+			wantErr: `[MTLSConfig] rule 0: invalid identity pattern "[": syntax error in pattern`,
+		},
+		{
+			name: "unknown permission",
+			cfg: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true, Rules: []MTLSRule{
+				{Identity: "worker", Permissions: []string{"execute"}, Domains: []string{"*"}},
+			}}},
+			wantErr: `[MTLSConfig] rule 0: unknown permission "execute"`,
+		},
+		{
+			name: "permissions without domains",
+			cfg: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true, Rules: []MTLSRule{
+				{Identity: "worker", Permissions: []string{"read"}},
+			}}},
+			wantErr: "[MTLSConfig] rule 0: domains must be set when permissions are granted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMTLSTransportValidation(t *testing.T) {
+	mtlsFrontend := Service{RPC: RPC{TLS: TLS{Enabled: true, RequireClientAuth: true}}}
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "mtls authorizer disabled",
+			cfg: Config{
+				Services:     map[string]Service{"frontend": {}},
+				PublicClient: PublicClient{Transport: "tchannel"},
+			},
+		},
+		{
+			name: "grpc with client certificates",
+			cfg: Config{
+				Authorization: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true}},
+				Services:      map[string]Service{"frontend": mtlsFrontend},
+				PublicClient:  PublicClient{Transport: "grpc"},
+				ClusterGroupMetadata: &ClusterGroupMetadata{ClusterGroup: map[string]ClusterInformation{
+					"active":   {Enabled: true, RPCTransport: "grpc"},
+					"disabled": {Enabled: false, RPCTransport: "tchannel"},
+				}},
+			},
+		},
+		{
+			name: "frontend without client auth",
+			cfg: Config{
+				Authorization: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true}},
+				Services:      map[string]Service{"frontend": {RPC: RPC{TLS: TLS{Enabled: true}}}},
+				PublicClient:  PublicClient{Transport: "grpc"},
+			},
+			wantErr: "[MTLSConfig] frontend rpc.tls must be enabled with requireClientAuth",
+		},
+		{
+			name: "frontend http inbound",
+			cfg: Config{
+				Authorization: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true}},
+				Services:      map[string]Service{"frontend": {RPC: RPC{TLS: mtlsFrontend.RPC.TLS, HTTP: &HTTP{Port: 8800}}}},
+				PublicClient:  PublicClient{Transport: "grpc"},
+			},
+			wantErr: "[MTLSConfig] frontend rpc.http is not supported, client certificates are only read from gRPC calls",
+		},
+		{
+			name: "tchannel public client",
+			cfg: Config{
+				Authorization: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true}},
+				Services:      map[string]Service{"frontend": mtlsFrontend},
+				PublicClient:  PublicClient{Transport: "tchannel"},
+			},
+			wantErr: "[MTLSConfig] publicClient must use the grpc transport, TChannel calls carry no client certificate",
+		},
+		{
+			name: "tchannel cluster",
+			cfg: Config{
+				Authorization: Authorization{MTLSAuthorizer: MTLSAuthorizer{Enable: true}},
+				Services:      map[string]Service{"frontend": mtlsFrontend},
+				PublicClient:  PublicClient{Transport: "grpc"},
+				ClusterGroupMetadata: &ClusterGroupMetadata{ClusterGroup: map[string]ClusterInformation{
+					"standby": {Enabled: true, RPCTransport: "tchannel"},
+				}},
+			},
+			wantErr: "[MTLSConfig] cluster standby must use the grpc rpcTransport, TChannel calls carry no client certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validateMTLSTransports()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -213,6 +213,8 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
+		// Migration configures an online migration of the default and visibility stores to other datastores
+		Migration *PersistenceMigration `yaml:"migration"`
 		// TODO: move dynamic config out of static config
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicproperties.IntPropertyFn `yaml:"-" json:"-"`
@@ -223,6 +225,16 @@ type (
 		HostName string `yaml:"-" json:"-"`
 	}
 
+	// PersistenceMigration contains the configuration for copying a live cluster to another datastore.
+	// Which store serves the traffic is controlled through dynamic config, see docs/persistence-migration.md
+	PersistenceMigration struct {
+		// TargetStore is the name of the datastore the DefaultStore data is migrated to
+		TargetStore string `yaml:"targetStore"`
+		// TargetVisibilityStore is the name of the datastore the VisibilityStore data is migrated to.
+		// Optional, db visibility records are not migrated when empty
+		TargetVisibilityStore string `yaml:"targetVisibilityStore"`
+	}
+
 	// DataStore is the configuration for a single datastore
 	DataStore struct {
 		// Cassandra contains the config for a cassandra datastore
@@ -626,6 +638,7 @@ type (
 	// Config is the configuration for the queue provider.
 	// Config types and structures expected in the main default binary include:
 	// - type: "kafka", config: [*github.com/uber/cadence/common/asyncworkflow/queue/kafka.QueueConfig]]]
+	// - type: "database", config: [*github.com/uber/cadence/common/asyncworkflow/queue/database.QueueConfig]]]
 	AsyncWorkflowQueueProvider struct {
 		Type   string     `yaml:"type"`
 		Config *yaml.Node `yaml:"config"`
@@ -669,7 +682,11 @@ func (c *Config) validate() error {
 		return err
 	}
 
-	return c.Authorization.Validate()
+	if err := c.Authorization.Validate(); err != nil {
+		return err
+	}
+
+	return c.validateMTLSTransports()
 }
 
 func (c *Config) fillDefaults() {
@@ -561,3 +561,51 @@ names:
 		assert.ErrorContains(t, err, `unknown histogram-migration metric name "definitely_does_not_exist"`)
 	})
 }
+
+func TestPersistenceMigrationConfig(t *testing.T) {
+	tests := map[string]struct {
+		migration *PersistenceMigration
+		wantErr   string
+	}{
+		"no migration": {},
+		"valid target store": {
+			migration: &PersistenceMigration{TargetStore: "target"},
+		},
+		"missing target store": {
+			migration: &PersistenceMigration{},
+			wantErr:   "persistence migration config: targetStore must be set and differ from defaultStore",
+		},
+		"target store is the default store": {
+			migration: &PersistenceMigration{TargetStore: "default"},
+			wantErr:   "persistence migration config: targetStore must be set and differ from defaultStore",
+		},
+		"unknown target store": {
+			migration: &PersistenceMigration{TargetStore: "unknown"},
+			wantErr:   "persistence config: missing config for datastore unknown",
+		},
+		"target visibility store without db visibility": {
+			migration: &PersistenceMigration{TargetStore: "target", TargetVisibilityStore: "target"},
+			wantErr:   "persistence migration config: targetVisibilityStore requires visibilityStore and must differ from it",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := getValidShardedNoSQLConfig()
+			cfg.Persistence.DataStores["target"] = DataStore{
+				SQL: &SQL{
+					PluginName:   "postgres",
+					DatabaseName: "cadence",
+					ConnectAddr:  "127.0.0.1:5432",
+				},
+			}
+			cfg.Persistence.Migration = tc.migration
+
+			err := cfg.ValidateAndFillDefaults()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
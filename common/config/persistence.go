@@ -85,6 +85,19 @@ func (c *Persistence) Validate() error {
 		useAdvancedVisibilityOnly = true
 	}
 
+	if m := c.Migration; m != nil {
+		if m.TargetStore == "" || m.TargetStore == c.DefaultStore {
+			return fmt.Errorf("persistence migration config: targetStore must be set and differ from defaultStore")
+		}
+		dbStoreKeys = append(dbStoreKeys, m.TargetStore)
+		if m.TargetVisibilityStore != "" {
+			if useAdvancedVisibilityOnly || m.TargetVisibilityStore == c.VisibilityStore {
+				return fmt.Errorf("persistence migration config: targetVisibilityStore requires visibilityStore and must differ from it")
+			}
+			dbStoreKeys = append(dbStoreKeys, m.TargetVisibilityStore)
+		}
+	}
+
 	for _, st := range dbStoreKeys {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -199,3 +212,21 @@ func (c *Persistence) Validate() error {
 func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
 }
+
+// MigrationSource returns a copy of the config without the migration section,
+// i.e. one that only talks to the stores being migrated from
+func (c *Persistence) MigrationSource() Persistence {
+	source := *c
+	source.Migration = nil
+	return source
+}
+
+// MigrationTarget returns a copy of the config that uses the migration target stores as
+// default and visibility stores. It must only be called when Migration is set
+func (c *Persistence) MigrationTarget() Persistence {
+	target := *c
+	target.DefaultStore = c.Migration.TargetStore
+	target.VisibilityStore = c.Migration.TargetVisibilityStore
+	target.Migration = nil
+	return target
+}
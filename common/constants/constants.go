@@ -192,6 +192,8 @@ const (
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
 	// DomainDataKeyForProcessGroups stores which groups have process permission of the domain API
 	DomainDataKeyForProcessGroups = "PROCESS_GROUPS"
+	// DomainDataKeyForAPIPolicies stores per-API authorization policies of the domain as JSON
+	DomainDataKeyForAPIPolicies = "API_POLICIES"
 )
 
 type (
@@ -250,6 +252,22 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+const (
+	// PartitionConfigKeyForTaskPriority is the partition config key holding the priority of the tasks
+	// generated by a workflow, higher priority tasks are dispatched first by matching
+	PartitionConfigKeyForTaskPriority = "task-priority"
+	// MaxTaskPriority is the highest task priority, priorities above it are capped
+	MaxTaskPriority = 5
+	// PartitionConfigKeyForTaskFairnessKey is the partition config key holding the fairness key of the tasks
+	// generated by a workflow, matching dispatches the backlog tasks round robin across fairness keys
+	PartitionConfigKeyForTaskFairnessKey = "task-fairness-key"
+	// MaxTaskFairnessKeyLength is the maximum length of a task fairness key
+	MaxTaskFairnessKeyLength = 255
+)
+
+// MaxCompletionCallbacks is the maximum number of completion callbacks of a workflow execution
+const MaxCompletionCallbacks = 16
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
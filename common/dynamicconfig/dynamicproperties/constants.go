@@ -923,6 +923,13 @@ const (
 	// Default value: 1
 	// Allowed filters: DomainName,TasklistName,TaskType
 	MatchingTaskListMinimumWritePartitions
+	// MatchingPriorityDispatchFairnessInterval is the interval, in dispatched tasks, at which matching ignores task priority
+	// and dispatches the oldest task, so that low priority tasks are not starved. Set to 1 to dispatch tasks in FIFO order
+	// KeyName: matching.priorityDispatchFairnessInterval
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPriorityDispatchFairnessInterval
 
 	// key for history
 
@@ -1305,6 +1312,13 @@ const (
 	// Default value: 10
 	// Allowed filters: N/A
 	NumParentClosePolicySystemWorkflows
+	// CompletionCallbackMaxAttempts is the number of attempts at delivering the completion callbacks of a workflow
+	// before they are written to the history task DLQ
+	// KeyName: history.completionCallbackMaxAttempts
+	// Value type: Int
+	// Default value: 20
+	// Allowed filters: DomainName
+	CompletionCallbackMaxAttempts
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	// KeyName: history.throttledLogRPS
 	// Value type: Int
@@ -1541,6 +1555,30 @@ const (
 	// Default value: 25
 	// Allowed filters: N/A
 	TimersScannerActivityBatchSize
+	// PersistenceMigrationScannerConcurrency is the concurrency of persistence migration scanner
+	// KeyName: worker.persistenceMigrationScannerConcurrency
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	PersistenceMigrationScannerConcurrency
+	// PersistenceMigrationScannerPersistencePageSize is the page size of execution persistence fetches in persistence migration scanner
+	// KeyName: worker.persistenceMigrationScannerPersistencePageSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	PersistenceMigrationScannerPersistencePageSize
+	// PersistenceMigrationScannerBlobstoreFlushThreshold is threshold to flush blob store
+	// KeyName: worker.persistenceMigrationScannerBlobstoreFlushThreshold
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	PersistenceMigrationScannerBlobstoreFlushThreshold
+	// PersistenceMigrationScannerActivityBatchSize is the number of shards handled by a single persistence migration activity
+	// KeyName: worker.persistenceMigrationScannerActivityBatchSize
+	// Value type: Int
+	// Default value: 25
+	// Allowed filters: N/A
+	PersistenceMigrationScannerActivityBatchSize
 	// TimersScannerPeriodStart is interval start for fetching scheduled timers
 	// KeyName: worker.timersScannerPeriodStart
 	// Value type: Int
@@ -1742,6 +1780,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	DisallowQuery
+	// EnableCompletionCallbacks is the key to allow workflows of a domain to register completion callbacks
+	// KeyName: system.enableCompletionCallbacks
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableCompletionCallbacks
 	// EnableDebugMode is for enabling debugging components, logs and metrics
 	// KeyName: system.enableDebugMode
 	// Value type: Bool
@@ -1854,6 +1898,20 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	MatchingEnableReturnAllTaskListKinds
+	// MatchingEnableFairnessKeyDispatch enables round robin dispatch of the backlog tasks across their fairness keys
+	// KeyName: matching.enableFairnessKeyDispatch
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingEnableFairnessKeyDispatch
+	// MatchingEnableBacklogSubqueues enables persisting the backlog tasks having a priority or a fairness key in sub-queues,
+	// read independently of the task list backlog, so that a large backlog doesn't delay the tasks of other priorities and fairness keys.
+	// Disable it only once the sub-queues are drained: sub-queues are not read while it is disabled
+	// KeyName: matching.enableBacklogSubqueues
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingEnableBacklogSubqueues
 
 	// key for history
 
@@ -2173,6 +2231,26 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	TimersFixerDomainAllow
+	// PersistenceMigrationScannerEnabled is if persistence migration scanner should be started as part of worker.Scanner,
+	// it only runs when persistence.migration is configured
+	// KeyName: worker.persistenceMigrationScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	PersistenceMigrationScannerEnabled
+	// PersistenceMigrationFixerEnabled is if persistence migration fixer, which copies executions to the target store,
+	// should be started as part of worker.Scanner
+	// KeyName: worker.persistenceMigrationFixerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	PersistenceMigrationFixerEnabled
+	// PersistenceMigrationFixerDomainAllow is which domains are allowed to be copied by persistence migration fixer workflow
+	// KeyName: worker.persistenceMigrationFixerDomainAllow
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName
+	PersistenceMigrationFixerDomainAllow
 	// ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled
 	// KeyName: worker.concreteExecutionFixerEnabled
 	// Value type: Bool
@@ -2378,6 +2456,13 @@ const (
 	// Allowed filters: DomainName
 	EnableActiveClusterSelectionPolicyInStartWorkflow
 
+	// EnableAsyncWorkflowRequestTracking is to record the status of requests sent through async workflow APIs for a domain
+	// KeyName: frontend.enableAsyncWorkflowRequestTracking
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableAsyncWorkflowRequestTracking
+
 	// EnforceDecisionTaskAttempts is the key for enforcing decision retry attempts limit in case of timeouts.
 	// KeyName: history.enforceDecisionTaskAttempts
 	// Value type: Bool
@@ -2741,6 +2826,23 @@ const (
 	// Allowed filters: ShardID
 	TimerProcessorCachedQueueReaderMode
 
+	// PersistenceMigrationShardMode selects which datastore serves the per-shard data (shards, executions, history)
+	// while migrating to the store configured in persistence.migration.targetStore.
+	// "source": only the current default store is used.
+	// "dual-write": reads go to the source store and writes are mirrored to the target store.
+	// "target": only the target store is used.
+	// KeyName: system.persistenceMigrationShardMode
+	// Value type: string enum: "source", "dual-write", "target"
+	// Default value: "source"
+	// Allowed filters: ShardID
+	PersistenceMigrationShardMode
+	// PersistenceMigrationGlobalMode selects which datastore serves the data that is not owned by a shard
+	// (domains, task lists and db visibility) while migrating to the store configured in persistence.migration.
+	// KeyName: system.persistenceMigrationGlobalMode
+	// Value type: string enum: "source", "dual-write", "target"
+	// Default value: "source"
+	PersistenceMigrationGlobalMode
+
 	// LastStringKey must be the last one in this const group
 	LastStringKey
 )
@@ -2932,6 +3034,12 @@ const (
 	// Default value: 240h (10 days)
 	// Allowed filters: N/A
 	WorkflowTimerTaskCleanupMinTTL
+	// CompletionCallbackTimeout is the timeout of a request delivering a completion callback
+	// KeyName: history.completionCallbackTimeout
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: DomainName
+	CompletionCallbackTimeout
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)before calling remote for missing events
 	// KeyName: history.standbyTaskMissingEventsResendDelay
 	// Value type: Duration
@@ -3333,6 +3441,13 @@ const (
 	// Allowed filters: DomainID
 	DomainAuditLogTTL
 
+	// AsyncWorkflowRequestStatusTTL is the TTL for async workflow request status entries
+	// KeyName: system.asyncWorkflowRequestStatusTTL
+	// Value type: Duration
+	// Default value: 7 days
+	// Allowed filters: DomainID
+	AsyncWorkflowRequestStatusTTL
+
 	// HistoryTaskDLQProcessorInterval is the interval for background processing of the History Task DLQ
 	// KeyName: history.historyTaskDLQProcessorInterval
 	// Value type: Duration
@@ -3400,6 +3515,15 @@ const (
 	// Allowed filters: N/A
 	ValidSearchAttributes
 
+	// key for matching
+
+	// MatchingFairnessKeyWeights is the dispatch weight of each task fairness key, fairness keys not in the map have weight 1
+	// KeyName: matching.fairnessKeyWeights
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	MatchingFairnessKeyWeights
+
 	// key for history
 
 	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler
@@ -3463,6 +3587,14 @@ const (
 	// Allowed filters: N/A
 	RateLimiterBypassCallerTypes
 
+	// CompletionCallbackAllowedHosts is the list of hosts completion callbacks can be delivered to, an entry
+	// starting with "*." also allows the subdomains of the host that follows
+	// KeyName: system.completionCallbackAllowedHosts
+	// Value type: []string
+	// Default value: empty list
+	// Allowed filters: N/A
+	CompletionCallbackAllowedHosts
+
 	// HeaderForwardingRules defines which headers are forwarded from inbound calls to outbound.
 	// This value is only loaded at startup.
 	//
@@ -3977,6 +4109,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingTaskListMinimumWritePartitions is the minimum number of write partitions",
 		DefaultValue: 1,
 	},
+	MatchingPriorityDispatchFairnessInterval: {
+		KeyName:      "matching.priorityDispatchFairnessInterval",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPriorityDispatchFairnessInterval is the interval, in dispatched tasks, at which matching ignores task priority and dispatches the oldest task. Set to 1 to dispatch tasks in FIFO order",
+		DefaultValue: 5,
+	},
 	HistoryRPS: {
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -4298,6 +4436,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total",
 		DefaultValue: 10,
 	},
+	CompletionCallbackMaxAttempts: {
+		KeyName:      "history.completionCallbackMaxAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackMaxAttempts is the number of attempts at delivering the completion callbacks of a workflow before they are written to the history task DLQ",
+		DefaultValue: 20,
+	},
 	HistoryThrottledLogRPS: {
 		KeyName:      "history.throttledLogRPS",
 		Description:  "HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
@@ -4495,6 +4639,26 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TimersScannerActivityBatchSize is TimersScannerActivityBatchSize",
 		DefaultValue: 25,
 	},
+	PersistenceMigrationScannerConcurrency: {
+		KeyName:      "worker.persistenceMigrationScannerConcurrency",
+		Description:  "PersistenceMigrationScannerConcurrency is the concurrency of persistence migration scanner",
+		DefaultValue: 5,
+	},
+	PersistenceMigrationScannerPersistencePageSize: {
+		KeyName:      "worker.persistenceMigrationScannerPersistencePageSize",
+		Description:  "PersistenceMigrationScannerPersistencePageSize is the page size of execution persistence fetches in persistence migration scanner",
+		DefaultValue: 100,
+	},
+	PersistenceMigrationScannerBlobstoreFlushThreshold: {
+		KeyName:      "worker.persistenceMigrationScannerBlobstoreFlushThreshold",
+		Description:  "PersistenceMigrationScannerBlobstoreFlushThreshold is threshold to flush blob store",
+		DefaultValue: 100,
+	},
+	PersistenceMigrationScannerActivityBatchSize: {
+		KeyName:      "worker.persistenceMigrationScannerActivityBatchSize",
+		Description:  "PersistenceMigrationScannerActivityBatchSize is the number of shards handled by a single persistence migration activity",
+		DefaultValue: 25,
+	},
 	TimersScannerPeriodStart: {
 		KeyName:      "worker.timersScannerPeriodStart",
 		Description:  "TimersScannerPeriodStart is interval start for fetching scheduled timers",
@@ -4698,6 +4862,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "DisallowQuery is the key to disallow query for a domain",
 		DefaultValue: false,
 	},
+	EnableCompletionCallbacks: {
+		KeyName:      "system.enableCompletionCallbacks",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableCompletionCallbacks is the key to allow workflows of a local domain to register completion callbacks",
+		DefaultValue: false,
+	},
 	EnableDebugMode: {
 		KeyName:      "system.enableDebugMode",
 		Description:  "EnableDebugMode is for enabling debugging components, logs and metrics",
@@ -4786,6 +4956,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "Returns TaskLists of all kinds when GetTaskListsByDomain is called. Useful in testing Cadence",
 		DefaultValue: false,
 	},
+	MatchingEnableFairnessKeyDispatch: {
+		KeyName:      "matching.enableFairnessKeyDispatch",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableFairnessKeyDispatch enables round robin dispatch of the backlog tasks across their fairness keys",
+		DefaultValue: true,
+	},
+	MatchingEnableBacklogSubqueues: {
+		KeyName:      "matching.enableBacklogSubqueues",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingEnableBacklogSubqueues enables persisting the backlog tasks having a priority or a fairness key in sub-queues, read independently of the task list backlog. Disable it only once the sub-queues are drained: sub-queues are not read while it is disabled",
+		DefaultValue: false,
+	},
 	EventsCacheGlobalEnable: {
 		KeyName:      "history.eventsCacheGlobalEnable",
 		Description:  "EventsCacheGlobalEnable is enables global cache over all history shards",
@@ -5054,6 +5236,22 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "TimersFixerDomainAllow is which domains are allowed to be fixed by timer fixer workflow",
 		DefaultValue: false,
 	},
+	PersistenceMigrationScannerEnabled: {
+		KeyName:      "worker.persistenceMigrationScannerEnabled",
+		Description:  "PersistenceMigrationScannerEnabled is if persistence migration scanner should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	PersistenceMigrationFixerEnabled: {
+		KeyName:      "worker.persistenceMigrationFixerEnabled",
+		Description:  "PersistenceMigrationFixerEnabled is if persistence migration fixer should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	PersistenceMigrationFixerDomainAllow: {
+		KeyName:      "worker.persistenceMigrationFixerDomainAllow",
+		Filters:      []Filter{DomainName},
+		Description:  "PersistenceMigrationFixerDomainAllow is which domains are allowed to be copied by persistence migration fixer workflow",
+		DefaultValue: true,
+	},
 	ConcreteExecutionFixerEnabled: {
 		KeyName:      "worker.concreteExecutionFixerEnabled",
 		Description:  "ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled",
@@ -5226,6 +5424,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		DefaultValue: false,
 		Filters:      []Filter{DomainName},
 	},
+	EnableAsyncWorkflowRequestTracking: {
+		KeyName:      "frontend.enableAsyncWorkflowRequestTracking",
+		Description:  "EnableAsyncWorkflowRequestTracking is to record the status of requests sent through async workflow APIs for a domain",
+		DefaultValue: false,
+		Filters:      []Filter{DomainName},
+	},
 	EnforceDecisionTaskAttempts: {
 		KeyName:      "history.enforceDecisionTaskAttempts",
 		Filters:      []Filter{DomainName},
@@ -5521,6 +5725,17 @@ var StringKeys = map[StringKey]DynamicString{
 		DefaultValue: "disabled",
 		Filters:      []Filter{ShardID},
 	},
+	PersistenceMigrationShardMode: {
+		KeyName:      "system.persistenceMigrationShardMode",
+		Description:  "PersistenceMigrationShardMode selects which datastore serves the per-shard data while migrating persistence: source/dual-write/target",
+		DefaultValue: "source",
+		Filters:      []Filter{ShardID},
+	},
+	PersistenceMigrationGlobalMode: {
+		KeyName:      "system.persistenceMigrationGlobalMode",
+		Description:  "PersistenceMigrationGlobalMode selects which datastore serves domains, task lists and db visibility while migrating persistence: source/dual-write/target",
+		DefaultValue: "source",
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -5708,6 +5923,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "Minimum remaining time before a timer task is worth explicitly deleting at workflow execution cleanup. Timers firing within this window are skipped and will clean up naturally.",
 		DefaultValue: time.Hour * 24 * 10,
 	},
+	CompletionCallbackTimeout: {
+		KeyName:      "history.completionCallbackTimeout",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackTimeout is the timeout of a request delivering a completion callback",
+		DefaultValue: time.Second * 10,
+	},
 	StandbyTaskMissingEventsResendDelay: {
 		KeyName:      "history.standbyTaskMissingEventsResendDelay",
 		Description:  "StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)before calling remote for missing events",
@@ -6049,6 +6270,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "DomainAuditLogTTL is the TTL for domain audit log entries",
 		DefaultValue: time.Hour * 24 * 365, // 1 year
 	},
+	AsyncWorkflowRequestStatusTTL: {
+		KeyName:      "system.asyncWorkflowRequestStatusTTL",
+		Filters:      []Filter{DomainID},
+		Description:  "AsyncWorkflowRequestStatusTTL is the TTL for async workflow request status entries",
+		DefaultValue: time.Hour * 24 * 7,
+	},
 	CorruptionRepairTimeout: {
 		KeyName:      "history.corruptionRepairTimeout",
 		Filters:      []Filter{DomainName},
@@ -6100,6 +6327,12 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "ValidSearchAttributes is legal indexed keys that can be used in list APIs. When overriding, ensure to include the existing default attributes of the current release",
 		DefaultValue: definition.GetDefaultIndexedKeys(),
 	},
+	MatchingFairnessKeyWeights: {
+		KeyName:      "matching.fairnessKeyWeights",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingFairnessKeyWeights is the dispatch weight of each task fairness key, fairness keys not in the map have weight 1",
+		DefaultValue: map[string]interface{}{},
+	},
 	TaskSchedulerRoundRobinWeights: {
 		KeyName:      "history.taskSchedulerRoundRobinWeight",
 		Description:  "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
@@ -6143,6 +6376,11 @@ var ListKeys = map[ListKey]DynamicList{
 		Description:  "List of caller types that bypass rate limiters (both frontend and persistence)",
 		DefaultValue: []interface{}{},
 	},
+	CompletionCallbackAllowedHosts: {
+		KeyName:      "system.completionCallbackAllowedHosts",
+		Description:  "List of hosts completion callbacks can be delivered to, an entry starting with \"*.\" also allows the subdomains of the host that follows",
+		DefaultValue: []interface{}{},
+	},
 	DefaultIsolationGroupConfigStoreManagerGlobalMapping: {
 		KeyName: "system.defaultIsolationGroupConfigStoreManagerGlobalMapping",
 		Description: "A configuration store for global isolation groups - used in isolation-group config only, not normal dynamic config." +
@@ -56,6 +56,10 @@ const (
 
 	// ClientIsolationGroupHeaderName refers to the name of the header that contains the isolation group which the client request is from
 	ClientIsolationGroupHeaderName = "cadence-client-isolation-group"
+	// ClientTaskPriorityHeaderName refers to the name of the header that contains the task priority of the workflow started by the client request
+	ClientTaskPriorityHeaderName = "cadence-client-task-priority"
+	// ClientTaskFairnessKeyHeaderName refers to the name of the header that contains the task fairness key of the workflow started by the client request
+	ClientTaskFairnessKeyHeaderName = "cadence-client-task-fairness-key"
 
 	// CallerTypeHeaderName refers to the name of the header that contains the caller type (CLI, UI, SDK, internal, etc.)
 	CallerTypeHeaderName = types.CallerTypeHeaderName
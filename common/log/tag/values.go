@@ -405,7 +405,15 @@ var (
 	FrontendClientOperationPauseSchedule                         = clientOperation("frontend-pause-schedule")
 	FrontendClientOperationUnpauseSchedule                       = clientOperation("frontend-unpause-schedule")
 	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationTriggerSchedule                       = clientOperation("frontend-trigger-schedule")
 	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
+	FrontendClientOperationDescribeAsyncWorkflowRequest          = clientOperation("frontend-describe-async-workflow-request")
+	FrontendClientOperationPauseWorkflowExecution                = clientOperation("frontend-pause-workflow-execution")
+	FrontendClientOperationUnpauseWorkflowExecution              = clientOperation("frontend-unpause-workflow-execution")
+	FrontendClientOperationPauseActivity                         = clientOperation("frontend-pause-activity")
+	FrontendClientOperationUnpauseActivity                       = clientOperation("frontend-unpause-activity")
+	FrontendClientOperationResetActivity                         = clientOperation("frontend-reset-activity")
+	FrontendClientOperationUpdateActivityOptions                 = clientOperation("frontend-update-activity-options")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -446,6 +454,10 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationPauseActivity                     = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                   = clientOperation("history-unpause-activity")
+	HistoryClientOperationResetActivity                     = clientOperation("history-reset-activity")
+	HistoryClientOperationUpdateActivityOptions             = clientOperation("history-update-activity-options")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persister
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const defaultPageSize = 100
+
+var _ types.Persister = (*sqlPersister)(nil)
+
+type sqlPersister struct {
+	db      sqlplugin.DB
+	queueID string
+	codec   types.ItemCodec
+	logger  log.Logger
+}
+
+// NewSQLPersister returns a persister which stores the items and offsets of a MAPQ queue in the
+// mapq_items and mapq_offsets tables using one of the SQL plugins.
+// Queues can share the tables as long as they use different queue IDs.
+// Item offsets must be unique within a partition.
+func NewSQLPersister(db sqlplugin.DB, queueID string, codec types.ItemCodec, logger log.Logger) types.Persister {
+	return &sqlPersister{
+		db:      db,
+		queueID: queueID,
+		codec:   codec,
+		logger:  logger.WithTags(tag.ComponentMapQ, tag.Dynamic("queue-id", queueID)),
+	}
+}
+
+// Persist writes the items in a single statement so either all or none of them are persisted
+func (p *sqlPersister) Persist(ctx context.Context, items []types.ItemToPersist) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	rows := make([]sqlplugin.MapQItemsRow, 0, len(items))
+	for _, item := range items {
+		data, err := p.codec.Encode(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode item %v: %w", item, err)
+		}
+		rows = append(rows, sqlplugin.MapQItemsRow{
+			QueueID:       p.queueID,
+			PartitionPath: types.PartitionPath(item),
+			ItemOffset:    item.Offset(),
+			Data:          data,
+		})
+	}
+
+	if _, err := p.db.InsertIntoMapQItems(ctx, rows); err != nil {
+		return fmt.Errorf("failed to persist %d items: %w", len(rows), err)
+	}
+	return nil
+}
+
+func (p *sqlPersister) GetOffsets(ctx context.Context) (*types.Offsets, error) {
+	rows, err := p.db.SelectFromMapQOffsets(ctx, p.queueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offsets: %w", err)
+	}
+
+	offsets := &types.Offsets{Partitions: make(map[string]int64, len(rows))}
+	for _, row := range rows {
+		offsets.Partitions[row.PartitionPath] = row.ItemOffset
+	}
+	return offsets, nil
+}
+
+// CommitOffsets records the offsets and deletes the items up to them, which are no longer needed, in a single transaction
+func (p *sqlPersister) CommitOffsets(ctx context.Context, offsets *types.Offsets) error {
+	if offsets == nil || len(offsets.Partitions) == 0 {
+		return nil
+	}
+
+	// sort partitions so that concurrent commits acquire row locks in the same order
+	paths := make([]string, 0, len(offsets.Partitions))
+	for path := range offsets.Partitions {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	rows := make([]sqlplugin.MapQOffsetsRow, 0, len(paths))
+	for _, path := range paths {
+		rows = append(rows, sqlplugin.MapQOffsetsRow{
+			QueueID:       p.queueID,
+			PartitionPath: path,
+			ItemOffset:    offsets.Partitions[path],
+		})
+	}
+
+	tx, err := p.db.BeginTx(ctx, sqlplugin.DbDefaultShard)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := commitOffsets(ctx, tx, rows); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			p.logger.Error("transaction rollback error", tag.Error(rollbackErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func commitOffsets(ctx context.Context, tx sqlplugin.Tx, rows []sqlplugin.MapQOffsetsRow) error {
+	if _, err := tx.ReplaceIntoMapQOffsets(ctx, rows); err != nil {
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+	for _, row := range rows {
+		if _, err := tx.RangeDeleteFromMapQItems(ctx, row.QueueID, row.PartitionPath, row.ItemOffset); err != nil {
+			return fmt.Errorf("failed to delete items of partition %s: %w", row.PartitionPath, err)
+		}
+	}
+	return nil
+}
+
+// Fetch returns the items of the leaf node which the partitions point to, ordered by offset
+func (p *sqlPersister) Fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	pageSize := pageInfo.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	path := types.PartitionPath(partitions)
+	rows, err := p.db.SelectFromMapQItems(ctx, &sqlplugin.MapQItemsFilter{
+		QueueID:       p.queueID,
+		PartitionPath: path,
+		MinItemOffset: pageInfo.AckLevel,
+		PageSize:      pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch items of partition %s: %w", path, err)
+	}
+
+	items := make([]types.Item, 0, len(rows))
+	for _, row := range rows {
+		item, err := p.codec.Decode(row.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode item at offset %d of partition %s: %w", row.ItemOffset, path, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persister
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+)
+
+type testItem struct {
+	Domain string `json:"domain"`
+	Seq    int64  `json:"seq"`
+}
+
+func (i *testItem) GetAttribute(key string) any {
+	switch key {
+	case "domain":
+		return i.Domain
+	default:
+		panic(fmt.Errorf("unknown key: %v", key))
+	}
+}
+
+func (i *testItem) Offset() int64 {
+	return i.Seq
+}
+
+func (i *testItem) String() string {
+	return fmt.Sprintf("testItem{domain: %v, seq: %v}", i.Domain, i.Seq)
+}
+
+type testItemCodec struct{}
+
+func (testItemCodec) Encode(item types.Item) ([]byte, error) {
+	return json.Marshal(&testItem{Domain: item.GetAttribute("domain").(string), Seq: item.Offset()})
+}
+
+func (testItemCodec) Decode(data []byte) (types.Item, error) {
+	var item testItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+type noopConsumerFactory struct{}
+
+func (noopConsumerFactory) New(types.ItemPartitions) (types.Consumer, error) {
+	return noopConsumer{}, nil
+}
+func (noopConsumerFactory) Stop(context.Context) error { return nil }
+
+type noopConsumer struct{}
+
+func (noopConsumer) Start(context.Context) error               { return nil }
+func (noopConsumer) Stop(context.Context) error                { return nil }
+func (noopConsumer) Process(context.Context, types.Item) error { return nil }
+
+func newSQLiteDB(t *testing.T) sqlplugin.DB {
+	t.Helper()
+	cfg := &config.SQL{
+		PluginName:   sqlite.PluginName,
+		DatabaseName: filepath.Join(t.TempDir(), "mapq.db"),
+	}
+
+	adminDB, err := sql.NewSQLAdminDB(cfg)
+	require.NoError(t, err)
+	schemaDB := sql.NewSQLSchemaDB(testlogger.New(t), cfg.DatabaseName, adminDB, sqliteschema.DefaultSchema)
+	defer schemaDB.Close()
+	latest, err := schemaDB.LatestSchema().SkipToLatest()
+	require.NoError(t, err)
+	require.NoError(t, schemaDB.ForceApplySchema(context.Background(), latest))
+
+	db, err := sql.NewSQLDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func domainPartitions(domain string) types.ItemPartitions {
+	return types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": domain})
+}
+
+func TestSQLPersister_EndToEnd(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+	persister := NewSQLPersister(db, "queue1", testItemCodec{}, testlogger.New(t))
+
+	cl, err := mapq.New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		mapq.WithPersister(persister),
+		mapq.WithConsumerFactory(noopConsumerFactory{}),
+		mapq.WithPartitions([]string{"domain"}),
+		mapq.WithPolicies([]types.NodePolicy{
+			{
+				Path:        "*",
+				SplitPolicy: &types.SplitPolicy{PredefinedSplits: []any{"d1"}},
+			},
+			{
+				Path:        "*/.",
+				SplitPolicy: &types.SplitPolicy{Disabled: true},
+			},
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, cl.Start(ctx))
+	defer cl.Stop(ctx)
+
+	_, err = cl.Enqueue(ctx, []types.Item{
+		&testItem{Domain: "d1", Seq: 1},
+		&testItem{Domain: "d2", Seq: 2},
+		&testItem{Domain: "d1", Seq: 3},
+		&testItem{Domain: "d3", Seq: 4},
+		&testItem{Domain: "d1", Seq: 5},
+	})
+	require.NoError(t, err)
+
+	// d1 has its own partition, other domains go to the catch-all partition
+	items, err := persister.Fetch(ctx, domainPartitions("d1"), types.PageInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{
+		&testItem{Domain: "d1", Seq: 1},
+		&testItem{Domain: "d1", Seq: 3},
+		&testItem{Domain: "d1", Seq: 5},
+	}, items)
+
+	items, err = persister.Fetch(ctx, domainPartitions("*"), types.PageInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{
+		&testItem{Domain: "d2", Seq: 2},
+		&testItem{Domain: "d3", Seq: 4},
+	}, items)
+
+	// pagination
+	items, err = persister.Fetch(ctx, domainPartitions("d1"), types.PageInfo{AckLevel: 1, PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{&testItem{Domain: "d1", Seq: 3}}, items)
+
+	// offsets
+	offsets, err := persister.GetOffsets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, offsets.Partitions)
+
+	require.NoError(t, persister.CommitOffsets(ctx, &types.Offsets{Partitions: map[string]int64{"*/d1": 3, "*/*": 2}}))
+	require.NoError(t, persister.CommitOffsets(ctx, &types.Offsets{Partitions: map[string]int64{"*/*": 4}}))
+
+	offsets, err = persister.GetOffsets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"*/d1": 3, "*/*": 4}, offsets.Partitions)
+
+	// committed items are deleted
+	items, err = persister.Fetch(ctx, domainPartitions("d1"), types.PageInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{&testItem{Domain: "d1", Seq: 5}}, items)
+	items, err = persister.Fetch(ctx, domainPartitions("*"), types.PageInfo{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// queues sharing the tables are isolated
+	other := NewSQLPersister(db, "queue2", testItemCodec{}, testlogger.New(t))
+	items, err = other.Fetch(ctx, domainPartitions("d1"), types.PageInfo{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	offsets, err = other.GetOffsets(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, offsets.Partitions)
+}
+
+func TestSQLPersister_PersistIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	persister := NewSQLPersister(newSQLiteDB(t), "queue1", testItemCodec{}, testlogger.New(t))
+	partitions := domainPartitions("d1")
+
+	require.NoError(t, persister.Persist(ctx, []types.ItemToPersist{
+		types.NewItemToPersist(&testItem{Domain: "d1", Seq: 1}, partitions),
+	}))
+	err := persister.Persist(ctx, []types.ItemToPersist{
+		types.NewItemToPersist(&testItem{Domain: "d1", Seq: 2}, partitions),
+		types.NewItemToPersist(&testItem{Domain: "d1", Seq: 1}, partitions),
+	})
+	assert.Error(t, err)
+
+	items, err := persister.Fetch(ctx, partitions, types.PageInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{&testItem{Domain: "d1", Seq: 1}}, items)
+}
+
+func TestSQLPersister_NoOps(t *testing.T) {
+	persister := NewSQLPersister(nil, "queue1", testItemCodec{}, testlogger.New(t))
+	assert.NoError(t, persister.Persist(context.Background(), nil))
+	assert.NoError(t, persister.CommitOffsets(context.Background(), nil))
+	assert.NoError(t, persister.CommitOffsets(context.Background(), &types.Offsets{}))
+}
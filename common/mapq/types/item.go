@@ -24,7 +24,10 @@ package types
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination item_mock.go -package types github.com/uber/cadence/common/mapq/types Item
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Item interface {
 	// GetAttribute returns the value of the attribute key.
@@ -75,6 +78,17 @@ func NewItemPartitions(partitionKeys []string, partitionMap map[string]any) Item
 	}
 }
 
+// PartitionPath returns the path of the leaf node which the partitions point to, e.g. "*/timer/*".
+// It follows the path conventions of the queue tree so it can be used to identify a leaf queue.
+func PartitionPath(p ItemPartitions) string {
+	var sb strings.Builder
+	sb.WriteString("*")
+	for _, k := range p.GetPartitionKeys() {
+		fmt.Fprintf(&sb, "/%v", p.GetPartitionValue(k))
+	}
+	return sb.String()
+}
+
 type defaultItemPartitions struct {
 	partitionKeys []string
 	partitionMap  map[string]any
@@ -79,3 +79,33 @@ func TestNewItemToPersist(t *testing.T) {
 		t.Errorf("itemToPersist.String() = %v, want to contain %v", itemToPersistStr, itemStr)
 	}
 }
+
+func TestPartitionPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		partitions ItemPartitions
+		want       string
+	}{
+		{
+			name:       "root",
+			partitions: NewItemPartitions(nil, nil),
+			want:       "*",
+		},
+		{
+			name: "leaf",
+			partitions: NewItemPartitions(
+				[]string{"type", "sub-type", "domain"},
+				map[string]any{"type": "timer", "sub-type": 4, "domain": "*"},
+			),
+			want: "*/timer/4/*",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PartitionPath(tc.partitions); got != tc.want {
+				t.Errorf("PartitionPath() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -24,5 +24,6 @@ package types
 
 // Offsets encapsulates the whole queue tree state including the offsets of each leaf node
 type Offsets struct {
-	// TODO: complete
+	// Partitions maps the path of each leaf node (see PartitionPath) to the offset of the last item consumed from it
+	Partitions map[string]int64
 }
@@ -34,5 +34,14 @@ type Persister interface {
 }
 
 type PageInfo struct {
-	// TODO: define ack levels, page size, etc.
+	// AckLevel is the offset of the last consumed item. Only items with greater offsets are fetched.
+	AckLevel int64
+	// PageSize is the maximum number of items to fetch
+	PageSize int
+}
+
+// ItemCodec converts items to and from bytes for persisters which store them outside of memory
+type ItemCodec interface {
+	Encode(Item) ([]byte, error)
+	Decode([]byte) (Item, error)
 }
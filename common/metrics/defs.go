@@ -422,6 +422,14 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
@@ -562,8 +570,24 @@ const (
 	FrontendClientUnpauseScheduleScope
 	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
 	FrontendClientBackfillScheduleScope
+	// FrontendClientTriggerScheduleScope tracks RPC calls to frontend service
+	FrontendClientTriggerScheduleScope
 	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
 	FrontendClientListSchedulesScope
+	// FrontendClientDescribeAsyncWorkflowRequestScope tracks RPC calls to frontend service
+	FrontendClientDescribeAsyncWorkflowRequestScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
+	// FrontendClientPauseActivityScope tracks RPC calls to frontend service
+	FrontendClientPauseActivityScope
+	// FrontendClientUnpauseActivityScope tracks RPC calls to frontend service
+	FrontendClientUnpauseActivityScope
+	// FrontendClientResetActivityScope tracks RPC calls to frontend service
+	FrontendClientResetActivityScope
+	// FrontendClientUpdateActivityOptionsScope tracks RPC calls to frontend service
+	FrontendClientUpdateActivityOptionsScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListWorkflowExecutionsScope
 	// FrontendClientScanWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -778,8 +802,24 @@ const (
 	DCRedirectionUnpauseScheduleScope
 	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
 	DCRedirectionBackfillScheduleScope
+	// DCRedirectionTriggerScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionTriggerScheduleScope
 	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
 	DCRedirectionListSchedulesScope
+	// DCRedirectionDescribeAsyncWorkflowRequestScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeAsyncWorkflowRequestScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
+	// DCRedirectionPauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionPauseActivityScope
+	// DCRedirectionUnpauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseActivityScope
+	// DCRedirectionResetActivityScope tracks RPC calls for dc redirection
+	DCRedirectionResetActivityScope
+	// DCRedirectionUpdateActivityOptionsScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateActivityOptionsScope
 	// DCRedirectionForwardingPolicyScope tracks cluster redirection decisions
 	DCRedirectionForwardingPolicyScope
 
@@ -971,6 +1011,9 @@ const (
 	// PersistenceDeleteHistoryDLQTasksScope tracks DeleteTasks calls to the persistence layer
 	PersistenceDeleteHistoryDLQTasksScope
 
+	// PersistenceMigrationScope is the scope used when writes are mirrored to a persistence migration target
+	PersistenceMigrationScope
+
 	NumCommonScopes
 )
 
@@ -1175,8 +1218,24 @@ const (
 	FrontendUnpauseScheduleScope
 	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
 	FrontendBackfillScheduleScope
+	// FrontendTriggerScheduleScope is the metric scope for frontend.TriggerSchedule
+	FrontendTriggerScheduleScope
 	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
 	FrontendListSchedulesScope
+	// FrontendDescribeAsyncWorkflowRequestScope is the metric scope for frontend.DescribeAsyncWorkflowRequest
+	FrontendDescribeAsyncWorkflowRequestScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
+	// FrontendPauseActivityScope is the metric scope for frontend.PauseActivity
+	FrontendPauseActivityScope
+	// FrontendUnpauseActivityScope is the metric scope for frontend.UnpauseActivity
+	FrontendUnpauseActivityScope
+	// FrontendResetActivityScope is the metric scope for frontend.ResetActivity
+	FrontendResetActivityScope
+	// FrontendUpdateActivityOptionsScope is the metric scope for frontend.UpdateActivityOptions
+	FrontendUpdateActivityOptionsScope
 
 	NumFrontendScopes
 )
@@ -1259,6 +1318,14 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope tracks ResetActivity API calls received by service
+	HistoryResetActivityScope
+	// HistoryUpdateActivityOptionsScope tracks UpdateActivityOptions API calls received by service
+	HistoryUpdateActivityOptionsScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
@@ -1303,6 +1370,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferActiveTaskCompletionCallbackScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1327,6 +1396,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferStandbyTaskCompletionCallbackScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerQueueProcessorV2Scope is the scope used by all metric emitted by timer queue processor
@@ -1347,6 +1418,10 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskWorkflowDeadlineScope is the scope used by metric emitted by timer queue processor for processing workflow deadlines.
+	TimerActiveTaskWorkflowDeadlineScope
+	// TimerActiveTaskWorkflowDeadlineTerminateScope is the scope used by metric emitted by timer queue processor for processing workflow deadline terminations.
+	TimerActiveTaskWorkflowDeadlineTerminateScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -1363,6 +1438,10 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskWorkflowDeadlineScope is the scope used by metric emitted by timer queue processor for processing workflow deadlines.
+	TimerStandbyTaskWorkflowDeadlineScope
+	// TimerStandbyTaskWorkflowDeadlineTerminateScope is the scope used by metric emitted by timer queue processor for processing workflow deadline terminations.
+	TimerStandbyTaskWorkflowDeadlineTerminateScope
 	// CrossClusterQueueProcessorScope is the scope used by all metric emitted by cross cluster queue processor in the source cluster
 	CrossClusterQueueProcessorScope
 	// CrossClusterTaskProcessorScope is the scope used by all metric emitted by cross cluster task processor in the target cluster
@@ -1705,6 +1784,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:              {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                     {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                   {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityScope:                     {operation: "HistoryClientResetActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:             {operation: "HistoryClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:             {operation: "HistoryClientNotifyFailoverMarkers", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:              {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope: {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1787,7 +1870,15 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientPauseScheduleScope:                         {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUnpauseScheduleScope:                       {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientBackfillScheduleScope:                      {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientTriggerScheduleScope:                       {operation: "FrontendClientTriggerSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListSchedulesScope:                         {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeAsyncWorkflowRequestScope:          {operation: "FrontendClientDescribeAsyncWorkflowRequest", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:                {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:              {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseActivityScope:                         {operation: "FrontendClientPauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseActivityScope:                       {operation: "FrontendClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResetActivityScope:                         {operation: "FrontendClientResetActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateActivityOptionsScope:                 {operation: "FrontendClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 
 		AdminClientGetReplicationTasksScope:                   {operation: "AdminClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1885,7 +1976,15 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionPauseScheduleScope:                         {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUnpauseScheduleScope:                       {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionBackfillScheduleScope:                      {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTriggerScheduleScope:                       {operation: "DCRedirectionTriggerSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListSchedulesScope:                         {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeAsyncWorkflowRequestScope:          {operation: "DCRedirectionDescribeAsyncWorkflowRequest", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:                {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:              {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseActivityScope:                         {operation: "DCRedirectionPauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseActivityScope:                       {operation: "DCRedirectionUnpauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetActivityScope:                         {operation: "DCRedirectionResetActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateActivityOptionsScope:                 {operation: "DCRedirectionUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionForwardingPolicyScope:                      {operation: "DCRedirectionForwardingPolicy", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
@@ -1976,6 +2075,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PersistenceGetHistoryDLQTasksScope:                  {operation: "GetHistoryDLQTasks"},
 		PersistenceUpdateHistoryDLQAckLevelScope:            {operation: "UpdateHistoryDLQAckLevel"},
 		PersistenceDeleteHistoryDLQTasksScope:               {operation: "DeleteHistoryDLQTasks"},
+		PersistenceMigrationScope:                           {operation: "PersistenceMigration"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -2075,7 +2175,15 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendPauseScheduleScope:                         {operation: "PauseSchedule"},
 		FrontendUnpauseScheduleScope:                       {operation: "UnpauseSchedule"},
 		FrontendBackfillScheduleScope:                      {operation: "BackfillSchedule"},
+		FrontendTriggerScheduleScope:                       {operation: "TriggerSchedule"},
 		FrontendListSchedulesScope:                         {operation: "ListSchedules"},
+		FrontendDescribeAsyncWorkflowRequestScope:          {operation: "DescribeAsyncWorkflowRequest"},
+		FrontendPauseWorkflowExecutionScope:                {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:              {operation: "UnpauseWorkflowExecution"},
+		FrontendPauseActivityScope:                         {operation: "PauseActivity"},
+		FrontendUnpauseActivityScope:                       {operation: "UnpauseActivity"},
+		FrontendResetActivityScope:                         {operation: "ResetActivity"},
+		FrontendUpdateActivityOptionsScope:                 {operation: "UpdateActivityOptions"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
 	},
@@ -2122,6 +2230,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
+		HistoryPauseActivityScope:                                       {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                                     {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                                       {operation: "ResetActivity"},
+		HistoryUpdateActivityOptionsScope:                               {operation: "UpdateActivityOptions"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
@@ -2144,6 +2256,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskCompletionCallbackScope:                       {operation: "TransferActiveTaskCompletionCallback"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -2156,6 +2269,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskCompletionCallbackScope:                      {operation: "TransferStandbyTaskCompletionCallback"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerQueueProcessorV2Scope:                                      {operation: "TimerQueueProcessorV2"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
@@ -2166,6 +2280,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:                             {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:                          {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:                        {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskWorkflowDeadlineScope:                            {operation: "TimerActiveTaskWorkflowDeadline"},
+		TimerActiveTaskWorkflowDeadlineTerminateScope:                   {operation: "TimerActiveTaskWorkflowDeadlineTerminate"},
 		TimerActiveTaskDeleteHistoryEventScope:                          {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:                            {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                            {operation: "TimerStandbyTaskDecisionTimeout"},
@@ -2173,6 +2289,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:                            {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:                         {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:                       {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskWorkflowDeadlineScope:                           {operation: "TimerStandbyTaskWorkflowDeadline"},
+		TimerStandbyTaskWorkflowDeadlineTerminateScope:                  {operation: "TimerStandbyTaskWorkflowDeadlineTerminate"},
 		TimerStandbyTaskDeleteHistoryEventScope:                         {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		CrossClusterQueueProcessorScope:                                 {operation: "CrossClusterQueueProcessor"},
 		CrossClusterTaskProcessorScope:                                  {operation: "CrossClusterTaskProcessor"},
@@ -2359,6 +2477,9 @@ const (
 	NoSQLShardStoreReadFromOriginalColumnCounter
 	NoSQLShardStoreReadFromDataBlobCounter
 
+	PersistenceMigrationMirroredRequests
+	PersistenceMigrationMirrorFailures
+
 	CadenceClientRequests
 	CadenceClientFailures
 	CadenceClientLatency
@@ -3012,6 +3133,15 @@ const (
 	// HistoryTaskDLQPageSizeBytes tracks the serialized byte size of each re-injected DLQ page
 	HistoryTaskDLQPageSizeBytes
 
+	// CompletionCallbackDeliveryFailures counts failed completion callback delivery attempts
+	CompletionCallbackDeliveryFailures
+	// CompletionCallbackDeadLettered counts completion callbacks given up on after exhausting their attempts
+	CompletionCallbackDeadLettered
+	// WorkflowDeadlineCancelRequested counts workflows requested to cancel after exceeding their deadline
+	WorkflowDeadlineCancelRequested
+	// WorkflowDeadlineTerminated counts workflows terminated after the grace period of their deadline
+	WorkflowDeadlineTerminated
+
 	NumHistoryMetrics
 )
 
@@ -3229,6 +3359,8 @@ const (
 	// Scheduler activity metrics
 	// SchedulerFireStartedCountPerDomain measures successfully started target workflows; use trigger_source to differentiate schedule vs backfill rates.
 	SchedulerFireStartedCountPerDomain
+	// SchedulerFireSignaledCountPerDomain measures signals delivered by SignalWorkflow and SignalWithStartWorkflow schedule actions.
+	SchedulerFireSignaledCountPerDomain
 	// SchedulerFireSkippedCountPerDomain measures fires dropped entirely under SkipNew overlap policy.
 	SchedulerFireSkippedCountPerDomain
 	// SchedulerFireBufferedCountPerDomain measures fires deferred for sequential execution under the Buffer overlap policy.
@@ -3332,6 +3464,8 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		PersistenceEmptyResponseCounterPerDomain:                     {metricName: "persistence_empty_response_per_domain", metricRollupName: "persistence_empty_response", metricType: Counter},
 		NoSQLShardStoreReadFromOriginalColumnCounter:                 {metricName: "nosql_shard_store_read_from_original_column", metricType: Counter},
 		NoSQLShardStoreReadFromDataBlobCounter:                       {metricName: "nosql_shard_store_read_from_data_blob", metricType: Counter},
+		PersistenceMigrationMirroredRequests:                         {metricName: "persistence_migration_mirrored_requests", metricType: Counter},
+		PersistenceMigrationMirrorFailures:                           {metricName: "persistence_migration_mirror_failures", metricType: Counter},
 		CadenceClientRequests:                                        {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                                        {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                         {metricName: "cadence_client_latency", metricType: Timer},
@@ -3658,6 +3792,10 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 
 		HistoryTaskDLQReinjectFailuresCounter: {metricName: "history_task_dlq_reinject_failures", metricType: Counter},
 		HistoryTaskDLQPageSizeBytes:           {metricName: "history_task_dlq_page_size_bytes", metricType: Histogram, buckets: ResponsePayloadSizeBuckets},
+		CompletionCallbackDeliveryFailures:    {metricName: "completion_callback_delivery_failures", metricType: Counter},
+		CompletionCallbackDeadLettered:        {metricName: "completion_callback_dead_lettered", metricType: Counter},
+		WorkflowDeadlineCancelRequested:       {metricName: "workflow_deadline_cancel_requested", metricType: Counter},
+		WorkflowDeadlineTerminated:            {metricName: "workflow_deadline_terminated", metricType: Counter},
 
 		TaskBatchCompleteCounter:                                      {metricName: "task_batch_complete_counter", metricType: Counter},
 		TaskBatchCompleteFailure:                                      {metricName: "task_batch_complete_error", metricType: Counter},
@@ -4187,6 +4325,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		SchedulerWorkerLookupFailuresCount:              {metricName: "scheduler_worker_lookup_failures_count", metricType: Counter},
 		SchedulerWorkerDomainCoverageCount:              {metricName: "scheduler_worker_domain_coverage_count", metricType: Counter},
 		SchedulerFireStartedCountPerDomain:              {metricName: "scheduler_fire_started_per_domain", metricType: Counter},
+		SchedulerFireSignaledCountPerDomain:             {metricName: "scheduler_fire_signaled_per_domain", metricType: Counter},
 		SchedulerFireSkippedCountPerDomain:              {metricName: "scheduler_fire_skipped_per_domain", metricType: Counter},
 		SchedulerFireBufferedCountPerDomain:             {metricName: "scheduler_fire_buffered_per_domain", metricType: Counter},
 		SchedulerFireAlreadyRunningCountPerDomain:       {metricName: "scheduler_fire_already_running_per_domain", metricType: Counter},
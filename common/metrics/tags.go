@@ -73,6 +73,7 @@ const (
 	isRetry                   = "is_retry"
 	queryConsistencyLevel     = "query_consistency_level"
 	budgetManagerName         = "budget_manager_name"
+	persistenceOperation      = "persistence_operation"
 
 	// limiter-side tags
 	globalRatelimitKey            = "global_ratelimit_key"
@@ -426,3 +427,8 @@ func QueryConsistencyLevelTag(level string) Tag {
 func BudgetManagerNameTag(name string) Tag {
 	return metricWithUnknown(budgetManagerName, name)
 }
+
+// PersistenceOperationTag returns a new persistence operation tag, e.g. "ExecutionManager.UpdateWorkflowExecution".
+func PersistenceOperationTag(operation string) Tag {
+	return metricWithUnknown(persistenceOperation, operation)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+// maxAsyncWorkflowRequestFailureReasonLength bounds the failure reason kept for a request
+const maxAsyncWorkflowRequestFailureReasonLength = 1024
+
+type (
+	// asyncWorkflowRequestStatusManagerImpl implements AsyncWorkflowRequestStatusManager based on AsyncWorkflowRequestStatusStore
+	asyncWorkflowRequestStatusManagerImpl struct {
+		persistence AsyncWorkflowRequestStatusStore
+		logger      log.Logger
+		timeSrc     clock.TimeSource
+		dc          *DynamicConfiguration
+	}
+)
+
+// NewAsyncWorkflowRequestStatusManagerImpl returns new AsyncWorkflowRequestStatusManager
+func NewAsyncWorkflowRequestStatusManagerImpl(persistence AsyncWorkflowRequestStatusStore, logger log.Logger, dc *DynamicConfiguration) AsyncWorkflowRequestStatusManager {
+	return &asyncWorkflowRequestStatusManagerImpl{
+		persistence: persistence,
+		logger:      logger,
+		timeSrc:     clock.NewRealTimeSource(),
+		dc:          dc,
+	}
+}
+
+func (m *asyncWorkflowRequestStatusManagerImpl) GetName() string {
+	return m.persistence.GetName()
+}
+
+func (m *asyncWorkflowRequestStatusManagerImpl) Close() {
+	m.persistence.Close()
+}
+
+func (m *asyncWorkflowRequestStatusManagerImpl) CreateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *CreateAsyncWorkflowRequestStatusRequest,
+) error {
+	ttl := m.dc.AsyncWorkflowRequestStatusTTL(request.DomainID)
+	return m.persistence.CreateAsyncWorkflowRequestStatus(ctx, &InternalCreateAsyncWorkflowRequestStatusRequest{
+		DomainID:    request.DomainID,
+		RequestID:   request.RequestID,
+		WorkflowID:  request.WorkflowID,
+		CreatedTime: request.CreatedTime.UTC(),
+		TTLSeconds:  int64(ttl.Seconds()),
+		ExpiryTime:  request.CreatedTime.Add(ttl).UTC(),
+	})
+}
+
+func (m *asyncWorkflowRequestStatusManagerImpl) UpdateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *UpdateAsyncWorkflowRequestStatusRequest,
+) error {
+	// the retention is counted from the last update so that the outcome stays visible for the full period
+	ttl := m.dc.AsyncWorkflowRequestStatusTTL(request.DomainID)
+	reason := request.FailureReason
+	if len(reason) > maxAsyncWorkflowRequestFailureReasonLength {
+		reason = reason[:maxAsyncWorkflowRequestFailureReasonLength]
+	}
+	return m.persistence.UpdateAsyncWorkflowRequestStatus(ctx, &InternalUpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        request.DomainID,
+		RequestID:       request.RequestID,
+		State:           request.State,
+		RunID:           request.RunID,
+		FailureReason:   reason,
+		LastUpdatedTime: request.LastUpdatedTime.UTC(),
+		TTLSeconds:      int64(ttl.Seconds()),
+		ExpiryTime:      request.LastUpdatedTime.Add(ttl).UTC(),
+	})
+}
+
+func (m *asyncWorkflowRequestStatusManagerImpl) GetAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *GetAsyncWorkflowRequestStatusRequest,
+) (*GetAsyncWorkflowRequestStatusResponse, error) {
+	resp, err := m.persistence.GetAsyncWorkflowRequestStatus(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	status := resp.Status
+	// stores without native TTL keep expired rows around until they are cleaned up
+	if !status.ExpiryTime.IsZero() && status.ExpiryTime.Before(m.timeSrc.Now()) {
+		return nil, &types.EntityNotExistsError{
+			Message: "async workflow request " + request.RequestID + " not found",
+		}
+	}
+	return &GetAsyncWorkflowRequestStatusResponse{
+		Status: &AsyncWorkflowRequestStatus{
+			DomainID:        status.DomainID,
+			RequestID:       status.RequestID,
+			WorkflowID:      status.WorkflowID,
+			RunID:           status.RunID,
+			State:           status.State,
+			FailureReason:   status.FailureReason,
+			CreatedTime:     status.CreatedTime,
+			LastUpdatedTime: status.LastUpdatedTime,
+		},
+	}, nil
+}
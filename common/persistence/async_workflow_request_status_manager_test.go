@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForAsyncWorkflowRequestStatusManager(t *testing.T) (*asyncWorkflowRequestStatusManagerImpl, *MockAsyncWorkflowRequestStatusStore) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockAsyncWorkflowRequestStatusStore(ctrl)
+	m := &asyncWorkflowRequestStatusManagerImpl{
+		persistence: mockStore,
+		logger:      log.NewNoop(),
+		timeSrc:     clock.NewMockedTimeSourceAt(testTimeNow),
+		dc: &DynamicConfiguration{
+			AsyncWorkflowRequestStatusTTL: func(domainID string) time.Duration { return time.Hour },
+		},
+	}
+	return m, mockStore
+}
+
+func TestCreateAsyncWorkflowRequestStatus(t *testing.T) {
+	m, mockStore := setUpMocksForAsyncWorkflowRequestStatusManager(t)
+	mockStore.EXPECT().CreateAsyncWorkflowRequestStatus(gomock.Any(), &InternalCreateAsyncWorkflowRequestStatusRequest{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		WorkflowID:  "workflow-id",
+		CreatedTime: testTimeNow.UTC(),
+		TTLSeconds:  3600,
+		ExpiryTime:  testTimeNow.Add(time.Hour).UTC(),
+	}).Return(nil)
+
+	err := m.CreateAsyncWorkflowRequestStatus(context.Background(), &CreateAsyncWorkflowRequestStatusRequest{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		WorkflowID:  "workflow-id",
+		CreatedTime: testTimeNow,
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateAsyncWorkflowRequestStatusTruncatesReason(t *testing.T) {
+	m, mockStore := setUpMocksForAsyncWorkflowRequestStatusManager(t)
+	mockStore.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *InternalUpdateAsyncWorkflowRequestStatusRequest) error {
+			assert.Len(t, req.FailureReason, maxAsyncWorkflowRequestFailureReasonLength)
+			assert.Equal(t, AsyncWorkflowRequestStateFailed, req.State)
+			assert.Equal(t, int64(3600), req.TTLSeconds)
+			assert.Equal(t, testTimeNow.Add(time.Hour).UTC(), req.ExpiryTime)
+			return nil
+		})
+
+	err := m.UpdateAsyncWorkflowRequestStatus(context.Background(), &UpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        "domain-id",
+		RequestID:       "request-id",
+		State:           AsyncWorkflowRequestStateFailed,
+		FailureReason:   strings.Repeat("x", 2*maxAsyncWorkflowRequestFailureReasonLength),
+		LastUpdatedTime: testTimeNow,
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetAsyncWorkflowRequestStatus(t *testing.T) {
+	stored := InternalAsyncWorkflowRequestStatus{
+		DomainID:        "domain-id",
+		RequestID:       "request-id",
+		WorkflowID:      "workflow-id",
+		RunID:           "run-id",
+		State:           AsyncWorkflowRequestStateStarted,
+		CreatedTime:     testTimeNow,
+		LastUpdatedTime: testTimeNow,
+	}
+
+	tests := map[string]struct {
+		expiryTime time.Time
+		storeErr   error
+		wantErr    error
+	}{
+		"store with native TTL": {},
+		"not yet expired": {
+			expiryTime: testTimeNow.Add(time.Minute),
+		},
+		"expired": {
+			expiryTime: testTimeNow.Add(-time.Minute),
+			wantErr:    &types.EntityNotExistsError{},
+		},
+		"store error": {
+			storeErr: &types.EntityNotExistsError{},
+			wantErr:  &types.EntityNotExistsError{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m, mockStore := setUpMocksForAsyncWorkflowRequestStatusManager(t)
+			request := &GetAsyncWorkflowRequestStatusRequest{DomainID: "domain-id", RequestID: "request-id"}
+			status := stored
+			status.ExpiryTime = tc.expiryTime
+			if tc.storeErr != nil {
+				mockStore.EXPECT().GetAsyncWorkflowRequestStatus(gomock.Any(), request).Return(nil, tc.storeErr)
+			} else {
+				mockStore.EXPECT().GetAsyncWorkflowRequestStatus(gomock.Any(), request).Return(&InternalGetAsyncWorkflowRequestStatusResponse{Status: &status}, nil)
+			}
+
+			resp, err := m.GetAsyncWorkflowRequestStatus(context.Background(), request)
+			if tc.wantErr != nil {
+				assert.IsType(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &AsyncWorkflowRequestStatus{
+				DomainID:        "domain-id",
+				RequestID:       "request-id",
+				WorkflowID:      "workflow-id",
+				RunID:           "run-id",
+				State:           AsyncWorkflowRequestStateStarted,
+				CreatedTime:     testTimeNow,
+				LastUpdatedTime: testTimeNow,
+			}, resp.Status)
+		})
+	}
+}
@@ -54,6 +54,11 @@ type (
 		GetDomainReplicationQueueManager() persistence.QueueManager
 		SetDomainReplicationQueueManager(persistence.QueueManager)
 
+		GetAsyncWorkflowQueueManager() persistence.QueueManager
+		SetAsyncWorkflowQueueManager(persistence.QueueManager)
+		GetAsyncWorkflowRequestStatusManager() persistence.AsyncWorkflowRequestStatusManager
+		SetAsyncWorkflowRequestStatusManager(persistence.AsyncWorkflowRequestStatusManager)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -72,15 +77,17 @@ type (
 
 	// BeanImpl stores persistence managers
 	BeanImpl struct {
-		domainManager                 persistence.DomainManager
-		domainAuditManager            persistence.DomainAuditManager
-		taskManager                   persistence.TaskManager
-		visibilityManager             persistence.VisibilityManager
-		domainReplicationQueueManager persistence.QueueManager
-		shardManager                  persistence.ShardManager
-		historyManager                persistence.HistoryManager
-		configStoreManager            persistence.ConfigStoreManager
-		historyTaskDLQManager         persistence.HistoryTaskDLQManager
+		domainManager                     persistence.DomainManager
+		domainAuditManager                persistence.DomainAuditManager
+		taskManager                       persistence.TaskManager
+		visibilityManager                 persistence.VisibilityManager
+		domainReplicationQueueManager     persistence.QueueManager
+		asyncWorkflowQueueManager         persistence.QueueManager
+		asyncWorkflowRequestStatusManager persistence.AsyncWorkflowRequestStatusManager
+		shardManager                      persistence.ShardManager
+		historyManager                    persistence.HistoryManager
+		configStoreManager                persistence.ConfigStoreManager
+		historyTaskDLQManager             persistence.HistoryTaskDLQManager
 		// factory is retained only so Close() can tear down the underlying
 		// datastores (see Factory.Close()). The execution manager itself is
 		// constructed eagerly in NewBeanFromFactory, not lazily via factory.
@@ -136,6 +143,16 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	asyncWorkflowQueue, err := factory.NewAsyncWorkflowQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
+	asyncWorkflowRequestStatusMgr, err := factory.NewAsyncWorkflowRequestStatusManager()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -170,6 +187,8 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		domainReplicationQueue,
+		asyncWorkflowQueue,
+		asyncWorkflowRequestStatusMgr,
 		shardMgr,
 		historyMgr,
 		configStoreMgr,
@@ -188,6 +207,8 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	domainReplicationQueueManager persistence.QueueManager,
+	asyncWorkflowQueueManager persistence.QueueManager,
+	asyncWorkflowRequestStatusManager persistence.AsyncWorkflowRequestStatusManager,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	configStoreManager persistence.ConfigStoreManager,
@@ -195,16 +216,18 @@ func NewBean(
 	executionManager persistence.ExecutionManager,
 ) *BeanImpl {
 	return &BeanImpl{
-		domainManager:                 domainManager,
-		domainAuditManager:            domainAuditManager,
-		taskManager:                   taskManager,
-		visibilityManager:             visibilityManager,
-		domainReplicationQueueManager: domainReplicationQueueManager,
-		shardManager:                  shardManager,
-		historyManager:                historyManager,
-		configStoreManager:            configStoreManager,
-		historyTaskDLQManager:         historyTaskDLQManager,
-		executionManager:              executionManager,
+		domainManager:                     domainManager,
+		domainAuditManager:                domainAuditManager,
+		taskManager:                       taskManager,
+		visibilityManager:                 visibilityManager,
+		domainReplicationQueueManager:     domainReplicationQueueManager,
+		asyncWorkflowQueueManager:         asyncWorkflowQueueManager,
+		asyncWorkflowRequestStatusManager: asyncWorkflowRequestStatusManager,
+		shardManager:                      shardManager,
+		historyManager:                    historyManager,
+		configStoreManager:                configStoreManager,
+		historyTaskDLQManager:             historyTaskDLQManager,
+		executionManager:                  executionManager,
 	}
 }
 
@@ -308,6 +331,46 @@ func (s *BeanImpl) SetDomainReplicationQueueManager(
 	s.domainReplicationQueueManager = domainReplicationQueueManager
 }
 
+// GetAsyncWorkflowQueueManager gets async workflow QueueManager
+func (s *BeanImpl) GetAsyncWorkflowQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.asyncWorkflowQueueManager
+}
+
+// SetAsyncWorkflowQueueManager sets async workflow QueueManager
+func (s *BeanImpl) SetAsyncWorkflowQueueManager(
+	asyncWorkflowQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.asyncWorkflowQueueManager = asyncWorkflowQueueManager
+}
+
+// GetAsyncWorkflowRequestStatusManager gets AsyncWorkflowRequestStatusManager
+func (s *BeanImpl) GetAsyncWorkflowRequestStatusManager() persistence.AsyncWorkflowRequestStatusManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.asyncWorkflowRequestStatusManager
+}
+
+// SetAsyncWorkflowRequestStatusManager sets AsyncWorkflowRequestStatusManager
+func (s *BeanImpl) SetAsyncWorkflowRequestStatusManager(
+	asyncWorkflowRequestStatusManager persistence.AsyncWorkflowRequestStatusManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.asyncWorkflowRequestStatusManager = asyncWorkflowRequestStatusManager
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -424,6 +487,12 @@ func (s *BeanImpl) Close() {
 		s.visibilityManager.Close()
 	}
 	s.domainReplicationQueueManager.Close()
+	if s.asyncWorkflowQueueManager != nil {
+		s.asyncWorkflowQueueManager.Close()
+	}
+	if s.asyncWorkflowRequestStatusManager != nil {
+		s.asyncWorkflowRequestStatusManager.Close()
+	}
 	s.shardManager.Close()
 	s.historyManager.Close()
 	if s.factory != nil {
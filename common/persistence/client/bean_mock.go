@@ -53,6 +53,34 @@ func (mr *MockBeanMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBean)(nil).Close))
 }
 
+// GetAsyncWorkflowQueueManager mocks base method.
+func (m *MockBean) GetAsyncWorkflowQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncWorkflowQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetAsyncWorkflowQueueManager indicates an expected call of GetAsyncWorkflowQueueManager.
+func (mr *MockBeanMockRecorder) GetAsyncWorkflowQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncWorkflowQueueManager", reflect.TypeOf((*MockBean)(nil).GetAsyncWorkflowQueueManager))
+}
+
+// GetAsyncWorkflowRequestStatusManager mocks base method.
+func (m *MockBean) GetAsyncWorkflowRequestStatusManager() persistence.AsyncWorkflowRequestStatusManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncWorkflowRequestStatusManager")
+	ret0, _ := ret[0].(persistence.AsyncWorkflowRequestStatusManager)
+	return ret0
+}
+
+// GetAsyncWorkflowRequestStatusManager indicates an expected call of GetAsyncWorkflowRequestStatusManager.
+func (mr *MockBeanMockRecorder) GetAsyncWorkflowRequestStatusManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncWorkflowRequestStatusManager", reflect.TypeOf((*MockBean)(nil).GetAsyncWorkflowRequestStatusManager))
+}
+
 // GetConfigStoreManager mocks base method.
 func (m *MockBean) GetConfigStoreManager() persistence.ConfigStoreManager {
 	m.ctrl.T.Helper()
@@ -193,6 +221,30 @@ func (mr *MockBeanMockRecorder) GetVisibilityManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityManager", reflect.TypeOf((*MockBean)(nil).GetVisibilityManager))
 }
 
+// SetAsyncWorkflowQueueManager mocks base method.
+func (m *MockBean) SetAsyncWorkflowQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAsyncWorkflowQueueManager", arg0)
+}
+
+// SetAsyncWorkflowQueueManager indicates an expected call of SetAsyncWorkflowQueueManager.
+func (mr *MockBeanMockRecorder) SetAsyncWorkflowQueueManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWorkflowQueueManager", reflect.TypeOf((*MockBean)(nil).SetAsyncWorkflowQueueManager), arg0)
+}
+
+// SetAsyncWorkflowRequestStatusManager mocks base method.
+func (m *MockBean) SetAsyncWorkflowRequestStatusManager(arg0 persistence.AsyncWorkflowRequestStatusManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAsyncWorkflowRequestStatusManager", arg0)
+}
+
+// SetAsyncWorkflowRequestStatusManager indicates an expected call of SetAsyncWorkflowRequestStatusManager.
+func (mr *MockBeanMockRecorder) SetAsyncWorkflowRequestStatusManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncWorkflowRequestStatusManager", reflect.TypeOf((*MockBean)(nil).SetAsyncWorkflowRequestStatusManager), arg0)
+}
+
 // SetConfigStoreManager mocks base method.
 func (m *MockBean) SetConfigStoreManager(arg0 persistence.ConfigStoreManager) {
 	m.ctrl.T.Helper()
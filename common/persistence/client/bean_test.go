@@ -42,6 +42,8 @@ type beanmocks struct {
 	taskManager           *persistence.MockTaskManager
 	visibilityManager     *persistence.MockVisibilityManager
 	replicationManager    *persistence.MockQueueManager
+	asyncWorkflowManager  *persistence.MockQueueManager
+	asyncStatusManager    *persistence.MockAsyncWorkflowRequestStatusManager
 	shardManager          *persistence.MockShardManager
 	historyManager        *persistence.MockHistoryManager
 	configManager         *persistence.MockConfigStoreManager
@@ -58,6 +60,8 @@ func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func())
 		taskManager:           persistence.NewMockTaskManager(ctrl),
 		visibilityManager:     persistence.NewMockVisibilityManager(ctrl),
 		replicationManager:    persistence.NewMockQueueManager(ctrl),
+		asyncWorkflowManager:  persistence.NewMockQueueManager(ctrl),
+		asyncStatusManager:    persistence.NewMockAsyncWorkflowRequestStatusManager(ctrl),
 		shardManager:          persistence.NewMockShardManager(ctrl),
 		historyManager:        persistence.NewMockHistoryManager(ctrl),
 		configManager:         persistence.NewMockConfigStoreManager(ctrl),
@@ -72,6 +76,8 @@ func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func())
 		f.EXPECT().NewTaskManager().Return(m.taskManager, nil).MaxTimes(1)
 		f.EXPECT().NewVisibilityManager(gomock.Any(), gomock.Any()).Return(m.visibilityManager, nil).MaxTimes(1)
 		f.EXPECT().NewDomainReplicationQueueManager().Return(m.replicationManager, nil).MaxTimes(1)
+		f.EXPECT().NewAsyncWorkflowQueueManager().Return(m.asyncWorkflowManager, nil).MaxTimes(1)
+		f.EXPECT().NewAsyncWorkflowRequestStatusManager().Return(m.asyncStatusManager, nil).MaxTimes(1)
 		f.EXPECT().NewShardManager().Return(m.shardManager, nil).MaxTimes(1)
 		f.EXPECT().NewHistoryManager().Return(m.historyManager, nil).MaxTimes(1)
 		f.EXPECT().NewConfigStoreManager().Return(m.configManager, nil).MaxTimes(1)
@@ -120,6 +126,18 @@ func TestBeanCoverage(t *testing.T) {
 				},
 				err: "no domain replication queue manager",
 			},
+			"async workflow queue manager error": {
+				mockSetup: func(t *testing.T, f *MockFactory) {
+					f.EXPECT().NewAsyncWorkflowQueueManager().Return(nil, fmt.Errorf("no async workflow queue manager"))
+				},
+				err: "no async workflow queue manager",
+			},
+			"async workflow request status manager error": {
+				mockSetup: func(t *testing.T, f *MockFactory) {
+					f.EXPECT().NewAsyncWorkflowRequestStatusManager().Return(nil, fmt.Errorf("no async workflow request status manager"))
+				},
+				err: "no async workflow request status manager",
+			},
 			"shard manager error": {
 				mockSetup: func(t *testing.T, f *MockFactory) {
 					f.EXPECT().NewShardManager().Return(nil, fmt.Errorf("no shard manager"))
@@ -182,6 +200,8 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertEqual(t, m.taskManager, impl.GetTaskManager))
 		g.Go(errgroupAssertEqual(t, m.visibilityManager, impl.GetVisibilityManager))
 		g.Go(errgroupAssertEqual(t, m.replicationManager, impl.GetDomainReplicationQueueManager))
+		g.Go(errgroupAssertEqual(t, m.asyncWorkflowManager, impl.GetAsyncWorkflowQueueManager))
+		g.Go(errgroupAssertEqual(t, m.asyncStatusManager, impl.GetAsyncWorkflowRequestStatusManager))
 		g.Go(errgroupAssertEqual(t, m.shardManager, impl.GetShardManager))
 		g.Go(errgroupAssertEqual(t, m.historyManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertEqual(t, m.configManager, impl.GetConfigStoreManager))
@@ -205,6 +225,8 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertSets(t, m2.taskManager, impl.SetTaskManager, impl.GetTaskManager))
 		g.Go(errgroupAssertSets(t, m2.visibilityManager, impl.SetVisibilityManager, impl.GetVisibilityManager))
 		g.Go(errgroupAssertSets(t, m2.replicationManager, impl.SetDomainReplicationQueueManager, impl.GetDomainReplicationQueueManager))
+		g.Go(errgroupAssertSets(t, m2.asyncWorkflowManager, impl.SetAsyncWorkflowQueueManager, impl.GetAsyncWorkflowQueueManager))
+		g.Go(errgroupAssertSets(t, m2.asyncStatusManager, impl.SetAsyncWorkflowRequestStatusManager, impl.GetAsyncWorkflowRequestStatusManager))
 		g.Go(errgroupAssertSets(t, m2.shardManager, impl.SetShardManager, impl.GetShardManager))
 		g.Go(errgroupAssertSets(t, m2.historyManager, impl.SetHistoryManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertSets(t, m2.configManager, impl.SetConfigStoreManager, impl.GetConfigStoreManager))
@@ -267,6 +289,8 @@ func TestBeanCoverage(t *testing.T) {
 		m.taskManager.EXPECT().Close().Return().Times(1)
 		m.visibilityManager.EXPECT().Close().Return().Times(1)
 		m.replicationManager.EXPECT().Close().Return().Times(1)
+		m.asyncWorkflowManager.EXPECT().Close().Return().Times(1)
+		m.asyncStatusManager.EXPECT().Close().Return().Times(1)
 		m.shardManager.EXPECT().Close().Return().Times(1)
 		m.historyManager.EXPECT().Close().Return().Times(1)
 		m.configManager.EXPECT().Close().Return().Times(1)
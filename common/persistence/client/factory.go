@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/wrappers/errorinjectors"
 	"github.com/uber/cadence/common/persistence/wrappers/metered"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
 	"github.com/uber/cadence/common/persistence/wrappers/ratelimited"
 	"github.com/uber/cadence/common/persistence/wrappers/sampled"
 	pnt "github.com/uber/cadence/common/pinot"
@@ -63,6 +65,9 @@ type (
 		NewShardManager() (p.ShardManager, error)
 		// NewHistoryManager returns a new history manager
 		NewHistoryManager() (p.HistoryManager, error)
+		// NewHistoryStore returns the history store of the default datastore without any wrappers,
+		// for tools that need to read and write history nodes with their transaction IDs
+		NewHistoryStore() (p.HistoryStore, error)
 		// NewDomainManager returns a new metadata manager
 		NewDomainManager() (p.DomainManager, error)
 		// NewDomainAuditManager returns a new domain audit manager
@@ -75,6 +80,10 @@ type (
 		NewVisibilityManager(params *Params, serviceConfig *service.Config) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewAsyncWorkflowQueueManager returns a new queue for async workflow requests
+		NewAsyncWorkflowQueueManager() (p.QueueManager, error)
+		// NewAsyncWorkflowRequestStatusManager returns a new manager tracking async workflow requests
+		NewAsyncWorkflowRequestStatusManager() (p.AsyncWorkflowRequestStatusManager, error)
 		// NewConfigStoreManager returns a new config store manager
 		NewConfigStoreManager() (p.ConfigStoreManager, error)
 		NewAdminDBs() ([]p.AdminDB, error)
@@ -94,6 +103,8 @@ type (
 		NewDomainStore() (p.DomainStore, error)
 		// NewDomainAuditStore returns a new domain audit store
 		NewDomainAuditStore() (p.DomainAuditStore, error)
+		// NewAsyncWorkflowRequestStatusStore returns a new async workflow request status store
+		NewAsyncWorkflowRequestStatusStore() (p.AsyncWorkflowRequestStatusStore, error)
 		// NewHistoryDLQTaskStore returns a new history DLQ task store
 		NewHistoryDLQTaskStore() (p.HistoryDLQTaskStore, error)
 		// NewExecutionStore returns an execution store
@@ -121,6 +132,11 @@ type (
 		datastores    map[storeType]Datastore
 		clusterName   string
 		dc            *p.DynamicConfiguration
+		// migrationTarget vends the managers of the datastore configured in persistence.migration, if any
+		migrationTarget *factoryImpl
+		// migrationVerification is shared by the shard routers of all managers
+		migrationVerification     migration.ShardVerification
+		migrationVerificationOnce sync.Once
 	}
 
 	storeType int
@@ -172,6 +188,18 @@ func NewFactory(
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
+	if m := cfg.Migration; m != nil {
+		targetCfg := cfg.MigrationTarget()
+		// target calls are not metered, so that the persistence metrics keep describing the store serving reads
+		factory.migrationTarget = &factoryImpl{
+			config:        &targetCfg,
+			metricsClient: metrics.NewNoopMetricsClient(),
+			logger:        logger.WithTags(tag.StoreType(m.TargetStore)),
+			clusterName:   clusterName,
+			dc:            dc,
+		}
+		factory.migrationTarget.init(clusterName, limiters)
+	}
 	return factory
 }
 
@@ -192,6 +220,13 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if f.metricsClient != nil {
 		result = metered.NewTaskManager(result, f.metricsClient, f.logger, f.config)
 	}
+	if f.migrationTarget != nil {
+		target, err := f.migrationTarget.NewTaskManager()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewTaskManager(result, target, f.globalMigrationRouter(), f.migrationMetricsClient(), f.logger)
+	}
 	return result, nil
 }
 
@@ -212,6 +247,13 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if f.metricsClient != nil {
 		result = metered.NewShardManager(result, f.metricsClient, f.logger, f.config)
 	}
+	if f.migrationTarget != nil {
+		target, err := f.migrationTarget.NewShardManager()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewShardManager(result, target, f.shardMigrationRouter(), f.migrationMetricsClient(), f.logger)
+	}
 	return result, nil
 }
 
@@ -232,9 +274,21 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if f.metricsClient != nil {
 		result = metered.NewHistoryManager(result, f.metricsClient, f.logger, f.config)
 	}
+	if f.migrationTarget != nil {
+		target, err := f.migrationTarget.NewHistoryManager()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewHistoryManager(result, target, f.shardMigrationRouter(), f.migrationMetricsClient(), f.logger)
+	}
 	return result, nil
 }
 
+// NewHistoryStore returns the unwrapped history store of the default datastore
+func (f *factoryImpl) NewHistoryStore() (p.HistoryStore, error) {
+	return f.datastores[storeTypeHistory].factory.NewHistoryStore()
+}
+
 // NewDomainManager returns a new metadata manager
 func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	var err error
@@ -254,6 +308,13 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	if f.metricsClient != nil {
 		result = metered.NewDomainManager(result, f.metricsClient, f.logger, f.config)
 	}
+	if f.migrationTarget != nil {
+		target, err := f.migrationTarget.NewDomainManager()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewDomainManager(result, target, f.globalMigrationRouter(), f.migrationMetricsClient(), f.logger)
+	}
 	return result, nil
 }
 
@@ -274,6 +335,16 @@ func (f *factoryImpl) NewDomainAuditManager() (p.DomainAuditManager, error) {
 	return result, nil
 }
 
+// NewAsyncWorkflowRequestStatusManager returns a new manager tracking async workflow requests
+func (f *factoryImpl) NewAsyncWorkflowRequestStatusManager() (p.AsyncWorkflowRequestStatusManager, error) {
+	ds := f.datastores[storeTypeExecution]
+	store, err := ds.factory.NewAsyncWorkflowRequestStatusStore()
+	if err != nil {
+		return nil, err
+	}
+	return p.NewAsyncWorkflowRequestStatusManagerImpl(store, f.logger, f.dc), nil
+}
+
 // NewHistoryTaskDLQManager returns a new history task DLQ manager
 func (f *factoryImpl) NewHistoryTaskDLQManager() (p.HistoryTaskDLQManager, error) {
 	ds := f.datastores[storeTypeExecution]
@@ -310,6 +381,13 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 	if f.metricsClient != nil {
 		result = metered.NewExecutionManager(result, f.metricsClient, f.logger, f.config, f.dc.EnableShardIDMetrics)
 	}
+	if f.migrationTarget != nil {
+		target, err := f.migrationTarget.NewExecutionManager()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewExecutionManager(result, target, f.shardMigrationRouter(), f.migrationMetricsClient(), f.logger)
+	}
 	return result, nil
 }
 
@@ -518,13 +596,30 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if f.metricsClient != nil {
 		result = metered.NewVisibilityManager(result, f.metricsClient, f.logger, f.config)
 	}
+	if f.migrationTarget != nil {
+		if _, ok := f.migrationTarget.datastores[storeTypeVisibility]; ok {
+			target, err := f.migrationTarget.newDBVisibilityManager(visibilityConfig)
+			if err != nil {
+				return nil, err
+			}
+			result = migration.NewVisibilityManager(result, target, f.globalMigrationRouter(), f.migrationMetricsClient(), f.logger)
+		}
+	}
 
 	return result, nil
 }
 
 func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.DomainReplicationQueueType)
+}
+
+func (f *factoryImpl) NewAsyncWorkflowQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.AsyncWorkflowQueueType)
+}
+
+func (f *factoryImpl) newQueueManager(queueType p.QueueType) (p.QueueManager, error) {
 	ds := f.datastores[storeTypeQueue]
-	store, err := ds.factory.NewQueue(p.DomainReplicationQueueType)
+	store, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
@@ -587,6 +682,9 @@ func (f *factoryImpl) NewAdminDBs() ([]p.AdminDB, error) {
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
 	ds.factory.Close()
+	if f.migrationTarget != nil {
+		f.migrationTarget.Close()
+	}
 }
 
 func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limiter) {
@@ -652,6 +750,46 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
+func (f *factoryImpl) shardMigrationRouter() migration.Router {
+	shardMode := f.dc.MigrationShardMode
+	if shardMode == nil {
+		shardMode = dynamicproperties.GetStringPropertyFnFilteredByShardID(string(migration.ModeSource))
+	}
+	return migration.NewShardRouter(shardMode, f.globalMigrationMode(), f.shardMigrationVerification(), f.logger)
+}
+
+// shardMigrationVerification reads the verified shards from the config store of the source datastore
+func (f *factoryImpl) shardMigrationVerification() migration.ShardVerification {
+	f.migrationVerificationOnce.Do(func() {
+		manager, err := f.NewConfigStoreManager()
+		if err != nil {
+			f.logger.Error("Failed to create the config store manager, no shard can be switched to the persistence migration target", tag.Error(err))
+			f.migrationVerification = migration.NewStaticShardVerification()
+			return
+		}
+		f.migrationVerification = migration.NewShardVerification(manager, clock.NewRealTimeSource(), f.logger)
+	})
+	return f.migrationVerification
+}
+
+func (f *factoryImpl) globalMigrationRouter() migration.Router {
+	return migration.NewGlobalRouter(f.globalMigrationMode(), f.logger)
+}
+
+func (f *factoryImpl) globalMigrationMode() dynamicproperties.StringPropertyFn {
+	if f.dc.MigrationGlobalMode == nil {
+		return dynamicproperties.GetStringPropertyFn(string(migration.ModeSource))
+	}
+	return f.dc.MigrationGlobalMode
+}
+
+func (f *factoryImpl) migrationMetricsClient() metrics.Client {
+	if f.metricsClient == nil {
+		return metrics.NewNoopMetricsClient()
+	}
+	return f.metricsClient
+}
+
 func (f *factoryImpl) getParser() serialization.Parser {
 	parser, err := serialization.NewParser(f.dc)
 	if err != nil {
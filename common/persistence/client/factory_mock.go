@@ -69,6 +69,36 @@ func (mr *MockFactoryMockRecorder) NewAdminDBs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAdminDBs", reflect.TypeOf((*MockFactory)(nil).NewAdminDBs))
 }
 
+// NewAsyncWorkflowQueueManager mocks base method.
+func (m *MockFactory) NewAsyncWorkflowQueueManager() (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAsyncWorkflowQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAsyncWorkflowQueueManager indicates an expected call of NewAsyncWorkflowQueueManager.
+func (mr *MockFactoryMockRecorder) NewAsyncWorkflowQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAsyncWorkflowQueueManager", reflect.TypeOf((*MockFactory)(nil).NewAsyncWorkflowQueueManager))
+}
+
+// NewAsyncWorkflowRequestStatusManager mocks base method.
+func (m *MockFactory) NewAsyncWorkflowRequestStatusManager() (persistence.AsyncWorkflowRequestStatusManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAsyncWorkflowRequestStatusManager")
+	ret0, _ := ret[0].(persistence.AsyncWorkflowRequestStatusManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAsyncWorkflowRequestStatusManager indicates an expected call of NewAsyncWorkflowRequestStatusManager.
+func (mr *MockFactoryMockRecorder) NewAsyncWorkflowRequestStatusManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAsyncWorkflowRequestStatusManager", reflect.TypeOf((*MockFactory)(nil).NewAsyncWorkflowRequestStatusManager))
+}
+
 // NewConfigStoreManager mocks base method.
 func (m *MockFactory) NewConfigStoreManager() (persistence.ConfigStoreManager, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +189,21 @@ func (mr *MockFactoryMockRecorder) NewHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryManager", reflect.TypeOf((*MockFactory)(nil).NewHistoryManager))
 }
 
+// NewHistoryStore mocks base method.
+func (m *MockFactory) NewHistoryStore() (persistence.HistoryStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewHistoryStore")
+	ret0, _ := ret[0].(persistence.HistoryStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewHistoryStore indicates an expected call of NewHistoryStore.
+func (mr *MockFactoryMockRecorder) NewHistoryStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryStore", reflect.TypeOf((*MockFactory)(nil).NewHistoryStore))
+}
+
 // NewHistoryTaskDLQManager mocks base method.
 func (m *MockFactory) NewHistoryTaskDLQManager() (persistence.HistoryTaskDLQManager, error) {
 	m.ctrl.T.Helper()
@@ -270,6 +315,21 @@ func (mr *MockDataStoreFactoryMockRecorder) NewAdminDBs(pType any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAdminDBs", reflect.TypeOf((*MockDataStoreFactory)(nil).NewAdminDBs), pType)
 }
 
+// NewAsyncWorkflowRequestStatusStore mocks base method.
+func (m *MockDataStoreFactory) NewAsyncWorkflowRequestStatusStore() (persistence.AsyncWorkflowRequestStatusStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAsyncWorkflowRequestStatusStore")
+	ret0, _ := ret[0].(persistence.AsyncWorkflowRequestStatusStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAsyncWorkflowRequestStatusStore indicates an expected call of NewAsyncWorkflowRequestStatusStore.
+func (mr *MockDataStoreFactoryMockRecorder) NewAsyncWorkflowRequestStatusStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAsyncWorkflowRequestStatusStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewAsyncWorkflowRequestStatusStore))
+}
+
 // NewConfigStore mocks base method.
 func (m *MockDataStoreFactory) NewConfigStore() (persistence.ConfigStore, error) {
 	m.ctrl.T.Helper()
@@ -24,6 +24,7 @@ package client
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/IBM/sarama/mocks"
@@ -128,6 +129,20 @@ func TestFactoryMethods(t *testing.T) {
 		ds.EXPECT().NewQueue(persistence.DomainReplicationQueueType).Return(nil, nil).MinTimes(1)
 		check(t, fact.NewDomainReplicationQueueManager)
 	})
+	t.Run("NewAsyncWorkflowQueueManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeQueue)
+
+		ds.EXPECT().NewQueue(persistence.AsyncWorkflowQueueType).Return(nil, nil).MinTimes(1)
+		check(t, fact.NewAsyncWorkflowQueueManager)
+	})
+	t.Run("NewAsyncWorkflowRequestStatusManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeExecution)
+
+		ds.EXPECT().NewAsyncWorkflowRequestStatusStore().Return(nil, nil).MinTimes(1)
+		check(t, fact.NewAsyncWorkflowRequestStatusManager)
+	})
 	t.Run("NewConfigStoreManager", func(t *testing.T) {
 		fact := makeFactory(t)
 		ds := mockDatastore(t, fact, storeTypeConfigStore)
@@ -251,6 +266,44 @@ func TestFactoryMethods(t *testing.T) {
 	})
 }
 
+func TestMigrationTarget(t *testing.T) {
+	logger := testlogger.New(t)
+	dc := dynamicconfig.NewCollection(dynamicconfig.NewMockClient(gomock.NewController(t)), logger)
+	cfg := &config.Persistence{
+		DefaultStore:     "source",
+		NumHistoryShards: 1024,
+		DataStores: map[string]config.DataStore{
+			"source": {NoSQL: &config.NoSQL{}},
+			"target": {NoSQL: &config.NoSQL{}},
+		},
+		Migration: &config.PersistenceMigration{TargetStore: "target"},
+		ErrorInjectionRate: func(opts ...dynamicproperties.FilterOption) float64 {
+			return 0
+		},
+	}
+	fact := NewFactory(cfg, nil, "test cluster", nil, logger, persistence.NewDynamicConfiguration(dc))
+	impl := fact.(*factoryImpl)
+	if !assert.NotNil(t, impl.migrationTarget) {
+		return
+	}
+	assert.Equal(t, "target", impl.migrationTarget.config.DefaultStore)
+	assert.Nil(t, impl.migrationTarget.migrationTarget)
+
+	source := mockDatastore(t, fact, storeTypeShard)
+	target := mockDatastore(t, impl.migrationTarget, storeTypeShard)
+	source.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	target.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+
+	shardManager, err := fact.NewShardManager()
+	assert.NoError(t, err)
+	assert.Equal(t, "*migration.migrationShardManager", fmt.Sprintf("%T", shardManager))
+
+	target.EXPECT().NewShardStore().Return(nil, errors.New("connection failed")).Times(1)
+	source.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	_, err = fact.NewShardManager()
+	assert.EqualError(t, err, "connection failed")
+}
+
 func makeFactory(t *testing.T) Factory {
 	return makeFactoryWithMetrics(t, true)
 }
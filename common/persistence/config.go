@@ -38,8 +38,12 @@ type (
 		ReadNoSQLShardFromDataBlob               dynamicproperties.BoolPropertyFn
 		SerializationEncoding                    dynamicproperties.StringPropertyFn
 		DomainAuditLogTTL                        dynamicproperties.DurationPropertyFnWithDomainIDFilter
+		AsyncWorkflowRequestStatusTTL            dynamicproperties.DurationPropertyFnWithDomainIDFilter
 		HistoryNodeDeleteBatchSize               dynamicproperties.IntPropertyFn
 		RateLimiterBypassCallerTypes             dynamicproperties.ListPropertyFn
+		ValidSearchAttributes                    dynamicproperties.MapPropertyFn
+		MigrationShardMode                       dynamicproperties.StringPropertyFnWithShardIDFilter
+		MigrationGlobalMode                      dynamicproperties.StringPropertyFn
 	}
 )
 
@@ -56,7 +60,11 @@ func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration
 		ReadNoSQLShardFromDataBlob:               dc.GetBoolProperty(dynamicproperties.ReadNoSQLShardFromDataBlob),
 		SerializationEncoding:                    dc.GetStringProperty(dynamicproperties.SerializationEncoding),
 		DomainAuditLogTTL:                        dc.GetDurationPropertyFilteredByDomainID(dynamicproperties.DomainAuditLogTTL),
+		AsyncWorkflowRequestStatusTTL:            dc.GetDurationPropertyFilteredByDomainID(dynamicproperties.AsyncWorkflowRequestStatusTTL),
 		HistoryNodeDeleteBatchSize:               dc.GetIntProperty(dynamicproperties.HistoryNodeDeleteBatchSize),
 		RateLimiterBypassCallerTypes:             dc.GetListProperty(dynamicproperties.RateLimiterBypassCallerTypes),
+		ValidSearchAttributes:                    dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
+		MigrationShardMode:                       dc.GetStringPropertyFilteredByShardID(dynamicproperties.PersistenceMigrationShardMode),
+		MigrationGlobalMode:                      dc.GetStringProperty(dynamicproperties.PersistenceMigrationGlobalMode),
 	}
 }
@@ -20,7 +20,7 @@
 // THE SOFTWARE.
 
 // Generate rate limiter wrappers.
-//go:generate mockgen -package $GOPACKAGE -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager
+//go:generate mockgen -package $GOPACKAGE -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,AsyncWorkflowRequestStatusManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager
 //go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/configstore_generated.go
 //go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/domain_generated.go
 //go:generate gowrap gen -g -p . -i HistoryManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/history_generated.go
@@ -47,6 +47,13 @@
 //go:generate gowrap gen -g -p . -i HistoryTaskDLQManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/historytaskdlq_generated.go
 //go:generate gowrap gen -g -p . -i QueueManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/queue_generated.go
 
+// Generate persistence migration wrappers.
+//go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/shard_generated.go
+//go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/execution_generated.go
+//go:generate gowrap gen -g -p . -i HistoryManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/history_generated.go
+//go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/domain_generated.go
+//go:generate gowrap gen -g -p . -i TaskManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/task_generated.go
+
 // execution metered wrapper is special
 //go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/metered_execution.tmpl -o wrappers/metered/execution_generated.go
 
@@ -96,11 +103,12 @@ type QueueType int
 // Valid shard IDs start from 0 (i.e. 0 is a valid shard).
 type ShardID = *int
 
-// DomainReplicationQueueType queue types used in queue table
+// Queue types used in queue table
 // Use positive numbers for queue type
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	AsyncWorkflowQueueType
 )
 
 // Create Workflow Execution Mode
@@ -260,6 +268,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy // Deprecated: this is related to cross-cluster tasks
+	TransferTaskTypeCompletionCallback
 )
 
 // Types of replication tasks
@@ -278,6 +287,8 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeWorkflowDeadline
+	TaskTypeWorkflowDeadlineTerminate
 )
 
 // WorkflowRequestType is the type of workflow request
@@ -317,6 +328,29 @@ func (d DomainAuditOperationType) String() string {
 	}
 }
 
+// AsyncWorkflowRequestState is the processing state of a request sent through an async workflow API
+type AsyncWorkflowRequestState int
+
+const (
+	AsyncWorkflowRequestStateInvalid AsyncWorkflowRequestState = iota
+	AsyncWorkflowRequestStateQueued
+	AsyncWorkflowRequestStateStarted
+	AsyncWorkflowRequestStateFailed
+)
+
+func (s AsyncWorkflowRequestState) String() string {
+	switch s {
+	case AsyncWorkflowRequestStateQueued:
+		return "Queued"
+	case AsyncWorkflowRequestStateStarted:
+		return "Started"
+	case AsyncWorkflowRequestStateFailed:
+		return "Failed"
+	default:
+		return "Invalid"
+	}
+}
+
 // CreateWorkflowRequestMode is the mode of create workflow request
 type CreateWorkflowRequestMode int
 
@@ -364,6 +398,8 @@ const (
 	DynamicConfig ConfigType = iota
 	GlobalIsolationGroupConfig
 	OperationalDynamicConfig
+	// PersistenceMigrationConfig records the shards that are verified for a persistence migration
+	PersistenceMigrationConfig
 )
 
 type (
@@ -436,6 +472,7 @@ type (
 		Memo                               map[string][]byte
 		SearchAttributes                   map[string][]byte
 		PartitionConfig                    map[string]string
+		Paused                             bool // paused workflow executions don't get new decision tasks
 		ExecutionStatus                    types.WorkflowExecutionStatus
 		ScheduledExecutionTimestamp        int64 // unit is unix nano, used to record the actual execution timestamp if it's a cron workflow
 		// for retry
@@ -455,6 +492,10 @@ type (
 		CronSchedule      string
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+		// completion callbacks registered at workflow start, notified once the workflow closes
+		CompletionCallbacks []*types.CompletionCallback
+		// soft deadline set at workflow start, the workflow is requested to cancel once it is exceeded
+		WorkflowDeadline *types.WorkflowDeadline
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -674,6 +715,10 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		// Priority overrides the task priority of the workflow for the activity tasks, 0 means not set
+		Priority int32
+		// Paused activities are not dispatched to workers until they are unpaused
+		Paused bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1404,6 +1449,47 @@ type (
 		Comment         string
 	}
 
+	// CreateAsyncWorkflowRequestStatusRequest is used to record a newly queued async workflow request
+	CreateAsyncWorkflowRequestStatusRequest struct {
+		DomainID    string
+		RequestID   string
+		WorkflowID  string
+		CreatedTime time.Time
+	}
+
+	// UpdateAsyncWorkflowRequestStatusRequest is used to record the outcome of an async workflow request
+	UpdateAsyncWorkflowRequestStatusRequest struct {
+		DomainID        string
+		RequestID       string
+		State           AsyncWorkflowRequestState
+		RunID           string
+		FailureReason   string
+		LastUpdatedTime time.Time
+	}
+
+	// GetAsyncWorkflowRequestStatusRequest is used to get the status of an async workflow request
+	GetAsyncWorkflowRequestStatusRequest struct {
+		DomainID  string
+		RequestID string
+	}
+
+	// GetAsyncWorkflowRequestStatusResponse is the response for GetAsyncWorkflowRequestStatus
+	GetAsyncWorkflowRequestStatusResponse struct {
+		Status *AsyncWorkflowRequestStatus
+	}
+
+	// AsyncWorkflowRequestStatus is the status of a single async workflow request
+	AsyncWorkflowRequestStatus struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           AsyncWorkflowRequestState
+		FailureReason   string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+	}
+
 	// MutableStateStats is the size stats for MutableState
 	MutableStateStats struct {
 		// Total size of mutable state
@@ -1788,6 +1874,16 @@ type (
 		GetDomainAuditLogs(ctx context.Context, request *GetDomainAuditLogsRequest) (*GetDomainAuditLogsResponse, error)
 	}
 
+	// AsyncWorkflowRequestStatusManager is used to track async workflow requests from the time they are queued
+	// until they are processed. Statuses are only kept for a bounded retention period.
+	AsyncWorkflowRequestStatusManager interface {
+		Closeable
+		GetName() string
+		CreateAsyncWorkflowRequestStatus(ctx context.Context, request *CreateAsyncWorkflowRequestStatusRequest) error
+		UpdateAsyncWorkflowRequestStatus(ctx context.Context, request *UpdateAsyncWorkflowRequestStatusRequest) error
+		GetAsyncWorkflowRequestStatus(ctx context.Context, request *GetAsyncWorkflowRequestStatusRequest) (*GetAsyncWorkflowRequestStatusResponse, error)
+	}
+
 	// HistoryTaskDLQManager is the manager-level interface for the history task DLQ.
 	HistoryTaskDLQManager interface {
 		Closeable
@@ -2119,6 +2215,13 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 			TaskData:           taskData,
 			TaskList:           t.TaskList,
 		}, nil
+	case TransferTaskTypeCompletionCallback:
+		return &CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+			CallbackIndex:      t.ScheduleID,
+		}, nil
 	case TransferTaskTypeRecordChildExecutionCompleted:
 		targetRunID := t.TargetRunID
 		if t.TargetRunID == TransferTaskTransferTargetRunID {
@@ -2336,6 +2439,18 @@ func (t *TimerTaskInfo) ToTask() (Task, error) {
 			TimeoutType:        t.TimeoutType,
 			TaskList:           t.TaskList,
 		}, nil
+	case TaskTypeWorkflowDeadline:
+		return &WorkflowDeadlineTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+		}, nil
+	case TaskTypeWorkflowDeadlineTerminate:
+		return &WorkflowDeadlineTerminateTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown task type: %d", t.TaskType)
 	}
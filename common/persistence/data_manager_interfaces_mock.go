@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/uber/cadence/common/persistence (interfaces: Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager)
+// Source: github.com/uber/cadence/common/persistence (interfaces: Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,AsyncWorkflowRequestStatusManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager)
 //
 // Generated by this command:
 //
-//	mockgen -package persistence -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager
+//	mockgen -package persistence -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,TaskManager,HistoryManager,DomainManager,DomainAuditManager,AsyncWorkflowRequestStatusManager,HistoryTaskDLQManager,QueueManager,ConfigStoreManager
 //
 
 // Package persistence is a generated GoMock package.
@@ -1400,6 +1400,99 @@ func (mr *MockDomainAuditManagerMockRecorder) GetName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockDomainAuditManager)(nil).GetName))
 }
 
+// MockAsyncWorkflowRequestStatusManager is a mock of AsyncWorkflowRequestStatusManager interface.
+type MockAsyncWorkflowRequestStatusManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncWorkflowRequestStatusManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncWorkflowRequestStatusManagerMockRecorder is the mock recorder for MockAsyncWorkflowRequestStatusManager.
+type MockAsyncWorkflowRequestStatusManagerMockRecorder struct {
+	mock *MockAsyncWorkflowRequestStatusManager
+}
+
+// NewMockAsyncWorkflowRequestStatusManager creates a new mock instance.
+func NewMockAsyncWorkflowRequestStatusManager(ctrl *gomock.Controller) *MockAsyncWorkflowRequestStatusManager {
+	mock := &MockAsyncWorkflowRequestStatusManager{ctrl: ctrl}
+	mock.recorder = &MockAsyncWorkflowRequestStatusManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncWorkflowRequestStatusManager) EXPECT() *MockAsyncWorkflowRequestStatusManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAsyncWorkflowRequestStatusManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAsyncWorkflowRequestStatusManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAsyncWorkflowRequestStatusManager)(nil).Close))
+}
+
+// CreateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusManager) CreateAsyncWorkflowRequestStatus(ctx context.Context, request *CreateAsyncWorkflowRequestStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAsyncWorkflowRequestStatus indicates an expected call of CreateAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusManagerMockRecorder) CreateAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusManager)(nil).CreateAsyncWorkflowRequestStatus), ctx, request)
+}
+
+// GetAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusManager) GetAsyncWorkflowRequestStatus(ctx context.Context, request *GetAsyncWorkflowRequestStatusRequest) (*GetAsyncWorkflowRequestStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncWorkflowRequestStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncWorkflowRequestStatus indicates an expected call of GetAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusManagerMockRecorder) GetAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusManager)(nil).GetAsyncWorkflowRequestStatus), ctx, request)
+}
+
+// GetName mocks base method.
+func (m *MockAsyncWorkflowRequestStatusManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName.
+func (mr *MockAsyncWorkflowRequestStatusManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockAsyncWorkflowRequestStatusManager)(nil).GetName))
+}
+
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusManager) UpdateAsyncWorkflowRequestStatus(ctx context.Context, request *UpdateAsyncWorkflowRequestStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusManagerMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusManager)(nil).UpdateAsyncWorkflowRequestStatus), ctx, request)
+}
+
 // MockHistoryTaskDLQManager is a mock of HistoryTaskDLQManager interface.
 type MockHistoryTaskDLQManager struct {
 	ctrl     *gomock.Controller
@@ -32,7 +32,7 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
-//go:generate mockgen -package $GOPACKAGE -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,HistoryDLQTaskStore
+//go:generate mockgen -package $GOPACKAGE -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,AsyncWorkflowRequestStatusStore,HistoryDLQTaskStore
 //go:generate mockgen -package $GOPACKAGE -destination visibility_store_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence VisibilityStore
 
 type (
@@ -102,6 +102,15 @@ type (
 		GetDomainAuditLogs(ctx context.Context, request *GetDomainAuditLogsRequest) (*InternalGetDomainAuditLogsResponse, error)
 	}
 
+	// AsyncWorkflowRequestStatusStore is a lower level of AsyncWorkflowRequestStatusManager
+	AsyncWorkflowRequestStatusStore interface {
+		Closeable
+		GetName() string
+		CreateAsyncWorkflowRequestStatus(ctx context.Context, request *InternalCreateAsyncWorkflowRequestStatusRequest) error
+		UpdateAsyncWorkflowRequestStatus(ctx context.Context, request *InternalUpdateAsyncWorkflowRequestStatusRequest) error
+		GetAsyncWorkflowRequestStatus(ctx context.Context, request *GetAsyncWorkflowRequestStatusRequest) (*InternalGetAsyncWorkflowRequestStatusResponse, error)
+	}
+
 	// HistoryDLQTaskStore is the store-level interface for history task DLQ operations.
 	HistoryDLQTaskStore interface {
 		Closeable
@@ -433,8 +442,11 @@ type (
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
 		PartitionConfig    map[string]string
+		Paused             bool
 
 		ActiveClusterSelectionPolicy *DataBlob
+		CompletionCallbacks          *DataBlob
+		WorkflowDeadline             *DataBlob
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -500,6 +512,8 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		Priority                 int32
+		Paused                   bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1109,6 +1123,48 @@ type (
 		Comment         string
 	}
 
+	// InternalCreateAsyncWorkflowRequestStatusRequest is used to record a newly queued async workflow request
+	InternalCreateAsyncWorkflowRequestStatusRequest struct {
+		DomainID    string
+		RequestID   string
+		WorkflowID  string
+		CreatedTime time.Time
+		// TTLSeconds is enforced by stores supporting TTL, the others store ExpiryTime and filter on read
+		TTLSeconds int64
+		ExpiryTime time.Time
+	}
+
+	// InternalUpdateAsyncWorkflowRequestStatusRequest is used to record the outcome of an async workflow request
+	InternalUpdateAsyncWorkflowRequestStatusRequest struct {
+		DomainID        string
+		RequestID       string
+		State           AsyncWorkflowRequestState
+		RunID           string
+		FailureReason   string
+		LastUpdatedTime time.Time
+		TTLSeconds      int64
+		ExpiryTime      time.Time
+	}
+
+	// InternalGetAsyncWorkflowRequestStatusResponse is the response for GetAsyncWorkflowRequestStatus
+	InternalGetAsyncWorkflowRequestStatusResponse struct {
+		Status *InternalAsyncWorkflowRequestStatus
+	}
+
+	// InternalAsyncWorkflowRequestStatus is the stored status of an async workflow request
+	InternalAsyncWorkflowRequestStatus struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           AsyncWorkflowRequestState
+		FailureReason   string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+		// ExpiryTime is zero for stores which expire rows on their own
+		ExpiryTime time.Time
+	}
+
 	// InternalShardInfo describes a shard
 	InternalShardInfo struct {
 		ShardID                       int                         `json:"shard_id"`
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/uber/cadence/common/persistence (interfaces: ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,HistoryDLQTaskStore)
+// Source: github.com/uber/cadence/common/persistence (interfaces: ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,AsyncWorkflowRequestStatusStore,HistoryDLQTaskStore)
 //
 // Generated by this command:
 //
-//	mockgen -package persistence -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,HistoryDLQTaskStore
+//	mockgen -package persistence -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,DomainAuditStore,AsyncWorkflowRequestStatusStore,HistoryDLQTaskStore
 //
 
 // Package persistence is a generated GoMock package.
@@ -1142,6 +1142,99 @@ func (mr *MockDomainAuditStoreMockRecorder) GetName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockDomainAuditStore)(nil).GetName))
 }
 
+// MockAsyncWorkflowRequestStatusStore is a mock of AsyncWorkflowRequestStatusStore interface.
+type MockAsyncWorkflowRequestStatusStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncWorkflowRequestStatusStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncWorkflowRequestStatusStoreMockRecorder is the mock recorder for MockAsyncWorkflowRequestStatusStore.
+type MockAsyncWorkflowRequestStatusStoreMockRecorder struct {
+	mock *MockAsyncWorkflowRequestStatusStore
+}
+
+// NewMockAsyncWorkflowRequestStatusStore creates a new mock instance.
+func NewMockAsyncWorkflowRequestStatusStore(ctrl *gomock.Controller) *MockAsyncWorkflowRequestStatusStore {
+	mock := &MockAsyncWorkflowRequestStatusStore{ctrl: ctrl}
+	mock.recorder = &MockAsyncWorkflowRequestStatusStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncWorkflowRequestStatusStore) EXPECT() *MockAsyncWorkflowRequestStatusStoreMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAsyncWorkflowRequestStatusStore) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAsyncWorkflowRequestStatusStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAsyncWorkflowRequestStatusStore)(nil).Close))
+}
+
+// CreateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusStore) CreateAsyncWorkflowRequestStatus(ctx context.Context, request *InternalCreateAsyncWorkflowRequestStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAsyncWorkflowRequestStatus indicates an expected call of CreateAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusStoreMockRecorder) CreateAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusStore)(nil).CreateAsyncWorkflowRequestStatus), ctx, request)
+}
+
+// GetAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusStore) GetAsyncWorkflowRequestStatus(ctx context.Context, request *GetAsyncWorkflowRequestStatusRequest) (*InternalGetAsyncWorkflowRequestStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(*InternalGetAsyncWorkflowRequestStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncWorkflowRequestStatus indicates an expected call of GetAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusStoreMockRecorder) GetAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusStore)(nil).GetAsyncWorkflowRequestStatus), ctx, request)
+}
+
+// GetName mocks base method.
+func (m *MockAsyncWorkflowRequestStatusStore) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName.
+func (mr *MockAsyncWorkflowRequestStatusStoreMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockAsyncWorkflowRequestStatusStore)(nil).GetName))
+}
+
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusStore) UpdateAsyncWorkflowRequestStatus(ctx context.Context, request *InternalUpdateAsyncWorkflowRequestStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusStoreMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusStore)(nil).UpdateAsyncWorkflowRequestStatus), ctx, request)
+}
+
 // MockHistoryDLQTaskStore is a mock of HistoryDLQTaskStore interface.
 type MockHistoryDLQTaskStore struct {
 	ctrl     *gomock.Controller
@@ -148,6 +148,16 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	completionCallbacks, err := m.serializer.DeserializeCompletionCallbacks(info.CompletionCallbacks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflowDeadline, err := m.serializer.DeserializeWorkflowDeadline(info.WorkflowDeadline)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -209,7 +219,10 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
+		Paused:                             info.Paused,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
+		WorkflowDeadline:                   workflowDeadline,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -331,6 +344,8 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Priority:                                v.Priority,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -464,6 +479,8 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Priority:                                v.Priority,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -495,6 +512,16 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	completionCallbacks, err := m.serializer.SerializeCompletionCallbacks(info.CompletionCallbacks)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowDeadline, err := m.serializer.SerializeWorkflowDeadline(info.WorkflowDeadline)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -553,8 +580,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
 		PartitionConfig:                    info.PartitionConfig,
+		Paused:                             info.Paused,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
+		WorkflowDeadline:                   workflowDeadline,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
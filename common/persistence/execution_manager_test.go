@@ -235,6 +235,8 @@ func TestExecutionManager_GetWorkflowExecution(t *testing.T) {
 
 	activeClusterSelPlcyData := sampleActiveClusterSelectionPolicyData()
 	mockedSerializer.EXPECT().DeserializeActiveClusterSelectionPolicy(activeClusterSelPlcyData).Return(generateActiveClusterSelectionPolicy(), nil).Times(1)
+	mockedSerializer.EXPECT().DeserializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+	mockedSerializer.EXPECT().DeserializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 
 	res, err := manager.GetWorkflowExecution(context.Background(), request)
 	assert.NoError(t, err)
@@ -307,6 +309,8 @@ func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 	mockedSerializer.EXPECT().SerializeResetPoints(generateResetPoints(), constants.EncodingTypeThriftRW).Return(expectedInfo.ExecutionInfo.AutoResetPoints, nil).Times(2)
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(2)
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(2)
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(2)
 
 	request := &UpdateWorkflowExecutionRequest{
 		RangeID:                1,
@@ -372,6 +376,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -426,6 +432,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(NewDataBlob([]byte("test-reset-points"), constants.EncodingTypeThriftRW), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -440,6 +448,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -455,6 +465,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -471,6 +483,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -488,6 +502,8 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -866,6 +882,8 @@ func TestListConcreteExecutions(t *testing.T) {
 					},
 				}, nil)
 				mockedSerializer.EXPECT().DeserializeActiveClusterSelectionPolicy(internalResponse.Executions[0].ExecutionInfo.ActiveClusterSelectionPolicy).Return(generateActiveClusterSelectionPolicy(), nil)
+				mockedSerializer.EXPECT().DeserializeCompletionCallbacks(gomock.Any()).Return(nil, nil)
+				mockedSerializer.EXPECT().DeserializeWorkflowDeadline(gomock.Any()).Return(nil, nil)
 				mockedSerializer.EXPECT().DeserializeVersionHistories(internalResponse.Executions[0].VersionHistories).Return(&types.VersionHistories{
 					CurrentVersionHistoryIndex: 1,
 					Histories: []*types.VersionHistory{
@@ -1023,6 +1041,8 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			checkRes: func(t *testing.T, response *CreateWorkflowExecutionResponse, err error) {
 				assert.Equal(t, &CreateWorkflowExecutionResponse{
@@ -1053,6 +1073,8 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 
 				// Persistence call will fail
 				mockedStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
@@ -1139,6 +1161,8 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(1)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(1)
 			},
 			checkRes: func(t *testing.T, response *ConflictResolveWorkflowExecutionResponse, err error) {
 				assert.NoError(t, err)
@@ -1214,6 +1238,8 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(2)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(2)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).Times(2)
+				mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).Times(2)
 			},
 			checkRes: func(t *testing.T, response *ConflictResolveWorkflowExecutionResponse, err error) {
 				assert.NoError(t, err)
@@ -1801,6 +1827,8 @@ func TestUpdateWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalUpdateWorkflowExecutionRequest) error {
 			tracked := req.UpdateWorkflowMutation.WorkflowTimerTasks
@@ -1865,6 +1893,8 @@ func TestCreateWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalCreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 			tracked := req.NewWorkflowSnapshot.WorkflowTimerTasks
@@ -1916,6 +1946,8 @@ func TestUpdateWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalUpdateWorkflowExecutionRequest) error {
 			assert.Empty(t, req.UpdateWorkflowMutation.WorkflowTimerTasks,
@@ -1959,6 +1991,8 @@ func TestCreateWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalCreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 			assert.Empty(t, req.NewWorkflowSnapshot.WorkflowTimerTasks,
@@ -2025,6 +2059,8 @@ func TestConflictResolveWorkflowExecution_TimerTaskTracking(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().ConflictResolveWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalConflictResolveWorkflowExecutionRequest) error {
 			containsTaskID := func(keys []HistoryTaskKey, taskID int64) bool {
@@ -2086,6 +2122,8 @@ func TestConflictResolveWorkflowExecution_TimerTaskTrackingFlagOff(t *testing.T)
 	mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(sampleEventData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).AnyTimes()
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(gomock.Any(), gomock.Any()).Return(sampleActiveClusterSelectionPolicyData(), nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockedSerializer.EXPECT().SerializeWorkflowDeadline(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockedStore.EXPECT().ConflictResolveWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, req *InternalConflictResolveWorkflowExecutionRequest) error {
 			assert.Empty(t, req.ResetWorkflowSnapshot.WorkflowTimerTasks,
@@ -82,6 +82,11 @@ func (f *Factory) NewDomainAuditStore() (persistence.DomainAuditStore, error) {
 	return newNoSQLDomainAuditStore(f.cfg, f.logger, f.metricsClient, f.dc)
 }
 
+// NewAsyncWorkflowRequestStatusStore returns an async workflow request status store
+func (f *Factory) NewAsyncWorkflowRequestStatusStore() (persistence.AsyncWorkflowRequestStatusStore, error) {
+	return newNoSQLAsyncWorkflowRequestStatusStore(f.cfg, f.logger, f.metricsClient, f.dc)
+}
+
 // NewHistoryDLQTaskStore returns a history DLQ task store
 func (f *Factory) NewHistoryDLQTaskStore() (persistence.HistoryDLQTaskStore, error) {
 	return newNoSQLHistoryDLQTaskStore(f.cfg, f.logger, f.metricsClient, f.dc)
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type nosqlAsyncWorkflowRequestStatusStore struct {
+	nosqlStore
+}
+
+// newNoSQLAsyncWorkflowRequestStatusStore is used to create an instance of AsyncWorkflowRequestStatusStore implementation
+func newNoSQLAsyncWorkflowRequestStatusStore(
+	cfg config.ShardedNoSQL,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	dc *persistence.DynamicConfiguration,
+) (persistence.AsyncWorkflowRequestStatusStore, error) {
+	shardedStore, err := newShardedNosqlStore(cfg, logger, metricsClient, dc, false)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlAsyncWorkflowRequestStatusStore{
+		nosqlStore: shardedStore.GetDefaultShard(),
+	}, nil
+}
+
+func (m *nosqlAsyncWorkflowRequestStatusStore) CreateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.InternalCreateAsyncWorkflowRequestStatusRequest,
+) error {
+	err := m.db.InsertAsyncWorkflowRequestStatus(ctx, &nosqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        request.DomainID,
+		RequestID:       request.RequestID,
+		WorkflowID:      request.WorkflowID,
+		State:           persistence.AsyncWorkflowRequestStateQueued,
+		CreatedTime:     request.CreatedTime,
+		LastUpdatedTime: request.CreatedTime,
+		TTLSeconds:      request.TTLSeconds,
+	})
+	if err != nil {
+		return convertCommonErrors(m.db, "CreateAsyncWorkflowRequestStatus", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncWorkflowRequestStatusStore) UpdateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.InternalUpdateAsyncWorkflowRequestStatusRequest,
+) error {
+	err := m.db.UpdateAsyncWorkflowRequestStatus(ctx, &nosqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        request.DomainID,
+		RequestID:       request.RequestID,
+		RunID:           request.RunID,
+		State:           request.State,
+		FailureReason:   request.FailureReason,
+		LastUpdatedTime: request.LastUpdatedTime,
+		TTLSeconds:      request.TTLSeconds,
+	})
+	if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+		return &types.EntityNotExistsError{
+			Message: "async workflow request " + request.RequestID + " not found",
+		}
+	}
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateAsyncWorkflowRequestStatus", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncWorkflowRequestStatusStore) GetAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.GetAsyncWorkflowRequestStatusRequest,
+) (*persistence.InternalGetAsyncWorkflowRequestStatusResponse, error) {
+	row, err := m.db.SelectAsyncWorkflowRequestStatus(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncWorkflowRequestStatus", err)
+	}
+	// rows are expired by the database so no expiry time is returned
+	return &persistence.InternalGetAsyncWorkflowRequestStatusResponse{
+		Status: &persistence.InternalAsyncWorkflowRequestStatus{
+			DomainID:        row.DomainID,
+			RequestID:       row.RequestID,
+			WorkflowID:      row.WorkflowID,
+			RunID:           row.RunID,
+			State:           row.State,
+			FailureReason:   row.FailureReason,
+			CreatedTime:     row.CreatedTime,
+			LastUpdatedTime: row.LastUpdatedTime,
+		},
+	}, nil
+}
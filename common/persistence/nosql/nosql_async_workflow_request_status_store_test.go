@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForAsyncWorkflowRequestStatusStore(t *testing.T) (*nosqlAsyncWorkflowRequestStatusStore, *nosqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	dbMock := nosqlplugin.NewMockDB(ctrl)
+	return &nosqlAsyncWorkflowRequestStatusStore{
+		nosqlStore: nosqlStore{db: dbMock},
+	}, dbMock
+}
+
+func TestCreateAsyncWorkflowRequestStatus(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	request := &persistence.InternalCreateAsyncWorkflowRequestStatusRequest{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		WorkflowID:  "workflow-id",
+		CreatedTime: now,
+		TTLSeconds:  60,
+	}
+
+	tests := map[string]struct {
+		dbErr     error
+		wantError bool
+	}{
+		"success": {},
+		"db error": {
+			dbErr:     errors.New("insert failed"),
+			wantError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForAsyncWorkflowRequestStatusStore(t)
+			dbMock.EXPECT().InsertAsyncWorkflowRequestStatus(gomock.Any(), &nosqlplugin.AsyncWorkflowRequestStatusRow{
+				DomainID:        "domain-id",
+				RequestID:       "request-id",
+				WorkflowID:      "workflow-id",
+				State:           persistence.AsyncWorkflowRequestStateQueued,
+				CreatedTime:     now,
+				LastUpdatedTime: now,
+				TTLSeconds:      60,
+			}).Return(tc.dbErr).Times(1)
+			if tc.dbErr != nil {
+				dbMock.EXPECT().IsNotFoundError(tc.dbErr).Return(false)
+				dbMock.EXPECT().IsTimeoutError(tc.dbErr).Return(false)
+				dbMock.EXPECT().IsThrottlingError(tc.dbErr).Return(false)
+				dbMock.EXPECT().IsDBUnavailableError(tc.dbErr).Return(false)
+			}
+
+			err := store.CreateAsyncWorkflowRequestStatus(context.Background(), request)
+			assert.Equal(t, tc.wantError, err != nil)
+		})
+	}
+}
+
+func TestUpdateAsyncWorkflowRequestStatus(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	store, dbMock := setUpMocksForAsyncWorkflowRequestStatusStore(t)
+	dbMock.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), &nosqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        "domain-id",
+		RequestID:       "request-id",
+		State:           persistence.AsyncWorkflowRequestStateFailed,
+		FailureReason:   "bad request",
+		LastUpdatedTime: now,
+		TTLSeconds:      60,
+	}).Return(nil).Times(1)
+
+	err := store.UpdateAsyncWorkflowRequestStatus(context.Background(), &persistence.InternalUpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        "domain-id",
+		RequestID:       "request-id",
+		State:           persistence.AsyncWorkflowRequestStateFailed,
+		FailureReason:   "bad request",
+		LastUpdatedTime: now,
+		TTLSeconds:      60,
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetAsyncWorkflowRequestStatus(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	notFound := errors.New("not found")
+
+	tests := map[string]struct {
+		setupMock func(*nosqlplugin.MockDB)
+		want      *persistence.InternalGetAsyncWorkflowRequestStatusResponse
+		wantError error
+	}{
+		"success": {
+			setupMock: func(dbMock *nosqlplugin.MockDB) {
+				dbMock.EXPECT().SelectAsyncWorkflowRequestStatus(gomock.Any(), "domain-id", "request-id").Return(&nosqlplugin.AsyncWorkflowRequestStatusRow{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					WorkflowID:      "workflow-id",
+					RunID:           "run-id",
+					State:           persistence.AsyncWorkflowRequestStateStarted,
+					CreatedTime:     now,
+					LastUpdatedTime: now,
+				}, nil)
+			},
+			want: &persistence.InternalGetAsyncWorkflowRequestStatusResponse{
+				Status: &persistence.InternalAsyncWorkflowRequestStatus{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					WorkflowID:      "workflow-id",
+					RunID:           "run-id",
+					State:           persistence.AsyncWorkflowRequestStateStarted,
+					CreatedTime:     now,
+					LastUpdatedTime: now,
+				},
+			},
+		},
+		"not found": {
+			setupMock: func(dbMock *nosqlplugin.MockDB) {
+				dbMock.EXPECT().SelectAsyncWorkflowRequestStatus(gomock.Any(), "domain-id", "request-id").Return(nil, notFound)
+				dbMock.EXPECT().IsNotFoundError(notFound).Return(true)
+			},
+			wantError: &types.EntityNotExistsError{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForAsyncWorkflowRequestStatusStore(t)
+			tc.setupMock(dbMock)
+
+			resp, err := store.GetAsyncWorkflowRequestStatus(context.Background(), &persistence.GetAsyncWorkflowRequestStatusRequest{
+				DomainID:  "domain-id",
+				RequestID: "request-id",
+			})
+			if tc.wantError != nil {
+				assert.IsType(t, tc.wantError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resp)
+		})
+	}
+}
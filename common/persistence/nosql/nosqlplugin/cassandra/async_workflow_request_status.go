@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	templateInsertAsyncWorkflowRequestStatusQuery = `INSERT INTO async_workflow_request_status (` +
+		`domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?) USING TTL ?`
+
+	templateUpdateAsyncWorkflowRequestStatusQuery = `UPDATE async_workflow_request_status USING TTL ? ` +
+		`SET run_id = ?, state = ?, failure_reason = ?, last_updated_time = ? ` +
+		`WHERE domain_id = ? AND request_id = ? ` +
+		`IF EXISTS`
+
+	templateSelectAsyncWorkflowRequestStatusQuery = `SELECT ` +
+		`domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time ` +
+		`FROM async_workflow_request_status ` +
+		`WHERE domain_id = ? AND request_id = ?`
+)
+
+// InsertAsyncWorkflowRequestStatus inserts the status of a newly queued request
+func (db *CDB) InsertAsyncWorkflowRequestStatus(ctx context.Context, row *nosqlplugin.AsyncWorkflowRequestStatusRow) error {
+	query := db.session.Query(templateInsertAsyncWorkflowRequestStatusQuery,
+		row.DomainID,
+		row.RequestID,
+		row.WorkflowID,
+		row.RunID,
+		row.State,
+		row.FailureReason,
+		row.CreatedTime,
+		row.LastUpdatedTime,
+		row.TTLSeconds,
+	).WithContext(ctx)
+	return query.Exec()
+}
+
+// UpdateAsyncWorkflowRequestStatus updates the state, run ID and failure reason of an existing request
+func (db *CDB) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *nosqlplugin.AsyncWorkflowRequestStatusRow) error {
+	query := db.session.Query(templateUpdateAsyncWorkflowRequestStatusQuery,
+		row.TTLSeconds,
+		row.RunID,
+		row.State,
+		row.FailureReason,
+		row.LastUpdatedTime,
+		row.DomainID,
+		row.RequestID,
+	).WithContext(ctx)
+	applied, err := query.MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("async workflow request status")
+	}
+	return nil
+}
+
+// SelectAsyncWorkflowRequestStatus returns the status of a request
+func (db *CDB) SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*nosqlplugin.AsyncWorkflowRequestStatusRow, error) {
+	query := db.session.Query(templateSelectAsyncWorkflowRequestStatusQuery,
+		domainID,
+		requestID,
+	).WithContext(ctx)
+
+	row := &nosqlplugin.AsyncWorkflowRequestStatusRow{}
+	err := query.Scan(
+		&row.DomainID,
+		&row.RequestID,
+		&row.WorkflowID,
+		&row.RunID,
+		&row.State,
+		&row.FailureReason,
+		&row.CreatedTime,
+		&row.LastUpdatedTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+func TestInsertAsyncWorkflowRequestStatus(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	row := &nosqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        "test-domain-id",
+		RequestID:       "test-request-id",
+		WorkflowID:      "test-workflow-id",
+		RunID:           "test-run-id",
+		State:           persistence.AsyncWorkflowRequestStateStarted,
+		CreatedTime:     now,
+		LastUpdatedTime: now,
+		TTLSeconds:      3600,
+	}
+
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "exec failed",
+			execErr: errors.New("exec failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+			query.EXPECT().Exec().Return(tc.execErr).Times(1)
+			session := &fakeSession{
+				query: query,
+			}
+			db := NewCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, DbWithClient(gocql.NewMockClient(ctrl)))
+
+			err := db.InsertAsyncWorkflowRequestStatus(context.Background(), row)
+
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, []string{`INSERT INTO async_workflow_request_status (domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time) VALUES(test-domain-id, test-request-id, test-workflow-id, test-run-id, Started, , 2024-06-01T12:00:00Z, 2024-06-01T12:00:00Z) USING TTL 3600`}, session.queries)
+		})
+	}
+}
+
+func TestUpdateAsyncWorkflowRequestStatus(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	row := &nosqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        "test-domain-id",
+		RequestID:       "test-request-id",
+		RunID:           "test-run-id",
+		State:           persistence.AsyncWorkflowRequestStateStarted,
+		LastUpdatedTime: now,
+		TTLSeconds:      3600,
+	}
+
+	tests := []struct {
+		name                 string
+		applied              bool
+		scanErr              error
+		wantConditionFailure bool
+		wantErr              bool
+	}{
+		{
+			name:    "success",
+			applied: true,
+		},
+		{
+			name:                 "status does not exist",
+			applied:              false,
+			wantConditionFailure: true,
+			wantErr:              true,
+		},
+		{
+			name:    "scan failed",
+			scanErr: errors.New("scan failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+			query.EXPECT().MapScanCAS(gomock.Any()).Return(tc.applied, tc.scanErr).Times(1)
+			session := &fakeSession{
+				query: query,
+			}
+			db := NewCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, DbWithClient(gocql.NewMockClient(ctrl)))
+
+			err := db.UpdateAsyncWorkflowRequestStatus(context.Background(), row)
+
+			assert.Equal(t, tc.wantErr, err != nil)
+			var conditionFailure *nosqlplugin.ConditionFailure
+			assert.Equal(t, tc.wantConditionFailure, errors.As(err, &conditionFailure))
+			assert.Equal(t, []string{`UPDATE async_workflow_request_status USING TTL 3600 SET run_id = test-run-id, state = Started, failure_reason = , last_updated_time = 2024-06-01T12:00:00Z WHERE domain_id = test-domain-id AND request_id = test-request-id IF EXISTS`}, session.queries)
+		})
+	}
+}
+
+func TestSelectAsyncWorkflowRequestStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		scanErr error
+		wantRow *nosqlplugin.AsyncWorkflowRequestStatusRow
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			wantRow: &nosqlplugin.AsyncWorkflowRequestStatusRow{},
+		},
+		{
+			name:    "scan failed",
+			scanErr: errors.New("not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+			query.EXPECT().Scan(gomock.Any()).Return(tc.scanErr).Times(1)
+			session := &fakeSession{
+				query: query,
+			}
+			db := NewCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, DbWithClient(gocql.NewMockClient(ctrl)))
+
+			row, err := db.SelectAsyncWorkflowRequestStatus(context.Background(), "test-domain-id", "test-request-id")
+
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantRow, row)
+			assert.Equal(t, []string{`SELECT domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time FROM async_workflow_request_status WHERE domain_id = test-domain-id AND request_id = test-request-id`}, session.queries)
+		})
+	}
+}
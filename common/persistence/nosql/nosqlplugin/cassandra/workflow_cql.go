@@ -85,8 +85,13 @@ const (
 		`search_attributes: ?, ` +
 		`memo: ?, ` +
 		`partition_config: ?, ` +
+		`paused: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`completion_callbacks: ?, ` +
+		`completion_callbacks_encoding: ?, ` +
+		`workflow_deadline: ?, ` +
+		`workflow_deadline_encoding: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -178,6 +183,8 @@ const (
 		`last_failure_details: ?, ` +
 		`last_failure_category: ?, ` +
 		`last_retry_interval_seconds: ?, ` +
+		`priority: ?, ` +
+		`paused: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -50,6 +50,10 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 	var autoResetPointsEncoding constants.EncodingType
 	var activeClusterSelectionPolicy []byte
 	var activeClusterSelectionPolicyEncoding constants.EncodingType
+	var completionCallbacks []byte
+	var completionCallbacksEncoding constants.EncodingType
+	var workflowDeadline []byte
+	var workflowDeadlineEncoding constants.EncodingType
 
 	for k, v := range executionBlob {
 		switch k {
@@ -183,10 +187,20 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 			info.Memo = v.(map[string][]byte)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "paused":
+			info.Paused = v.(bool)
 		case "active_cluster_selection_policy":
 			activeClusterSelectionPolicy = v.([]byte)
 		case "active_cluster_selection_policy_encoding":
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
+		case "completion_callbacks":
+			completionCallbacks = v.([]byte)
+		case "completion_callbacks_encoding":
+			completionCallbacksEncoding = constants.EncodingType(v.(string))
+		case "workflow_deadline":
+			workflowDeadline = v.([]byte)
+		case "workflow_deadline_encoding":
+			workflowDeadlineEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
 		}
@@ -194,6 +208,8 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
 	info.ActiveClusterSelectionPolicy = persistence.NewDataBlob(activeClusterSelectionPolicy, activeClusterSelectionPolicyEncoding)
+	info.CompletionCallbacks = persistence.NewDataBlob(completionCallbacks, completionCallbacksEncoding)
+	info.WorkflowDeadline = persistence.NewDataBlob(workflowDeadline, workflowDeadlineEncoding)
 
 	if nextEventID, ok := result["next_event_id"].(int64); ok {
 		info.NextEventID = nextEventID
@@ -331,6 +347,10 @@ func parseActivityInfo(
 			info.LastFailureCategory = types.FailureCategory(v.(int))
 		case "last_retry_interval_seconds":
 			info.LastRetryIntervalSeconds = int32(v.(int))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "paused":
+			info.Paused = v.(bool)
 		case "event_data_encoding":
 			sharedEncoding = constants.EncodingType(v.(string))
 		}
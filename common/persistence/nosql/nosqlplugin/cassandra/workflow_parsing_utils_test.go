@@ -53,6 +53,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 	completionEventData := []byte("completion event data")
 	autoResetPointsData := []byte("auto reset points data")
 	activeClusterSelectionPolicyData := []byte("active cluster selection policy data")
+	completionCallbacksData := []byte(`[{"url":"https://callbacks.example.com"}]`)
+	workflowDeadlineData := []byte(`{"deadlineSeconds":10}`)
 	searchAttributes := map[string][]byte{"AttributeKey": []byte("AttributeValue")}
 	memo := map[string][]byte{"MemoKey": []byte("MemoValue")}
 	partitionConfig := map[string]string{"PartitionKey": "PartitionValue"}
@@ -119,12 +121,17 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 					"search_attributes":                        searchAttributes,
 					"memo":                                     memo,
 					"partition_config":                         partitionConfig,
+					"paused":                                   true,
 					"completion_event":                         completionEventData,
 					"completion_event_data_encoding":           "Proto3",
 					"auto_reset_points":                        autoResetPointsData,
 					"auto_reset_points_encoding":               "Proto3",
 					"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 					"active_cluster_selection_policy_encoding": "Proto3",
+					"completion_callbacks":                     completionCallbacksData,
+					"completion_callbacks_encoding":            "json",
+					"workflow_deadline":                        workflowDeadlineData,
+					"workflow_deadline_encoding":               "json",
 				},
 				"next_event_id": int64(5),
 			},
@@ -179,7 +186,10 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				NonRetriableErrors:                 []string{"error1", "error2"},
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
+				Paused:                             true,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				CompletionCallbacks:                persistence.NewDataBlob(completionCallbacksData, "json"),
+				WorkflowDeadline:                   persistence.NewDataBlob(workflowDeadlineData, "json"),
 			},
 		},
 		{
@@ -284,6 +294,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 			assert.Equal(t, result.DecisionAttempt, tt.want.DecisionAttempt)
 			assert.Equal(t, result.ParentDomainID, tt.want.ParentDomainID)
 			assert.Equal(t, result.ActiveClusterSelectionPolicy, tt.want.ActiveClusterSelectionPolicy)
+			assert.Equal(t, result.CompletionCallbacks, tt.want.CompletionCallbacks)
+			assert.Equal(t, result.WorkflowDeadline, tt.want.WorkflowDeadline)
 		})
 	}
 }
@@ -423,6 +435,8 @@ func Test_parseActivityInfo(t *testing.T) {
 		"last_failure_details":        []byte("last_failure_details"),
 		"last_failure_category":       2,
 		"last_retry_interval_seconds": 14,
+		"priority":                    3,
+		"paused":                      true,
 		"event_data_encoding":         "Proto3",
 	}
 
@@ -462,6 +476,8 @@ func Test_parseActivityInfo(t *testing.T) {
 		LastFailureDetails:       []byte("last_failure_details"),
 		LastFailureCategory:      types.FailureCategoryFatal,
 		LastRetryIntervalSeconds: int32(14),
+		Priority:                 int32(3),
+		Paused:                   true,
 		DomainID:                 "domain_id",
 	}
 
@@ -1103,6 +1103,8 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_details"] = a.LastFailureDetails
 		aInfo["last_failure_category"] = int32(a.LastFailureCategory)
 		aInfo["last_retry_interval_seconds"] = a.LastRetryIntervalSeconds
+		aInfo["priority"] = a.Priority
+		aInfo["paused"] = a.Paused
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1158,6 +1160,8 @@ func updateActivityInfos(
 			a.LastFailureDetails,
 			int32(a.LastFailureCategory),
 			a.LastRetryIntervalSeconds,
+			a.Priority,
+			a.Paused,
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1471,8 +1475,13 @@ func updateWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.Paused,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
+		execution.WorkflowDeadline.GetData(),
+		execution.WorkflowDeadline.GetEncodingString(),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1573,8 +1582,13 @@ func createWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.Paused,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
+		execution.WorkflowDeadline.GetData(),
+		execution.WorkflowDeadline.GetEncodingString(),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -0,0 +1,349 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// maxTransactionItems is the max number of items that DynamoDB accepts in a single transaction
+	maxTransactionItems = 100
+	// maxBatchWriteItems is the max number of items that DynamoDB accepts in a single batch write
+	maxBatchWriteItems = 25
+)
+
+type (
+	// writeOp is a conditional write of a single item. It's either executed on its own or as part of a transaction.
+	writeOp struct {
+		table     string
+		put       item
+		deleteKey map[string]*dynamodb.AttributeValue
+		checkKey  map[string]*dynamodb.AttributeValue
+		condition *condition
+	}
+
+	// keyCondition is the condition on the sort key of a query
+	keyCondition struct {
+		expression string
+		values     []string
+	}
+
+	queryRequest struct {
+		table      string
+		pk         string
+		sk         *keyCondition
+		descending bool
+		// limit is the max number of items evaluated by one page, zero means no limit
+		limit     int
+		pageToken []byte
+	}
+)
+
+func putOp(table string, it item, cond *condition) writeOp {
+	return writeOp{table: table, put: it, condition: cond}
+}
+
+func deleteOp(table string, pk, sk string, cond *condition) writeOp {
+	return writeOp{table: table, deleteKey: itemKey(pk, sk), condition: cond}
+}
+
+// checkOp only checks the condition on an item without changing it, it can only be used in transactions
+func checkOp(table string, pk, sk string, cond *condition) writeOp {
+	return writeOp{table: table, checkKey: itemKey(pk, sk), condition: cond}
+}
+
+func skBetween(lower, upper string) *keyCondition {
+	return &keyCondition{expression: "#sk BETWEEN :sk0 AND :sk1", values: []string{lower, upper}}
+}
+
+// skIntBetween matches the sort keys prefix+sortableInt(v) where min <= v <= max, it returns false if the range is empty
+func skIntBetween(prefix string, min, max int64) (*keyCondition, bool) {
+	if min > max {
+		return nil, false
+	}
+	return skBetween(prefix+sortableInt(min), prefix+sortableInt(max)), true
+}
+
+func skBeginsWith(prefix string) *keyCondition {
+	return &keyCondition{expression: "begins_with(#sk, :sk0)", values: []string{prefix}}
+}
+
+func skLessThan(value string) *keyCondition {
+	return &keyCondition{expression: "#sk < :sk0", values: []string{value}}
+}
+
+// write executes a single conditional write, the error satisfies isConditionFailedError if the condition isn't met
+func (db *ddb) write(ctx context.Context, op writeOp) error {
+	expr, names, values := op.condition.build()
+	if op.put != nil {
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName:                 aws.String(db.tableName(op.table)),
+			Item:                      op.put,
+			ConditionExpression:       expr,
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		})
+		return err
+	}
+	if op.deleteKey != nil {
+		_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+			TableName:                 aws.String(db.tableName(op.table)),
+			Key:                       op.deleteKey,
+			ConditionExpression:       expr,
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+		})
+		return err
+	}
+	return fmt.Errorf("condition check on table %v can only be used in transactions", op.table)
+}
+
+// transact executes all the writes atomically. If any condition isn't met, none of the writes are applied
+// and failedConditions returns the indexes of the failed operations.
+func (db *ddb) transact(ctx context.Context, ops []writeOp) error {
+	if len(ops) > maxTransactionItems {
+		return fmt.Errorf("transaction has %v items, DynamoDB supports at most %v", len(ops), maxTransactionItems)
+	}
+	items := make([]*dynamodb.TransactWriteItem, 0, len(ops))
+	for _, op := range ops {
+		expr, names, values := op.condition.build()
+		table := aws.String(db.tableName(op.table))
+		switch {
+		case op.put != nil:
+			items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+				TableName:                 table,
+				Item:                      op.put,
+				ConditionExpression:       expr,
+				ExpressionAttributeNames:  names,
+				ExpressionAttributeValues: values,
+			}})
+		case op.deleteKey != nil:
+			items = append(items, &dynamodb.TransactWriteItem{Delete: &dynamodb.Delete{
+				TableName:                 table,
+				Key:                       op.deleteKey,
+				ConditionExpression:       expr,
+				ExpressionAttributeNames:  names,
+				ExpressionAttributeValues: values,
+			}})
+		default:
+			items = append(items, &dynamodb.TransactWriteItem{ConditionCheck: &dynamodb.ConditionCheck{
+				TableName:                 table,
+				Key:                       op.checkKey,
+				ConditionExpression:       expr,
+				ExpressionAttributeNames:  names,
+				ExpressionAttributeValues: values,
+			}})
+		}
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	return err
+}
+
+// getItem reads an item with strong consistency, an error satisfying IsNotFoundError is returned if it doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, pk, sk string) (item, error) {
+	resp, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName(table)),
+		Key:            itemKey(pk, sk),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Item) == 0 || item(resp.Item).expired(time.Now()) {
+		return nil, fmt.Errorf("%w: %v %v/%v", errNotFound, table, pk, sk)
+	}
+	return resp.Item, nil
+}
+
+// queryPage reads one page of items of a partition with strong consistency
+func (db *ddb) queryPage(ctx context.Context, req queryRequest) ([]item, []byte, error) {
+	input, err := db.queryInput(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextPageToken, err := encodePageToken(resp.LastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return liveItems(resp.Items), nextPageToken, nil
+}
+
+// queryCount counts the items of a partition that match the key condition, it doesn't exclude expired items
+func (db *ddb) queryCount(ctx context.Context, req queryRequest) (int64, error) {
+	input, err := db.queryInput(req)
+	if err != nil {
+		return 0, err
+	}
+	input.Select = aws.String(dynamodb.SelectCount)
+	var count int64
+	err = db.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		count += aws.Int64Value(page.Count)
+		return true
+	})
+	return count, err
+}
+
+func (db *ddb) queryInput(req queryRequest) (*dynamodb.QueryInput, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(db.tableName(req.table)),
+		ConsistentRead:         aws.Bool(true),
+		KeyConditionExpression: aws.String("#pk = :pk"),
+		ExpressionAttributeNames: map[string]*string{
+			"#pk": aws.String(pkAttr),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk": stringValue(req.pk),
+		},
+		ScanIndexForward: aws.Bool(!req.descending),
+	}
+	if req.sk != nil {
+		input.KeyConditionExpression = aws.String("#pk = :pk AND " + req.sk.expression)
+		input.ExpressionAttributeNames["#sk"] = aws.String(skAttr)
+		for i, value := range req.sk.values {
+			input.ExpressionAttributeValues[fmt.Sprintf(":sk%v", i)] = stringValue(value)
+		}
+	}
+	if req.limit > 0 {
+		input.Limit = aws.Int64(int64(req.limit))
+	}
+	startKey, err := decodePageToken(req.pageToken)
+	if err != nil {
+		return nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	return input, nil
+}
+
+// queryAll reads all the items of a partition that match the key condition
+func (db *ddb) queryAll(ctx context.Context, req queryRequest) ([]item, error) {
+	var result []item
+	for {
+		items, token, err := db.queryPage(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, items...)
+		if len(token) == 0 {
+			return result, nil
+		}
+		req.pageToken = token
+	}
+}
+
+// scanPage reads one page of items of a whole table with strong consistency
+func (db *ddb) scanPage(ctx context.Context, table string, limit int, pageToken []byte) ([]item, []byte, error) {
+	input := &dynamodb.ScanInput{
+		TableName:      aws.String(db.tableName(table)),
+		ConsistentRead: aws.Bool(true),
+	}
+	if limit > 0 {
+		input.Limit = aws.Int64(int64(limit))
+	}
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+
+	resp, err := db.client.ScanWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextPageToken, err := encodePageToken(resp.LastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return liveItems(resp.Items), nextPageToken, nil
+}
+
+// deleteItems deletes the items unconditionally with batch writes
+func (db *ddb) deleteItems(ctx context.Context, table string, items []item) error {
+	for start := 0; start < len(items); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(items) {
+			end = len(items)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, it := range items[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: it.key()},
+			})
+		}
+		pending := map[string][]*dynamodb.WriteRequest{db.tableName(table): requests}
+		for len(pending) > 0 {
+			resp, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = resp.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+func liveItems(items []map[string]*dynamodb.AttributeValue) []item {
+	now := time.Now()
+	result := make([]item, 0, len(items))
+	for _, it := range items {
+		if !item(it).expired(now) {
+			result = append(result, it)
+		}
+	}
+	return result
+}
+
+// encodePageToken encodes the last evaluated key of a page, which only contains the string keys of the table
+func encodePageToken(lastEvaluatedKey map[string]*dynamodb.AttributeValue) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	key := item(lastEvaluatedKey)
+	return json.Marshal(map[string]string{
+		pkAttr: key.getString(pkAttr),
+		skAttr: key.getString(skAttr),
+	})
+}
+
+func decodePageToken(token []byte) (map[string]*dynamodb.AttributeValue, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	var key map[string]string
+	if err := json.Unmarshal(token, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return itemKey(key[pkAttr], key[skAttr]), nil
+}
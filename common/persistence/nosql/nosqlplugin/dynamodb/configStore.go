@@ -22,15 +22,41 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	it, err := newItem(strconv.Itoa(row.RowType), sortableInt(row.Version)).setData(row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableClusterConfig, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	items, _, err := db.queryPage(ctx, queryRequest{
+		table:      tableClusterConfig,
+		pk:         strconv.Itoa(rowType),
+		descending: true,
+		limit:      1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no config of row type %v", errNotFound, rowType)
+	}
+	row := &persistence.InternalConfigStoreEntry{}
+	if err := items[0].getData(row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
@@ -21,58 +21,64 @@
 package dynamodb
 
 import (
-	"errors"
-	"fmt"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// tables defined in schema/dynamodb
 const (
-	// PluginName is the name of the plugin
-	PluginName = "dynamodb"
-)
-
-var (
-	errConditionFailed = errors.New("internal condition fail error")
+	tableShards                     = "shards"
+	tableExecutions                 = "executions"
+	tableHistoryTasks               = "history_tasks"
+	tableHistoryTree                = "history_tree"
+	tableHistoryNode                = "history_node"
+	tableTasks                      = "tasks"
+	tableQueue                      = "queue"
+	tableDomains                    = "domains"
+	tableClusterConfig              = "cluster_config"
+	tableDomainAuditLog             = "domain_audit_log"
+	tableAsyncWorkflowRequestStatus = "async_workflow_request_status"
+	tableHistoryTaskDLQ             = "history_task_dlq"
+	tableHistoryTaskDLQAckLevel     = "history_task_dlq_ack_level"
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	logger log.Logger
+	client dynamodbiface.DynamoDBAPI
+	cfg    *config.NoSQL
+	dc     *persistence.DynamicConfiguration
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
-// NewDynamoDB return a new DB
-func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+func newDDB(
+	client dynamodbiface.DynamoDBAPI,
+	cfg *config.NoSQL,
+	logger log.Logger,
+	dc *persistence.DynamicConfiguration,
+) *ddb {
+	return &ddb{
+		logger: logger,
+		client: client,
+		cfg:    cfg,
+		dc:     dc,
+	}
 }
 
+// Close is a no-op, the DynamoDB client is a stateless HTTP client
 func (db *ddb) Close() {
-	panic("TODO")
 }
 
 func (db *ddb) PluginName() string {
 	return PluginName
 }
 
-func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
-}
-
-func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
-}
-
-func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
-}
-
-func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
-}
-
-func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+// tableName returns the physical name of a table, tables of a cluster are prefixed with the keyspace
+func (db *ddb) tableName(table string) string {
+	return db.cfg.Keyspace + "." + table
 }
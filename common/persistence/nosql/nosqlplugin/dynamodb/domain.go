@@ -22,17 +22,85 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+const (
+	// all the domains are stored in a single partition, there are only a few of them
+	domainsPartition      = "domains"
+	domainMetadataSortKey = "metadata"
+	domainByNamePrefix    = "name" + keySeparator
+	domainByIDPrefix      = "id" + keySeparator
+
+	notificationVersionAttr = "notification_version"
+)
+
+type domainIDRow struct {
+	ID   string
+	Name string
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	idItem, err := newItem(domainsPartition, domainByIDPrefix+row.Info.ID).setData(&domainIDRow{
+		ID:   row.Info.ID,
+		Name: row.Info.Name,
+	})
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableDomains, idItem, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+	if err != nil {
+		return err
+	}
+
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	inserted := *row
+	inserted.NotificationVersion = metadataNotificationVersion
+	inserted.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	inserted.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	nameItem, err := newDomainItem(&inserted)
+	if err != nil {
+		return err
+	}
+	err = db.transact(ctx, []writeOp{
+		putOp(tableDomains, nameItem, newCondition().notExists()),
+		domainMetadataOp(metadataNotificationVersion),
+	})
+	failed, conditionFailed := failedConditions(err)
+	if !conditionFailed {
+		return err
+	}
+
+	// Domain already exist.  Delete orphan domain record before returning back to user
+	if errDelete := db.write(ctx, deleteOp(tableDomains, domainsPartition, domainByIDPrefix+row.Info.ID, nil)); errDelete != nil {
+		db.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
+	}
+	if failed[0] == 0 {
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+	return nosqlplugin.NewConditionFailure("domain")
 }
 
 // Update domain
@@ -40,7 +108,25 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	// whether the domain is global is only set on creation, so it is carried over from the current item
+	current, err := db.SelectDomain(ctx, nil, &row.Info.Name)
+	if err != nil {
+		return err
+	}
+	updated := *row
+	updated.IsGlobalDomain = current.IsGlobalDomain
+	nameItem, err := newDomainItem(&updated)
+	if err != nil {
+		return err
+	}
+	err = db.transact(ctx, []writeOp{
+		putOp(tableDomains, nameItem, newCondition().exists()),
+		domainMetadataOp(row.NotificationVersion),
+	})
+	if _, conditionFailed := failedConditions(err); conditionFailed {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +135,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		idRow, err := db.selectDomainID(ctx, *domainID)
+		if err != nil {
+			return nil, err
+		}
+		domainName = &idRow.Name
+	}
+
+	it, err := db.getItem(ctx, tableDomains, domainsPartition, domainByNamePrefix+*domainName)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRow(it)
 }
 
 // Get all domain data
@@ -58,7 +162,26 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableDomains,
+		pk:        domainsPartition,
+		sk:        skBeginsWith(domainByNamePrefix),
+		limit:     pageSize,
+		pageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, it := range items {
+		row, err := toDomainRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +190,90 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	var name, id string
+	if domainName == nil {
+		idRow, err := db.selectDomainID(ctx, *domainID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = idRow.Name, *domainID
+	} else {
+		row, err := db.SelectDomain(ctx, nil, domainName)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = *domainName, row.Info.ID
+	}
+
+	if err := db.write(ctx, deleteOp(tableDomains, domainsPartition, domainByNamePrefix+name, nil)); err != nil {
+		return err
+	}
+	return db.write(ctx, deleteOp(tableDomains, domainsPartition, domainByIDPrefix+id, nil))
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableDomains, domainsPartition, domainMetadataSortKey)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata is created by the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return it.getInt(notificationVersionAttr), nil
+}
+
+func (db *ddb) selectDomainID(ctx context.Context, domainID string) (*domainIDRow, error) {
+	it, err := db.getItem(ctx, tableDomains, domainsPartition, domainByIDPrefix+domainID)
+	if err != nil {
+		return nil, err
+	}
+	row := &domainIDRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// domainMetadataOp increments the notification version of the domain metadata, on the condition that it wasn't changed
+func domainMetadataOp(notificationVersion int64) writeOp {
+	cond := newCondition()
+	if notificationVersion > 0 {
+		cond.equalInt(notificationVersionAttr, notificationVersion)
+	} else {
+		cond.notExists()
+	}
+	it := newItem(domainsPartition, domainMetadataSortKey).setInt(notificationVersionAttr, notificationVersion+1)
+	return putOp(tableDomains, it, cond)
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (item, error) {
+	return newItem(domainsPartition, domainByNamePrefix+row.Info.Name).
+		setInt(notificationVersionAttr, row.NotificationVersion).
+		setData(row)
+}
+
+func toDomainRow(it item) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	// same as the other plugins, empty blobs are returned as nil
+	row.Config.BadBinaries = emptyBlobToNil(row.Config.BadBinaries)
+	row.Config.IsolationGroups = emptyBlobToNil(row.Config.IsolationGroups)
+	row.Config.AsyncWorkflowsConfig = emptyBlobToNil(row.Config.AsyncWorkflowsConfig)
+	row.ReplicationConfig.ActiveClustersConfig = emptyBlobToNil(row.ReplicationConfig.ActiveClustersConfig)
+	return row, nil
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// cancellation reason code of a transaction item whose condition isn't met
+	reasonConditionalCheckFailed = "ConditionalCheckFailed"
+	errCodeServiceUnavailable    = "ServiceUnavailable"
+)
+
+var (
+	errNotFound = errors.New("item not found")
+)
+
+func (db *ddb) IsNotFoundError(err error) bool {
+	return errors.Is(err, errNotFound)
+}
+
+func (db *ddb) IsTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return hasErrorCode(err, request.ErrCodeResponseTimeout)
+}
+
+func (db *ddb) IsThrottlingError(err error) bool {
+	return request.IsErrorThrottle(err)
+}
+
+func (db *ddb) IsDBUnavailableError(err error) bool {
+	return hasErrorCode(err, dynamodb.ErrCodeInternalServerError, errCodeServiceUnavailable)
+}
+
+func hasErrorCode(err error, codes ...string) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	for _, code := range codes {
+		if awsErr.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isConditionFailedError returns true if a single item write failed because of its condition expression
+func isConditionFailedError(err error) bool {
+	return hasErrorCode(err, dynamodb.ErrCodeConditionalCheckFailedException)
+}
+
+// failedConditions returns the indexes of the transaction items whose condition wasn't met.
+// The second return value is false if the error isn't a cancelled transaction caused by conditions.
+func failedConditions(err error) ([]int, bool) {
+	var canceled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return nil, false
+	}
+	var failed []int
+	for i, reason := range canceled.CancellationReasons {
+		if reason != nil && reason.Code != nil && *reason.Code == reasonConditionalCheckFailed {
+			failed = append(failed, i)
+		}
+	}
+	return failed, len(failed) > 0
+}
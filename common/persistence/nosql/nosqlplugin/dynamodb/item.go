@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// every table has a string partition key and a string sort key
+	pkAttr = "pk"
+	skAttr = "sk"
+	// dataAttr stores all the columns that are not used in keys or conditions, as JSON
+	dataAttr = "data"
+	// ttlAttr is the epoch seconds after which DynamoDB deletes the item
+	ttlAttr = "ttl"
+	// recordVersionAttr is incremented on every read-modify-write of an item, so that the write can be
+	// conditioned on the item not being changed since it was read
+	recordVersionAttr = "record_version"
+
+	// encodingAttrSuffix is appended to the name of a blob attribute to name the attribute of its encoding
+	encodingAttrSuffix = "_encoding"
+
+	keySeparator = "#"
+	// maxKeySuffix sorts after any ID that is part of a key, it's used as the inclusive upper bound of a key prefix
+	maxKeySuffix = "~"
+)
+
+// item is a DynamoDB item
+type item map[string]*dynamodb.AttributeValue
+
+func newItem(pk, sk string) item {
+	return item{
+		pkAttr: stringValue(pk),
+		skAttr: stringValue(sk),
+	}
+}
+
+func (it item) setString(attr, value string) item {
+	it[attr] = stringValue(value)
+	return it
+}
+
+func (it item) setInt(attr string, value int64) item {
+	it[attr] = intValue(value)
+	return it
+}
+
+// setTTL sets the expiry of the item, a non-positive ttl means that the item never expires
+func (it item) setTTL(now time.Time, ttlSeconds int64) item {
+	if ttlSeconds > 0 {
+		it.setInt(ttlAttr, now.Unix()+ttlSeconds)
+	}
+	return it
+}
+
+func (it item) setData(row interface{}) (item, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	it[dataAttr] = &dynamodb.AttributeValue{B: data}
+	return it, nil
+}
+
+// setBlob stores the data of a blob as a binary attribute, next to its encoding. Blobs without data aren't stored.
+func (it item) setBlob(attr string, blob *persistence.DataBlob) item {
+	if blob == nil || len(blob.Data) == 0 {
+		return it
+	}
+	it[attr] = &dynamodb.AttributeValue{B: blob.Data}
+	it.setString(attr+encodingAttrSuffix, string(blob.Encoding))
+	return it
+}
+
+func (it item) getString(attr string) string {
+	if v, ok := it[attr]; ok && v.S != nil {
+		return *v.S
+	}
+	return ""
+}
+
+func (it item) getInt(attr string) int64 {
+	if v, ok := it[attr]; ok && v.N != nil {
+		n, _ := strconv.ParseInt(*v.N, 10, 64)
+		return n
+	}
+	return 0
+}
+
+func (it item) getData(row interface{}) error {
+	v, ok := it[dataAttr]
+	if !ok {
+		return fmt.Errorf("item %v/%v has no data", it.getString(pkAttr), it.getString(skAttr))
+	}
+	return json.Unmarshal(v.B, row)
+}
+
+// getBlob returns the blob stored by setBlob, or nil if there is none
+func (it item) getBlob(attr string) *persistence.DataBlob {
+	v, ok := it[attr]
+	if !ok {
+		return nil
+	}
+	return persistence.NewDataBlob(v.B, constants.EncodingType(it.getString(attr+encodingAttrSuffix)))
+}
+
+// expired returns true if the item has a TTL which has passed. DynamoDB deletes expired items
+// in the background, so they can still be returned by reads for a while.
+func (it item) expired(now time.Time) bool {
+	if _, ok := it[ttlAttr]; !ok {
+		return false
+	}
+	return it.getInt(ttlAttr) <= now.Unix()
+}
+
+func (it item) key() map[string]*dynamodb.AttributeValue {
+	return itemKey(it.getString(pkAttr), it.getString(skAttr))
+}
+
+func emptyBlobToNil(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil {
+		return nil
+	}
+	return persistence.NewDataBlob(blob.Data, blob.Encoding)
+}
+
+func itemKey(pk, sk string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		pkAttr: stringValue(pk),
+		skAttr: stringValue(sk),
+	}
+}
+
+func stringValue(value string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(value)}
+}
+
+func intValue(value int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(value, 10))}
+}
+
+// joinKey joins the parts of a composite partition or sort key
+func joinKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+// sortableInt encodes an int64 as a fixed width string whose lexical order is the numeric order
+func sortableInt(value int64) string {
+	return fmt.Sprintf("%020d", uint64(value)^(1<<63))
+}
+
+// descendingInt encodes an int64 as a fixed width string whose lexical order is the reversed numeric order
+func descendingInt(value int64) string {
+	return fmt.Sprintf("%020d", ^(uint64(value) ^ (1 << 63)))
+}
+
+// sortableTime encodes a timestamp as a string whose lexical order is the chronological order
+func sortableTime(t time.Time) string {
+	return sortableInt(t.UnixNano())
+}
+
+// condition builds the condition expression of a write. Clauses are joined with AND.
+type condition struct {
+	clauses []string
+	names   map[string]*string
+	values  map[string]*dynamodb.AttributeValue
+}
+
+func newCondition() *condition {
+	return &condition{
+		names:  map[string]*string{},
+		values: map[string]*dynamodb.AttributeValue{},
+	}
+}
+
+// notExists requires the item to not exist
+func (c *condition) notExists() *condition {
+	c.names["#"+pkAttr] = aws.String(pkAttr)
+	c.clauses = append(c.clauses, fmt.Sprintf("attribute_not_exists(#%v)", pkAttr))
+	return c
+}
+
+// exists requires the item to exist
+func (c *condition) exists() *condition {
+	c.names["#"+pkAttr] = aws.String(pkAttr)
+	c.clauses = append(c.clauses, fmt.Sprintf("attribute_exists(#%v)", pkAttr))
+	return c
+}
+
+// notExistsOrExpired requires the item to not exist, or to be expired but not removed by DynamoDB yet
+func (c *condition) notExistsOrExpired(now time.Time) *condition {
+	placeholder := fmt.Sprintf(":v%v", len(c.values))
+	c.names["#"+pkAttr] = aws.String(pkAttr)
+	c.names["#"+ttlAttr] = aws.String(ttlAttr)
+	c.values[placeholder] = intValue(now.Unix())
+	c.clauses = append(c.clauses, fmt.Sprintf("(attribute_not_exists(#%v) OR #%v <= %v)", pkAttr, ttlAttr, placeholder))
+	return c
+}
+
+func (c *condition) equal(attr string, value *dynamodb.AttributeValue) *condition {
+	name := "#" + attr
+	placeholder := fmt.Sprintf(":v%v", len(c.values))
+	c.names[name] = aws.String(attr)
+	c.values[placeholder] = value
+	c.clauses = append(c.clauses, fmt.Sprintf("%v = %v", name, placeholder))
+	return c
+}
+
+func (c *condition) equalInt(attr string, value int64) *condition {
+	return c.equal(attr, intValue(value))
+}
+
+func (c *condition) equalString(attr string, value string) *condition {
+	return c.equal(attr, stringValue(value))
+}
+
+func (c *condition) build() (*string, map[string]*string, map[string]*dynamodb.AttributeValue) {
+	if c == nil || len(c.clauses) == 0 {
+		return nil, nil, nil
+	}
+	var values map[string]*dynamodb.AttributeValue
+	if len(c.values) > 0 {
+		values = c.values
+	}
+	return aws.String(strings.Join(c.clauses, " AND ")), c.names, values
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestSortableInt(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 42, 1000, math.MaxInt64}
+
+	ascending := make([]string, 0, len(values))
+	descending := make([]string, 0, len(values))
+	for _, v := range values {
+		ascending = append(ascending, sortableInt(v))
+		descending = append(descending, descendingInt(v))
+	}
+	assert.True(t, sort.StringsAreSorted(ascending), "sortableInt should preserve the numeric order")
+	sort.Sort(sort.Reverse(sort.StringSlice(descending)))
+	for i, v := range values {
+		assert.Equal(t, descendingInt(v), descending[i], "descendingInt should reverse the numeric order")
+		assert.Len(t, ascending[i], 20)
+	}
+}
+
+func TestConditionBuild(t *testing.T) {
+	tests := map[string]struct {
+		condition      *condition
+		expectedExpr   *string
+		expectedValues int
+	}{
+		"nil condition": {
+			condition: nil,
+		},
+		"empty condition": {
+			condition: newCondition(),
+		},
+		"not exists": {
+			condition:    newCondition().notExists(),
+			expectedExpr: aws.String("attribute_not_exists(#pk)"),
+		},
+		"multiple clauses": {
+			condition:      newCondition().exists().equalInt("range_id", 5).equalString("run_id", "abc"),
+			expectedExpr:   aws.String("attribute_exists(#pk) AND #range_id = :v0 AND #run_id = :v1"),
+			expectedValues: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			expr, _, values := tc.condition.build()
+			assert.Equal(t, tc.expectedExpr, expr)
+			assert.Len(t, values, tc.expectedValues)
+		})
+	}
+}
+
+func TestFailedConditions(t *testing.T) {
+	tests := map[string]struct {
+		err            error
+		expectedFailed []int
+		expectedOK     bool
+	}{
+		"nil error": {},
+		"other error": {
+			err: errors.New("some error"),
+		},
+		"cancelled without condition failure": {
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String("None")},
+				{Code: aws.String("TransactionConflict")},
+			}},
+		},
+		"cancelled by condition failures": {
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String(reasonConditionalCheckFailed)},
+				{Code: aws.String("None")},
+				{Code: aws.String(reasonConditionalCheckFailed)},
+			}},
+			expectedFailed: []int{0, 2},
+			expectedOK:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			failed, ok := failedConditions(tc.err)
+			assert.Equal(t, tc.expectedFailed, failed)
+			assert.Equal(t, tc.expectedOK, ok)
+		})
+	}
+}
+
+func TestBlob(t *testing.T) {
+	tests := map[string]struct {
+		blob     *persistence.DataBlob
+		expected *persistence.DataBlob
+	}{
+		"nil blob": {},
+		"empty blob": {
+			blob: persistence.NewDataBlob(nil, constants.EncodingTypeThriftRW),
+		},
+		"blob": {
+			blob:     persistence.NewDataBlob([]byte("events"), constants.EncodingTypeThriftRW),
+			expected: persistence.NewDataBlob([]byte("events"), constants.EncodingTypeThriftRW),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			it := newItem("pk", "sk").setBlob(eventsAttr, tc.blob)
+			assert.Equal(t, tc.expected, it.getBlob(eventsAttr))
+		})
+	}
+}
+
+func TestToWorkflowExecution(t *testing.T) {
+	key := executionKey{shardID: 1, domainID: "domain", workflowID: "workflow", runID: "run"}
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			5: {
+				ScheduleID:     5,
+				ScheduledEvent: persistence.NewDataBlob([]byte("scheduled"), constants.EncodingTypeThriftRW),
+			},
+		},
+		TimerInfos:         map[string]*persistence.TimerInfo{"timer": {TimerID: "timer", StartedID: 6}},
+		SignalRequestedIDs: []string{"signal"},
+	}
+	txn := newWorkflowTransaction()
+	require.NoError(t, txn.addMutableState(key, execution))
+	txn.addBufferedEvents(key, 1, persistence.NewDataBlob([]byte("batch 1"), constants.EncodingTypeThriftRW))
+	txn.addBufferedEvents(key, 2, persistence.NewDataBlob([]byte("batch 2"), constants.EncodingTypeThriftRW))
+
+	items := make([]item, 0, len(txn.ops))
+	for _, op := range txn.ops {
+		items = append(items, op.put)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].getString(skAttr) < items[j].getString(skAttr) })
+	actual, err := toWorkflowExecution(&executionRecord{}, items)
+	require.NoError(t, err)
+
+	assert.Equal(t, execution.ActivityInfos, actual.ActivityInfos)
+	assert.Equal(t, execution.TimerInfos, actual.TimerInfos)
+	assert.Equal(t, map[string]struct{}{"signal": {}}, actual.SignalRequestedIDs)
+	assert.Equal(t, []*persistence.DataBlob{
+		persistence.NewDataBlob([]byte("batch 1"), constants.EncodingTypeThriftRW),
+		persistence.NewDataBlob([]byte("batch 2"), constants.EncodingTypeThriftRW),
+	}, actual.BufferedEvents)
+
+	_, err = toWorkflowExecution(&executionRecord{}, []item{key.newMutableStateItem("unknown", "1")})
+	assert.Error(t, err)
+}
+
+func TestMutableStateWritesSkipWrittenItems(t *testing.T) {
+	key := executionKey{shardID: 1, domainID: "domain", workflowID: "workflow", runID: "run"}
+	txn := newWorkflowTransaction()
+	require.NoError(t, txn.addMutableState(key, &nosqlplugin.WorkflowExecutionRequest{
+		TimerInfos:            map[string]*persistence.TimerInfo{"timer": {TimerID: "timer"}},
+		TimerInfoKeysToDelete: []string{"timer", "other"},
+	}))
+	txn.deleteMutableStateItems(key, []item{key.newMutableStateItem(timerInfoKind, "timer"), key.newMutableStateItem(timerInfoKind, "old")})
+
+	require.Len(t, txn.ops, 3)
+	assert.NotNil(t, txn.ops[0].put)
+	assert.NotNil(t, txn.ops[1].deleteKey)
+	assert.Equal(t, key.mutableStateSortKey(timerInfoKind, "other"), aws.StringValue(txn.ops[1].deleteKey[skAttr].S))
+	assert.Equal(t, key.mutableStateSortKey(timerInfoKind, "old"), aws.StringValue(txn.ops[2].deleteKey[skAttr].S))
+}
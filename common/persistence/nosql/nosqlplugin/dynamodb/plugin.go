@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/environment"
+	dynamodbschema "github.com/uber/cadence/schema/dynamodb"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "dynamodb"
+
+	// defaultRegion is used when no region is configured, DynamoDB local accepts any region
+	defaultRegion = "us-east-1"
+)
+
+type (
+	// clientFactory creates the client that the plugin talks to DynamoDB with
+	clientFactory func(cfg *config.NoSQL) (dynamodbiface.DynamoDBAPI, error)
+
+	plugin struct {
+		newClient clientFactory
+	}
+)
+
+var _ nosqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	nosql.RegisterPlugin(PluginName, &plugin{newClient: newClient})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.DB, error) {
+	return p.doCreateDB(cfg, logger, dc)
+}
+
+// SetupDB returns the DB used to set up the database. DynamoDB has no keyspace to create, tables
+// are namespaced by prefixing them with the keyspace instead.
+func (p *plugin) SetupDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (persistence.SetupDB, error) {
+	return p.doCreateDB(cfg, logger, dc)
+}
+
+func (p *plugin) SchemaDB(dbType persistence.DBType, cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (persistence.SchemaDB, error) {
+	schema, err := getLatestSchema(dbType)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := p.doCreateDB(cfg, logger, dc)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaDB{
+		ddb:    db,
+		latest: schema,
+	}, nil
+}
+
+func getLatestSchema(dbType persistence.DBType) (persistence.Schema, error) {
+	switch dbType {
+	// DB based visibility isn't supported, but the visibility store can still point to the same
+	// database as the default store, so it gets the same tables which are created only once.
+	case persistence.DBTypeDefault, persistence.DBTypeVisibility:
+		return dynamodbschema.DefaultSchema, nil
+	default:
+		return nil, fmt.Errorf("unknown db type: %v", dbType)
+	}
+}
+
+func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (*ddb, error) {
+	if cfg.Keyspace == "" {
+		return nil, fmt.Errorf("keyspace cannot be empty, it's used as the prefix of table names")
+	}
+	client, err := p.newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newDDB(client, cfg, logger, dc), nil
+}
+
+func newClient(cfg *config.NoSQL) (dynamodbiface.DynamoDBAPI, error) {
+	awsConfig, err := toAWSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return dynamodb.New(sess), nil
+}
+
+func toAWSConfig(cfg *config.NoSQL) (*aws.Config, error) {
+	awsConfig := aws.NewConfig()
+
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	awsConfig = awsConfig.WithRegion(region)
+
+	endpoint, err := toEndpoint(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint)
+	}
+
+	// use the default credential chain (env, shared config, instance role) unless the credentials are configured
+	if cfg.User != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(cfg.User, cfg.Password, ""))
+	}
+	if cfg.Timeout > 0 {
+		awsConfig.HTTPClient = &http.Client{Timeout: cfg.Timeout}
+	}
+	return awsConfig, nil
+}
+
+// toEndpoint returns the endpoint override to connect to, or empty to use the regional AWS endpoint.
+// Hosts can either be a full URL or a host name, in which case the port and scheme are derived from the config.
+func toEndpoint(cfg *config.NoSQL) (string, error) {
+	hosts := strings.TrimSpace(cfg.Hosts)
+	if hosts == "" {
+		return "", nil
+	}
+	// only the first host is used, DynamoDB endpoints are load balanced
+	host := strings.TrimSpace(strings.Split(hosts, ",")[0])
+	if strings.Contains(host, "://") {
+		return host, nil
+	}
+
+	scheme := "http"
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		scheme = "https"
+	}
+	port := cfg.Port
+	if port == 0 {
+		var err error
+		port, err = environment.GetDynamoDBPort()
+		if err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%v://%v", scheme, net.JoinHostPort(host, strconv.Itoa(port))), nil
+}
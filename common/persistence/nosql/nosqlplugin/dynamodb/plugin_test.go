@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestToEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		cfg      *config.NoSQL
+		expected string
+	}{
+		"no hosts uses the AWS endpoint": {
+			cfg:      &config.NoSQL{},
+			expected: "",
+		},
+		"full URL": {
+			cfg:      &config.NoSQL{Hosts: "https://dynamodb.local:8443"},
+			expected: "https://dynamodb.local:8443",
+		},
+		"host and port": {
+			cfg:      &config.NoSQL{Hosts: "127.0.0.1, 127.0.0.2", Port: 8001},
+			expected: "http://127.0.0.1:8001",
+		},
+		"TLS": {
+			cfg:      &config.NoSQL{Hosts: "dynamodb.local", Port: 8443, TLS: &config.TLS{Enabled: true}},
+			expected: "https://dynamodb.local:8443",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			endpoint, err := toEndpoint(tc.cfg)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, endpoint)
+		})
+	}
+}
@@ -22,18 +22,35 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	queueMetadataSortKey = "metadata"
+	queueMessagePrefix   = "message" + keySeparator
+	versionAttr          = "version"
+)
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	it, err := newItem(queueKey(row.QueueType), queueMessageSortKey(row.ID)).setData(row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableQueue, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +58,24 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	items, _, err := db.queryPage(ctx, queryRequest{
+		table:      tableQueue,
+		pk:         queueKey(queueType),
+		sk:         skBeginsWith(queueMessagePrefix),
+		descending: true,
+		limit:      1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, fmt.Errorf("%w: queue %v is empty", errNotFound, queueType)
+	}
+	row, err := toQueueMessageRow(items[0])
+	if err != nil {
+		return 0, err
+	}
+	return row.ID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +85,29 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	sk, ok := skIntBetween(queueMessagePrefix, exclusiveBeginMessageID+1, math.MaxInt64)
+	if !ok {
+		return nil, nil
+	}
+	items, _, err := db.queryPage(ctx, queryRequest{
+		table: tableQueue,
+		pk:    queueKey(queueType),
+		sk:    sk,
+		limit: maxRows,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, it := range items {
+		row, err := toQueueMessageRow(it)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +115,33 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	sk, ok := skIntBetween(queueMessagePrefix, request.ExclusiveBeginMessageID+1, request.InclusiveEndMessageID)
+	if !ok {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableQueue,
+		pk:        queueKey(request.QueueType),
+		sk:        sk,
+		limit:     request.PageSize,
+		pageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []nosqlplugin.QueueMessageRow
+	for _, it := range items {
+		row, err := toQueueMessageRow(it)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, *row)
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +150,10 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID == math.MinInt64 {
+		return nil
+	}
+	return db.deleteMessages(ctx, queueType, math.MinInt64, exclusiveBeginMessageID-1)
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +163,7 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteMessages(ctx, queueType, exclusiveBeginMessageID+1, inclusiveEndMessageID)
 }
 
 // Delete one message
@@ -87,12 +172,22 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.write(ctx, deleteOp(tableQueue, queueKey(queueType), queueMessageSortKey(messageID), nil))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	row.ClusterAckLevels = map[string]int64{}
+	it, err := newQueueMetadataItem(&row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableQueue, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		// it's ok if the item is not written, which means that the metadata exists already
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +197,15 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	it, err := newQueueMetadataItem(&row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableQueue, it, newCondition().equalInt(versionAttr, row.Version-1)))
+	if isConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +213,77 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableQueue, queueKey(queueType), queueMetadataSortKey)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.QueueMetadataRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	if row.ClusterAckLevels == nil {
+		row.ClusterAckLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: row.ClusterAckLevels,
+		Version:          row.Version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, queryRequest{
+		table: tableQueue,
+		pk:    queueKey(queueType),
+		sk:    skBeginsWith(queueMessagePrefix),
+	})
+}
+
+// deleteMessages deletes the messages with minMessageID <= ID <= maxMessageID
+func (db *ddb) deleteMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	minMessageID int64,
+	maxMessageID int64,
+) error {
+	sk, ok := skIntBetween(queueMessagePrefix, minMessageID, maxMessageID)
+	if !ok {
+		return nil
+	}
+	items, err := db.queryAll(ctx, queryRequest{
+		table: tableQueue,
+		pk:    queueKey(queueType),
+		sk:    sk,
+	})
+	if err != nil {
+		return err
+	}
+	return db.deleteItems(ctx, tableQueue, items)
+}
+
+func newQueueMetadataItem(row *nosqlplugin.QueueMetadataRow) (item, error) {
+	return newItem(queueKey(row.QueueType), queueMetadataSortKey).
+		setInt(versionAttr, row.Version).
+		setData(row)
+}
+
+func toQueueMessageRow(it item) (*nosqlplugin.QueueMessageRow, error) {
+	row := &nosqlplugin.QueueMessageRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.QueueMessageRow{ID: row.ID, Payload: row.Payload}, nil
+}
+
+func queueKey(queueType persistence.QueueType) string {
+	return strconv.Itoa(int(queueType))
+}
+
+func queueMessageSortKey(messageID int64) string {
+	return queueMessagePrefix + sortableInt(messageID)
 }
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	tableSchemaVersion = "schema_version"
+
+	// partition keys of the schema_version table
+	schemaVersionPartition       = "version"
+	schemaUpdateHistoryPartition = "history"
+)
+
+type (
+	schemaDB struct {
+		*ddb
+		latest persistence.Schema
+	}
+
+	// tableDefinition is a statement of the DynamoDB schema files. Table names are prefixed with the keyspace when applied.
+	tableDefinition struct {
+		dynamodb.CreateTableInput
+		// TimeToLiveSpecification is applied after the table is created, as CreateTable doesn't support it
+		TimeToLiveSpecification *dynamodb.TimeToLiveSpecification
+	}
+
+	schemaVersionRow struct {
+		Version              string
+		MinCompatibleVersion string
+		CreationTime         time.Time
+	}
+
+	schemaUpdateHistoryRow struct {
+		UpdateTime  time.Time
+		Description string
+		ManifestMD5 string
+		NewVersion  string
+		OldVersion  string
+	}
+)
+
+func (s *schemaDB) LatestSchema() persistence.Schema {
+	return s.latest
+}
+
+func (db *ddb) HasSchemaVersioning(ctx context.Context) (bool, error) {
+	_, err := db.client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(db.tableName(tableSchemaVersion)),
+	})
+	if hasErrorCode(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error checking for schema_version table: %w", err)
+	}
+	return true, nil
+}
+
+func (db *ddb) SetupVersioning(ctx context.Context) error {
+	return db.createTable(ctx, &tableDefinition{
+		CreateTableInput: dynamodb.CreateTableInput{
+			TableName: aws.String(tableSchemaVersion),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(pkAttr), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+				{AttributeName: aws.String(skAttr), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(pkAttr), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String(skAttr), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		},
+	})
+}
+
+func (db *ddb) GetSchemaVersion(ctx context.Context) (persistence.Version, error) {
+	it, err := db.getItem(ctx, tableSchemaVersion, schemaVersionPartition, schemaVersionPartition)
+	if err != nil {
+		return persistence.Version{}, fmt.Errorf("reading schemaDB version: %w", err)
+	}
+	var row schemaVersionRow
+	if err := it.getData(&row); err != nil {
+		return persistence.Version{}, err
+	}
+	return persistence.ParseVersion(row.Version)
+}
+
+func (db *ddb) UpdateSchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	current, err := db.GetSchemaVersion(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	if !current.IsBefore(update.Version) {
+		return fmt.Errorf("unable to update backwards from %s to %s", current, update.Version)
+	}
+	err = db.applyUpdate(ctx, update)
+	if err != nil {
+		return fmt.Errorf("unable to apply update: %w", err)
+	}
+
+	now := time.Now().UTC()
+	versionItem, err := newItem(schemaVersionPartition, schemaVersionPartition).setData(&schemaVersionRow{
+		Version:              update.Version.String(),
+		MinCompatibleVersion: update.MinCompatibleVersion.String(),
+		CreationTime:         now,
+	})
+	if err != nil {
+		return err
+	}
+	if err := db.write(ctx, putOp(tableSchemaVersion, versionItem, nil)); err != nil {
+		return err
+	}
+	historyItem, err := newItem(schemaUpdateHistoryPartition, sortableTime(now)).setData(&schemaUpdateHistoryRow{
+		UpdateTime:  now,
+		Description: update.Description,
+		ManifestMD5: update.ManifestMD5,
+		NewVersion:  update.Version.String(),
+		OldVersion:  current.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(tableSchemaVersion, historyItem, nil))
+}
+
+func (db *ddb) ForceApplySchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	return db.applyUpdate(ctx, update)
+}
+
+// applyUpdate creates the tables of the update which don't exist yet
+func (db *ddb) applyUpdate(ctx context.Context, update *persistence.SchemaUpdate) error {
+	for _, ddl := range update.DDLStatements {
+		var table tableDefinition
+		if err := json.Unmarshal([]byte(ddl), &table); err != nil {
+			return fmt.Errorf("invalid table definition %v: %w", ddl, err)
+		}
+		if err := db.createTable(ctx, &table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTable creates a table and waits until it's active, a table that already exists is left unchanged
+func (db *ddb) createTable(ctx context.Context, table *tableDefinition) error {
+	input := table.CreateTableInput
+	input.TableName = aws.String(db.tableName(aws.StringValue(table.TableName)))
+	_, err := db.client.CreateTableWithContext(ctx, &input)
+	if hasErrorCode(err, dynamodb.ErrCodeResourceInUseException) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create table %v: %w", aws.StringValue(input.TableName), err)
+	}
+
+	describe := &dynamodb.DescribeTableInput{TableName: input.TableName}
+	if err := db.client.WaitUntilTableExistsWithContext(ctx, describe); err != nil {
+		return err
+	}
+	if table.TimeToLiveSpecification != nil {
+		_, err := db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName:               input.TableName,
+			TimeToLiveSpecification: table.TimeToLiveSpecification,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to enable TTL on table %v: %w", aws.StringValue(input.TableName), err)
+		}
+	}
+	return nil
+}
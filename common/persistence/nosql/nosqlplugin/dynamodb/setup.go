@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// IsSetup always returns true, DynamoDB has no keyspace. The tables of a cluster are created by the schema.
+func (db *ddb) IsSetup(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// Setup is a no-op, DynamoDB has no keyspace to create
+func (db *ddb) Setup(ctx context.Context, options map[string]string) error {
+	return nil
+}
+
+// Teardown deletes all the tables prefixed with the keyspace
+func (db *ddb) Teardown(ctx context.Context) error {
+	prefix := db.tableName("")
+	var tables []string
+	err := db.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		for _, name := range page.TableNames {
+			if strings.HasPrefix(aws.StringValue(name), prefix) {
+				tables = append(tables, aws.StringValue(name))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{
+			TableName: aws.String(table),
+		})
+		if err != nil && !hasErrorCode(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return err
+		}
+	}
+	return nil
+}
@@ -22,29 +22,122 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	shardSortKey = "shard"
+	// rangeIDAttr is the range ID of the shard row, the range ID in the shard info is only updated by UpdateShard
+	rangeIDAttr = "range_id"
+)
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	it, err := newShardItem(row.ShardID, row.RangeID, row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(tableShards, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return db.shardConditionFailure(ctx, row.ShardID, "shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableShards, shardKey(shardID), shardSortKey)
+	if err != nil {
+		return 0, nil, err
+	}
+	row := &nosqlplugin.ShardRow{}
+	if err := it.getData(row); err != nil {
+		return 0, nil, err
+	}
+	info := row.InternalShardInfo
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return it.getInt(rangeIDAttr), row, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableShards, shardKey(shardID), shardSortKey)
+	if err != nil {
+		return err
+	}
+	it.setInt(rangeIDAttr, rangeID)
+	return db.updateShardItem(ctx, shardID, it, previousRangeID)
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	it, err := newShardItem(row.ShardID, row.RangeID, row)
+	if err != nil {
+		return err
+	}
+	return db.updateShardItem(ctx, row.ShardID, it, previousRangeID)
+}
+
+func (db *ddb) updateShardItem(ctx context.Context, shardID int, it item, previousRangeID int64) error {
+	err := db.write(ctx, putOp(tableShards, it, newCondition().equalInt(rangeIDAttr, previousRangeID)))
+	if isConditionFailedError(err) {
+		return db.shardConditionFailure(ctx, shardID, fmt.Sprintf("previous range_id %v doesn't match", previousRangeID))
+	}
+	return err
+}
+
+// shardConditionFailure reads the current range ID of the shard to report a failed condition
+func (db *ddb) shardConditionFailure(ctx context.Context, shardID int, details string) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("%v, range_id=%v", details, rangeID),
+	}
+}
+
+// selectShardRangeID returns the range ID of the shard, or -1 if the shard doesn't exist
+func (db *ddb) selectShardRangeID(ctx context.Context, shardID int) (int64, error) {
+	it, err := db.getItem(ctx, tableShards, shardKey(shardID), shardSortKey)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return -1, nil
+		}
+		return 0, err
+	}
+	return it.getInt(rangeIDAttr), nil
+}
+
+func newShardItem(shardID int, rangeID int64, row *nosqlplugin.ShardRow) (item, error) {
+	return newItem(shardKey(shardID), shardSortKey).
+		setInt(rangeIDAttr, rangeID).
+		setData(row)
+}
+
+func shardKey(shardID int) string {
+	return strconv.Itoa(shardID)
 }
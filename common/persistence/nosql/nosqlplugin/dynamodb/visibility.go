@@ -24,14 +24,21 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+// errVisibilityNotSupported is returned by all the visibility operations, DynamoDB can't serve the
+// filtered and sorted listings of basic visibility so advanced visibility must be used instead
+var errVisibilityNotSupported = &types.InternalServiceError{
+	Message: "visibility is not supported by DynamoDB, please use advanced visibility",
+}
+
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	return errVisibilityNotSupported
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +46,26 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	return errVisibilityNotSupported
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	return nil, errVisibilityNotSupported
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	return errVisibilityNotSupported
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	return nil, errVisibilityNotSupported
 }
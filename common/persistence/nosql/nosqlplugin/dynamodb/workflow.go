@@ -23,8 +23,11 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -40,7 +43,32 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	txn := newWorkflowTransaction()
+
+	if err := txn.addActiveClusterSelectionPolicy(activeClusterSelectionPolicyRow); err != nil {
+		return err
+	}
+	if err := txn.addWorkflowRequests(requests, time.Now()); err != nil {
+		return err
+	}
+	if err := txn.addCurrentWorkflow(shardID, execution.DomainID, execution.WorkflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if err := addNewExecution(txn, shardID, execution); err != nil {
+		return err
+	}
+	if err := txn.addHistoryTasks(shardID, tasksByCategory); err != nil {
+		return err
+	}
+	txn.addShardCondition(shardCondition)
+
+	err := db.transact(ctx, txn.ops)
+	failed, conditionFailed := failedConditions(err)
+	if !conditionFailed {
+		return err
+	}
+	return db.insertWorkflowConditionFailure(ctx, txn, failed, currentWorkflowRequest, execution, shardCondition)
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -54,113 +82,775 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	txn := newWorkflowTransaction()
+	if err := txn.addWorkflowRequests(requests, time.Now()); err != nil {
+		return err
+	}
+	if err := txn.addCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := db.addMutatedExecution(ctx, txn, shardID, mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := addNewExecution(txn, shardID, insertedExecution); err != nil {
+			return err
+		}
+		if err := txn.addActiveClusterSelectionPolicy(activeClusterSelectionPolicyRow); err != nil {
+			return err
+		}
+	}
+	if resetExecution != nil {
+		if err := db.addResetExecution(ctx, txn, shardID, resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := txn.addHistoryTasks(shardID, tasksByCategory); err != nil {
+		return err
+	}
+	txn.addShardCondition(shardCondition)
+
+	err := db.transact(ctx, txn.ops)
+	failed, conditionFailed := failedConditions(err)
+	if !conditionFailed {
+		return err
+	}
+	return db.updateWorkflowConditionFailure(ctx, txn, failed, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID), currentWorkflowSortKey(domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.CurrentWorkflowRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
 
+// SelectWorkflowExecution reads the execution item and its mutable state items. The items are read by a query,
+// which isn't atomic with the read of the execution item, so the read is retried if the execution was written
+// in between.
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	key := executionKey{shardID: shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+	for attempt := 1; ; attempt++ {
+		record, recordVersion, err := db.selectExecutionRecord(ctx, shardID, domainID, workflowID, runID)
+		if err != nil {
+			return nil, err
+		}
+		items, err := db.queryAll(ctx, queryRequest{
+			table: tableExecutions,
+			pk:    shardKey(shardID),
+			sk:    skBeginsWith(key.mutableStatePrefix()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		it, err := db.getItem(ctx, tableExecutions, shardKey(shardID), executionSortKey(domainID, workflowID, runID))
+		if err != nil && !db.IsNotFoundError(err) {
+			return nil, err
+		}
+		if it != nil && it.getInt(recordVersionAttr) == recordVersion {
+			return toWorkflowExecution(record, items)
+		}
+		if attempt >= mutableStateReadAttempts {
+			return nil, fmt.Errorf("execution %v/%v/%v kept changing while it was read", domainID, workflowID, runID)
+		}
+	}
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	err := db.write(ctx, deleteOp(tableExecutions, shardKey(shardID), currentWorkflowSortKey(domainID, workflowID),
+		newCondition().equalString(currentRunIDAttr, currentRunIDCondition)))
+	if isConditionFailedError(err) {
+		// same as a lightweight transaction that isn't applied, the current workflow has moved on to another run
+		return nil
+	}
+	return err
 }
 
+// DeleteWorkflowExecution deletes the execution item first, so the execution is gone even if deleting
+// its other items fails. Those are never read again, as run IDs aren't reused.
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	if err := db.write(ctx, deleteOp(tableExecutions, shardKey(shardID), executionSortKey(domainID, workflowID, runID), nil)); err != nil {
+		return err
+	}
+	key := executionKey{shardID: shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+	for _, prefix := range []string{key.mutableStatePrefix(), key.workflowTimerTaskPrefix()} {
+		items, err := db.queryAll(ctx, queryRequest{
+			table: tableExecutions,
+			pk:    shardKey(shardID),
+			sk:    skBeginsWith(prefix),
+		})
+		if err != nil {
+			return err
+		}
+		if err := db.deleteItems(ctx, tableExecutions, items); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// SelectWorkflowTimerTasks reads the timer task items of the execution.
 func (db *ddb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
-	panic("TODO")
+	key := executionKey{shardID: shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+	items, err := db.queryAll(ctx, queryRequest{
+		table: tableExecutions,
+		pk:    shardKey(shardID),
+		sk:    skBeginsWith(key.workflowTimerTaskPrefix()),
+	})
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	keys := make([]persistence.HistoryTaskKey, 0, len(items))
+	for _, it := range items {
+		var task workflowTimerTask
+		if err := it.getData(&task); err != nil {
+			return nil, err
+		}
+		keys = append(keys, persistence.NewHistoryTaskKey(task.VisibilityTimestamp, task.TaskID))
+	}
+	return keys, nil
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableExecutions,
+		pk:        shardKey(shardID),
+		sk:        skBeginsWith(currentWorkflowPrefix + keySeparator),
+		limit:     pageSize,
+		pageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, it := range items {
+		row := &nosqlplugin.CurrentWorkflowRow{}
+		if err := it.getData(row); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        row.RunID,
+			State:        row.State,
+			CurrentRunID: row.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableExecutions,
+		pk:        shardKey(shardID),
+		sk:        skBeginsWith(executionPrefix + keySeparator),
+		limit:     pageSize,
+		pageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, it := range items {
+		record := &executionRecord{}
+		if err := it.getData(record); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    record.ExecutionInfo,
+			VersionHistories: record.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	_, err := db.getItem(ctx, tableExecutions, shardKey(shardID), executionSortKey(domainID, workflowID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryTransfer)
+	return db.selectHistoryTasksByID(ctx, pk, pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryTransfer)
+	items := make([]item, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, newItem(pk, sortableInt(key.GetTaskID())))
+	}
+	return db.deleteItems(ctx, tableHistoryTasks, items)
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryTransfer)
+	return db.rangeDeleteHistoryTasksByID(ctx, pk, inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	sk, ok := timerTasksKeyCondition(inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableHistoryTasks,
+		pk:        historyTaskKey(shardID, persistence.HistoryTaskCategoryTimer),
+		sk:        sk,
+		limit:     pageSize,
+		pageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toHistoryMigrationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryTimer)
+	items := make([]item, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, newItem(pk, timerTaskSortKey(key.GetScheduledTime(), key.GetTaskID())))
+	}
+	return db.deleteItems(ctx, tableHistoryTasks, items)
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	sk, ok := timerTasksKeyCondition(inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil
+	}
+	items, err := db.queryAll(ctx, queryRequest{
+		table: tableHistoryTasks,
+		pk:    historyTaskKey(shardID, persistence.HistoryTaskCategoryTimer),
+		sk:    sk,
+	})
+	if err != nil {
+		return err
+	}
+	return db.deleteItems(ctx, tableHistoryTasks, items)
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryReplication)
+	return db.selectHistoryTasksByID(ctx, pk, pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryReplication)
+	items := make([]item, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, newItem(pk, sortableInt(key.GetTaskID())))
+	}
+	return db.deleteItems(ctx, tableHistoryTasks, items)
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	pk := historyTaskKey(shardID, persistence.HistoryTaskCategoryReplication)
+	return db.rangeDeleteHistoryTasksByID(ctx, pk, math.MinInt64, exclusiveEndTaskID)
 }
 
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	return db.InsertHistoryTasks(ctx, map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		persistence.HistoryTaskCategoryReplication: tasks,
+	}, time.Now(), condition)
 }
 
 func (db *ddb) InsertHistoryTasks(ctx context.Context, tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask, currentTimeStamp time.Time, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	txn := newWorkflowTransaction()
+	if err := txn.addHistoryTasks(condition.ShardID, tasksByCategory); err != nil {
+		return err
+	}
+	if len(txn.ops) == 0 {
+		return nil
+	}
+
+	// every transaction checks the range ID of the shard, so tasks are never written after losing the ownership
+	for start := 0; start < len(txn.ops); start += maxTransactionItems - 1 {
+		end := start + maxTransactionItems - 1
+		if end > len(txn.ops) {
+			end = len(txn.ops)
+		}
+		chunk := append([]writeOp{shardConditionOp(&condition)}, txn.ops[start:end]...)
+		err := db.transact(ctx, chunk)
+		if _, conditionFailed := failedConditions(err); conditionFailed {
+			return db.shardConditionFailure(ctx, condition.ShardID, fmt.Sprintf("range_id %v doesn't match", condition.RangeID))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// DeleteCrossClusterTask is a noop, cross cluster tasks are deprecated and never written by this plugin
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return nil
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	it, err := newHistoryTaskItem(replicationDLQKey(shardID, sourceCluster), persistence.HistoryTaskCategoryReplication, task)
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(tableHistoryTasks, it, nil))
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	pk := replicationDLQKey(shardID, sourceCluster)
+	return db.selectHistoryTasksByID(ctx, pk, pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, queryRequest{
+		table: tableHistoryTasks,
+		pk:    replicationDLQKey(shardID, sourceCluster),
+	})
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.write(ctx, deleteOp(tableHistoryTasks, replicationDLQKey(shardID, sourceCluster), sortableInt(taskID), nil))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	pk := replicationDLQKey(shardID, sourceCluster)
+	return db.rangeDeleteHistoryTasksByID(ctx, pk, inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID), activeClusterSelectionPolicySortKey(domainID, wfID, rID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	policy := &persistence.DataBlob{}
+	if err := it.getData(policy); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     policy,
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.write(ctx, deleteOp(tableExecutions, shardKey(shardID), activeClusterSelectionPolicySortKey(domainID, wfID, rID), nil))
+}
+
+func addNewExecution(txn *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	key := newExecutionKey(shardID, execution)
+	if err := txn.addExecution(shardID, execution, 0, newCondition().notExists()); err != nil {
+		return err
+	}
+	if err := txn.addMutableState(key, execution); err != nil {
+		return err
+	}
+	return txn.addWorkflowTimerTasks(key, execution.WorkflowTimerTasks)
+}
+
+func (db *ddb) addMutatedExecution(ctx context.Context, txn *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+	// a missing item is written with a condition that can't be met, the same as updating a missing row
+	recordVersion, err := db.selectRecordVersion(ctx, shardID, execution)
+	if err != nil {
+		return err
+	}
+
+	key := newExecutionKey(shardID, execution)
+	if err := txn.addExecution(shardID, execution, recordVersion, executionUpdateCondition(execution, recordVersion)); err != nil {
+		return err
+	}
+	if err := txn.addMutableState(key, execution); err != nil {
+		return err
+	}
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		items, err := db.queryAll(ctx, queryRequest{
+			table: tableExecutions,
+			pk:    shardKey(shardID),
+			sk:    skBeginsWith(key.mutableStateKindPrefix(bufferedEventsKind)),
+		})
+		if err != nil {
+			return err
+		}
+		txn.deleteMutableStateItems(key, items)
+	case nosqlplugin.EventBufferWriteModeAppend:
+		txn.addBufferedEvents(key, recordVersion, execution.NewBufferedEventBatch)
+	}
+	return txn.addWorkflowTimerTasks(key, execution.WorkflowTimerTasks)
+}
+
+func (db *ddb) addResetExecution(ctx context.Context, txn *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	recordVersion, err := db.selectRecordVersion(ctx, shardID, execution)
+	if err != nil {
+		return err
+	}
+	key := newExecutionKey(shardID, execution)
+	previous, err := db.queryAll(ctx, queryRequest{
+		table: tableExecutions,
+		pk:    shardKey(shardID),
+		sk:    skBeginsWith(key.mutableStatePrefix()),
+	})
+	if err != nil {
+		return err
+	}
+
+	// all the mutable state items are replaced, while the timer tasks are only appended
+	if err := txn.addExecution(shardID, execution, recordVersion, executionUpdateCondition(execution, recordVersion)); err != nil {
+		return err
+	}
+	if err := txn.addMutableState(key, execution); err != nil {
+		return err
+	}
+	txn.deleteMutableStateItems(key, previous)
+	return txn.addWorkflowTimerTasks(key, execution.WorkflowTimerTasks)
+}
+
+// selectRecordVersion returns the version of the item of an execution, or zero if it doesn't exist
+func (db *ddb) selectRecordVersion(ctx context.Context, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) (int64, error) {
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return it.getInt(recordVersionAttr), nil
+}
+
+// selectExecutionRecord returns the record of an execution and its version
+func (db *ddb) selectExecutionRecord(ctx context.Context, shardID int, domainID, workflowID, runID string) (*executionRecord, int64, error) {
+	it, err := db.getItem(ctx, tableExecutions, shardKey(shardID), executionSortKey(domainID, workflowID, runID))
+	if err != nil {
+		return nil, 0, err
+	}
+	record := &executionRecord{}
+	if err := it.getData(record); err != nil {
+		return nil, 0, err
+	}
+	return record, it.getInt(recordVersionAttr), nil
+}
+
+func (db *ddb) selectHistoryTasksByID(ctx context.Context, pk string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	if exclusiveMaxTaskID == math.MinInt64 {
+		return nil, nil, nil
+	}
+	sk, ok := skIntBetween("", inclusiveMinTaskID, exclusiveMaxTaskID-1)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		table:     tableHistoryTasks,
+		pk:        pk,
+		sk:        sk,
+		limit:     pageSize,
+		pageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := toHistoryMigrationTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *ddb) rangeDeleteHistoryTasksByID(ctx context.Context, pk string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
+	if exclusiveEndTaskID == math.MinInt64 {
+		return nil
+	}
+	sk, ok := skIntBetween("", inclusiveBeginTaskID, exclusiveEndTaskID-1)
+	if !ok {
+		return nil
+	}
+	items, err := db.queryAll(ctx, queryRequest{
+		table: tableHistoryTasks,
+		pk:    pk,
+		sk:    sk,
+	})
+	if err != nil {
+		return err
+	}
+	return db.deleteItems(ctx, tableHistoryTasks, items)
+}
+
+func (db *ddb) insertWorkflowConditionFailure(
+	ctx context.Context,
+	txn *workflowTransaction,
+	failed []int,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if err := db.commonWorkflowConditionFailure(ctx, txn, failed, shardCondition); err != nil {
+		return err
+	}
+
+	for _, i := range failed {
+		if i != txn.current {
+			continue
+		}
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, execution.DomainID, execution.WorkflowID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if current == nil {
+			// the current workflow is gone after the condition failed
+			break
+		}
+		switch currentWorkflowRequest.WriteMode {
+		case nosqlplugin.CurrentWorkflowWriteModeInsert:
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, current.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  current.CreateRequestID,
+					RunID:            current.RunID,
+					State:            current.State,
+					CloseStatus:      current.CloseStatus,
+					LastWriteVersion: current.LastWriteVersion,
+				},
+			}
+		case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+			condition := currentWorkflowRequest.Condition
+			var msg string
+			if current.RunID != condition.GetCurrentRunID() {
+				msg = fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+					currentWorkflowRequest.Row.WorkflowID, condition.GetCurrentRunID(), current.RunID)
+			} else if condition.LastWriteVersion != nil && *condition.LastWriteVersion != current.LastWriteVersion {
+				msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.LastWriteVersion, current.LastWriteVersion)
+			} else if condition.State != nil && *condition.State != current.State {
+				msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+					currentWorkflowRequest.Row.WorkflowID, *condition.State, current.State)
+			}
+			if msg != "" {
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+		}
+	}
+
+	for _, i := range failed {
+		if _, ok := txn.executions[i]; !ok {
+			continue
+		}
+		record, _, err := db.selectExecutionRecord(ctx, shardCondition.ShardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		lastWriteVersion := execution.LastWriteVersion
+		if record != nil {
+			lastWriteVersion = record.LastWriteVersion
+		}
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: lastWriteVersion,
+			},
+		}
+	}
+
+	return newUnknownConditionFailureReason(shardCondition.RangeID, failed)
+}
+
+func (db *ddb) updateWorkflowConditionFailure(
+	ctx context.Context,
+	txn *workflowTransaction,
+	failed []int,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	if err := db.commonWorkflowConditionFailure(ctx, txn, failed, shardCondition); err != nil {
+		return err
+	}
+
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+	for _, i := range failed {
+		if i != txn.current {
+			continue
+		}
+		actualCurrRunID := ""
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, currentWorkflowRequest.Row.DomainID, currentWorkflowRequest.Row.WorkflowID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if current != nil {
+			actualCurrRunID = current.RunID
+		}
+		if requestConditionalRunID != "" && actualCurrRunID != requestConditionalRunID {
+			msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+				requestConditionalRunID, actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	}
+
+	for _, i := range failed {
+		execution, ok := txn.executions[i]
+		if !ok || execution.PreviousNextEventIDCondition == nil {
+			continue
+		}
+		actualNextEventID := int64(0)
+		it, err := db.getItem(ctx, tableExecutions, shardKey(shardCondition.ShardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID))
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if it != nil {
+			actualNextEventID = it.getInt(nextEventIDAttr)
+		}
+		if actualNextEventID != previousNextEventIDCondition {
+			msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+				previousNextEventIDCondition, actualNextEventID, currentWorkflowRequest.Row.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, failed conditions: %v",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, failed)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// commonWorkflowConditionFailure explains the failed conditions that are shared by creating and updating workflows,
+// in the same priority as the Cassandra plugin: the shard ownership first, then the duplicate requests.
+// It returns nil if none of them failed.
+func (db *ddb) commonWorkflowConditionFailure(
+	ctx context.Context,
+	txn *workflowTransaction,
+	failed []int,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	for _, i := range failed {
+		if i != txn.shard {
+			continue
+		}
+		rangeID, err := db.selectShardRangeID(ctx, shardCondition.ShardID)
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+		}
+	}
+
+	for _, i := range failed {
+		row, ok := txn.requests[i]
+		if !ok {
+			continue
+		}
+		it, err := db.getItem(ctx, tableExecutions, shardKey(row.ShardID), workflowRequestSortKey(row.DomainID, row.WorkflowID, row.RequestType, row.RequestID))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				// the request expired after the condition failed
+				continue
+			}
+			return err
+		}
+		existing := &nosqlplugin.WorkflowRequestRow{}
+		if err := it.getData(existing); err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: existing.RequestType,
+				RunID:       existing.RunID,
+			},
+		}
+	}
+	return nil
+}
+
+func executionUpdateCondition(execution *nosqlplugin.WorkflowExecutionRequest, recordVersion int64) *condition {
+	return newCondition().
+		equalInt(nextEventIDAttr, *execution.PreviousNextEventIDCondition).
+		equalInt(recordVersionAttr, recordVersion)
+}
+
+func timerTasksKeyCondition(inclusiveMinTime, exclusiveMaxTime time.Time) (*keyCondition, bool) {
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil, false
+	}
+	return skBetween(
+		sortableTime(inclusiveMinTime),
+		joinKey(sortableTime(exclusiveMaxTime.Add(-time.Nanosecond)), maxKeySuffix),
+	), true
+}
+
+func newUnknownConditionFailureReason(rangeID int64, failed []int) *nosqlplugin.WorkflowOperationConditionFailure {
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, failed conditions: %v", rangeID, failed)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
 }
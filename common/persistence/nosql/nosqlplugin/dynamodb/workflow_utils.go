@@ -0,0 +1,521 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	currentWorkflowPrefix              = "current"
+	executionPrefix                    = "execution"
+	workflowRequestPrefix              = "request"
+	activeClusterSelectionPolicyPrefix = "policy"
+	replicationDLQPrefix               = "dlq"
+	mutableStatePrefix                 = "state"
+	workflowTimerTaskPrefix            = "timer_task"
+
+	// kinds of the mutable state items of an execution, which are also part of their sort keys
+	activityInfoKind       = "activity"
+	timerInfoKind          = "timer"
+	childExecutionInfoKind = "child"
+	requestCancelInfoKind  = "cancel"
+	signalInfoKind         = "signal"
+	signalRequestedIDKind  = "signal_requested"
+	bufferedEventsKind     = "buffered"
+
+	currentRunIDAttr     = "current_run_id"
+	lastWriteVersionAttr = "last_write_version"
+	workflowStateAttr    = "workflow_state"
+	nextEventIDAttr      = "next_event_id"
+	kindAttr             = "kind"
+	// entryKeyAttr is the key of the map entry that a mutable state item stores
+	entryKeyAttr = "entry_key"
+
+	// event blobs are stored as binary attributes instead of in the JSON data, where they would be base64 encoded
+	scheduledEventAttr = "scheduled_event"
+	startedEventAttr   = "started_event"
+	initiatedEventAttr = "initiated_event"
+	eventsAttr         = "events"
+
+	// mutableStateReadAttempts is how many times an execution is read before giving up when it keeps changing
+	mutableStateReadAttempts = 5
+
+	// workflowRequestTTLInSeconds is how long a request is remembered for deduplication
+	workflowRequestTTLInSeconds = 10800
+)
+
+type (
+	// executionRecord is the data of an execution item. The maps and the buffered events of the execution
+	// are separate items of the same partition, one per entry like the rows of the Cassandra and SQL plugins,
+	// so an execution isn't bounded by the size limit of an item. So are its timer tasks.
+	executionRecord struct {
+		ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+		VersionHistories *persistence.DataBlob
+		Checksum         checksum.Checksum
+		LastWriteVersion int64
+	}
+
+	// workflowTimerTask is an entry of the timer tasks of an execution, HistoryTaskKey can't be encoded directly
+	workflowTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+	}
+
+	// executionKey is the key of an execution, which its items are stored under
+	executionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	// workflowTransaction is the transaction of a workflow write, it tracks what each write is
+	// so that a failed condition can be explained
+	workflowTransaction struct {
+		ops        []writeOp
+		shard      int
+		current    int
+		executions map[int]*nosqlplugin.WorkflowExecutionRequest
+		requests   map[int]*nosqlplugin.WorkflowRequestRow
+		// written is the sort keys of the execution items written so far, DynamoDB rejects
+		// transactions with several operations on the same item
+		written map[string]struct{}
+	}
+)
+
+func newWorkflowTransaction() *workflowTransaction {
+	return &workflowTransaction{
+		shard:      -1,
+		current:    -1,
+		executions: map[int]*nosqlplugin.WorkflowExecutionRequest{},
+		requests:   map[int]*nosqlplugin.WorkflowRequestRow{},
+		written:    map[string]struct{}{},
+	}
+}
+
+func (t *workflowTransaction) add(op writeOp) int {
+	t.ops = append(t.ops, op)
+	return len(t.ops) - 1
+}
+
+func (t *workflowTransaction) addWorkflowRequests(requests *nosqlplugin.WorkflowRequestsWriteRequest, now time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	var cond *condition
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert:
+		cond = newCondition().notExistsOrExpired(now)
+	case nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	for _, row := range requests.Rows {
+		it, err := newItem(shardKey(row.ShardID), workflowRequestSortKey(row.DomainID, row.WorkflowID, row.RequestType, row.RequestID)).
+			setTTL(now, workflowRequestTTLInSeconds).
+			setData(row)
+		if err != nil {
+			return err
+		}
+		t.requests[t.add(putOp(tableExecutions, it, cond))] = row
+	}
+	return nil
+}
+
+func (t *workflowTransaction) addCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	var cond *condition
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		cond = newCondition().notExists()
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		cond = newCondition().equalString(currentRunIDAttr, *request.Condition.CurrentRunID)
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			cond.equalInt(lastWriteVersionAttr, *request.Condition.LastWriteVersion).
+				equalInt(workflowStateAttr, int64(*request.Condition.State))
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	it, err := newItem(shardKey(shardID), currentWorkflowSortKey(domainID, workflowID)).
+		setString(currentRunIDAttr, row.RunID).
+		setInt(lastWriteVersionAttr, row.LastWriteVersion).
+		setInt(workflowStateAttr, int64(row.State)).
+		setData(&row)
+	if err != nil {
+		return err
+	}
+	t.current = t.add(putOp(tableExecutions, it, cond))
+	return nil
+}
+
+// addExecution writes the item of an execution, recordVersion is the version of the item that was read
+func (t *workflowTransaction) addExecution(
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	recordVersion int64,
+	cond *condition,
+) error {
+	info := execution.InternalWorkflowExecutionInfo
+	record := &executionRecord{
+		ExecutionInfo:    &info,
+		VersionHistories: execution.VersionHistories,
+		LastWriteVersion: execution.LastWriteVersion,
+	}
+	if execution.Checksums != nil {
+		record.Checksum = *execution.Checksums
+	}
+	it, err := newItem(shardKey(shardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID)).
+		setInt(nextEventIDAttr, execution.NextEventID).
+		setInt(recordVersionAttr, recordVersion+1).
+		setData(record)
+	if err != nil {
+		return err
+	}
+	t.executions[t.add(putOp(tableExecutions, it, cond))] = execution
+	return nil
+}
+
+// addMutableState writes an item per entry of the maps of an execution and deletes the items of the deleted
+// keys. Entries which are written aren't deleted by the same transaction.
+func (t *workflowTransaction) addMutableState(key executionKey, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	for id, info := range execution.ActivityInfos {
+		activity := *info
+		activity.ScheduledEvent = nil
+		activity.StartedEvent = nil
+		it, err := key.newMutableStateItem(activityInfoKind, sortableInt(id)).setInt(entryKeyAttr, id).setData(&activity)
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it.setBlob(scheduledEventAttr, info.ScheduledEvent).setBlob(startedEventAttr, info.StartedEvent))
+	}
+	for id, info := range execution.TimerInfos {
+		it, err := key.newMutableStateItem(timerInfoKind, id).setString(entryKeyAttr, id).setData(info)
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it)
+	}
+	for id, info := range execution.ChildWorkflowInfos {
+		child := *info
+		child.InitiatedEvent = nil
+		child.StartedEvent = nil
+		it, err := key.newMutableStateItem(childExecutionInfoKind, sortableInt(id)).setInt(entryKeyAttr, id).setData(&child)
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it.setBlob(initiatedEventAttr, info.InitiatedEvent).setBlob(startedEventAttr, info.StartedEvent))
+	}
+	for id, info := range execution.RequestCancelInfos {
+		it, err := key.newMutableStateItem(requestCancelInfoKind, sortableInt(id)).setInt(entryKeyAttr, id).setData(info)
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it)
+	}
+	for id, info := range execution.SignalInfos {
+		it, err := key.newMutableStateItem(signalInfoKind, sortableInt(id)).setInt(entryKeyAttr, id).setData(info)
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it)
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		t.addMutableStateItem(key.newMutableStateItem(signalRequestedIDKind, id).setString(entryKeyAttr, id))
+	}
+
+	for _, id := range execution.ActivityInfoKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(activityInfoKind, sortableInt(id)))
+	}
+	for _, id := range execution.TimerInfoKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(timerInfoKind, id))
+	}
+	for _, id := range execution.ChildWorkflowInfoKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(childExecutionInfoKind, sortableInt(id)))
+	}
+	for _, id := range execution.RequestCancelInfoKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(requestCancelInfoKind, sortableInt(id)))
+	}
+	for _, id := range execution.SignalInfoKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(signalInfoKind, sortableInt(id)))
+	}
+	for _, id := range execution.SignalRequestedIDsKeysToDelete {
+		t.deleteMutableStateItem(key, key.mutableStateSortKey(signalRequestedIDKind, id))
+	}
+	return nil
+}
+
+// addBufferedEvents appends a batch of buffered events. The batches are ordered by the version of the
+// execution item which appended them, which is incremented by every write.
+func (t *workflowTransaction) addBufferedEvents(key executionKey, recordVersion int64, events *persistence.DataBlob) {
+	t.addMutableStateItem(key.newMutableStateItem(bufferedEventsKind, sortableInt(recordVersion+1)).setBlob(eventsAttr, events))
+}
+
+// addWorkflowTimerTasks adds the timer tasks of an execution, which are only deleted with the execution
+func (t *workflowTransaction) addWorkflowTimerTasks(key executionKey, tasks []persistence.HistoryTaskKey) error {
+	for _, task := range tasks {
+		it, err := newItem(shardKey(key.shardID), key.workflowTimerTaskSortKey(task.GetScheduledTime(), task.GetTaskID())).
+			setData(&workflowTimerTask{VisibilityTimestamp: task.GetScheduledTime(), TaskID: task.GetTaskID()})
+		if err != nil {
+			return err
+		}
+		t.addMutableStateItem(it)
+	}
+	return nil
+}
+
+// deleteMutableStateItems deletes the items which aren't written by the transaction
+func (t *workflowTransaction) deleteMutableStateItems(key executionKey, items []item) {
+	for _, it := range items {
+		t.deleteMutableStateItem(key, it.getString(skAttr))
+	}
+}
+
+func (t *workflowTransaction) addMutableStateItem(it item) {
+	t.written[it.getString(skAttr)] = struct{}{}
+	t.add(putOp(tableExecutions, it, nil))
+}
+
+func (t *workflowTransaction) deleteMutableStateItem(key executionKey, sk string) {
+	if _, ok := t.written[sk]; ok {
+		return
+	}
+	t.written[sk] = struct{}{}
+	t.add(deleteOp(tableExecutions, shardKey(key.shardID), sk, nil))
+}
+
+func (t *workflowTransaction) addActiveClusterSelectionPolicy(row *nosqlplugin.ActiveClusterSelectionPolicyRow) error {
+	if row == nil || row.Policy == nil {
+		return nil
+	}
+	it, err := newItem(shardKey(row.ShardID), activeClusterSelectionPolicySortKey(row.DomainID, row.WorkflowID, row.RunID)).
+		setData(row.Policy)
+	if err != nil {
+		return err
+	}
+	t.add(putOp(tableExecutions, it, nil))
+	return nil
+}
+
+func (t *workflowTransaction) addHistoryTasks(
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for category, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			it, err := newHistoryTaskItem(historyTaskKey(shardID, category), category, task)
+			if err != nil {
+				return err
+			}
+			if it != nil {
+				t.add(putOp(tableHistoryTasks, it, nil))
+			}
+		}
+	}
+	return nil
+}
+
+func (t *workflowTransaction) addShardCondition(shardCondition *nosqlplugin.ShardCondition) {
+	t.shard = t.add(shardConditionOp(shardCondition))
+}
+
+func shardConditionOp(shardCondition *nosqlplugin.ShardCondition) writeOp {
+	return checkOp(tableShards, shardKey(shardCondition.ShardID), shardSortKey,
+		newCondition().equalInt(rangeIDAttr, shardCondition.RangeID))
+}
+
+func newExecutionKey(shardID int, execution *nosqlplugin.WorkflowExecutionRequest) executionKey {
+	return executionKey{shardID: shardID, domainID: execution.DomainID, workflowID: execution.WorkflowID, runID: execution.RunID}
+}
+
+func (k executionKey) newMutableStateItem(kind, entry string) item {
+	return newItem(shardKey(k.shardID), k.mutableStateSortKey(kind, entry)).setString(kindAttr, kind)
+}
+
+func (k executionKey) mutableStateSortKey(kind, entry string) string {
+	return joinKey(mutableStatePrefix, k.domainID, k.workflowID, k.runID, kind, entry)
+}
+
+// mutableStatePrefix is the prefix of the sort keys of all the mutable state items of the execution
+func (k executionKey) mutableStatePrefix() string {
+	return joinKey(mutableStatePrefix, k.domainID, k.workflowID, k.runID) + keySeparator
+}
+
+// mutableStateKindPrefix is the prefix of the sort keys of the mutable state items of one kind
+func (k executionKey) mutableStateKindPrefix(kind string) string {
+	return joinKey(mutableStatePrefix, k.domainID, k.workflowID, k.runID, kind) + keySeparator
+}
+
+func (k executionKey) workflowTimerTaskSortKey(visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(workflowTimerTaskPrefix, k.domainID, k.workflowID, k.runID, timerTaskSortKey(visibilityTimestamp, taskID))
+}
+
+func (k executionKey) workflowTimerTaskPrefix() string {
+	return joinKey(workflowTimerTaskPrefix, k.domainID, k.workflowID, k.runID) + keySeparator
+}
+
+// toWorkflowExecution converts an execution item and its mutable state items read from the table, where blobs
+// written without data have to be turned back into nil like the other plugins do
+func toWorkflowExecution(record *executionRecord, items []item) (*nosqlplugin.WorkflowExecution, error) {
+	if info := record.ExecutionInfo; info != nil {
+		info.CompletionEvent = emptyBlobToNil(info.CompletionEvent)
+		info.AutoResetPoints = emptyBlobToNil(info.AutoResetPoints)
+		info.ActiveClusterSelectionPolicy = emptyBlobToNil(info.ActiveClusterSelectionPolicy)
+		info.CompletionCallbacks = emptyBlobToNil(info.CompletionCallbacks)
+		info.WorkflowDeadline = emptyBlobToNil(info.WorkflowDeadline)
+	}
+	execution := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       record.ExecutionInfo,
+		VersionHistories:    emptyBlobToNil(record.VersionHistories),
+		ActivityInfos:       map[int64]*persistence.InternalActivityInfo{},
+		TimerInfos:          map[string]*persistence.TimerInfo{},
+		ChildExecutionInfos: map[int64]*persistence.InternalChildExecutionInfo{},
+		RequestCancelInfos:  map[int64]*persistence.RequestCancelInfo{},
+		SignalInfos:         map[int64]*persistence.SignalInfo{},
+		SignalRequestedIDs:  map[string]struct{}{},
+		Checksum:            record.Checksum,
+	}
+	// items are read in the order of their sort keys, which keeps the buffered events in the order they were appended
+	for _, it := range items {
+		var err error
+		switch kind := it.getString(kindAttr); kind {
+		case activityInfoKind:
+			info := &persistence.InternalActivityInfo{}
+			err = it.getData(info)
+			info.ScheduledEvent = it.getBlob(scheduledEventAttr)
+			info.StartedEvent = it.getBlob(startedEventAttr)
+			execution.ActivityInfos[it.getInt(entryKeyAttr)] = info
+		case timerInfoKind:
+			info := &persistence.TimerInfo{}
+			err = it.getData(info)
+			execution.TimerInfos[it.getString(entryKeyAttr)] = info
+		case childExecutionInfoKind:
+			info := &persistence.InternalChildExecutionInfo{}
+			err = it.getData(info)
+			info.InitiatedEvent = it.getBlob(initiatedEventAttr)
+			info.StartedEvent = it.getBlob(startedEventAttr)
+			execution.ChildExecutionInfos[it.getInt(entryKeyAttr)] = info
+		case requestCancelInfoKind:
+			info := &persistence.RequestCancelInfo{}
+			err = it.getData(info)
+			execution.RequestCancelInfos[it.getInt(entryKeyAttr)] = info
+		case signalInfoKind:
+			info := &persistence.SignalInfo{}
+			err = it.getData(info)
+			execution.SignalInfos[it.getInt(entryKeyAttr)] = info
+		case signalRequestedIDKind:
+			execution.SignalRequestedIDs[it.getString(entryKeyAttr)] = struct{}{}
+		case bufferedEventsKind:
+			if events := it.getBlob(eventsAttr); events != nil {
+				execution.BufferedEvents = append(execution.BufferedEvents, events)
+			}
+		default:
+			err = fmt.Errorf("unknown kind %q of mutable state item %v", kind, it.getString(skAttr))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return execution, nil
+}
+
+func newHistoryTaskItem(pk string, category persistence.HistoryTaskCategory, migrationTask *nosqlplugin.HistoryMigrationTask) (item, error) {
+	task := *migrationTask
+	var sk string
+	switch category.ID() {
+	case persistence.HistoryTaskCategoryIDTransfer:
+		task.TaskID = task.Transfer.TaskID
+		sk = sortableInt(task.TaskID)
+	case persistence.HistoryTaskCategoryIDTimer:
+		task.TaskID = task.Timer.TaskID
+		task.ScheduledTime = task.Timer.VisibilityTimestamp
+		sk = timerTaskSortKey(task.ScheduledTime, task.TaskID)
+	case persistence.HistoryTaskCategoryIDReplication:
+		task.TaskID = task.Replication.TaskID
+		sk = sortableInt(task.TaskID)
+	default:
+		// TODO: implementing writing tasks for other categories
+		return nil, nil
+	}
+	task.Task = emptyBlobToNil(task.Task)
+	return newItem(pk, sk).setData(&task)
+}
+
+func toHistoryMigrationTasks(items []item) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		task := &nosqlplugin.HistoryMigrationTask{}
+		if err := it.getData(task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func currentWorkflowSortKey(domainID, workflowID string) string {
+	return joinKey(currentWorkflowPrefix, domainID, workflowID)
+}
+
+func executionSortKey(domainID, workflowID, runID string) string {
+	return joinKey(executionPrefix, domainID, workflowID, runID)
+}
+
+func workflowRequestSortKey(domainID, workflowID string, requestType persistence.WorkflowRequestType, requestID string) string {
+	return joinKey(workflowRequestPrefix, domainID, workflowID, strconv.Itoa(int(requestType)), requestID)
+}
+
+func activeClusterSelectionPolicySortKey(domainID, workflowID, runID string) string {
+	return joinKey(activeClusterSelectionPolicyPrefix, domainID, workflowID, runID)
+}
+
+func historyTaskKey(shardID int, category persistence.HistoryTaskCategory) string {
+	return joinKey(shardKey(shardID), strconv.Itoa(category.ID()))
+}
+
+func replicationDLQKey(shardID int, sourceCluster string) string {
+	return joinKey(shardKey(shardID), replicationDLQPrefix, sourceCluster)
+}
+
+func timerTaskSortKey(visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(sortableTime(visibilityTimestamp), sortableInt(taskID))
+}
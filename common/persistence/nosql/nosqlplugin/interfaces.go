@@ -67,6 +67,7 @@ type (
 		WorkflowCRUD
 		ConfigStoreCRUD
 		DomainAuditLogCRUD
+		AsyncWorkflowRequestStatusCRUD
 		HistoryDLQTaskCRUD
 	}
 
@@ -549,6 +550,25 @@ type (
 		SelectDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]*DomainAuditLogRow, []byte, error)
 	}
 
+	/***
+	* AsyncWorkflowRequestStatusCRUD is for tracking requests sent through async workflow APIs
+	*
+	* Recommendation: use one table with a TTL
+	*
+	* Significant columns:
+	* async_workflow_request_status: partition key(domainID, requestID)
+	 */
+	AsyncWorkflowRequestStatusCRUD interface {
+		// InsertAsyncWorkflowRequestStatus inserts the status of a newly queued request
+		InsertAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error
+		// UpdateAsyncWorkflowRequestStatus updates the state, run ID and failure reason of a request
+		// Return ConditionFailure if the row doesn't exist, it must not be created by the update
+		UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error
+		// SelectAsyncWorkflowRequestStatus returns the status of a request
+		// Return NotFound error if the row doesn't exist
+		SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error)
+	}
+
 	/***
 	 * HistoryDLQTaskCRUD is for the history task dead-letter queue.
 	 *
@@ -366,6 +366,20 @@ func (mr *MockDBMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MockDB)(nil).GetTasksCount), ctx, filter)
 }
 
+// InsertAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) InsertAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncWorkflowRequestStatus indicates an expected call of InsertAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) InsertAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).InsertAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // InsertConfig mocks base method.
 func (m *MockDB) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
 	m.ctrl.T.Helper()
@@ -868,6 +882,21 @@ func (mr *MockDBMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, pageToke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MockDB)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncWorkflowRequestStatus indicates an expected call of SelectAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) SelectAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).SelectAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MockDB) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -1251,6 +1280,20 @@ func (mr *MockDBMockRecorder) SelectWorkflowTimerTasks(ctx, shardID, domainID, w
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectWorkflowTimerTasks", reflect.TypeOf((*MockDB)(nil).SelectWorkflowTimerTasks), ctx, shardID, domainID, workflowID, runID)
 }
 
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockDB) UpdateDomain(ctx context.Context, row *DomainRow) error {
 	m.ctrl.T.Helper()
@@ -1627,6 +1670,20 @@ func (mr *MocktableCRUDMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MocktableCRUD)(nil).GetTasksCount), ctx, filter)
 }
 
+// InsertAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) InsertAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncWorkflowRequestStatus indicates an expected call of InsertAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) InsertAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).InsertAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // InsertConfig mocks base method.
 func (m *MocktableCRUD) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
 	m.ctrl.T.Helper()
@@ -2059,6 +2116,21 @@ func (mr *MocktableCRUDMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MocktableCRUD)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncWorkflowRequestStatus indicates an expected call of SelectAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MocktableCRUD) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -2442,6 +2514,20 @@ func (mr *MocktableCRUDMockRecorder) SelectWorkflowTimerTasks(ctx, shardID, doma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectWorkflowTimerTasks", reflect.TypeOf((*MocktableCRUD)(nil).SelectWorkflowTimerTasks), ctx, shardID, domainID, workflowID, runID)
 }
 
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // UpdateDomain mocks base method.
 func (m *MocktableCRUD) UpdateDomain(ctx context.Context, row *DomainRow) error {
 	m.ctrl.T.Helper()
@@ -3930,6 +4016,73 @@ func (mr *MockDomainAuditLogCRUDMockRecorder) SelectDomainAuditLogs(ctx, filter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectDomainAuditLogs", reflect.TypeOf((*MockDomainAuditLogCRUD)(nil).SelectDomainAuditLogs), ctx, filter)
 }
 
+// MockAsyncWorkflowRequestStatusCRUD is a mock of AsyncWorkflowRequestStatusCRUD interface.
+type MockAsyncWorkflowRequestStatusCRUD struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncWorkflowRequestStatusCRUDMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncWorkflowRequestStatusCRUDMockRecorder is the mock recorder for MockAsyncWorkflowRequestStatusCRUD.
+type MockAsyncWorkflowRequestStatusCRUDMockRecorder struct {
+	mock *MockAsyncWorkflowRequestStatusCRUD
+}
+
+// NewMockAsyncWorkflowRequestStatusCRUD creates a new mock instance.
+func NewMockAsyncWorkflowRequestStatusCRUD(ctrl *gomock.Controller) *MockAsyncWorkflowRequestStatusCRUD {
+	mock := &MockAsyncWorkflowRequestStatusCRUD{ctrl: ctrl}
+	mock.recorder = &MockAsyncWorkflowRequestStatusCRUDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncWorkflowRequestStatusCRUD) EXPECT() *MockAsyncWorkflowRequestStatusCRUDMockRecorder {
+	return m.recorder
+}
+
+// InsertAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusCRUD) InsertAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncWorkflowRequestStatus indicates an expected call of InsertAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusCRUDMockRecorder) InsertAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusCRUD)(nil).InsertAsyncWorkflowRequestStatus), ctx, row)
+}
+
+// SelectAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusCRUD) SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncWorkflowRequestStatus indicates an expected call of SelectAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusCRUDMockRecorder) SelectAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusCRUD)(nil).SelectAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockAsyncWorkflowRequestStatusCRUD) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockAsyncWorkflowRequestStatusCRUDMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockAsyncWorkflowRequestStatusCRUD)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // MockHistoryDLQTaskCRUD is a mock of HistoryDLQTaskCRUD interface.
 type MockHistoryDLQTaskCRUD struct {
 	ctrl     *gomock.Controller
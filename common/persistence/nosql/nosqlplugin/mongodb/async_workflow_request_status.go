@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func (db *mdb) InsertAsyncWorkflowRequestStatus(ctx context.Context, row *nosqlplugin.AsyncWorkflowRequestStatusRow) error {
+	return db.putAsyncWorkflowRequestStatus(ctx, row, nil)
+}
+
+func (db *mdb) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *nosqlplugin.AsyncWorkflowRequestStatusRow) error {
+	// same as an UPDATE in Cassandra, the workflow ID and created time of an existing status are kept.
+	// They never change, so the write only has to check that the status still exists.
+	existing, err := db.SelectAsyncWorkflowRequestStatus(ctx, row.DomainID, row.RequestID)
+	if db.IsNotFoundError(err) {
+		return nosqlplugin.NewConditionFailure("async workflow request status")
+	}
+	if err != nil {
+		return err
+	}
+	updated := *row
+	updated.WorkflowID = existing.WorkflowID
+	updated.CreatedTime = existing.CreatedTime
+	err = db.putAsyncWorkflowRequestStatus(ctx, &updated, newCondition().exists())
+	if isConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("async workflow request status")
+	}
+	return err
+}
+
+func (db *mdb) SelectAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*nosqlplugin.AsyncWorkflowRequestStatusRow, error) {
+	it, err := db.getDocument(ctx, collectionAsyncWorkflowRequestStatus, domainID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.AsyncWorkflowRequestStatusRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (db *mdb) putAsyncWorkflowRequestStatus(ctx context.Context, row *nosqlplugin.AsyncWorkflowRequestStatusRow, cond *condition) error {
+	it, err := newDocument(row.DomainID, row.RequestID).
+		setTTL(time.Now(), row.TTLSeconds).
+		setData(row)
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(collectionAsyncWorkflowRequestStatus, it, cond))
+}
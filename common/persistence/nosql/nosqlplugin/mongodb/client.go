@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+type (
+	// client is the subset of MongoDB operations that the plugin uses. It's implemented with the official
+	// driver, and with an in-memory fake in tests as the driver only exposes concrete types.
+	client interface {
+		// InsertOne inserts a document, the error satisfies mongo.IsDuplicateKeyError if it violates a unique index
+		InsertOne(ctx context.Context, collection string, document interface{}) error
+		// ReplaceOne replaces the first document matching the filter and returns the number of matched documents.
+		// With upsert, the replacement is inserted if no document matches.
+		ReplaceOne(ctx context.Context, collection string, filter bson.M, replacement interface{}, upsert bool) (int64, error)
+		// UpdateOne applies the update operators to the first document matching the filter and returns the number of matched documents
+		UpdateOne(ctx context.Context, collection string, filter bson.M, update bson.M) (int64, error)
+		// DeleteOne deletes the first document matching the filter and returns the number of deleted documents
+		DeleteOne(ctx context.Context, collection string, filter bson.M) (int64, error)
+		// DeleteMany deletes all the documents matching the filter and returns the number of deleted documents
+		DeleteMany(ctx context.Context, collection string, filter bson.M) (int64, error)
+		// Find returns the documents matching the filter in the sort order, a zero limit means no limit
+		Find(ctx context.Context, collection string, filter bson.M, sort bson.D, limit int64) ([]bson.Raw, error)
+		CountDocuments(ctx context.Context, collection string, filter bson.M) (int64, error)
+		// WithTransaction runs fn in a multi-document transaction, which is committed if fn returns no error.
+		// fn may be called again if the transaction fails with a transient error, e.g. a write conflict.
+		WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+		RunCommand(ctx context.Context, command bson.D) error
+		CollectionExists(ctx context.Context, collection string) (bool, error)
+		DropDatabase(ctx context.Context) error
+		Disconnect(ctx context.Context) error
+	}
+
+	driverClient struct {
+		client *mongo.Client
+		db     *mongo.Database
+	}
+)
+
+var _ client = (*driverClient)(nil)
+
+func (c *driverClient) InsertOne(ctx context.Context, collection string, document interface{}) error {
+	_, err := c.db.Collection(collection).InsertOne(ctx, document)
+	return err
+}
+
+func (c *driverClient) ReplaceOne(ctx context.Context, collection string, filter bson.M, replacement interface{}, upsert bool) (int64, error) {
+	result, err := c.db.Collection(collection).ReplaceOne(ctx, filter, replacement, options.Replace().SetUpsert(upsert))
+	if err != nil {
+		return 0, err
+	}
+	return result.MatchedCount, nil
+}
+
+func (c *driverClient) UpdateOne(ctx context.Context, collection string, filter bson.M, update bson.M) (int64, error) {
+	result, err := c.db.Collection(collection).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.MatchedCount, nil
+}
+
+func (c *driverClient) DeleteOne(ctx context.Context, collection string, filter bson.M) (int64, error) {
+	result, err := c.db.Collection(collection).DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func (c *driverClient) DeleteMany(ctx context.Context, collection string, filter bson.M) (int64, error) {
+	result, err := c.db.Collection(collection).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func (c *driverClient) Find(ctx context.Context, collection string, filter bson.M, sort bson.D, limit int64) ([]bson.Raw, error) {
+	opts := options.Find().SetSort(sort)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cursor, err := c.db.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []bson.Raw
+	for cursor.Next(ctx) {
+		// the current document is only valid until the next call
+		result = append(result, append(bson.Raw(nil), cursor.Current...))
+	}
+	return result, cursor.Err()
+}
+
+func (c *driverClient) CountDocuments(ctx context.Context, collection string, filter bson.M) (int64, error) {
+	return c.db.Collection(collection).CountDocuments(ctx, filter)
+}
+
+func (c *driverClient) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := c.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	opts := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	}, opts)
+	return err
+}
+
+func (c *driverClient) RunCommand(ctx context.Context, command bson.D) error {
+	return c.db.RunCommand(ctx, command).Err()
+}
+
+func (c *driverClient) CollectionExists(ctx context.Context, collection string) (bool, error) {
+	names, err := c.db.ListCollectionNames(ctx, bson.M{"name": collection})
+	if err != nil {
+		return false, err
+	}
+	return len(names) > 0, nil
+}
+
+func (c *driverClient) DropDatabase(ctx context.Context) error {
+	return c.db.Drop(ctx)
+}
+
+func (c *driverClient) Disconnect(ctx context.Context) error {
+	return c.client.Disconnect(ctx)
+}
@@ -26,7 +26,6 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
@@ -35,7 +34,6 @@ import (
 )
 
 func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
 	doc := cadence.ClusterConfigCollectionEntry{
 		RowType:              row.RowType,
 		Version:              row.Version,
@@ -43,7 +41,7 @@ func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 		Data:                 row.Values.Data,
 		DataEncoding:         row.Values.GetEncodingString(),
 	}
-	_, err := collection.InsertOne(ctx, doc)
+	err := db.client.InsertOne(ctx, collectionClusterConfig, doc)
 	if mongo.IsDuplicateKeyError(err) {
 		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
 	}
@@ -51,16 +49,19 @@ func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 }
 
 func (db *mdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	filter := bson.D{{"rowtype", rowType}}
-	queryOptions := options.FindOneOptions{}
-	queryOptions.SetSort(bson.D{{"version", -1}})
-
-	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
-	var result cadence.ClusterConfigCollectionEntry
-	err := collection.FindOne(ctx, filter, &queryOptions).Decode(&result)
+	filter := bson.M{"rowtype": rowType}
+	sort := bson.D{{Key: "version", Value: -1}}
+	raws, err := db.client.Find(ctx, collectionClusterConfig, filter, sort, 1)
 	if err != nil {
 		return nil, err
 	}
+	if len(raws) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	var result cadence.ClusterConfigCollectionEntry
+	if err := bson.Unmarshal(raws[0], &result); err != nil {
+		return nil, err
+	}
 	return &persistence.InternalConfigStoreEntry{
 		RowType:   rowType,
 		Version:   result.Version,
@@ -23,25 +23,60 @@ package mongodb
 import (
 	"context"
 
-	"go.mongodb.org/mongo-driver/mongo"
-
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+// collections defined in schema/mongodb
+const (
+	collectionShards                     = cadence.ShardsCollectionName
+	collectionExecutions                 = cadence.ExecutionsCollectionName
+	collectionHistoryTasks               = cadence.HistoryTasksCollectionName
+	collectionHistoryTree                = cadence.HistoryTreeCollectionName
+	collectionHistoryNode                = cadence.HistoryNodeCollectionName
+	collectionTasks                      = cadence.TasksCollectionName
+	collectionQueue                      = cadence.QueueCollectionName
+	collectionDomains                    = cadence.DomainsCollectionName
+	collectionClusterConfig              = cadence.ClusterConfigCollectionName
+	collectionDomainAuditLog             = cadence.DomainAuditLogCollectionName
+	collectionAsyncWorkflowRequestStatus = cadence.AsyncWorkflowRequestStatusCollectionName
+	collectionHistoryTaskDLQ             = cadence.HistoryTaskDLQCollectionName
+	collectionHistoryTaskDLQAckLevel     = cadence.HistoryTaskDLQAckLevelCollectionName
+	collectionSchemaVersion              = cadence.SchemaVersionCollectionName
 )
 
 // mdb represents a logical connection to MongoDB database
 type mdb struct {
-	client *mongo.Client
-	dbConn *mongo.Database
-	cfg    *config.NoSQL
 	logger log.Logger
+	client client
+	cfg    *config.NoSQL
+	dc     *persistence.DynamicConfiguration
 }
 
 var _ nosqlplugin.DB = (*mdb)(nil)
 
+func newMDB(
+	client client,
+	cfg *config.NoSQL,
+	logger log.Logger,
+	dc *persistence.DynamicConfiguration,
+) *mdb {
+	return &mdb{
+		logger: logger,
+		client: client,
+		cfg:    cfg,
+		dc:     dc,
+	}
+}
+
 func (db *mdb) Close() {
-	db.client.Disconnect(context.Background())
+	if err := db.client.Disconnect(context.Background()); err != nil {
+		db.logger.Warn("failed to disconnect from MongoDB", tag.Error(err))
+	}
 }
 
 func (db *mdb) PluginName() string {
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// every document has a string partition key and a string sort key, which are unique together
+	pkField = "pk"
+	skField = "sk"
+	// dataField stores all the fields of a row that are not used in keys or conditions, as JSON
+	dataField = "data"
+	// expiresAtField is the date after which the TTL index deletes the document
+	expiresAtField = "expiresat"
+	// recordVersionField is incremented on every read-modify-write of a document, so that the write can be
+	// conditioned on the document not being changed since it was read
+	recordVersionField = "recordversion"
+	// checkSequenceField is incremented by condition checks. A check has to write the document so that
+	// transactions that change it concurrently conflict with the transaction of the check.
+	checkSequenceField = "checksequence"
+
+	keySeparator = "#"
+	// maxKeySuffix sorts after any ID that is part of a key, it's used as the inclusive upper bound of a key prefix
+	maxKeySuffix = "~"
+)
+
+// document is a document of the collections which are keyed by pk and sk
+type document bson.M
+
+func newDocument(pk, sk string) document {
+	return document{
+		pkField: pk,
+		skField: sk,
+	}
+}
+
+func (d document) setString(field, value string) document {
+	d[field] = value
+	return d
+}
+
+func (d document) setInt(field string, value int64) document {
+	d[field] = value
+	return d
+}
+
+// setTTL sets the expiry of the document, a non-positive ttl means that the document never expires
+func (d document) setTTL(now time.Time, ttlSeconds int64) document {
+	if ttlSeconds > 0 {
+		d[expiresAtField] = now.Add(time.Duration(ttlSeconds) * time.Second)
+	}
+	return d
+}
+
+func (d document) setData(row interface{}) (document, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	d[dataField] = data
+	return d, nil
+}
+
+func (d document) getString(field string) string {
+	value, _ := d[field].(string)
+	return value
+}
+
+func (d document) getInt(field string) int64 {
+	switch value := d[field].(type) {
+	case int64:
+		return value
+	case int32:
+		return int64(value)
+	case float64:
+		return int64(value)
+	}
+	return 0
+}
+
+// getTime returns the date of a field and false if the document has no such field
+func (d document) getTime(field string) (time.Time, bool) {
+	switch value := d[field].(type) {
+	case time.Time:
+		return value, true
+	case primitive.DateTime:
+		return value.Time(), true
+	}
+	return time.Time{}, false
+}
+
+func (d document) getData(row interface{}) error {
+	var data []byte
+	switch value := d[dataField].(type) {
+	case []byte:
+		data = value
+	case primitive.Binary:
+		data = value.Data
+	default:
+		return fmt.Errorf("document %v/%v has no data", d.getString(pkField), d.getString(skField))
+	}
+	return json.Unmarshal(data, row)
+}
+
+// expired returns true if the document has an expiry which has passed. MongoDB deletes expired
+// documents in the background, so they can still be returned by reads for a while.
+func (d document) expired(now time.Time) bool {
+	expiresAt, ok := d.getTime(expiresAtField)
+	return ok && !expiresAt.After(now)
+}
+
+func (d document) key() bson.M {
+	return documentKey(d.getString(pkField), d.getString(skField))
+}
+
+func decodeDocument(raw bson.Raw) (document, error) {
+	var d document
+	if err := bson.Unmarshal(raw, &d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func emptyBlobToNil(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil {
+		return nil
+	}
+	return persistence.NewDataBlob(blob.Data, blob.Encoding)
+}
+
+func documentKey(pk, sk string) bson.M {
+	return bson.M{
+		pkField: pk,
+		skField: sk,
+	}
+}
+
+// joinKey joins the parts of a composite partition or sort key
+func joinKey(parts ...string) string {
+	return strings.Join(parts, keySeparator)
+}
+
+// sortableInt encodes an int64 as a fixed width string whose lexical order is the numeric order
+func sortableInt(value int64) string {
+	return fmt.Sprintf("%020d", uint64(value)^(1<<63))
+}
+
+// descendingInt encodes an int64 as a fixed width string whose lexical order is the reversed numeric order
+func descendingInt(value int64) string {
+	return fmt.Sprintf("%020d", ^(uint64(value) ^ (1 << 63)))
+}
+
+// sortableTime encodes a timestamp as a string whose lexical order is the chronological order
+func sortableTime(t time.Time) string {
+	return sortableInt(t.UnixNano())
+}
+
+// condition is the condition of a write, every condition requires the document to exist unless it's
+// created with notExists or notExistsOrExpired
+type condition struct {
+	// insert requires that no live document has the same key
+	insert bool
+	// expiredBefore allows the insert to replace a document which expired before that time
+	expiredBefore *time.Time
+	// fields are the values that the document must have
+	fields bson.M
+}
+
+func newCondition() *condition {
+	return &condition{fields: bson.M{}}
+}
+
+// notExists requires the document to not exist
+func (c *condition) notExists() *condition {
+	c.insert = true
+	return c
+}
+
+// exists requires the document to exist
+func (c *condition) exists() *condition {
+	return c
+}
+
+// notExistsOrExpired requires the document to not exist, or to be expired but not removed by MongoDB yet
+func (c *condition) notExistsOrExpired(now time.Time) *condition {
+	c.insert = true
+	c.expiredBefore = &now
+	return c
+}
+
+func (c *condition) equalInt(field string, value int64) *condition {
+	c.fields[field] = value
+	return c
+}
+
+func (c *condition) equalString(field string, value string) *condition {
+	c.fields[field] = value
+	return c
+}
+
+// filter returns the filter matching the document with the key if it meets the condition
+func (c *condition) filter(key bson.M) bson.M {
+	filter := bson.M{}
+	for field, value := range key {
+		filter[field] = value
+	}
+	if c == nil {
+		return filter
+	}
+	if c.expiredBefore != nil {
+		filter[expiresAtField] = bson.M{"$lte": *c.expiredBefore}
+	}
+	for field, value := range c.fields {
+		filter[field] = value
+	}
+	return filter
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSortableInt(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 42, 1000, math.MaxInt64}
+
+	ascending := make([]string, 0, len(values))
+	descending := make([]string, 0, len(values))
+	for _, v := range values {
+		ascending = append(ascending, sortableInt(v))
+		descending = append(descending, descendingInt(v))
+	}
+	assert.True(t, sort.StringsAreSorted(ascending), "sortableInt should preserve the numeric order")
+	sort.Sort(sort.Reverse(sort.StringSlice(descending)))
+	for i, v := range values {
+		assert.Equal(t, descendingInt(v), descending[i], "descendingInt should reverse the numeric order")
+		assert.Len(t, ascending[i], 20)
+	}
+}
+
+func TestConditionFilter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	key := documentKey("pk1", "sk1")
+	tests := map[string]struct {
+		condition *condition
+		expected  bson.M
+	}{
+		"nil condition": {
+			condition: nil,
+			expected:  bson.M{pkField: "pk1", skField: "sk1"},
+		},
+		"not exists": {
+			condition: newCondition().notExists(),
+			expected:  bson.M{pkField: "pk1", skField: "sk1"},
+		},
+		"not exists or expired": {
+			condition: newCondition().notExistsOrExpired(now),
+			expected:  bson.M{pkField: "pk1", skField: "sk1", expiresAtField: bson.M{"$lte": now}},
+		},
+		"multiple fields": {
+			condition: newCondition().exists().equalInt(rangeIDField, 5).equalString(currentRunIDField, "abc"),
+			expected:  bson.M{pkField: "pk1", skField: "sk1", rangeIDField: int64(5), currentRunIDField: "abc"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.condition.filter(key))
+		})
+	}
+}
+
+func TestQueryFilter(t *testing.T) {
+	tests := map[string]struct {
+		req      queryRequest
+		expected bson.M
+	}{
+		"partition": {
+			req:      queryRequest{pk: "pk1"},
+			expected: bson.M{pkField: "pk1"},
+		},
+		"key condition": {
+			req:      queryRequest{pk: "pk1", sk: skLessThan("b")},
+			expected: bson.M{pkField: "pk1", skField: bson.M{"$lt": "b"}},
+		},
+		"page token": {
+			req:      queryRequest{pk: "pk1", pageToken: []byte(`{"pk":"pk1","sk":"a"}`)},
+			expected: bson.M{pkField: "pk1", skField: bson.M{"$gt": "a"}},
+		},
+		"descending page token with key condition": {
+			req: queryRequest{pk: "pk1", sk: skLessThan("b"), descending: true, pageToken: []byte(`{"pk":"pk1","sk":"a"}`)},
+			expected: bson.M{pkField: "pk1", "$and": bson.A{
+				bson.M{skField: bson.M{"$lt": "b"}},
+				bson.M{skField: bson.M{"$lt": "a"}},
+			}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter, err := queryFilter(tc.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, filter)
+		})
+	}
+
+	_, err := queryFilter(queryRequest{pk: "pk1", pageToken: []byte("invalid")})
+	assert.Error(t, err)
+}
+
+func TestFailedConditions(t *testing.T) {
+	tests := map[string]struct {
+		err            error
+		expectedFailed []int
+		expectedOK     bool
+	}{
+		"nil error": {},
+		"other error": {
+			err: errors.New("some error"),
+		},
+		"condition failure": {
+			err:            &conditionFailedError{index: 2, collection: collectionExecutions},
+			expectedFailed: []int{2},
+			expectedOK:     true,
+		},
+		"wrapped condition failure": {
+			err:            fmt.Errorf("transaction aborted: %w", &conditionFailedError{index: 0, collection: collectionShards}),
+			expectedFailed: []int{0},
+			expectedOK:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			failed, ok := failedConditions(tc.err)
+			assert.Equal(t, tc.expectedFailed, failed)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedOK, isConditionFailedError(tc.err))
+		})
+	}
+}
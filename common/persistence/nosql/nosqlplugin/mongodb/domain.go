@@ -22,17 +22,85 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+const (
+	// all the domains are stored in a single partition, there are only a few of them
+	domainsPartition      = "domains"
+	domainMetadataSortKey = "metadata"
+	domainByNamePrefix    = "name" + keySeparator
+	domainByIDPrefix      = "id" + keySeparator
+
+	notificationVersionField = "notificationversion"
+)
+
+type domainIDRow struct {
+	ID   string
+	Name string
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	idDoc, err := newDocument(domainsPartition, domainByIDPrefix+row.Info.ID).setData(&domainIDRow{
+		ID:   row.Info.ID,
+		Name: row.Info.Name,
+	})
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(collectionDomains, idDoc, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+	if err != nil {
+		return err
+	}
+
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	inserted := *row
+	inserted.NotificationVersion = metadataNotificationVersion
+	inserted.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	inserted.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	nameDoc, err := newDomainDocument(&inserted)
+	if err != nil {
+		return err
+	}
+	err = db.transact(ctx, []writeOp{
+		putOp(collectionDomains, nameDoc, newCondition().notExists()),
+		domainMetadataOp(metadataNotificationVersion),
+	})
+	failed, conditionFailed := failedConditions(err)
+	if !conditionFailed {
+		return err
+	}
+
+	// Domain already exist.  Delete orphan domain record before returning back to user
+	if errDelete := db.write(ctx, deleteOp(collectionDomains, domainsPartition, domainByIDPrefix+row.Info.ID, nil)); errDelete != nil {
+		db.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
+	}
+	if failed[0] == 0 {
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+	return nosqlplugin.NewConditionFailure("domain")
 }
 
 // Update domain
@@ -40,7 +108,25 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	// whether the domain is global is only set on creation, so it is carried over from the current document
+	current, err := db.SelectDomain(ctx, nil, &row.Info.Name)
+	if err != nil {
+		return err
+	}
+	updated := *row
+	updated.IsGlobalDomain = current.IsGlobalDomain
+	nameDoc, err := newDomainDocument(&updated)
+	if err != nil {
+		return err
+	}
+	err = db.transact(ctx, []writeOp{
+		putOp(collectionDomains, nameDoc, newCondition().exists()),
+		domainMetadataOp(row.NotificationVersion),
+	})
+	if _, conditionFailed := failedConditions(err); conditionFailed {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +135,25 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		idRow, err := db.selectDomainID(ctx, *domainID)
+		if err != nil {
+			return nil, err
+		}
+		domainName = &idRow.Name
+	}
+
+	it, err := db.getDocument(ctx, collectionDomains, domainsPartition, domainByNamePrefix+*domainName)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRow(it)
 }
 
 // Get all domain data
@@ -58,7 +162,26 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		collection: collectionDomains,
+		pk:         domainsPartition,
+		sk:         skBeginsWith(domainByNamePrefix),
+		limit:      pageSize,
+		pageToken:  pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(docs))
+	for _, it := range docs {
+		row, err := toDomainRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +190,90 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	var name, id string
+	if domainName == nil {
+		idRow, err := db.selectDomainID(ctx, *domainID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = idRow.Name, *domainID
+	} else {
+		row, err := db.SelectDomain(ctx, nil, domainName)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		name, id = *domainName, row.Info.ID
+	}
+
+	if err := db.write(ctx, deleteOp(collectionDomains, domainsPartition, domainByNamePrefix+name, nil)); err != nil {
+		return err
+	}
+	return db.write(ctx, deleteOp(collectionDomains, domainsPartition, domainByIDPrefix+id, nil))
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getDocument(ctx, collectionDomains, domainsPartition, domainMetadataSortKey)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata is created by the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return it.getInt(notificationVersionField), nil
+}
+
+func (db *mdb) selectDomainID(ctx context.Context, domainID string) (*domainIDRow, error) {
+	it, err := db.getDocument(ctx, collectionDomains, domainsPartition, domainByIDPrefix+domainID)
+	if err != nil {
+		return nil, err
+	}
+	row := &domainIDRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// domainMetadataOp increments the notification version of the domain metadata, on the condition that it wasn't changed
+func domainMetadataOp(notificationVersion int64) writeOp {
+	cond := newCondition()
+	if notificationVersion > 0 {
+		cond.equalInt(notificationVersionField, notificationVersion)
+	} else {
+		cond.notExists()
+	}
+	it := newDocument(domainsPartition, domainMetadataSortKey).setInt(notificationVersionField, notificationVersion+1)
+	return putOp(collectionDomains, it, cond)
+}
+
+func newDomainDocument(row *nosqlplugin.DomainRow) (document, error) {
+	return newDocument(domainsPartition, domainByNamePrefix+row.Info.Name).
+		setInt(notificationVersionField, row.NotificationVersion).
+		setData(row)
+}
+
+func toDomainRow(it document) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	// same as the other plugins, empty blobs are returned as nil
+	row.Config.BadBinaries = emptyBlobToNil(row.Config.BadBinaries)
+	row.Config.IsolationGroups = emptyBlobToNil(row.Config.IsolationGroups)
+	row.Config.AsyncWorkflowsConfig = emptyBlobToNil(row.Config.AsyncWorkflowsConfig)
+	row.ReplicationConfig.ActiveClustersConfig = emptyBlobToNil(row.ReplicationConfig.ActiveClustersConfig)
+	return row, nil
 }
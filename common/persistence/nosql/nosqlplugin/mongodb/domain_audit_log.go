@@ -22,17 +22,65 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *mdb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	return fmt.Errorf("InsertDomainAuditLog not implemented")
+	it, err := newDocument(domainAuditLogKey(row.DomainID, int(row.OperationType)), domainAuditLogSortKey(row.CreatedTime, row.EventID)).
+		setTTL(time.Now(), row.TTLSeconds).
+		setData(row)
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(collectionDomainAuditLog, it, nil))
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *mdb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+	if !filter.MinCreatedTime.Before(*filter.MaxCreatedTime) {
+		return nil, nil, nil
+	}
+
+	// entries are sorted by created time descending, so the newest entry in range comes first
+	docs, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		collection: collectionDomainAuditLog,
+		pk:         domainAuditLogKey(filter.DomainID, int(filter.OperationType)),
+		sk: skBetween(
+			descendingInt(filter.MaxCreatedTime.UnixNano()-1),
+			joinKey(descendingInt(filter.MinCreatedTime.UnixNano()), maxKeySuffix),
+		),
+		limit:     filter.PageSize,
+		pageToken: filter.NextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(docs))
+	for _, it := range docs {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := it.getData(row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
+}
+
+func domainAuditLogKey(domainID string, operationType int) string {
+	return joinKey(domainID, strconv.Itoa(operationType))
+}
+
+func domainAuditLogSortKey(createdTime time.Time, eventID string) string {
+	return joinKey(descendingInt(createdTime.UnixNano()), eventID)
 }
@@ -20,14 +20,35 @@
 
 package mongodb
 
-import "go.mongodb.org/mongo-driver/mongo"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	errNotFound = errors.New("document not found")
+)
+
+// conditionFailedError is returned when the condition of a write isn't met, the writes of its transaction are rolled back
+type conditionFailedError struct {
+	// index is the index of the write in its transaction
+	index      int
+	collection string
+}
+
+func (e *conditionFailedError) Error() string {
+	return fmt.Sprintf("condition of write %v on collection %v failed", e.index, e.collection)
+}
 
 func (db *mdb) IsNotFoundError(err error) bool {
-	return err == mongo.ErrNoDocuments
+	return errors.Is(err, errNotFound) || errors.Is(err, mongo.ErrNoDocuments)
 }
 
 func (db *mdb) IsTimeoutError(err error) bool {
-	return mongo.IsTimeout(err) || mongo.IsNetworkError(err)
+	return errors.Is(err, context.DeadlineExceeded) || mongo.IsTimeout(err) || mongo.IsNetworkError(err)
 }
 
 func (db *mdb) IsThrottlingError(err error) bool {
@@ -37,3 +58,19 @@ func (db *mdb) IsThrottlingError(err error) bool {
 func (db *mdb) IsDBUnavailableError(err error) bool {
 	return false
 }
+
+// isConditionFailedError returns true if a single document write failed because of its condition
+func isConditionFailedError(err error) bool {
+	var conditionFailed *conditionFailedError
+	return errors.As(err, &conditionFailed)
+}
+
+// failedConditions returns the index of the transaction write whose condition wasn't met, writes after
+// it are not attempted. The second return value is false if the error isn't caused by a condition.
+func failedConditions(err error) ([]int, bool) {
+	var conditionFailed *conditionFailedError
+	if !errors.As(err, &conditionFailed) {
+		return nil, false
+	}
+	return []int{conditionFailed.index}, true
+}
@@ -22,31 +22,164 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var ops []writeOp
+	if treeRow != nil {
+		it, err := newDocument(treeRow.TreeID, treeRow.BranchID).setData(treeRow)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, putOp(collectionHistoryTree, it, nil))
+	}
+	if nodeRow != nil {
+		it, err := newDocument(nodeRow.TreeID, historyNodeSortKey(nodeRow.BranchID, nodeRow.NodeID, *nodeRow.TxnID)).setData(nodeRow)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, putOp(collectionHistoryNode, it, nil))
+	}
+	if len(ops) == 1 {
+		return db.write(ctx, ops[0])
+	}
+	return db.transact(ctx, ops)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	// nodes are sorted by node ID ascending then transaction ID descending
+	docs, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		collection: collectionHistoryNode,
+		pk:         filter.TreeID,
+		sk: skBetween(
+			joinKey(filter.BranchID, sortableInt(filter.MinNodeID)),
+			joinKey(filter.BranchID, sortableInt(filter.MaxNodeID-1), maxKeySuffix),
+		),
+		limit:     filter.PageSize,
+		pageToken: filter.NextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryNodeRow
+	for _, it := range docs {
+		row := &nosqlplugin.HistoryNodeRow{}
+		if err := it.getData(row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			NodeID:       row.NodeID,
+			TxnID:        row.TxnID,
+			Data:         row.Data,
+			DataEncoding: row.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// the nodes are deleted before the branch, so that a failed deletion can be retried
+	for _, nodeFilter := range nodeFilters {
+		if _, err := db.deleteRange(ctx, collectionHistoryNode, nodeFilter.TreeID, skBetween(
+			joinKey(nodeFilter.BranchID, sortableInt(nodeFilter.MinNodeID)),
+			joinKey(nodeFilter.BranchID, maxKeySuffix),
+		)); err != nil {
+			return err
+		}
+	}
+	return db.write(ctx, deleteOp(collectionHistoryTree, treeFilter.TreeID, *treeFilter.BranchID, nil))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := db.scanPage(ctx, collectionHistoryTree, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, it := range docs {
+		row, err := toHistoryTreeRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          row.TreeID,
+			BranchID:        row.BranchID,
+			CreateTimestamp: row.CreateTimestamp,
+			Info:            row.Info,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	docs, err := db.queryAll(ctx, queryRequest{
+		collection: collectionHistoryTree,
+		pk:         filter.TreeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryTreeRow
+	for _, it := range docs {
+		row, err := toHistoryTreeRow(it)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:    filter.TreeID,
+			BranchID:  row.BranchID,
+			Ancestors: row.Ancestors,
+		})
+	}
+	return rows, nil
+}
+
+func toHistoryTreeRow(it document) (*nosqlplugin.HistoryTreeRow, error) {
+	row := &nosqlplugin.HistoryTreeRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+
+	// only the branch and end node of the ancestors are significant, same as in the other plugins
+	// the ancestors are sorted by EndNodeID so that BeginNodeID can be set
+	ancestors := make([]*types.HistoryBranchRange, 0, len(row.Ancestors))
+	for _, ancestor := range row.Ancestors {
+		ancestors = append(ancestors, &types.HistoryBranchRange{
+			BranchID:  ancestor.BranchID,
+			EndNodeID: ancestor.EndNodeID,
+		})
+	}
+	if len(ancestors) > 0 {
+		sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+		ancestors[0].BeginNodeID = int64(1)
+		for i := 1; i < len(ancestors); i++ {
+			ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+		}
+	}
+	row.Ancestors = ancestors
+	return row, nil
+}
+
+func historyNodeSortKey(branchID string, nodeID int64, txnID int64) string {
+	return joinKey(branchID, sortableInt(nodeID), descendingInt(txnID))
 }
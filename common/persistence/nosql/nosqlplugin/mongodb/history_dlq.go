@@ -22,32 +22,140 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 // InsertHistoryDLQTaskRow writes a task to the history DLQ.
+// Tasks are partitioned by (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category)
+// and sorted by (visibility_ts, task_id).
 func (db *mdb) InsertHistoryDLQTaskRow(ctx context.Context, task *nosqlplugin.HistoryDLQTaskRow) error {
-	return fmt.Errorf("InsertHistoryDLQTaskRow not implemented for MongoDB")
+	it, err := newDocument(
+		historyDLQTaskKey(task.ShardID, task.DomainID, task.ClusterAttributeScope, task.ClusterAttributeName, task.TaskCategory),
+		historyDLQTaskSortKey(task.VisibilityTimestamp, task.TaskID),
+	).setData(task)
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(collectionHistoryTaskDLQ, it, nil))
 }
 
+// SelectHistoryDLQTaskRows reads paginated tasks from the history DLQ within the given bounds.
 func (db *mdb) SelectHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskFilter) ([]*nosqlplugin.HistoryDLQTaskRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectHistoryDLQTaskRows not implemented for MongoDB")
+	lower := historyDLQTaskSortKey(filter.InclusiveMinVisibilityTS, filter.InclusiveMinTaskID)
+	upper := historyDLQTaskUpperBound(filter.ExclusiveMaxVisibilityTS, filter.ExclusiveMaxTaskID)
+	if lower > upper {
+		return nil, nil, nil
+	}
+	docs, nextPageToken, err := db.queryPage(ctx, queryRequest{
+		collection: collectionHistoryTaskDLQ,
+		pk:         historyDLQTaskKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory),
+		sk:         skBetween(lower, upper),
+		limit:      filter.PageSize,
+		pageToken:  filter.NextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryDLQTaskRow, 0, len(docs))
+	for _, it := range docs {
+		row := &nosqlplugin.HistoryDLQTaskRow{}
+		if err := it.getData(row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
+// RangeDeleteHistoryDLQTaskRows deletes all tasks before the given exclusive bounds.
 func (db *mdb) RangeDeleteHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskRangeDeleteFilter) error {
-	return fmt.Errorf("RangeDeleteHistoryDLQTaskRows not implemented for MongoDB")
+	_, err := db.deleteRange(ctx, collectionHistoryTaskDLQ, historyDLQTaskKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory), skLessThan(historyDLQTaskSortKey(filter.ExclusiveMaxVisibilityTS, filter.ExclusiveMaxTaskID)))
+	return err
 }
 
+// SelectHistoryDLQAckLevelRows reads ack-level rows for a shard.
+// If domainID is non-empty the query is restricted to that domain.
+// If clusterAttributeScope and clusterAttributeName are also non-empty it is
+// further restricted to that cluster attribute.
 func (db *mdb) SelectHistoryDLQAckLevelRows(ctx context.Context, filter nosqlplugin.HistoryDLQAckLevelFilter) ([]*nosqlplugin.HistoryDLQAckLevelRow, error) {
-	return nil, fmt.Errorf("SelectHistoryDLQAckLevelRows not implemented for MongoDB")
+	req := queryRequest{
+		collection: collectionHistoryTaskDLQAckLevel,
+		pk:         shardKey(filter.ShardID),
+	}
+	byClusterAttribute := filter.DomainID != "" && filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != ""
+	switch {
+	case byClusterAttribute:
+		req.sk = skBeginsWith(joinKey(filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName) + keySeparator)
+	case filter.DomainID != "":
+		req.sk = skBeginsWith(filter.DomainID + keySeparator)
+	}
+	docs, err := db.queryAll(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*nosqlplugin.HistoryDLQAckLevelRow
+	for _, it := range docs {
+		row := &nosqlplugin.HistoryDLQAckLevelRow{}
+		if err := it.getData(row); err != nil {
+			return nil, err
+		}
+		// the key prefix is ambiguous if the cluster attribute contains the separator
+		if byClusterAttribute && (row.ClusterAttributeScope != filter.ClusterAttributeScope || row.ClusterAttributeName != filter.ClusterAttributeName) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
+// InsertOrUpdateHistoryDLQAckLevelRow upserts a single ack-level row.
 func (db *mdb) InsertOrUpdateHistoryDLQAckLevelRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertOrUpdateHistoryDLQAckLevelRow not implemented for MongoDB")
+	it, err := newHistoryDLQAckLevelDocument(row)
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(collectionHistoryTaskDLQAckLevel, it, nil))
 }
 
+// InsertHistoryDLQAckLevelIfNotExistsRow inserts a sentinel ack-level row if it does not already exist.
+// Returns success if the row is written or if it already exists.
 func (db *mdb) InsertHistoryDLQAckLevelIfNotExistsRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertHistoryDLQAckLevelIfNotExistsRow not implemented for MongoDB")
+	it, err := newHistoryDLQAckLevelDocument(row)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(collectionHistoryTaskDLQAckLevel, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return nil
+	}
+	return err
+}
+
+func newHistoryDLQAckLevelDocument(row *nosqlplugin.HistoryDLQAckLevelRow) (document, error) {
+	return newDocument(
+		shardKey(row.ShardID),
+		joinKey(row.DomainID, row.ClusterAttributeScope, row.ClusterAttributeName, strconv.Itoa(row.TaskCategory)),
+	).setData(row)
+}
+
+func historyDLQTaskKey(shardID int, domainID, clusterAttributeScope, clusterAttributeName string, taskCategory int) string {
+	return joinKey(shardKey(shardID), domainID, clusterAttributeScope, clusterAttributeName, strconv.Itoa(taskCategory))
+}
+
+func historyDLQTaskSortKey(visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(sortableTime(visibilityTimestamp), sortableInt(taskID))
+}
+
+// historyDLQTaskUpperBound returns the inclusive upper bound of the sort keys before (visibilityTimestamp, taskID)
+func historyDLQTaskUpperBound(visibilityTimestamp time.Time, taskID int64) string {
+	if taskID == math.MinInt64 {
+		return joinKey(sortableTime(visibilityTimestamp.Add(-time.Nanosecond)), maxKeySuffix)
+	}
+	return historyDLQTaskSortKey(visibilityTimestamp, taskID-1)
 }
@@ -0,0 +1,319 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type (
+	// writeOp is a conditional write of a single document. It's either executed on its own or as part of a transaction.
+	writeOp struct {
+		collection string
+		put        document
+		deleteKey  bson.M
+		checkKey   bson.M
+		condition  *condition
+	}
+
+	// keyCondition is the condition on the sort key of a query
+	keyCondition bson.M
+
+	queryRequest struct {
+		collection string
+		pk         string
+		sk         keyCondition
+		descending bool
+		// limit is the max number of documents returned by one page, zero means no limit
+		limit     int
+		pageToken []byte
+	}
+
+	// pageToken is the key of the last document of a page
+	pageToken struct {
+		PK string `json:"pk"`
+		SK string `json:"sk"`
+	}
+)
+
+func putOp(collection string, d document, cond *condition) writeOp {
+	return writeOp{collection: collection, put: d, condition: cond}
+}
+
+func deleteOp(collection string, pk, sk string, cond *condition) writeOp {
+	return writeOp{collection: collection, deleteKey: documentKey(pk, sk), condition: cond}
+}
+
+// checkOp only checks the condition on a document, it can only be used in transactions
+func checkOp(collection string, pk, sk string, cond *condition) writeOp {
+	return writeOp{collection: collection, checkKey: documentKey(pk, sk), condition: cond}
+}
+
+func skBetween(lower, upper string) keyCondition {
+	return keyCondition{"$gte": lower, "$lte": upper}
+}
+
+// skIntBetween matches the sort keys prefix+sortableInt(v) where min <= v <= max, it returns false if the range is empty
+func skIntBetween(prefix string, min, max int64) (keyCondition, bool) {
+	if min > max {
+		return nil, false
+	}
+	return skBetween(prefix+sortableInt(min), prefix+sortableInt(max)), true
+}
+
+func skBeginsWith(prefix string) keyCondition {
+	return keyCondition{"$regex": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)}}
+}
+
+func skLessThan(value string) keyCondition {
+	return keyCondition{"$lt": value}
+}
+
+// write executes a single conditional write, the error satisfies isConditionFailedError if the condition isn't met
+func (db *mdb) write(ctx context.Context, op writeOp) error {
+	ok, err := db.apply(ctx, op)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &conditionFailedError{index: 0, collection: op.collection}
+	}
+	return nil
+}
+
+// transact executes all the writes atomically. If any condition isn't met, none of the writes are applied
+// and failedConditions returns the index of the failed operation.
+func (db *mdb) transact(ctx context.Context, ops []writeOp) error {
+	return db.client.WithTransaction(ctx, func(ctx context.Context) error {
+		for i, op := range ops {
+			ok, err := db.apply(ctx, op)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return &conditionFailedError{index: i, collection: op.collection}
+			}
+		}
+		return nil
+	})
+}
+
+// apply executes a write and returns false if its condition isn't met
+func (db *mdb) apply(ctx context.Context, op writeOp) (bool, error) {
+	cond := op.condition
+	switch {
+	case op.put != nil:
+		switch {
+		case cond == nil:
+			_, err := db.client.ReplaceOne(ctx, op.collection, op.put.key(), op.put, true)
+			return true, err
+		case cond.insert && cond.expiredBefore == nil:
+			err := db.client.InsertOne(ctx, op.collection, op.put)
+			if mongo.IsDuplicateKeyError(err) {
+				return false, nil
+			}
+			return err == nil, err
+		case cond.insert:
+			// the upsert fails on the unique key if a live document exists
+			_, err := db.client.ReplaceOne(ctx, op.collection, cond.filter(op.put.key()), op.put, true)
+			if mongo.IsDuplicateKeyError(err) {
+				return false, nil
+			}
+			return err == nil, err
+		default:
+			matched, err := db.client.ReplaceOne(ctx, op.collection, cond.filter(op.put.key()), op.put, false)
+			return matched > 0, err
+		}
+	case op.deleteKey != nil:
+		deleted, err := db.client.DeleteOne(ctx, op.collection, cond.filter(op.deleteKey))
+		return cond == nil || deleted > 0, err
+	default:
+		if cond == nil {
+			return false, fmt.Errorf("condition check on collection %v has no condition", op.collection)
+		}
+		matched, err := db.client.UpdateOne(ctx, op.collection, cond.filter(op.checkKey), bson.M{
+			"$inc": bson.M{checkSequenceField: int64(1)},
+		})
+		return matched > 0, err
+	}
+}
+
+// getDocument reads a document, an error satisfying IsNotFoundError is returned if it doesn't exist
+func (db *mdb) getDocument(ctx context.Context, collection string, pk, sk string) (document, error) {
+	raws, err := db.client.Find(ctx, collection, documentKey(pk, sk), nil, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(raws) == 0 {
+		return nil, fmt.Errorf("%w: %v %v/%v", errNotFound, collection, pk, sk)
+	}
+	d, err := decodeDocument(raws[0])
+	if err != nil {
+		return nil, err
+	}
+	if d.expired(time.Now()) {
+		return nil, fmt.Errorf("%w: %v %v/%v", errNotFound, collection, pk, sk)
+	}
+	return d, nil
+}
+
+// queryPage reads one page of documents of a partition in the order of their sort keys
+func (db *mdb) queryPage(ctx context.Context, req queryRequest) ([]document, []byte, error) {
+	filter, err := queryFilter(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	order := 1
+	if req.descending {
+		order = -1
+	}
+	raws, err := db.client.Find(ctx, req.collection, filter, bson.D{{Key: skField, Value: order}}, int64(req.limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	return pageOf(raws, req.limit)
+}
+
+// queryCount counts the documents of a partition that match the key condition, it doesn't exclude expired documents
+func (db *mdb) queryCount(ctx context.Context, req queryRequest) (int64, error) {
+	filter, err := queryFilter(req)
+	if err != nil {
+		return 0, err
+	}
+	return db.client.CountDocuments(ctx, req.collection, filter)
+}
+
+// queryAll reads all the documents of a partition that match the key condition
+func (db *mdb) queryAll(ctx context.Context, req queryRequest) ([]document, error) {
+	req.limit = 0
+	req.pageToken = nil
+	documents, _, err := db.queryPage(ctx, req)
+	return documents, err
+}
+
+// deleteRange deletes all the documents of a partition that match the key condition
+func (db *mdb) deleteRange(ctx context.Context, collection string, pk string, sk keyCondition) (int64, error) {
+	return db.client.DeleteMany(ctx, collection, queryFilterWithoutToken(pk, sk))
+}
+
+// deleteKeys deletes the documents of a partition with the given sort keys
+func (db *mdb) deleteKeys(ctx context.Context, collection string, pk string, sks []string) error {
+	if len(sks) == 0 {
+		return nil
+	}
+	_, err := db.client.DeleteMany(ctx, collection, bson.M{pkField: pk, skField: bson.M{"$in": sks}})
+	return err
+}
+
+// scanPage reads one page of documents of a whole collection, in the order of their keys
+func (db *mdb) scanPage(ctx context.Context, collection string, limit int, token []byte) ([]document, []byte, error) {
+	filter := bson.M{}
+	if len(token) > 0 {
+		last, err := decodePageToken(token)
+		if err != nil {
+			return nil, nil, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{pkField: bson.M{"$gt": last.PK}},
+			bson.M{pkField: last.PK, skField: bson.M{"$gt": last.SK}},
+		}
+	}
+	raws, err := db.client.Find(ctx, collection, filter, bson.D{{Key: pkField, Value: 1}, {Key: skField, Value: 1}}, int64(limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	return pageOf(raws, limit)
+}
+
+func queryFilter(req queryRequest) (bson.M, error) {
+	filter := queryFilterWithoutToken(req.pk, req.sk)
+	if len(req.pageToken) == 0 {
+		return filter, nil
+	}
+	last, err := decodePageToken(req.pageToken)
+	if err != nil {
+		return nil, err
+	}
+	after := bson.M{"$gt": last.SK}
+	if req.descending {
+		after = bson.M{"$lt": last.SK}
+	}
+	if req.sk == nil {
+		filter[skField] = after
+	} else {
+		delete(filter, skField)
+		filter["$and"] = bson.A{
+			bson.M{skField: bson.M(req.sk)},
+			bson.M{skField: after},
+		}
+	}
+	return filter, nil
+}
+
+func queryFilterWithoutToken(pk string, sk keyCondition) bson.M {
+	filter := bson.M{pkField: pk}
+	if sk != nil {
+		filter[skField] = bson.M(sk)
+	}
+	return filter
+}
+
+// pageOf decodes the documents of a page and excludes the expired ones. The page token is returned
+// whenever the page is full, so the last page can be empty.
+func pageOf(raws []bson.Raw, limit int) ([]document, []byte, error) {
+	now := time.Now()
+	documents := make([]document, 0, len(raws))
+	var last document
+	for _, raw := range raws {
+		d, err := decodeDocument(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		last = d
+		if !d.expired(now) {
+			documents = append(documents, d)
+		}
+	}
+	if limit <= 0 || len(raws) < limit || last == nil {
+		return documents, nil, nil
+	}
+	token, err := json.Marshal(pageToken{PK: last.getString(pkField), SK: last.getString(skField)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return documents, token, nil
+}
+
+func decodePageToken(token []byte) (*pageToken, error) {
+	var last pageToken
+	if err := json.Unmarshal(token, &last); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return &last, nil
+}
@@ -23,6 +23,10 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -32,6 +36,8 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/environment"
+	mongodbschema "github.com/uber/cadence/schema/mongodb"
 )
 
 const (
@@ -39,45 +45,141 @@ const (
 	PluginName = "mongodb"
 )
 
-type plugin struct{}
+type (
+	// clientFactory creates the client that the plugin talks to MongoDB with
+	clientFactory func(cfg *config.NoSQL) (client, error)
+
+	plugin struct {
+		newClient clientFactory
+	}
+)
 
 var _ nosqlplugin.Plugin = (*plugin)(nil)
 
 func init() {
-	nosql.RegisterPlugin(PluginName, &plugin{})
+	nosql.RegisterPlugin(PluginName, &plugin{newClient: newDriverClient})
 }
 
 // CreateDB initialize the db object
 func (p *plugin) CreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.DB, error) {
-	return p.doCreateDB(cfg, logger)
+	return p.doCreateDB(cfg, logger, dc)
 }
 
 func (p *plugin) SetupDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (persistence.SetupDB, error) {
-	// TODO implement me
-	panic("implement me")
+	return p.doCreateDB(cfg, logger, dc)
 }
 
 func (p *plugin) SchemaDB(dbType persistence.DBType, cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (persistence.SchemaDB, error) {
-	// TODO implement me
-	panic("implement me")
-}
+	schema, err := getLatestSchema(dbType)
+	if err != nil {
+		return nil, err
+	}
 
-func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
-	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
-	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
-	// It's okay because this is being called during server startup or CLI.
-	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	db, err := p.doCreateDB(cfg, logger, dc)
 	if err != nil {
 		return nil, err
 	}
+	return &schemaDB{
+		mdb:    db,
+		latest: schema,
+	}, nil
+}
+
+func getLatestSchema(dbType persistence.DBType) (persistence.Schema, error) {
+	switch dbType {
+	// DB based visibility isn't supported, but the visibility store can still point to the same
+	// database as the default store, so it gets the same collections which are created only once.
+	case persistence.DBTypeDefault, persistence.DBTypeVisibility:
+		return mongodbschema.DefaultSchema, nil
+	default:
+		return nil, fmt.Errorf("unknown db type: %v", dbType)
+	}
+}
+
+func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (*mdb, error) {
 	if cfg.Keyspace == "" {
 		return nil, fmt.Errorf("database name cannot be empty")
 	}
-	db := client.Database(cfg.Keyspace)
-	return &mdb{
-		client: client,
-		dbConn: db,
-		cfg:    cfg,
-		logger: logger,
-	}, err
+	client, err := p.newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newMDB(client, cfg, logger, dc), nil
+}
+
+func newDriverClient(cfg *config.NoSQL) (client, error) {
+	uri, err := toURI(cfg)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Client().ApplyURI(uri)
+	if cfg.MaxConns > 0 {
+		opts.SetMaxPoolSize(uint64(cfg.MaxConns))
+	}
+	if cfg.ConnectTimeout > 0 {
+		opts.SetConnectTimeout(cfg.ConnectTimeout)
+	}
+	if cfg.Timeout > 0 {
+		opts.SetSocketTimeout(cfg.Timeout)
+	}
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		tlsConfig, err := cfg.TLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	// CreateDB/SetupDB/SchemaDB don't pass in context.Context so we are using background for now
+	// It's okay because this is being called during server startup or CLI.
+	mongoClient, err := mongo.Connect(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return &driverClient{
+		client: mongoClient,
+		db:     mongoClient.Database(cfg.Keyspace),
+	}, nil
+}
+
+// toURI returns the connection string of the hosts. ConnectAttributes are passed as the options of the
+// connection string, e.g. replicaSet or authSource. Transactions require a replica set or a sharded cluster.
+func toURI(cfg *config.NoSQL) (string, error) {
+	port := cfg.Port
+	if port == 0 {
+		var err error
+		port, err = environment.GetMongoPort()
+		if err != nil {
+			return "", err
+		}
+	}
+	var hosts []string
+	for _, host := range strings.Split(cfg.Hosts, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			host = net.JoinHostPort(host, strconv.Itoa(port))
+		}
+		hosts = append(hosts, host)
+	}
+	if len(hosts) == 0 {
+		return "", fmt.Errorf("hosts cannot be empty")
+	}
+
+	uri := &url.URL{
+		Scheme: "mongodb",
+		Host:   strings.Join(hosts, ","),
+		Path:   "/",
+	}
+	if cfg.User != "" {
+		uri.User = url.UserPassword(cfg.User, cfg.Password)
+	}
+	query := url.Values{}
+	for key, value := range cfg.ConnectAttributes {
+		query.Set(key, value)
+	}
+	uri.RawQuery = query.Encode()
+	return uri.String(), nil
 }
@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// partition keys of the schema_version collection
+	schemaVersionPartition       = "version"
+	schemaUpdateHistoryPartition = "history"
+
+	// errCodeNamespaceExists is returned when creating a collection which already exists
+	errCodeNamespaceExists = 48
+)
+
+type (
+	schemaDB struct {
+		*mdb
+		latest persistence.Schema
+	}
+
+	schemaVersionRow struct {
+		Version              string
+		MinCompatibleVersion string
+		CreationTime         time.Time
+	}
+
+	schemaUpdateHistoryRow struct {
+		UpdateTime  time.Time
+		Description string
+		ManifestMD5 string
+		NewVersion  string
+		OldVersion  string
+	}
+)
+
+func (s *schemaDB) LatestSchema() persistence.Schema {
+	return s.latest
+}
+
+func (db *mdb) HasSchemaVersioning(ctx context.Context) (bool, error) {
+	exists, err := db.client.CollectionExists(ctx, collectionSchemaVersion)
+	if err != nil {
+		return false, fmt.Errorf("error checking for schema_version collection: %w", err)
+	}
+	return exists, nil
+}
+
+func (db *mdb) SetupVersioning(ctx context.Context) error {
+	return db.applyCommands(ctx, []bson.D{
+		{{Key: "create", Value: collectionSchemaVersion}},
+		{
+			{Key: "createIndexes", Value: collectionSchemaVersion},
+			{Key: "indexes", Value: bson.A{bson.D{
+				{Key: "key", Value: bson.D{{Key: pkField, Value: 1}, {Key: skField, Value: 1}}},
+				{Key: "name", Value: "pk_sk"},
+				{Key: "unique", Value: true},
+			}}},
+		},
+	})
+}
+
+func (db *mdb) GetSchemaVersion(ctx context.Context) (persistence.Version, error) {
+	d, err := db.getDocument(ctx, collectionSchemaVersion, schemaVersionPartition, schemaVersionPartition)
+	if err != nil {
+		return persistence.Version{}, fmt.Errorf("reading schemaDB version: %w", err)
+	}
+	var row schemaVersionRow
+	if err := d.getData(&row); err != nil {
+		return persistence.Version{}, err
+	}
+	return persistence.ParseVersion(row.Version)
+}
+
+func (db *mdb) UpdateSchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	current, err := db.GetSchemaVersion(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	if !current.IsBefore(update.Version) {
+		return fmt.Errorf("unable to update backwards from %s to %s", current, update.Version)
+	}
+	err = db.applyUpdate(ctx, update)
+	if err != nil {
+		return fmt.Errorf("unable to apply update: %w", err)
+	}
+
+	now := time.Now().UTC()
+	versionDocument, err := newDocument(schemaVersionPartition, schemaVersionPartition).setData(&schemaVersionRow{
+		Version:              update.Version.String(),
+		MinCompatibleVersion: update.MinCompatibleVersion.String(),
+		CreationTime:         now,
+	})
+	if err != nil {
+		return err
+	}
+	if err := db.write(ctx, putOp(collectionSchemaVersion, versionDocument, nil)); err != nil {
+		return err
+	}
+	historyDocument, err := newDocument(schemaUpdateHistoryPartition, sortableTime(now)).setData(&schemaUpdateHistoryRow{
+		UpdateTime:  now,
+		Description: update.Description,
+		ManifestMD5: update.ManifestMD5,
+		NewVersion:  update.Version.String(),
+		OldVersion:  current.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return db.write(ctx, putOp(collectionSchemaVersion, historyDocument, nil))
+}
+
+func (db *mdb) ForceApplySchema(ctx context.Context, update *persistence.SchemaUpdate) error {
+	return db.applyUpdate(ctx, update)
+}
+
+// applyUpdate runs the commands of the update, which are in MongoDB extended JSON
+func (db *mdb) applyUpdate(ctx context.Context, update *persistence.SchemaUpdate) error {
+	commands := make([]bson.D, 0, len(update.DDLStatements))
+	for _, ddl := range update.DDLStatements {
+		var command bson.D
+		if err := bson.UnmarshalExtJSON([]byte(ddl), false, &command); err != nil {
+			return fmt.Errorf("invalid command %v: %w", ddl, err)
+		}
+		commands = append(commands, command)
+	}
+	return db.applyCommands(ctx, commands)
+}
+
+// applyCommands runs the commands in order, creating a collection which already exists is ignored so that
+// the schema can be applied again. Creating an index which already exists is a no-op for MongoDB.
+func (db *mdb) applyCommands(ctx context.Context, commands []bson.D) error {
+	for _, command := range commands {
+		err := db.client.RunCommand(ctx, command)
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == errCodeNamespaceExists {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unable to run command %v: %w", command, err)
+		}
+	}
+	return nil
+}
@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2026 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,47 +22,19 @@ package mongodb
 
 import (
 	"context"
-	"io/ioutil"
-
-	"go.mongodb.org/mongo-driver/bson"
-
-	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
-const (
-	testSchemaDir = "schema/mongodb/"
-)
-
-func (db *mdb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
-	if schemaBaseDir == "" {
-		var err error
-		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
-		if err != nil {
-			return err
-		}
-	}
+// IsSetup always returns true, MongoDB creates the database when its first collection is created by the schema
+func (db *mdb) IsSetup(ctx context.Context) (bool, error) {
+	return true, nil
+}
 
-	schemaFile := schemaBaseDir + "cadence/schema.json"
-	byteValues, err := ioutil.ReadFile(schemaFile)
-	if err != nil {
-		return err
-	}
-	var commands []interface{}
-	err = bson.UnmarshalExtJSON(byteValues, false, &commands)
-	if err != nil {
-		return err
-	}
-	for _, cmd := range commands {
-		result := db.dbConn.RunCommand(context.Background(), cmd)
-		if result.Err() != nil {
-			return result.Err()
-		}
-	}
+// Setup is a no-op, MongoDB creates the database when its first collection is created by the schema
+func (db *mdb) Setup(ctx context.Context, options map[string]string) error {
 	return nil
 }
 
-func (db *mdb) TeardownTestDatabase() error {
-	result := db.dbConn.RunCommand(context.Background(), bson.D{{"dropDatabase", 1}})
-	err := result.Err()
-	return err
+// Teardown drops the database
+func (db *mdb) Teardown(ctx context.Context) error {
+	return db.client.DropDatabase(ctx)
 }
@@ -22,20 +22,62 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	taskListSortKey = "tasklist"
+	taskPrefix      = "task" + keySeparator
+
+	initialRangeID = 1 // Id of the first range of a new task list
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	it, err := db.getDocument(ctx, collectionTasks, taskListKey(filter), taskListSortKey)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.TaskListRow{}
+	if err := it.getData(row); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:     filter.DomainID,
+		TaskListName: filter.TaskListName,
+		TaskListType: filter.TaskListType,
+
+		TaskListKind:            row.TaskListKind,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AckLevel:                row.AckLevel,
+		RangeID:                 it.getInt(rangeIDField),
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	}, nil
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	inserted := *row
+	inserted.RangeID = initialRangeID
+	inserted.AckLevel = 0
+	it, err := newTaskListDocument(&inserted, 0)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(collectionTasks, it, newCondition().notExists()))
+	if isConditionFailedError(err) {
+		return db.taskListConditionFailure(ctx, taskListFilter(row), "tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +87,7 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.UpdateTaskListWithTTL(ctx, 0, row, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +100,33 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	it, err := newTaskListDocument(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	err = db.write(ctx, putOp(collectionTasks, it, newCondition().equalInt(rangeIDField, previousRangeID)))
+	if isConditionFailedError(err) {
+		return db.taskListConditionFailure(ctx, taskListFilter(row), fmt.Sprintf("previous range_id %v doesn't match", previousRangeID))
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	err := db.write(ctx, deleteOp(collectionTasks, taskListKey(filter), taskListSortKey, newCondition().equalInt(rangeIDField, previousRangeID)))
+	if isConditionFailedError(err) {
+		return db.taskListConditionFailure(ctx, filter, fmt.Sprintf("previous range_id %v doesn't match", previousRangeID))
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,16 +136,79 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := taskListFilter(tasklistCondition)
+	pk := taskListKey(filter)
+	now := time.Now()
+
+	var ops []writeOp
+	for _, task := range tasksToInsert {
+		row := task.TaskRow
+		row.DomainID = tasklistCondition.DomainID
+		row.TaskListName = tasklistCondition.TaskListName
+		row.TaskListType = tasklistCondition.TaskListType
+		it, err := newDocument(pk, taskSortKey(task.TaskID)).
+			setTTL(now, int64(task.TTLSeconds)).
+			setData(&row)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, putOp(collectionTasks, it, nil))
+	}
+
+	// the transaction checks the range ID of the tasklist, so tasks are never written after losing the ownership
+	err := db.transact(ctx, append([]writeOp{
+		checkOp(collectionTasks, pk, taskListSortKey, newCondition().equalInt(rangeIDField, tasklistCondition.RangeID)),
+	}, ops...))
+	if _, conditionFailed := failedConditions(err); conditionFailed {
+		return db.taskListConditionFailure(ctx, filter, fmt.Sprintf("range_id %v doesn't match", tasklistCondition.RangeID))
+	}
+	return err
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID == math.MaxInt64 {
+		return nil, nil
+	}
+	sk, ok := skIntBetween(taskPrefix, filter.MinTaskID+1, filter.MaxTaskID)
+	if !ok {
+		return nil, nil
+	}
+	docs, _, err := db.queryPage(ctx, queryRequest{
+		collection: collectionTasks,
+		pk:         taskListKey(&filter.TaskListFilter),
+		sk:         sk,
+		limit:      filter.BatchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, it := range docs {
+		task := &nosqlplugin.TaskRow{}
+		if err := it.getData(task); err != nil {
+			return nil, err
+		}
+		if expiry, ok := it.getTime(expiresAtField); ok {
+			task.Expiry = expiry
+		}
+		response = append(response, task)
+	}
+	return response, nil
 }
 
+// SelectTasks return tasks that associated to a tasklist
 func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	if filter.MinTaskID == math.MaxInt64 {
+		return 0, nil
+	}
+	sk, _ := skIntBetween(taskPrefix, filter.MinTaskID+1, math.MaxInt64)
+	return db.queryCount(ctx, queryRequest{
+		collection: collectionTasks,
+		pk:         taskListKey(&filter.TaskListFilter),
+		sk:         sk,
+	})
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
@@ -97,5 +216,51 @@ func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilte
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID == math.MaxInt64 {
+		return persistence.UnknownNumRowsAffected, nil
+	}
+	sk, ok := skIntBetween(taskPrefix, filter.MinTaskID+1, filter.MaxTaskID)
+	if !ok {
+		return persistence.UnknownNumRowsAffected, nil
+	}
+	_, err = db.deleteRange(ctx, collectionTasks, taskListKey(&filter.TaskListFilter), sk)
+	return persistence.UnknownNumRowsAffected, err
+}
+
+// taskListConditionFailure reads the current range ID of the tasklist to report a failed condition
+func (db *mdb) taskListConditionFailure(ctx context.Context, filter *nosqlplugin.TaskListFilter, details string) error {
+	rangeID := int64(-1)
+	it, err := db.getDocument(ctx, collectionTasks, taskListKey(filter), taskListSortKey)
+	if err == nil {
+		rangeID = it.getInt(rangeIDField)
+	} else if !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("%v, range_id=%v", details, rangeID),
+	}
+}
+
+func newTaskListDocument(row *nosqlplugin.TaskListRow, ttlSeconds int64) (document, error) {
+	return newDocument(taskListKey(taskListFilter(row)), taskListSortKey).
+		setInt(rangeIDField, row.RangeID).
+		setTTL(time.Now(), ttlSeconds).
+		setData(row)
+}
+
+func taskListFilter(row *nosqlplugin.TaskListRow) *nosqlplugin.TaskListFilter {
+	return &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) string {
+	return joinKey(filter.DomainID, filter.TaskListName, strconv.Itoa(filter.TaskListType))
+}
+
+func taskSortKey(taskID int64) string {
+	return taskPrefix + sortableInt(taskID)
 }
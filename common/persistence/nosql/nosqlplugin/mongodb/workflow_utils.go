@@ -0,0 +1,400 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	currentWorkflowPrefix              = "current"
+	executionPrefix                    = "execution"
+	workflowRequestPrefix              = "request"
+	activeClusterSelectionPolicyPrefix = "policy"
+	replicationDLQPrefix               = "dlq"
+
+	currentRunIDField     = "currentrunid"
+	lastWriteVersionField = "lastwriteversion"
+	workflowStateField    = "workflowstate"
+	nextEventIDField      = "nexteventid"
+
+	// workflowRequestTTLInSeconds is how long a request is remembered for deduplication
+	workflowRequestTTLInSeconds = 10800
+)
+
+type (
+	// executionRecord is the data of an execution document. Unlike Cassandra, the maps can't be updated in place,
+	// so updates read the record, merge the changes and write it back conditioned on record_version.
+	executionRecord struct {
+		ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+		VersionHistories    *persistence.DataBlob
+		Checksum            checksum.Checksum
+		LastWriteVersion    int64
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  map[string]struct{}
+		BufferedEvents      []*persistence.DataBlob
+		WorkflowTimerTasks  []workflowTimerTask
+	}
+
+	// workflowTimerTask is an entry of the timer tasks of an execution, HistoryTaskKey can't be encoded directly
+	workflowTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+	}
+
+	// workflowTransaction is the transaction of a workflow write, it tracks what each write is
+	// so that a failed condition can be explained. A transaction stops at the first failed condition,
+	// so the shard condition is added first to take precedence over the others.
+	workflowTransaction struct {
+		ops        []writeOp
+		shard      int
+		current    int
+		executions map[int]*nosqlplugin.WorkflowExecutionRequest
+		requests   map[int]*nosqlplugin.WorkflowRequestRow
+	}
+)
+
+func newWorkflowTransaction() *workflowTransaction {
+	return &workflowTransaction{
+		shard:      -1,
+		current:    -1,
+		executions: map[int]*nosqlplugin.WorkflowExecutionRequest{},
+		requests:   map[int]*nosqlplugin.WorkflowRequestRow{},
+	}
+}
+
+func (t *workflowTransaction) add(op writeOp) int {
+	t.ops = append(t.ops, op)
+	return len(t.ops) - 1
+}
+
+func (t *workflowTransaction) addWorkflowRequests(requests *nosqlplugin.WorkflowRequestsWriteRequest, now time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	var cond *condition
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert:
+		cond = newCondition().notExistsOrExpired(now)
+	case nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	for _, row := range requests.Rows {
+		it, err := newDocument(shardKey(row.ShardID), workflowRequestSortKey(row.DomainID, row.WorkflowID, row.RequestType, row.RequestID)).
+			setTTL(now, workflowRequestTTLInSeconds).
+			setData(row)
+		if err != nil {
+			return err
+		}
+		t.requests[t.add(putOp(collectionExecutions, it, cond))] = row
+	}
+	return nil
+}
+
+func (t *workflowTransaction) addCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	var cond *condition
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		cond = newCondition().notExists()
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		cond = newCondition().equalString(currentRunIDField, *request.Condition.CurrentRunID)
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			cond.equalInt(lastWriteVersionField, *request.Condition.LastWriteVersion).
+				equalInt(workflowStateField, int64(*request.Condition.State))
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	it, err := newDocument(shardKey(shardID), currentWorkflowSortKey(domainID, workflowID)).
+		setString(currentRunIDField, row.RunID).
+		setInt(lastWriteVersionField, row.LastWriteVersion).
+		setInt(workflowStateField, int64(row.State)).
+		setData(&row)
+	if err != nil {
+		return err
+	}
+	t.current = t.add(putOp(collectionExecutions, it, cond))
+	return nil
+}
+
+// addExecution writes the record of an execution, recordVersion is the version of the record that was read
+func (t *workflowTransaction) addExecution(
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	record *executionRecord,
+	recordVersion int64,
+	cond *condition,
+) error {
+	it, err := newDocument(shardKey(shardID), executionSortKey(execution.DomainID, execution.WorkflowID, execution.RunID)).
+		setInt(nextEventIDField, execution.NextEventID).
+		setInt(recordVersionField, recordVersion+1).
+		setData(record)
+	if err != nil {
+		return err
+	}
+	t.executions[t.add(putOp(collectionExecutions, it, cond))] = execution
+	return nil
+}
+
+func (t *workflowTransaction) addActiveClusterSelectionPolicy(row *nosqlplugin.ActiveClusterSelectionPolicyRow) error {
+	if row == nil || row.Policy == nil {
+		return nil
+	}
+	it, err := newDocument(shardKey(row.ShardID), activeClusterSelectionPolicySortKey(row.DomainID, row.WorkflowID, row.RunID)).
+		setData(row.Policy)
+	if err != nil {
+		return err
+	}
+	t.add(putOp(collectionExecutions, it, nil))
+	return nil
+}
+
+func (t *workflowTransaction) addHistoryTasks(
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for category, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			it, err := newHistoryTaskDocument(historyTaskKey(shardID, category), category, task)
+			if err != nil {
+				return err
+			}
+			if it != nil {
+				t.add(putOp(collectionHistoryTasks, it, nil))
+			}
+		}
+	}
+	return nil
+}
+
+func (t *workflowTransaction) addShardCondition(shardCondition *nosqlplugin.ShardCondition) {
+	t.shard = t.add(shardConditionOp(shardCondition))
+}
+
+func shardConditionOp(shardCondition *nosqlplugin.ShardCondition) writeOp {
+	return checkOp(collectionShards, shardKey(shardCondition.ShardID), shardSortKey,
+		newCondition().equalInt(rangeIDField, shardCondition.RangeID))
+}
+
+// newExecutionRecord creates the record of a new execution, or resets all the maps of an existing one
+func newExecutionRecord(execution *nosqlplugin.WorkflowExecutionRequest) *executionRecord {
+	record := &executionRecord{
+		ActivityInfos:       execution.ActivityInfos,
+		TimerInfos:          execution.TimerInfos,
+		ChildExecutionInfos: execution.ChildWorkflowInfos,
+		RequestCancelInfos:  execution.RequestCancelInfos,
+		SignalInfos:         execution.SignalInfos,
+		SignalRequestedIDs:  map[string]struct{}{},
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		record.SignalRequestedIDs[id] = struct{}{}
+	}
+	record.setExecution(execution)
+	record.appendWorkflowTimerTasks(execution.WorkflowTimerTasks)
+	return record
+}
+
+func (r *executionRecord) setExecution(execution *nosqlplugin.WorkflowExecutionRequest) {
+	info := execution.InternalWorkflowExecutionInfo
+	r.ExecutionInfo = &info
+	r.VersionHistories = execution.VersionHistories
+	r.LastWriteVersion = execution.LastWriteVersion
+	if execution.Checksums != nil {
+		r.Checksum = *execution.Checksums
+	}
+}
+
+// mergeExecution applies an update of the execution info and of the maps to the record
+func (r *executionRecord) mergeExecution(execution *nosqlplugin.WorkflowExecutionRequest) {
+	r.setExecution(execution)
+	r.ActivityInfos = mergeMap(r.ActivityInfos, execution.ActivityInfos, execution.ActivityInfoKeysToDelete)
+	r.TimerInfos = mergeMap(r.TimerInfos, execution.TimerInfos, execution.TimerInfoKeysToDelete)
+	r.ChildExecutionInfos = mergeMap(r.ChildExecutionInfos, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete)
+	r.RequestCancelInfos = mergeMap(r.RequestCancelInfos, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete)
+	r.SignalInfos = mergeMap(r.SignalInfos, execution.SignalInfos, execution.SignalInfoKeysToDelete)
+	if r.SignalRequestedIDs == nil {
+		r.SignalRequestedIDs = map[string]struct{}{}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		r.SignalRequestedIDs[id] = struct{}{}
+	}
+	for _, id := range execution.SignalRequestedIDsKeysToDelete {
+		delete(r.SignalRequestedIDs, id)
+	}
+	r.appendWorkflowTimerTasks(execution.WorkflowTimerTasks)
+}
+
+// appendWorkflowTimerTasks adds the timer tasks which aren't in the record yet
+func (r *executionRecord) appendWorkflowTimerTasks(keys []persistence.HistoryTaskKey) {
+	for _, key := range keys {
+		task := workflowTimerTask{VisibilityTimestamp: key.GetScheduledTime(), TaskID: key.GetTaskID()}
+		found := false
+		for _, existing := range r.WorkflowTimerTasks {
+			if existing.TaskID == task.TaskID && existing.VisibilityTimestamp.Equal(task.VisibilityTimestamp) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.WorkflowTimerTasks = append(r.WorkflowTimerTasks, task)
+		}
+	}
+}
+
+// toWorkflowExecution converts a record read from the table, where blobs written without data have to be
+// turned back into nil like the other plugins do
+func (r *executionRecord) toWorkflowExecution() *nosqlplugin.WorkflowExecution {
+	if info := r.ExecutionInfo; info != nil {
+		info.CompletionEvent = emptyBlobToNil(info.CompletionEvent)
+		info.AutoResetPoints = emptyBlobToNil(info.AutoResetPoints)
+		info.ActiveClusterSelectionPolicy = emptyBlobToNil(info.ActiveClusterSelectionPolicy)
+		info.CompletionCallbacks = emptyBlobToNil(info.CompletionCallbacks)
+		info.WorkflowDeadline = emptyBlobToNil(info.WorkflowDeadline)
+	}
+	for _, info := range r.ActivityInfos {
+		info.ScheduledEvent = emptyBlobToNil(info.ScheduledEvent)
+		info.StartedEvent = emptyBlobToNil(info.StartedEvent)
+	}
+	for _, info := range r.ChildExecutionInfos {
+		info.InitiatedEvent = emptyBlobToNil(info.InitiatedEvent)
+		info.StartedEvent = emptyBlobToNil(info.StartedEvent)
+	}
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       r.ExecutionInfo,
+		VersionHistories:    emptyBlobToNil(r.VersionHistories),
+		ActivityInfos:       nonNilMap(r.ActivityInfos),
+		TimerInfos:          nonNilMap(r.TimerInfos),
+		ChildExecutionInfos: nonNilMap(r.ChildExecutionInfos),
+		RequestCancelInfos:  nonNilMap(r.RequestCancelInfos),
+		SignalInfos:         nonNilMap(r.SignalInfos),
+		SignalRequestedIDs:  nonNilMap(r.SignalRequestedIDs),
+		BufferedEvents:      r.BufferedEvents,
+		Checksum:            r.Checksum,
+	}
+}
+
+func mergeMap[K comparable, V any](m map[K]V, updates map[K]V, deletes []K) map[K]V {
+	if m == nil {
+		m = make(map[K]V, len(updates))
+	}
+	for k, v := range updates {
+		m[k] = v
+	}
+	for _, k := range deletes {
+		delete(m, k)
+	}
+	return m
+}
+
+func nonNilMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return map[K]V{}
+	}
+	return m
+}
+
+func newHistoryTaskDocument(pk string, category persistence.HistoryTaskCategory, migrationTask *nosqlplugin.HistoryMigrationTask) (document, error) {
+	task := *migrationTask
+	var sk string
+	switch category.ID() {
+	case persistence.HistoryTaskCategoryIDTransfer:
+		task.TaskID = task.Transfer.TaskID
+		sk = sortableInt(task.TaskID)
+	case persistence.HistoryTaskCategoryIDTimer:
+		task.TaskID = task.Timer.TaskID
+		task.ScheduledTime = task.Timer.VisibilityTimestamp
+		sk = timerTaskSortKey(task.ScheduledTime, task.TaskID)
+	case persistence.HistoryTaskCategoryIDReplication:
+		task.TaskID = task.Replication.TaskID
+		sk = sortableInt(task.TaskID)
+	default:
+		// TODO: implementing writing tasks for other categories
+		return nil, nil
+	}
+	task.Task = emptyBlobToNil(task.Task)
+	return newDocument(pk, sk).setData(&task)
+}
+
+func toHistoryMigrationTasks(docs []document) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(docs))
+	for _, it := range docs {
+		task := &nosqlplugin.HistoryMigrationTask{}
+		if err := it.getData(task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func currentWorkflowSortKey(domainID, workflowID string) string {
+	return joinKey(currentWorkflowPrefix, domainID, workflowID)
+}
+
+func executionSortKey(domainID, workflowID, runID string) string {
+	return joinKey(executionPrefix, domainID, workflowID, runID)
+}
+
+func workflowRequestSortKey(domainID, workflowID string, requestType persistence.WorkflowRequestType, requestID string) string {
+	return joinKey(workflowRequestPrefix, domainID, workflowID, strconv.Itoa(int(requestType)), requestID)
+}
+
+func activeClusterSelectionPolicySortKey(domainID, workflowID, runID string) string {
+	return joinKey(activeClusterSelectionPolicyPrefix, domainID, workflowID, runID)
+}
+
+func historyTaskKey(shardID int, category persistence.HistoryTaskCategory) string {
+	return joinKey(shardKey(shardID), strconv.Itoa(category.ID()))
+}
+
+func replicationDLQKey(shardID int, sourceCluster string) string {
+	return joinKey(shardKey(shardID), replicationDLQPrefix, sourceCluster)
+}
+
+func timerTaskSortKey(visibilityTimestamp time.Time, taskID int64) string {
+	return joinKey(sortableTime(visibilityTimestamp), sortableInt(taskID))
+}
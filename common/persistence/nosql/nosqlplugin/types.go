@@ -281,6 +281,19 @@ type (
 		NextPageToken  []byte
 	}
 
+	// AsyncWorkflowRequestStatusRow defines the row struct for async workflow request status
+	AsyncWorkflowRequestStatusRow struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           persistence.AsyncWorkflowRequestState
+		FailureReason   string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+		TTLSeconds      int64 // TTL for the status entry in seconds
+	}
+
 	// HistoryDLQTaskRow defines the row struct for history task dead-letter queue entries.
 	HistoryDLQTaskRow struct {
 		ShardID               int
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	AsyncWorkflowRequestStatusPersistenceSuite struct {
+		*TestBase
+		*require.Assertions
+	}
+)
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) TestCreateUpdateAndGet() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	manager, err := s.PersistenceFactory.NewAsyncWorkflowRequestStatusManager()
+	s.NoError(err)
+	defer manager.Close()
+
+	domainID := uuid.NewString()
+	requestID := uuid.NewString()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	err = manager.CreateAsyncWorkflowRequestStatus(ctx, &persistence.CreateAsyncWorkflowRequestStatusRequest{
+		DomainID:    domainID,
+		RequestID:   requestID,
+		WorkflowID:  "workflow-id",
+		CreatedTime: now,
+	})
+	s.NoError(err)
+
+	resp, err := manager.GetAsyncWorkflowRequestStatus(ctx, &persistence.GetAsyncWorkflowRequestStatusRequest{
+		DomainID:  domainID,
+		RequestID: requestID,
+	})
+	s.NoError(err)
+	s.Equal(persistence.AsyncWorkflowRequestStateQueued, resp.Status.State)
+	s.Equal("workflow-id", resp.Status.WorkflowID)
+	s.Empty(resp.Status.RunID)
+	s.WithinDuration(now, resp.Status.CreatedTime, time.Millisecond)
+
+	err = manager.UpdateAsyncWorkflowRequestStatus(ctx, &persistence.UpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        domainID,
+		RequestID:       requestID,
+		State:           persistence.AsyncWorkflowRequestStateStarted,
+		RunID:           "run-id",
+		LastUpdatedTime: now.Add(time.Second),
+	})
+	s.NoError(err)
+
+	resp, err = manager.GetAsyncWorkflowRequestStatus(ctx, &persistence.GetAsyncWorkflowRequestStatusRequest{
+		DomainID:  domainID,
+		RequestID: requestID,
+	})
+	s.NoError(err)
+	s.Equal(persistence.AsyncWorkflowRequestStateStarted, resp.Status.State)
+	s.Equal("workflow-id", resp.Status.WorkflowID)
+	s.Equal("run-id", resp.Status.RunID)
+	s.WithinDuration(now.Add(time.Second), resp.Status.LastUpdatedTime, time.Millisecond)
+}
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) TestGetNotFound() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	manager, err := s.PersistenceFactory.NewAsyncWorkflowRequestStatusManager()
+	s.NoError(err)
+	defer manager.Close()
+
+	_, err = manager.GetAsyncWorkflowRequestStatus(ctx, &persistence.GetAsyncWorkflowRequestStatusRequest{
+		DomainID:  uuid.NewString(),
+		RequestID: uuid.NewString(),
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *AsyncWorkflowRequestStatusPersistenceSuite) TestUpdateNotFound() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	manager, err := s.PersistenceFactory.NewAsyncWorkflowRequestStatusManager()
+	s.NoError(err)
+	defer manager.Close()
+
+	domainID := uuid.NewString()
+	requestID := uuid.NewString()
+	err = manager.UpdateAsyncWorkflowRequestStatus(ctx, &persistence.UpdateAsyncWorkflowRequestStatusRequest{
+		DomainID:        domainID,
+		RequestID:       requestID,
+		State:           persistence.AsyncWorkflowRequestStateStarted,
+		RunID:           "run-id",
+		LastUpdatedTime: time.Now(),
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	// the update must not create the status
+	_, err = manager.GetAsyncWorkflowRequestStatus(ctx, &persistence.GetAsyncWorkflowRequestStatusRequest{
+		DomainID:  domainID,
+		RequestID: requestID,
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
@@ -22,6 +22,7 @@ package persistencetests
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"os"
 	"testing"
@@ -876,6 +877,10 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 			},
 		},
 	}
+	if s.isSQLVisibility() {
+		// SQL visibility supports upserts, which are a no-op for executions without a record
+		tests[1].expected = nil
+	}
 
 	for _, test := range tests {
 		err := s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request)
@@ -887,6 +892,269 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if !s.isSQLVisibility() {
+		s.T().Skip("queries are only supported by SQL visibility")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	now := time.Now()
+	deadline := now.Add(time.Hour).Truncate(time.Second)
+	encode := func(value interface{}) []byte {
+		data, err := json.Marshal(value)
+		s.NoError(err)
+		return data
+	}
+
+	execution1 := types.WorkflowExecution{WorkflowID: "visibility-query-test-1", RunID: uuid.New()}
+	execution2 := types.WorkflowExecution{WorkflowID: "visibility-query-test-2", RunID: uuid.New()}
+	execution3 := types.WorkflowExecution{WorkflowID: "visibility-query-test-3", RunID: uuid.New()}
+
+	err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        execution1,
+		WorkflowTypeName: "visibility-query-type-a",
+		StartTimestamp:   now.Add(-3 * time.Second).UnixNano(),
+		ShardID:          1234,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField":  encode("foo"),
+			"CustomIntField":      encode(10),
+			"CustomDoubleField":   encode(1.5),
+			"CustomBoolField":     encode(true),
+			"CustomDatetimeField": encode(deadline),
+		},
+	})
+	s.NoError(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        execution2,
+		WorkflowTypeName: "visibility-query-type-b",
+		StartTimestamp:   now.Add(-2 * time.Second).UnixNano(),
+		ShardID:          1234,
+	})
+	s.NoError(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        execution2,
+		WorkflowTypeName: "visibility-query-type-b",
+		StartTimestamp:   now.Add(-2 * time.Second).UnixNano(),
+		CloseTimestamp:   now.UnixNano(),
+		Status:           types.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    5,
+		ShardID:          1234,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": encode("bar"),
+			"CustomIntField":     encode(20),
+		},
+	})
+	s.NoError(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        execution3,
+		WorkflowTypeName: "visibility-query-type-b",
+		StartTimestamp:   now.Add(-1 * time.Second).UnixNano(),
+		ShardID:          1234,
+	})
+	s.NoError(err)
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        execution3,
+		WorkflowTypeName: "visibility-query-type-b",
+		StartTimestamp:   now.Add(-1 * time.Second).UnixNano(),
+		UpdateTimestamp:  now.UnixNano(),
+		ShardID:          1234,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": encode("baz"),
+			"CustomIntField":     encode(30),
+		},
+	})
+	s.NoError(err)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			query:    "",
+			expected: []string{execution3.WorkflowID, execution2.WorkflowID, execution1.WorkflowID},
+		},
+		{
+			name:     "system attribute",
+			query:    "WorkflowType = 'visibility-query-type-b'",
+			expected: []string{execution3.WorkflowID, execution2.WorkflowID},
+		},
+		{
+			name:     "close status",
+			query:    "CloseStatus = 'COMPLETED'",
+			expected: []string{execution2.WorkflowID},
+		},
+		{
+			name:     "open executions",
+			query:    "CloseTime = missing",
+			expected: []string{execution3.WorkflowID, execution1.WorkflowID},
+		},
+		{
+			name:     "validated keyword attribute",
+			query:    "`Attr.CustomKeywordField` = 'foo'",
+			expected: []string{execution1.WorkflowID},
+		},
+		{
+			name:     "keyword attribute like",
+			query:    "CustomKeywordField LIKE 'ba%'",
+			expected: []string{execution3.WorkflowID, execution2.WorkflowID},
+		},
+		{
+			name:     "keyword attribute in",
+			query:    "CustomKeywordField IN ('foo', 'baz')",
+			expected: []string{execution3.WorkflowID, execution1.WorkflowID},
+		},
+		{
+			name:     "int attribute ordered",
+			query:    "CustomIntField >= 20 ORDER BY CustomIntField ASC",
+			expected: []string{execution2.WorkflowID, execution3.WorkflowID},
+		},
+		{
+			name:     "int attribute between",
+			query:    "CustomIntField BETWEEN 5 AND 25 AND WorkflowType = 'visibility-query-type-a'",
+			expected: []string{execution1.WorkflowID},
+		},
+		{
+			name:     "double attribute",
+			query:    "CustomDoubleField > 1",
+			expected: []string{execution1.WorkflowID},
+		},
+		{
+			name:     "bool attribute",
+			query:    "CustomBoolField = true",
+			expected: []string{execution1.WorkflowID},
+		},
+		{
+			name:     "datetime attribute",
+			query:    "CustomDatetimeField >= '" + deadline.Format(time.RFC3339Nano) + "'",
+			expected: []string{execution1.WorkflowID},
+		},
+		{
+			name:     "missing attribute",
+			query:    "CustomBoolField = missing",
+			expected: []string{execution3.WorkflowID, execution2.WorkflowID},
+		},
+		{
+			name:     "or condition",
+			query:    "(CustomIntField = 10 OR CustomIntField = 30) AND CloseTime = missing",
+			expected: []string{execution3.WorkflowID, execution1.WorkflowID},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+				DomainUUID: testDomainUUID,
+				PageSize:   10,
+				Query:      test.query,
+			})
+			s.NoError(err)
+			var workflowIDs []string
+			for _, execution := range resp.Executions {
+				workflowIDs = append(workflowIDs, execution.Execution.WorkflowID)
+			}
+			s.Equal(test.expected, workflowIDs)
+			s.Nil(resp.NextPageToken)
+
+			countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+				DomainUUID: testDomainUUID,
+				Query:      test.query,
+			})
+			s.NoError(err)
+			s.Equal(int64(len(test.expected)), countResp.Count)
+		})
+	}
+
+	// search attributes are returned with the executions
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "WorkflowID = '" + execution3.WorkflowID + "'",
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal(encode("baz"), resp.Executions[0].SearchAttributes.IndexedFields["CustomKeywordField"])
+	s.Equal(encode(30), resp.Executions[0].SearchAttributes.IndexedFields["CustomIntField"])
+
+	// pages resume after the last execution returned, including on missing values which sort last
+	var listed []string
+	var token []byte
+	for {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      1,
+			Query:         "ORDER BY CustomBoolField DESC, CloseTime DESC",
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		for _, execution := range resp.Executions {
+			listed = append(listed, execution.Execution.WorkflowID)
+		}
+		if token = resp.NextPageToken; token == nil {
+			break
+		}
+	}
+	s.Equal([]string{execution1.WorkflowID, execution2.WorkflowID, execution3.WorkflowID}, listed)
+
+	// scans page through all executions, unaffected by executions started during the scan
+	var scanned []string
+	for {
+		resp, err := s.VisibilityMgr.ScanWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.NoError(err)
+		for _, execution := range resp.Executions {
+			scanned = append(scanned, execution.Execution.WorkflowID)
+		}
+		if token = resp.NextPageToken; token == nil {
+			break
+		}
+		if len(scanned) == 2 {
+			err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+				DomainUUID:       testDomainUUID,
+				Execution:        types.WorkflowExecution{WorkflowID: "visibility-query-test-4", RunID: uuid.New()},
+				WorkflowTypeName: "visibility-query-type-b",
+				StartTimestamp:   now.UnixNano(),
+				ShardID:          1234,
+			})
+			s.NoError(err)
+		}
+	}
+	s.Equal([]string{execution3.WorkflowID, execution2.WorkflowID, execution1.WorkflowID}, scanned)
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    testDomainUUID,
+		PageSize:      10,
+		Query:         "ORDER BY WorkflowID",
+		NextPageToken: []byte(`{"SortValues":[],"RunID":"run"}`),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "UnknownField = 'foo'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// isSQLVisibility returns true if the suite runs against a SQL visibility store
+func (s *DBVisibilityPersistenceSuite) isSQLVisibility() bool {
+	store, ok := s.PersistenceConfig.DataStores[s.PersistenceConfig.VisibilityStore]
+	return ok && store.SQL != nil
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
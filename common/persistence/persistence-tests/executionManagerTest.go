@@ -1656,6 +1656,9 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.True(len(info0.SearchAttributes) == 0)
 	s.True(len(info0.Memo) == 0)
 	s.Equal(partitionConfig0, info0.PartitionConfig)
+	s.False(info0.Paused)
+	s.Empty(info0.CompletionCallbacks)
+	s.Nil(info0.WorkflowDeadline)
 	s.assertChecksumsEqual(testWorkflowChecksum, state0.Checksum)
 
 	s.T().Logf("Workflow execution last updated: %v\n", info0.LastUpdatedTimestamp)
@@ -1691,6 +1694,11 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.Memo = map[string][]byte{memoKey: memoVal}
 	partitionConfig := map[string]string{"zone": "dca2"}
 	updatedInfo.PartitionConfig = partitionConfig
+	updatedInfo.Paused = true
+	completionCallbacks := []*types.CompletionCallback{{URL: "https://callbacks.example.com", Header: map[string]string{"key": "value"}}}
+	updatedInfo.CompletionCallbacks = completionCallbacks
+	workflowDeadline := &types.WorkflowDeadline{DeadlineSeconds: 10, GracePeriodSeconds: 5}
+	updatedInfo.WorkflowDeadline = workflowDeadline
 	updatedStats.HistorySize = math.MaxInt64
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -1752,6 +1760,9 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.True(ok)
 	s.Equal(memoVal, memoVal1)
 	s.Equal(partitionConfig, info1.PartitionConfig)
+	s.True(info1.Paused)
+	s.Equal(completionCallbacks, info1.CompletionCallbacks)
+	s.Equal(workflowDeadline, info1.WorkflowDeadline)
 	s.assertChecksumsEqual(testWorkflowChecksum, state1.Checksum)
 
 	s.T().Logf("Workflow execution last updated: %v\n", info1.LastUpdatedTimestamp)
@@ -3462,6 +3473,8 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 		LastFailureDetails:       []byte(uuid.New()),
 		LastFailureCategory:      types.FailureCategoryFatal,
 		LastRetryIntervalSeconds: 10,
+		Priority:                 4,
+		Paused:                   true,
 	}}
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -5965,6 +5978,9 @@ func copyWorkflowExecutionInfo(sourceInfo *p.WorkflowExecutionInfo) *p.WorkflowE
 		BranchToken:                 sourceInfo.BranchToken,
 		AutoResetPoints:             sourceInfo.AutoResetPoints,
 		PartitionConfig:             sourceInfo.PartitionConfig,
+		Paused:                      sourceInfo.Paused,
+		CompletionCallbacks:         sourceInfo.CompletionCallbacks,
+		WorkflowDeadline:            sourceInfo.WorkflowDeadline,
 	}
 }
 
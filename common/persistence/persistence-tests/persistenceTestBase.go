@@ -162,6 +162,7 @@ func NewTestBaseWithNoSQL(t *testing.T, options *TestBaseOptions) *TestBase {
 		SerializationEncoding:                    dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 		ReadNoSQLShardFromDataBlob:               dynamicproperties.GetBoolPropertyFn(true),
 		DomainAuditLogTTL:                        func(domainID string) time.Duration { return time.Hour * 24 * 365 }, // 1 year default
+		AsyncWorkflowRequestStatusTTL:            func(domainID string) time.Duration { return time.Hour * 24 * 7 },
 		HistoryNodeDeleteBatchSize:               dynamicproperties.GetIntPropertyFn(1000),
 		EnableWorkflowTimerTaskCleanup:           dynamicproperties.GetBoolPropertyFn(true),
 	}
@@ -195,6 +196,7 @@ func NewTestBaseWithSQL(t *testing.T, options *TestBaseOptions) *TestBase {
 		SerializationEncoding:                    dynamicproperties.GetStringPropertyFn(string(constants.EncodingTypeThriftRW)),
 		ReadNoSQLShardFromDataBlob:               dynamicproperties.GetBoolPropertyFn(true),
 		DomainAuditLogTTL:                        func(domainID string) time.Duration { return time.Hour * 24 * 365 }, // 1 year default
+		AsyncWorkflowRequestStatusTTL:            func(domainID string) time.Duration { return time.Hour * 24 * 7 },
 		HistoryNodeDeleteBatchSize:               dynamicproperties.GetIntPropertyFn(1000),
 		EnableWorkflowTimerTaskCleanup:           dynamicproperties.GetBoolPropertyFn(false),
 	}
@@ -1155,6 +1157,7 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(
 			*persistence.ActivityTask,
 			*persistence.CloseExecutionTask,
 			*persistence.RecordWorkflowClosedTask,
+			*persistence.CompletionCallbackTask,
 			*persistence.RecordChildExecutionCompletedTask,
 			*persistence.CancelExecutionTask,
 			*persistence.StartChildExecutionTask,
@@ -157,6 +157,12 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
 		info.TaskList = t.TaskList
+	case *persistence.CompletionCallbackTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
+		info.ScheduleID = t.CallbackIndex
 	case *persistence.ResetWorkflowTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -270,6 +276,13 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 			TaskData:           taskData,
 			TaskList:           info.GetTaskList(),
 		}
+	case persistence.TransferTaskTypeCompletionCallback:
+		task = &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+			CallbackIndex:      info.GetScheduleID(),
+		}
 	case persistence.TransferTaskTypeRecordChildExecutionCompleted:
 		task = &persistence.RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -332,6 +345,16 @@ func (s *taskSerializerImpl) serializeTimerTask(task persistence.Task) (persiste
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
 		info.TaskList = t.TaskList
+	case *persistence.WorkflowDeadlineTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
+	case *persistence.WorkflowDeadlineTerminateTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
 	case *persistence.DeleteHistoryEventTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -412,6 +435,18 @@ func (s *taskSerializerImpl) deserializeTimerTask(blob *persistence.DataBlob) (p
 			TimeoutType:        int(info.GetTimeoutType()),
 			TaskList:           info.GetTaskList(),
 		}
+	case persistence.TaskTypeWorkflowDeadline:
+		task = &persistence.WorkflowDeadlineTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+		}
+	case persistence.TaskTypeWorkflowDeadlineTerminate:
+		task = &persistence.WorkflowDeadlineTerminateTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+		}
 	default:
 		return nil, fmt.Errorf("unknown timer task type: %v", info.GetTaskType())
 	}
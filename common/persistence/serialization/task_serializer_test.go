@@ -195,6 +195,19 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				TargetRunID:      "2be8a310-7d20-483e-a5d2-48659dc47606",
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTransfer,
+			task: &persistence.CompletionCallbackTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             11,
+					TaskID:              11,
+					VisibilityTimestamp: time.Unix(11, 11),
+				},
+				TaskList:      "test-tl",
+				CallbackIndex: 2,
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryTimer,
 			task: &persistence.DecisionTimeoutTask{
@@ -282,6 +295,30 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				TimeoutType: 17,
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTimer,
+			task: &persistence.WorkflowDeadlineTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             22,
+					TaskID:              22,
+					VisibilityTimestamp: time.Unix(22, 22),
+				},
+				TaskList: "test-tl",
+			},
+		},
+		{
+			category: persistence.HistoryTaskCategoryTimer,
+			task: &persistence.WorkflowDeadlineTerminateTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             23,
+					TaskID:              23,
+					VisibilityTimestamp: time.Unix(23, 23),
+				},
+				TaskList: "test-tl",
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryReplication,
 			task: &persistence.HistoryReplicationTask{
@@ -99,6 +99,14 @@ type (
 		SerializeActiveClusterSelectionPolicy(policy *types.ActiveClusterSelectionPolicy, encodingType constants.EncodingType) (*DataBlob, error)
 		DeserializeActiveClusterSelectionPolicy(data *DataBlob) (*types.ActiveClusterSelectionPolicy, error)
 
+		// serialize/deserialize completion callbacks, they are always JSON encoded
+		SerializeCompletionCallbacks(callbacks []*types.CompletionCallback) (*DataBlob, error)
+		DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, error)
+
+		// serialize/deserialize workflow deadline, it is always JSON encoded
+		SerializeWorkflowDeadline(deadline *types.WorkflowDeadline) (*DataBlob, error)
+		DeserializeWorkflowDeadline(data *DataBlob) (*types.WorkflowDeadline, error)
+
 		// serialize/deserialize full replication task payload for DLQ storage
 		SerializeReplicationDLQTask(task *types.ReplicationTask, encodingType constants.EncodingType) (*DataBlob, error)
 		DeserializeReplicationDLQTask(data *DataBlob) (*types.ReplicationTask, error)
@@ -380,6 +388,40 @@ func (t *serializerImpl) DeserializeActiveClusterSelectionPolicy(data *DataBlob)
 	return &policy, err
 }
 
+func (t *serializerImpl) SerializeCompletionCallbacks(callbacks []*types.CompletionCallback) (*DataBlob, error) {
+	if len(callbacks) == 0 {
+		return nil, nil
+	}
+	return t.serialize(callbacks, constants.EncodingTypeJSON)
+}
+
+func (t *serializerImpl) DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil
+	}
+	var callbacks []*types.CompletionCallback
+	err := t.deserialize(data, &callbacks)
+	return callbacks, err
+}
+
+func (t *serializerImpl) SerializeWorkflowDeadline(deadline *types.WorkflowDeadline) (*DataBlob, error) {
+	if deadline == nil {
+		return nil, nil
+	}
+	return t.serialize(deadline, constants.EncodingTypeJSON)
+}
+
+func (t *serializerImpl) DeserializeWorkflowDeadline(data *DataBlob) (*types.WorkflowDeadline, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil
+	}
+	var deadline types.WorkflowDeadline
+	if err := t.deserialize(data, &deadline); err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
 func (t *serializerImpl) SerializeReplicationDLQTask(task *types.ReplicationTask, encodingType constants.EncodingType) (*DataBlob, error) {
 	if task == nil {
 		return nil, nil
@@ -12,11 +12,10 @@ package persistence
 import (
 	reflect "reflect"
 
-	gomock "go.uber.org/mock/gomock"
-
 	checksum "github.com/uber/cadence/common/checksum"
 	constants "github.com/uber/cadence/common/constants"
 	types "github.com/uber/cadence/common/types"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockPayloadSerializer is a mock of PayloadSerializer interface.
@@ -133,6 +132,21 @@ func (mr *MockPayloadSerializerMockRecorder) DeserializeChecksum(data any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeChecksum", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeChecksum), data)
 }
 
+// DeserializeCompletionCallbacks mocks base method.
+func (m *MockPayloadSerializer) DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeCompletionCallbacks", data)
+	ret0, _ := ret[0].([]*types.CompletionCallback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeCompletionCallbacks indicates an expected call of DeserializeCompletionCallbacks.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeCompletionCallbacks(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeCompletionCallbacks", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeCompletionCallbacks), data)
+}
+
 // DeserializeDynamicConfigBlob mocks base method.
 func (m *MockPayloadSerializer) DeserializeDynamicConfigBlob(data *DataBlob) (*types.DynamicConfigBlob, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +282,21 @@ func (mr *MockPayloadSerializerMockRecorder) DeserializeVisibilityMemo(data any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeVisibilityMemo", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeVisibilityMemo), data)
 }
 
+// DeserializeWorkflowDeadline mocks base method.
+func (m *MockPayloadSerializer) DeserializeWorkflowDeadline(data *DataBlob) (*types.WorkflowDeadline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeWorkflowDeadline", data)
+	ret0, _ := ret[0].(*types.WorkflowDeadline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializeWorkflowDeadline indicates an expected call of DeserializeWorkflowDeadline.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeWorkflowDeadline(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeWorkflowDeadline", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeWorkflowDeadline), data)
+}
+
 // SerializeActiveClusterSelectionPolicy mocks base method.
 func (m *MockPayloadSerializer) SerializeActiveClusterSelectionPolicy(policy *types.ActiveClusterSelectionPolicy, encodingType constants.EncodingType) (*DataBlob, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +387,21 @@ func (mr *MockPayloadSerializerMockRecorder) SerializeChecksum(sum, encodingType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeChecksum", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeChecksum), sum, encodingType)
 }
 
+// SerializeCompletionCallbacks mocks base method.
+func (m *MockPayloadSerializer) SerializeCompletionCallbacks(callbacks []*types.CompletionCallback) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeCompletionCallbacks", callbacks)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeCompletionCallbacks indicates an expected call of SerializeCompletionCallbacks.
+func (mr *MockPayloadSerializerMockRecorder) SerializeCompletionCallbacks(callbacks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeCompletionCallbacks", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeCompletionCallbacks), callbacks)
+}
+
 // SerializeDynamicConfigBlob mocks base method.
 func (m *MockPayloadSerializer) SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType constants.EncodingType) (*DataBlob, error) {
 	m.ctrl.T.Helper()
@@ -492,3 +536,18 @@ func (mr *MockPayloadSerializerMockRecorder) SerializeVisibilityMemo(memo, encod
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeVisibilityMemo", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeVisibilityMemo), memo, encodingType)
 }
+
+// SerializeWorkflowDeadline mocks base method.
+func (m *MockPayloadSerializer) SerializeWorkflowDeadline(deadline *types.WorkflowDeadline) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeWorkflowDeadline", deadline)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeWorkflowDeadline indicates an expected call of SerializeWorkflowDeadline.
+func (mr *MockPayloadSerializerMockRecorder) SerializeWorkflowDeadline(deadline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeWorkflowDeadline", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeWorkflowDeadline), deadline)
+}
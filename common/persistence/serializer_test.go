@@ -319,6 +319,28 @@ func TestSerializers(t *testing.T) {
 	}
 }
 
+func TestCompletionCallbacksSerialization(t *testing.T) {
+	serializer := NewPayloadSerializer()
+
+	blob, err := serializer.SerializeCompletionCallbacks(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, blob)
+	callbacks, err := serializer.DeserializeCompletionCallbacks(blob)
+	assert.NoError(t, err)
+	assert.Nil(t, callbacks)
+
+	expected := []*types.CompletionCallback{
+		{URL: "https://callbacks.example.com/done", Header: map[string]string{"Authorization": "Bearer token"}},
+		{URL: "https://callbacks.example.com/other"},
+	}
+	blob, err = serializer.SerializeCompletionCallbacks(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeJSON, blob.GetEncoding())
+	callbacks, err = serializer.DeserializeCompletionCallbacks(blob)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, callbacks)
+}
+
 func TestDataBlob_GetData(t *testing.T) {
 	tests := map[string]struct {
 		in          *DataBlob
@@ -119,6 +119,15 @@ func (f *Factory) NewDomainAuditStore() (p.DomainAuditStore, error) {
 	return newSQLDomainAuditStore(conn, f.logger, f.parser)
 }
 
+// NewAsyncWorkflowRequestStatusStore returns an async workflow request status store
+func (f *Factory) NewAsyncWorkflowRequestStatusStore() (p.AsyncWorkflowRequestStatusStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newSQLAsyncWorkflowRequestStatusStore(conn, f.logger, f.parser)
+}
+
 // NewHistoryDLQTaskStore returns a history DLQ task store.
 func (f *Factory) NewHistoryDLQTaskStore() (p.HistoryDLQTaskStore, error) {
 	return &sqlHistoryDLQTaskStore{}, nil
@@ -136,7 +145,7 @@ func (f *Factory) NewExecutionStore() (p.ExecutionStore, error) {
 // NewVisibilityStore returns a visibility store
 // TODO sortByCloseTime will be removed and implemented for https://github.com/uber/cadence/issues/3621
 func (f *Factory) NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg, f.logger, f.dc)
 }
 
 // NewQueue returns a new queue backed by sql
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type sqlAsyncWorkflowRequestStatusStore struct {
+	sqlStore
+}
+
+// newSQLAsyncWorkflowRequestStatusStore creates an instance of sqlAsyncWorkflowRequestStatusStore
+func newSQLAsyncWorkflowRequestStatusStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+	parser serialization.Parser,
+) (persistence.AsyncWorkflowRequestStatusStore, error) {
+	return &sqlAsyncWorkflowRequestStatusStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+			parser: parser,
+		},
+	}, nil
+}
+
+func (m *sqlAsyncWorkflowRequestStatusStore) CreateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.InternalCreateAsyncWorkflowRequestStatusRequest,
+) error {
+	_, err := m.db.InsertIntoAsyncWorkflowRequestStatus(ctx, &sqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        request.DomainID,
+		RequestID:       request.RequestID,
+		WorkflowID:      request.WorkflowID,
+		State:           persistence.AsyncWorkflowRequestStateQueued,
+		CreatedTime:     request.CreatedTime,
+		LastUpdatedTime: request.CreatedTime,
+		ExpiryTime:      request.ExpiryTime,
+	})
+	if err != nil {
+		return convertCommonErrors(m.db, "CreateAsyncWorkflowRequestStatus", "", err)
+	}
+	return nil
+}
+
+func (m *sqlAsyncWorkflowRequestStatusStore) UpdateAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.InternalUpdateAsyncWorkflowRequestStatusRequest,
+) error {
+	result, err := m.db.UpdateAsyncWorkflowRequestStatus(ctx, &sqlplugin.AsyncWorkflowRequestStatusRow{
+		DomainID:        request.DomainID,
+		RequestID:       request.RequestID,
+		RunID:           request.RunID,
+		State:           request.State,
+		FailureReason:   request.FailureReason,
+		LastUpdatedTime: request.LastUpdatedTime,
+		ExpiryTime:      request.ExpiryTime,
+	})
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateAsyncWorkflowRequestStatus", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("UpdateAsyncWorkflowRequestStatus operation failed. Could not verify the update: %v", err),
+		}
+	}
+	// the status is gone, or expired and waiting to be cleaned up
+	if rowsAffected == 0 {
+		return &types.EntityNotExistsError{
+			Message: "async workflow request " + request.RequestID + " not found",
+		}
+	}
+	return nil
+}
+
+func (m *sqlAsyncWorkflowRequestStatusStore) GetAsyncWorkflowRequestStatus(
+	ctx context.Context,
+	request *persistence.GetAsyncWorkflowRequestStatusRequest,
+) (*persistence.InternalGetAsyncWorkflowRequestStatusResponse, error) {
+	row, err := m.db.SelectFromAsyncWorkflowRequestStatus(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncWorkflowRequestStatus", "", err)
+	}
+	return &persistence.InternalGetAsyncWorkflowRequestStatusResponse{
+		Status: &persistence.InternalAsyncWorkflowRequestStatus{
+			DomainID:        row.DomainID,
+			RequestID:       row.RequestID,
+			WorkflowID:      row.WorkflowID,
+			RunID:           row.RunID,
+			State:           row.State,
+			FailureReason:   row.FailureReason,
+			CreatedTime:     row.CreatedTime,
+			LastUpdatedTime: row.LastUpdatedTime,
+			ExpiryTime:      row.ExpiryTime,
+		},
+	}, nil
+}
@@ -1170,6 +1170,9 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 	state.ExecutionInfo.WorkflowID = execution.WorkflowID
 	state.ExecutionInfo.RunID = execution.RunID.String()
 	state.ExecutionInfo.NextEventID = execution.NextEventID
+	state.ExecutionInfo.Paused = execution.Paused
+	state.ExecutionInfo.CompletionCallbacks = p.NewDataBlob(execution.CompletionCallbacks, constants.EncodingType(execution.CompletionCallbacksEncoding))
+	state.ExecutionInfo.WorkflowDeadline = p.NewDataBlob(execution.WorkflowDeadline, constants.EncodingType(execution.WorkflowDeadlineEncoding))
 	// TODO: remove this after all 2DC workflows complete
 	if info.LastWriteEventID != nil {
 		state.ReplicationState = &p.ReplicationState{}
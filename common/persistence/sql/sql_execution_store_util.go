@@ -1034,6 +1034,12 @@ func buildExecutionRow(
 		LastWriteVersion: lastWriteVersion,
 		Data:             blob.Data,
 		DataEncoding:     string(blob.Encoding),
+		Paused:           executionInfo.Paused,
+
+		CompletionCallbacks:         executionInfo.CompletionCallbacks.GetData(),
+		CompletionCallbacksEncoding: executionInfo.CompletionCallbacks.GetEncodingString(),
+		WorkflowDeadline:            executionInfo.WorkflowDeadline.GetData(),
+		WorkflowDeadlineEncoding:    executionInfo.WorkflowDeadline.GetEncodingString(),
 	}, nil
 }
 
@@ -814,14 +814,16 @@ func TestCreateExecution(t *testing.T) {
 					Encoding: constants.EncodingType("workflow"),
 				}, nil)
 				mockTx.EXPECT().InsertIntoExecutions(gomock.Any(), &sqlplugin.ExecutionsRow{
-					ShardID:          shardID,
-					DomainID:         serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
-					WorkflowID:       "abc",
-					RunID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
-					NextEventID:      9,
-					LastWriteVersion: 2,
-					Data:             []byte(`workflow`),
-					DataEncoding:     "workflow",
+					ShardID:             shardID,
+					DomainID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
+					WorkflowID:          "abc",
+					RunID:               serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
+					NextEventID:         9,
+					LastWriteVersion:    2,
+					Data:                []byte(`workflow`),
+					DataEncoding:        "workflow",
+					CompletionCallbacks: []byte{},
+					WorkflowDeadline:    []byte{},
 				}).Return(&sqlResult{rowsAffected: 1}, nil)
 			},
 			wantErr: false,
@@ -846,14 +848,16 @@ func TestCreateExecution(t *testing.T) {
 				}, nil)
 				err := errors.New("some error")
 				mockTx.EXPECT().InsertIntoExecutions(gomock.Any(), &sqlplugin.ExecutionsRow{
-					ShardID:          shardID,
-					DomainID:         serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
-					WorkflowID:       "abc",
-					RunID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
-					NextEventID:      9,
-					LastWriteVersion: 2,
-					Data:             []byte(`workflow`),
-					DataEncoding:     "workflow",
+					ShardID:             shardID,
+					DomainID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
+					WorkflowID:          "abc",
+					RunID:               serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
+					NextEventID:         9,
+					LastWriteVersion:    2,
+					Data:                []byte(`workflow`),
+					DataEncoding:        "workflow",
+					CompletionCallbacks: []byte{},
+					WorkflowDeadline:    []byte{},
 				}).Return(nil, err)
 				mockTx.EXPECT().IsDupEntryError(err).Return(true)
 			},
@@ -913,14 +917,16 @@ func TestUpdateExecution(t *testing.T) {
 					Encoding: constants.EncodingType("workflow"),
 				}, nil)
 				mockTx.EXPECT().UpdateExecutions(gomock.Any(), &sqlplugin.ExecutionsRow{
-					ShardID:          shardID,
-					DomainID:         serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
-					WorkflowID:       "abc",
-					RunID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
-					NextEventID:      9,
-					LastWriteVersion: 2,
-					Data:             []byte(`workflow`),
-					DataEncoding:     "workflow",
+					ShardID:             shardID,
+					DomainID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
+					WorkflowID:          "abc",
+					RunID:               serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
+					NextEventID:         9,
+					LastWriteVersion:    2,
+					Data:                []byte(`workflow`),
+					DataEncoding:        "workflow",
+					CompletionCallbacks: []byte{},
+					WorkflowDeadline:    []byte{},
 				}).Return(&sqlResult{rowsAffected: 1}, nil)
 			},
 			wantErr: false,
@@ -945,14 +951,16 @@ func TestUpdateExecution(t *testing.T) {
 				}, nil)
 				err := errors.New("some error")
 				mockTx.EXPECT().UpdateExecutions(gomock.Any(), &sqlplugin.ExecutionsRow{
-					ShardID:          shardID,
-					DomainID:         serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
-					WorkflowID:       "abc",
-					RunID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
-					NextEventID:      9,
-					LastWriteVersion: 2,
-					Data:             []byte(`workflow`),
-					DataEncoding:     "workflow",
+					ShardID:             shardID,
+					DomainID:            serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47602"),
+					WorkflowID:          "abc",
+					RunID:               serialization.MustParseUUID("8be8a310-7d20-483e-a5d2-48659dc47603"),
+					NextEventID:         9,
+					LastWriteVersion:    2,
+					Data:                []byte(`workflow`),
+					DataEncoding:        "workflow",
+					CompletionCallbacks: []byte{},
+					WorkflowDeadline:    []byte{},
 				}).Return(nil, err)
 				mockTx.EXPECT().IsNotFoundError(err).Return(true)
 			},
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -31,7 +32,10 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -41,26 +45,38 @@ import (
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicproperties.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of queries, which may be ordered by any column:
+	// the sort values and run ID of the last execution returned
+	visibilityQueryPageToken struct {
+		SortValues []interface{}
+		RunID      string
+	}
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger, dc *p.DynamicConfiguration) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
 	}
-	return &sqlVisibilityStore{
+	store := &sqlVisibilityStore{
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
 		},
-	}, nil
+	}
+	if dc != nil {
+		store.validSearchAttributes = dc.ValidSearchAttributes
+	}
+	return store, nil
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
@@ -83,6 +99,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(request.ExecutionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       s.serializeSearchAttributes(request.SearchAttributes),
 	})
 
 	if err != nil {
@@ -133,6 +150,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(executionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       s.serializeSearchAttributes(request.SearchAttributes),
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -160,13 +178,24 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.ErrVisibilityOperationNotSupported
+	_, err := s.db.UpdateVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		RunID:            request.RunID,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		UpdateTime:       request.UpdateTimestamp,
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -338,24 +367,35 @@ func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.searchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -376,6 +416,7 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		ShardID:                row.ShardID,
 		ExecutionStatus:        types.WorkflowExecutionStatus(row.ExecutionStatus),
 		ScheduledExecutionTime: row.ScheduledExecutionTime,
+		SearchAttributes:       s.deserializeSearchAttributes(row.SearchAttributes),
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
@@ -437,3 +478,139 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.searchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	var after *sqlplugin.VisibilityQueryCursor
+	if len(request.NextPageToken) > 0 {
+		// numbers are decoded as json.Number so int64 sort values keep their precision
+		var token visibilityQueryPageToken
+		decoder := json.NewDecoder(bytes.NewReader(request.NextPageToken))
+		decoder.UseNumber()
+		err := decoder.Decode(&token)
+		if err == nil {
+			after, err = query.NewCursor(token.SortValues, token.RunID)
+		}
+		if err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("invalid next page token: %v", err)}
+		}
+	}
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: request.PageSize,
+		After:    after,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		// the cursor is read before rowToInfo, which fills in missing execution times
+		lastRow := rows[len(rows)-1]
+		lastRow.DomainID = request.DomainUUID
+		cursor, err := query.Cursor(&lastRow)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: fmt.Sprintf("%v: failed to build next page token: %v", opName, err)}
+		}
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{SortValues: cursor.SortValues, RunID: cursor.RunID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		rows[i].DomainID = request.DomainUUID
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// searchAttributeTypes returns the types of the valid search attributes, which queries are validated against
+func (s *sqlVisibilityStore) searchAttributeTypes() map[string]types.IndexedValueType {
+	validSearchAttributes := definition.GetDefaultIndexedKeys()
+	if s.validSearchAttributes != nil {
+		validSearchAttributes = s.validSearchAttributes()
+	}
+	result := make(map[string]types.IndexedValueType, len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		result[key] = common.ConvertIndexedValueTypeToInternalType(valueType, s.logger)
+	}
+	return result
+}
+
+// serializeSearchAttributes encodes the search attributes of an execution as a single JSON object.
+// Datetime attributes are stored as unix nanos so they can be compared as integers.
+func (s *sqlVisibilityStore) serializeSearchAttributes(searchAttributes map[string][]byte) []byte {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	searchAttributeTypes := s.searchAttributeTypes()
+	values := make(map[string]interface{}, len(searchAttributes))
+	for key, data := range searchAttributes {
+		if valueType, ok := searchAttributeTypes[key]; ok && valueType == types.IndexedValueTypeDatetime {
+			if value, err := common.DeserializeSearchAttributeValue(data, valueType); err == nil {
+				switch t := value.(type) {
+				case time.Time:
+					values[key] = t.UnixNano()
+					continue
+				case []time.Time:
+					nanos := make([]int64, len(t))
+					for i := range t {
+						nanos[i] = t[i].UnixNano()
+					}
+					values[key] = nanos
+					continue
+				}
+			}
+		}
+		value, err := decodeSearchAttributeValue(data)
+		if err != nil {
+			s.logger.Warn("skipping invalid search attribute value", tag.Key(key), tag.Error(err))
+			continue
+		}
+		values[key] = value
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		s.logger.Warn("failed to encode search attributes", tag.Error(err))
+		return nil
+	}
+	return data
+}
+
+func (s *sqlVisibilityStore) deserializeSearchAttributes(data []byte) map[string]interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	value, err := decodeSearchAttributeValue(data)
+	if err != nil {
+		s.logger.Warn("failed to decode search attributes", tag.Error(err))
+		return nil
+	}
+	searchAttributes, _ := value.(map[string]interface{})
+	return searchAttributes
+}
+
+// decodeSearchAttributeValue decodes JSON keeping numbers as json.Number so int64 values keep their precision
+func decodeSearchAttributeValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	return value, err
+}
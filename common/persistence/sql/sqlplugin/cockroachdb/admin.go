@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const (
+	setPrimaryRegionQuery = "ALTER DATABASE %v PRIMARY REGION %v"
+	addRegionQuery        = "ALTER DATABASE %v ADD REGION IF NOT EXISTS %v"
+	setTableLocalityQuery = "ALTER TABLE %v SET LOCALITY %v"
+
+	localityGlobal           = "GLOBAL"
+	localityRegionalByRow    = "REGIONAL BY ROW"
+	localityRegionalByTable  = "REGIONAL BY TABLE"
+	localityInPrimaryRegion  = "IN PRIMARY REGION"
+	localityRegionalInPrefix = "IN "
+)
+
+// SetDatabaseRegions makes the database multi-region, the primary region is where tables without
+// an explicit locality are placed and the other regions can be used by REGIONAL tables
+func (d *db) SetDatabaseRegions(database string, primaryRegion string, regions []string) error {
+	stmts, err := databaseRegionsStatements(database, primaryRegion, regions)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if err := d.ExecSchemaOperationQuery(context.Background(), stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetTableLocality sets the locality of a table, which must be one of GLOBAL, REGIONAL BY ROW,
+// REGIONAL BY TABLE [IN PRIMARY REGION] or REGIONAL BY TABLE IN <region>
+func (d *db) SetTableLocality(table string, locality string) error {
+	normalized, err := parseLocality(locality)
+	if err != nil {
+		return err
+	}
+	return d.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(setTableLocalityQuery, pq.QuoteIdentifier(table), normalized))
+}
+
+func databaseRegionsStatements(database string, primaryRegion string, regions []string) ([]string, error) {
+	if primaryRegion == "" {
+		return nil, fmt.Errorf("primary region is required to make database %v multi-region", database)
+	}
+	db := pq.QuoteIdentifier(database)
+	stmts := []string{fmt.Sprintf(setPrimaryRegionQuery, db, pq.QuoteIdentifier(primaryRegion))}
+	for _, region := range regions {
+		if region == "" || region == primaryRegion {
+			continue
+		}
+		stmts = append(stmts, fmt.Sprintf(addRegionQuery, db, pq.QuoteIdentifier(region)))
+	}
+	return stmts, nil
+}
+
+// parseLocality validates a table locality and returns it in the form expected by ALTER TABLE,
+// keywords are case insensitive while region names are kept as is and quoted
+func parseLocality(locality string) (string, error) {
+	fields := strings.Fields(locality)
+	normalized := strings.ToUpper(strings.Join(fields, " "))
+	switch {
+	case normalized == localityGlobal, normalized == localityRegionalByRow:
+		return normalized, nil
+	case normalized == localityRegionalByTable, normalized == localityRegionalByTable+" "+localityInPrimaryRegion:
+		return localityRegionalByTable + " " + localityInPrimaryRegion, nil
+	case strings.HasPrefix(normalized, localityRegionalByTable+" "+localityRegionalInPrefix) && len(fields) == 5:
+		region := strings.Trim(fields[4], `"`)
+		if region == "" {
+			break
+		}
+		return localityRegionalByTable + " " + localityRegionalInPrefix + pq.QuoteIdentifier(region), nil
+	}
+	return "", fmt.Errorf("invalid table locality %q, it must be one of GLOBAL, REGIONAL BY ROW, REGIONAL BY TABLE [IN PRIMARY REGION | IN <region>]", locality)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocality(t *testing.T) {
+	tests := map[string]struct {
+		locality string
+		want     string
+		wantErr  bool
+	}{
+		"global": {
+			locality: "GLOBAL",
+			want:     "GLOBAL",
+		},
+		"regional by row in lower case": {
+			locality: "regional by row",
+			want:     "REGIONAL BY ROW",
+		},
+		"regional by table defaults to the primary region": {
+			locality: "REGIONAL BY TABLE",
+			want:     "REGIONAL BY TABLE IN PRIMARY REGION",
+		},
+		"regional by table in primary region": {
+			locality: "Regional  By Table In Primary Region",
+			want:     "REGIONAL BY TABLE IN PRIMARY REGION",
+		},
+		"regional by table in a region": {
+			locality: "REGIONAL BY TABLE IN us-east1",
+			want:     `REGIONAL BY TABLE IN "us-east1"`,
+		},
+		"regional by table in a quoted region": {
+			locality: `REGIONAL BY TABLE IN "us-east1"`,
+			want:     `REGIONAL BY TABLE IN "us-east1"`,
+		},
+		"missing region": {
+			locality: "REGIONAL BY TABLE IN",
+			wantErr:  true,
+		},
+		"unknown locality": {
+			locality: "REGIONAL",
+			wantErr:  true,
+		},
+		"empty": {
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseLocality(tc.locality)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDatabaseRegionsStatements(t *testing.T) {
+	tests := map[string]struct {
+		primaryRegion string
+		regions       []string
+		want          []string
+		wantErr       bool
+	}{
+		"primary region only": {
+			primaryRegion: "us-east1",
+			want:          []string{`ALTER DATABASE "cadence" PRIMARY REGION "us-east1"`},
+		},
+		"additional regions skip the primary one": {
+			primaryRegion: "us-east1",
+			regions:       []string{"us-east1", "us-west1", "", "europe-west1"},
+			want: []string{
+				`ALTER DATABASE "cadence" PRIMARY REGION "us-east1"`,
+				`ALTER DATABASE "cadence" ADD REGION IF NOT EXISTS "us-west1"`,
+				`ALTER DATABASE "cadence" ADD REGION IF NOT EXISTS "europe-west1"`,
+			},
+		},
+		"missing primary region": {
+			regions: []string{"us-west1"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := databaseRegionsStatements("cadence", tc.primaryRegion, tc.regions)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+)
+
+const (
+	// ErrSerializationFailure is returned when a transaction conflicts with a concurrent one and
+	// has to be restarted, check https://www.cockroachlabs.com/docs/stable/transaction-retry-error-reference
+	ErrSerializationFailure = "40001"
+	// ErrStatementCompletionUnknown is returned when the outcome of a statement is ambiguous,
+	// e.g. the node coordinating it lost contact with the rest of the cluster during the commit
+	ErrStatementCompletionUnknown = "40003"
+)
+
+// db embeds the postgres implementation since CockroachDB speaks the postgres wire protocol
+// and dialect, it only differs in how errors are classified
+type db struct {
+	*postgres.DB
+}
+
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.MultiRegionAdminDB = (*db)(nil)
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.Tx = (*db)(nil)
+
+func newDB(pdb *postgres.DB) *db {
+	return &db{DB: pdb}
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (d *db) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error) {
+	tx, err := d.DB.BeginTx(ctx, dbShardID)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(tx.(*postgres.DB)), nil
+}
+
+// PluginName returns the name of the cockroachdb plugin
+func (d *db) PluginName() string {
+	return PluginName
+}
+
+func (d *db) IsDupEntryError(err error) bool {
+	return hasErrorCode(err, postgres.ErrDupEntry)
+}
+
+// IsTimeoutError returns true for ambiguous results as well, the statement may or may not have been applied
+func (d *db) IsTimeoutError(err error) bool {
+	return d.DB.IsTimeoutError(err) || hasErrorCode(err, ErrStatementCompletionUnknown)
+}
+
+// IsThrottlingError returns true for serialization failures as well, they are reported when
+// a transaction lost a conflict and was aborted, so it is safe to retry it after a backoff
+func (d *db) IsThrottlingError(err error) bool {
+	return hasErrorCode(err, postgres.ErrTooManyConnections, postgres.ErrInsufficientResources, ErrSerializationFailure)
+}
+
+func hasErrorCode(err error, codes ...pq.ErrorCode) bool {
+	var sqlErr *pq.Error
+	if !errors.As(err, &sqlErr) {
+		return false
+	}
+	for _, code := range codes {
+		if sqlErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
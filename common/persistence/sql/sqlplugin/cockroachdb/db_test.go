@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+)
+
+func TestErrorChecker(t *testing.T) {
+	tests := map[string]struct {
+		err          error
+		isDupEntry   bool
+		isNotFound   bool
+		isTimeout    bool
+		isThrottling bool
+	}{
+		"duplicate key": {
+			err:        &pq.Error{Code: postgres.ErrDupEntry},
+			isDupEntry: true,
+		},
+		"wrapped duplicate key": {
+			err:        fmt.Errorf("insert failed: %w", &pq.Error{Code: postgres.ErrDupEntry}),
+			isDupEntry: true,
+		},
+		"no rows": {
+			err:        sql.ErrNoRows,
+			isNotFound: true,
+		},
+		"deadline exceeded": {
+			err:       context.DeadlineExceeded,
+			isTimeout: true,
+		},
+		"ambiguous result": {
+			err:       &pq.Error{Code: ErrStatementCompletionUnknown},
+			isTimeout: true,
+		},
+		"serialization failure": {
+			err:          &pq.Error{Code: ErrSerializationFailure},
+			isThrottling: true,
+		},
+		"too many connections": {
+			err:          &pq.Error{Code: postgres.ErrTooManyConnections},
+			isThrottling: true,
+		},
+		"insufficient resources": {
+			err:          &pq.Error{Code: postgres.ErrInsufficientResources},
+			isThrottling: true,
+		},
+		"other pq error": {
+			err: &pq.Error{Code: "42P01"},
+		},
+		"other error": {
+			err: errors.New("some error"),
+		},
+	}
+	d := newDB(nil)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.isDupEntry, d.IsDupEntryError(tc.err))
+			assert.Equal(t, tc.isNotFound, d.IsNotFoundError(tc.err))
+			assert.Equal(t, tc.isTimeout, d.IsTimeoutError(tc.err))
+			assert.Equal(t, tc.isThrottling, d.IsThrottlingError(tc.err))
+		})
+	}
+}
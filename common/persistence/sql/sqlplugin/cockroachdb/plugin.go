@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence"
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	"github.com/uber/cadence/environment"
+	"github.com/uber/cadence/schema/cockroachdb"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "cockroachdb"
+	// driverName is the name of the database/sql driver, CockroachDB speaks the postgres wire protocol
+	driverName = "postgres"
+	dsnFmt     = "postgres://%s@%s:%s/%s"
+	// defaultDatabaseName is the database that exists on every cluster, it is used for admin connections
+	defaultDatabaseName = "defaultdb"
+)
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	driver, err := sqldriver.CreateDBConnections(cfg, p.createSingleDBConn, sqldriver.NoopClose)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(postgres.NewDB(driver, cfg.NumShards)), nil
+}
+
+// CreateAdminDB initialize the adminDB object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	driver, err := sqldriver.CreateDBConnections(cfg, p.createSingleDBConn, sqldriver.NoopClose)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(postgres.NewDB(driver, cfg.NumShards)), nil
+}
+
+func (p *plugin) GetSchema(dbType persistence.DBType) (persistence.Schema, error) {
+	switch dbType {
+	case persistence.DBTypeDefault:
+		return cockroachdb.DefaultSchema, nil
+	case persistence.DBTypeVisibility:
+		return cockroachdb.VisibilitySchema, nil
+	default:
+		return nil, fmt.Errorf("unknown db type: %v", dbType)
+	}
+}
+
+func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	host, port, err := net.SplitHostPort(cfg.ConnectAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connect address, it must be in host:port format, %v, err: %v", cfg.ConnectAddr, err)
+	}
+
+	db, err := sqlx.Connect(driverName, buildDSN(cfg, host, port))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.MaxConnLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func buildDSN(cfg *config.SQL, host string, port string) string {
+	dbName := cfg.DatabaseName
+	if dbName == "" {
+		dbName = defaultDatabaseName
+	}
+
+	userPass := url.PathEscape(cfg.User)
+	if cfg.Password != "" {
+		userPass += ":" + url.PathEscape(cfg.Password)
+	}
+
+	params := url.Values{}
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		sslMode := cfg.TLS.SSLMode
+		if sslMode == "" {
+			sslMode = "verify-full"
+		}
+		params.Set("sslmode", sslMode)
+		params.Set("sslrootcert", cfg.TLS.CaFile)
+		params.Set("sslkey", cfg.TLS.KeyFile)
+		params.Set("sslcert", cfg.TLS.CertFile)
+	} else {
+		params.Set("sslmode", "disable")
+	}
+	for k, v := range cfg.ConnectAttributes {
+		params.Set(k, v)
+	}
+	return fmt.Sprintf(dsnFmt, userPass, host, port, dbName) + "?" + params.Encode()
+}
+
+// GetTestClusterOption return test options, the defaults match a local insecure single-node instance
+func GetTestClusterOption() (*pt.TestBaseOptions, error) {
+	testUser := "root"
+	if os.Getenv("COCKROACHDB_USER") != "" {
+		testUser = os.Getenv("COCKROACHDB_USER")
+	}
+	dbPort, err := environment.GetCockroachDBPort()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pt.TestBaseOptions{
+		DBPluginName: PluginName,
+		DBUsername:   testUser,
+		DBPassword:   os.Getenv("COCKROACHDB_PASSWORD"),
+		DBHost:       environment.GetCockroachDBAddress(),
+		DBPort:       dbPort,
+	}, nil
+}
@@ -114,6 +114,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MocktableCRUD) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -622,6 +637,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoActiveClusterSelectionPolicy(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoActiveClusterSelectionPolicy", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoActiveClusterSelectionPolicy), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) InsertIntoAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequestStatus indicates an expected call of InsertIntoAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) InsertIntoAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MocktableCRUD) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -742,6 +772,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQItems mocks base method.
+func (m *MocktableCRUD) InsertIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQItems indicates an expected call of InsertIntoMapQItems.
+func (mr *MocktableCRUDMockRecorder) InsertIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoMapQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MocktableCRUD) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1011,6 +1056,21 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxItemOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxItemOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1146,6 +1206,21 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQOffsets mocks base method.
+func (m *MocktableCRUD) ReplaceIntoMapQOffsets(ctx context.Context, rows []MapQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQOffsets", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQOffsets indicates an expected call of ReplaceIntoMapQOffsets.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoMapQOffsets(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQOffsets", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoMapQOffsets), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1236,6 +1311,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromActivityInfoMaps(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) SelectFromAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequestStatus indicates an expected call of SelectFromAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) SelectFromAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MocktableCRUD) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -1386,6 +1476,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQItems mocks base method.
+func (m *MocktableCRUD) SelectFromMapQItems(ctx context.Context, filter *MapQItemsFilter) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQItems", ctx, filter)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQItems indicates an expected call of SelectFromMapQItems.
+func (mr *MocktableCRUDMockRecorder) SelectFromMapQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromMapQItems), ctx, filter)
+}
+
+// SelectFromMapQOffsets mocks base method.
+func (m *MocktableCRUD) SelectFromMapQOffsets(ctx context.Context, queueID string) ([]MapQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQOffsets", ctx, queueID)
+	ret0, _ := ret[0].([]MapQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQOffsets indicates an expected call of SelectFromMapQOffsets.
+func (mr *MocktableCRUDMockRecorder) SelectFromMapQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQOffsets", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromMapQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MocktableCRUD) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1581,6 +1701,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1638,6 +1773,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckL
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MocktableCRUD) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MocktableCRUDMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MocktableCRUD) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1743,6 +1893,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibility mocks base method.
+func (m *MocktableCRUD) UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MocktableCRUDMockRecorder) UpdateVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpdateVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -2012,6 +2177,21 @@ func (mr *MockTxMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockTx) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockTx) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2520,6 +2700,21 @@ func (mr *MockTxMockRecorder) InsertIntoActiveClusterSelectionPolicy(ctx, row an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoActiveClusterSelectionPolicy", reflect.TypeOf((*MockTx)(nil).InsertIntoActiveClusterSelectionPolicy), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequestStatus mocks base method.
+func (m *MockTx) InsertIntoAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequestStatus indicates an expected call of InsertIntoAsyncWorkflowRequestStatus.
+func (mr *MockTxMockRecorder) InsertIntoAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequestStatus", reflect.TypeOf((*MockTx)(nil).InsertIntoAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockTx) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2640,6 +2835,21 @@ func (mr *MockTxMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQItems mocks base method.
+func (m *MockTx) InsertIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQItems indicates an expected call of InsertIntoMapQItems.
+func (mr *MockTxMockRecorder) InsertIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQItems", reflect.TypeOf((*MockTx)(nil).InsertIntoMapQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockTx) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2965,6 +3175,21 @@ func (mr *MockTxMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MockTx) RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxItemOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MockTxMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxItemOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockTx) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3100,6 +3325,21 @@ func (mr *MockTxMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQOffsets mocks base method.
+func (m *MockTx) ReplaceIntoMapQOffsets(ctx context.Context, rows []MapQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQOffsets", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQOffsets indicates an expected call of ReplaceIntoMapQOffsets.
+func (mr *MockTxMockRecorder) ReplaceIntoMapQOffsets(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQOffsets", reflect.TypeOf((*MockTx)(nil).ReplaceIntoMapQOffsets), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3204,6 +3444,21 @@ func (mr *MockTxMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequestStatus mocks base method.
+func (m *MockTx) SelectFromAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequestStatus indicates an expected call of SelectFromAsyncWorkflowRequestStatus.
+func (mr *MockTxMockRecorder) SelectFromAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequestStatus", reflect.TypeOf((*MockTx)(nil).SelectFromAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockTx) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -3354,6 +3609,36 @@ func (mr *MockTxMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQItems mocks base method.
+func (m *MockTx) SelectFromMapQItems(ctx context.Context, filter *MapQItemsFilter) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQItems", ctx, filter)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQItems indicates an expected call of SelectFromMapQItems.
+func (mr *MockTxMockRecorder) SelectFromMapQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQItems", reflect.TypeOf((*MockTx)(nil).SelectFromMapQItems), ctx, filter)
+}
+
+// SelectFromMapQOffsets mocks base method.
+func (m *MockTx) SelectFromMapQOffsets(ctx context.Context, queueID string) ([]MapQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQOffsets", ctx, queueID)
+	ret0, _ := ret[0].([]MapQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQOffsets indicates an expected call of SelectFromMapQOffsets.
+func (mr *MockTxMockRecorder) SelectFromMapQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQOffsets", reflect.TypeOf((*MockTx)(nil).SelectFromMapQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockTx) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -3549,6 +3834,21 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3606,6 +3906,21 @@ func (mr *MockTxMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockTx)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockTx) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockTxMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockTx)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockTx) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3711,6 +4026,21 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibility mocks base method.
+func (m *MockTx) UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockTxMockRecorder) UpdateVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockTx)(nil).UpdateVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3794,6 +4124,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockDB) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockDB) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4316,6 +4661,21 @@ func (mr *MockDBMockRecorder) InsertIntoActiveClusterSelectionPolicy(ctx, row an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoActiveClusterSelectionPolicy", reflect.TypeOf((*MockDB)(nil).InsertIntoActiveClusterSelectionPolicy), ctx, row)
 }
 
+// InsertIntoAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) InsertIntoAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncWorkflowRequestStatus indicates an expected call of InsertIntoAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) InsertIntoAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).InsertIntoAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockDB) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4436,6 +4796,21 @@ func (mr *MockDBMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQItems mocks base method.
+func (m *MockDB) InsertIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQItems indicates an expected call of InsertIntoMapQItems.
+func (mr *MockDBMockRecorder) InsertIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQItems", reflect.TypeOf((*MockDB)(nil).InsertIntoMapQItems), ctx, rows)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockDB) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4775,6 +5150,21 @@ func (mr *MockDBMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MockDB) RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxItemOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MockDBMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxItemOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxItemOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockDB) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4910,6 +5300,21 @@ func (mr *MockDBMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQOffsets mocks base method.
+func (m *MockDB) ReplaceIntoMapQOffsets(ctx context.Context, rows []MapQOffsetsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQOffsets", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQOffsets indicates an expected call of ReplaceIntoMapQOffsets.
+func (mr *MockDBMockRecorder) ReplaceIntoMapQOffsets(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQOffsets", reflect.TypeOf((*MockDB)(nil).ReplaceIntoMapQOffsets), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5000,6 +5405,21 @@ func (mr *MockDBMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) SelectFromAsyncWorkflowRequestStatus(ctx context.Context, domainID, requestID string) (*AsyncWorkflowRequestStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncWorkflowRequestStatus", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*AsyncWorkflowRequestStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncWorkflowRequestStatus indicates an expected call of SelectFromAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) SelectFromAsyncWorkflowRequestStatus(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).SelectFromAsyncWorkflowRequestStatus), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockDB) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -5150,6 +5570,36 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQItems mocks base method.
+func (m *MockDB) SelectFromMapQItems(ctx context.Context, filter *MapQItemsFilter) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQItems", ctx, filter)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQItems indicates an expected call of SelectFromMapQItems.
+func (mr *MockDBMockRecorder) SelectFromMapQItems(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQItems", reflect.TypeOf((*MockDB)(nil).SelectFromMapQItems), ctx, filter)
+}
+
+// SelectFromMapQOffsets mocks base method.
+func (m *MockDB) SelectFromMapQOffsets(ctx context.Context, queueID string) ([]MapQOffsetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQOffsets", ctx, queueID)
+	ret0, _ := ret[0].([]MapQOffsetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQOffsets indicates an expected call of SelectFromMapQOffsets.
+func (mr *MockDBMockRecorder) SelectFromMapQOffsets(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQOffsets", reflect.TypeOf((*MockDB)(nil).SelectFromMapQOffsets), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockDB) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -5345,6 +5795,21 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5402,6 +5867,21 @@ func (mr *MockDBMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockDB)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncWorkflowRequestStatus mocks base method.
+func (m *MockDB) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowRequestStatus", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncWorkflowRequestStatus indicates an expected call of UpdateAsyncWorkflowRequestStatus.
+func (mr *MockDBMockRecorder) UpdateAsyncWorkflowRequestStatus(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowRequestStatus", reflect.TypeOf((*MockDB)(nil).UpdateAsyncWorkflowRequestStatus), ctx, row)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockDB) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5507,6 +5987,21 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibility mocks base method.
+func (m *MockDB) UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockDBMockRecorder) UpdateVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockDB)(nil).UpdateVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -5766,6 +6261,263 @@ func (mr *MockAdminDBMockRecorder) WriteSchemaUpdateLog(oldVersion, newVersion,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSchemaUpdateLog", reflect.TypeOf((*MockAdminDB)(nil).WriteSchemaUpdateLog), oldVersion, newVersion, manifestMD5, desc)
 }
 
+// MockMultiRegionAdminDB is a mock of MultiRegionAdminDB interface.
+type MockMultiRegionAdminDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockMultiRegionAdminDBMockRecorder
+	isgomock struct{}
+}
+
+// MockMultiRegionAdminDBMockRecorder is the mock recorder for MockMultiRegionAdminDB.
+type MockMultiRegionAdminDBMockRecorder struct {
+	mock *MockMultiRegionAdminDB
+}
+
+// NewMockMultiRegionAdminDB creates a new mock instance.
+func NewMockMultiRegionAdminDB(ctrl *gomock.Controller) *MockMultiRegionAdminDB {
+	mock := &MockMultiRegionAdminDB{ctrl: ctrl}
+	mock.recorder = &MockMultiRegionAdminDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMultiRegionAdminDB) EXPECT() *MockMultiRegionAdminDBMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMultiRegionAdminDB) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMultiRegionAdminDBMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).Close))
+}
+
+// CreateDatabase mocks base method.
+func (m *MockMultiRegionAdminDB) CreateDatabase(database string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabase", database)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDatabase indicates an expected call of CreateDatabase.
+func (mr *MockMultiRegionAdminDBMockRecorder) CreateDatabase(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabase", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).CreateDatabase), database)
+}
+
+// CreateSchemaVersionTables mocks base method.
+func (m *MockMultiRegionAdminDB) CreateSchemaVersionTables() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchemaVersionTables")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSchemaVersionTables indicates an expected call of CreateSchemaVersionTables.
+func (mr *MockMultiRegionAdminDBMockRecorder) CreateSchemaVersionTables() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchemaVersionTables", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).CreateSchemaVersionTables))
+}
+
+// DatabaseExists mocks base method.
+func (m *MockMultiRegionAdminDB) DatabaseExists(database string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseExists", database)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseExists indicates an expected call of DatabaseExists.
+func (mr *MockMultiRegionAdminDBMockRecorder) DatabaseExists(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseExists", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).DatabaseExists), database)
+}
+
+// DropAllTables mocks base method.
+func (m *MockMultiRegionAdminDB) DropAllTables(database string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropAllTables", database)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropAllTables indicates an expected call of DropAllTables.
+func (mr *MockMultiRegionAdminDBMockRecorder) DropAllTables(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropAllTables", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).DropAllTables), database)
+}
+
+// DropDatabase mocks base method.
+func (m *MockMultiRegionAdminDB) DropDatabase(database string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropDatabase", database)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropDatabase indicates an expected call of DropDatabase.
+func (mr *MockMultiRegionAdminDBMockRecorder) DropDatabase(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropDatabase", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).DropDatabase), database)
+}
+
+// DropTable mocks base method.
+func (m *MockMultiRegionAdminDB) DropTable(table string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropTable", table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropTable indicates an expected call of DropTable.
+func (mr *MockMultiRegionAdminDBMockRecorder) DropTable(table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropTable", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).DropTable), table)
+}
+
+// ExecSchemaOperationQuery mocks base method.
+func (m *MockMultiRegionAdminDB) ExecSchemaOperationQuery(ctx context.Context, stmt string, args ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, stmt}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecSchemaOperationQuery", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecSchemaOperationQuery indicates an expected call of ExecSchemaOperationQuery.
+func (mr *MockMultiRegionAdminDBMockRecorder) ExecSchemaOperationQuery(ctx, stmt any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, stmt}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecSchemaOperationQuery", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).ExecSchemaOperationQuery), varargs...)
+}
+
+// HasSchemaVersionTables mocks base method.
+func (m *MockMultiRegionAdminDB) HasSchemaVersionTables() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSchemaVersionTables")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSchemaVersionTables indicates an expected call of HasSchemaVersionTables.
+func (mr *MockMultiRegionAdminDBMockRecorder) HasSchemaVersionTables() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSchemaVersionTables", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).HasSchemaVersionTables))
+}
+
+// ListTables mocks base method.
+func (m *MockMultiRegionAdminDB) ListTables(database string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", database)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables.
+func (mr *MockMultiRegionAdminDBMockRecorder) ListTables(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).ListTables), database)
+}
+
+// PluginName mocks base method.
+func (m *MockMultiRegionAdminDB) PluginName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PluginName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PluginName indicates an expected call of PluginName.
+func (mr *MockMultiRegionAdminDBMockRecorder) PluginName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PluginName", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).PluginName))
+}
+
+// ReadSchemaVersion mocks base method.
+func (m *MockMultiRegionAdminDB) ReadSchemaVersion(database string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSchemaVersion", database)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSchemaVersion indicates an expected call of ReadSchemaVersion.
+func (mr *MockMultiRegionAdminDBMockRecorder) ReadSchemaVersion(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSchemaVersion", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).ReadSchemaVersion), database)
+}
+
+// SetDatabaseRegions mocks base method.
+func (m *MockMultiRegionAdminDB) SetDatabaseRegions(database, primaryRegion string, regions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDatabaseRegions", database, primaryRegion, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDatabaseRegions indicates an expected call of SetDatabaseRegions.
+func (mr *MockMultiRegionAdminDBMockRecorder) SetDatabaseRegions(database, primaryRegion, regions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDatabaseRegions", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).SetDatabaseRegions), database, primaryRegion, regions)
+}
+
+// SetTableLocality mocks base method.
+func (m *MockMultiRegionAdminDB) SetTableLocality(table, locality string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTableLocality", table, locality)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTableLocality indicates an expected call of SetTableLocality.
+func (mr *MockMultiRegionAdminDBMockRecorder) SetTableLocality(table, locality any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTableLocality", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).SetTableLocality), table, locality)
+}
+
+// UpdateSchemaVersion mocks base method.
+func (m *MockMultiRegionAdminDB) UpdateSchemaVersion(database, newVersion, minCompatibleVersion string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchemaVersion", database, newVersion, minCompatibleVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchemaVersion indicates an expected call of UpdateSchemaVersion.
+func (mr *MockMultiRegionAdminDBMockRecorder) UpdateSchemaVersion(database, newVersion, minCompatibleVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchemaVersion", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).UpdateSchemaVersion), database, newVersion, minCompatibleVersion)
+}
+
+// WriteSchemaUpdateLog mocks base method.
+func (m *MockMultiRegionAdminDB) WriteSchemaUpdateLog(oldVersion, newVersion, manifestMD5, desc string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSchemaUpdateLog", oldVersion, newVersion, manifestMD5, desc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteSchemaUpdateLog indicates an expected call of WriteSchemaUpdateLog.
+func (mr *MockMultiRegionAdminDBMockRecorder) WriteSchemaUpdateLog(oldVersion, newVersion, manifestMD5, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSchemaUpdateLog", reflect.TypeOf((*MockMultiRegionAdminDB)(nil).WriteSchemaUpdateLog), oldVersion, newVersion, manifestMD5, desc)
+}
+
 // MockErrorChecker is a mock of ErrorChecker interface.
 type MockErrorChecker struct {
 	ctrl     *gomock.Controller
@@ -139,6 +139,13 @@ type (
 		DataEncoding             string
 		VersionHistories         []byte
 		VersionHistoriesEncoding string
+		Paused                   bool
+		// completion callbacks are kept out of the data blob, which has no field for them
+		CompletionCallbacks         []byte
+		CompletionCallbacksEncoding string
+		// the workflow deadline is kept out of the data blob for the same reason
+		WorkflowDeadline         []byte
+		WorkflowDeadlineEncoding string
 	}
 
 	// ExecutionsFilter contains the column names within executions table that
@@ -432,6 +439,8 @@ type (
 		DataEncoding             string
 		LastHeartbeatDetails     []byte
 		LastHeartbeatUpdatedTime time.Time
+		Priority                 int32
+		Paused                   bool
 	}
 
 	// ActivityInfoMapsFilter contains the column names within activity_info_maps table that
@@ -567,6 +576,18 @@ type (
 		ShardID                int16
 		ExecutionStatus        int32
 		ScheduledExecutionTime time.Time
+		// SearchAttributes is a JSON object holding the custom search attributes
+		SearchAttributes []byte
+	}
+
+	// VisibilityQueryFilter contains the visibility query used to filter and order the rows
+	// of a domain within executions_visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		PageSize int
+		// After is the position of the last row of the previous page, nil for the first page
+		After *VisibilityQueryCursor
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -632,6 +653,44 @@ type (
 		PageMinEventID     *string
 	}
 
+	// AsyncWorkflowRequestStatusRow represents a row in async_workflow_request_status table
+	AsyncWorkflowRequestStatusRow struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           persistence.AsyncWorkflowRequestState
+		FailureReason   string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+		ExpiryTime      time.Time
+	}
+
+	// MapQItemsRow represents a row in mapq_items table
+	MapQItemsRow struct {
+		QueueID       string
+		PartitionPath string
+		ItemOffset    int64
+		Data          []byte
+	}
+
+	// MapQItemsFilter contains the column names within mapq_items table that
+	// can be used to filter results through a WHERE clause
+	MapQItemsFilter struct {
+		QueueID       string
+		PartitionPath string
+		// MinItemOffset is exclusive
+		MinItemOffset int64
+		PageSize      int
+	}
+
+	// MapQOffsetsRow represents a row in mapq_offsets table
+	MapQOffsetsRow struct {
+		QueueID       string
+		PartitionPath string
+		ItemOffset    int64
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -859,6 +918,14 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpdateVisibility updates the memo, search attributes and update time of an existing row in visibility table
+		UpdateVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of the rows of a domain matching a visibility query
+		// Required filter params - {domainID, query, pageSize}, optional - {after}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of a domain matching a visibility query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -882,6 +949,25 @@ type (
 		// SelectFromDomainAuditLogs returns audit log entries for a domain. Returns paginated results ordered by created_time DESC, event_id ASC
 		SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]*DomainAuditLogRow, error)
 
+		// InsertIntoAsyncWorkflowRequestStatus inserts the status of a newly queued async workflow request
+		InsertIntoAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error)
+		// UpdateAsyncWorkflowRequestStatus updates the state, run ID, failure reason and expiry of an async workflow request.
+		// Rows which expired before LastUpdatedTime are not updated
+		UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *AsyncWorkflowRequestStatusRow) (sql.Result, error)
+		// SelectFromAsyncWorkflowRequestStatus returns the status of an async workflow request. Returns sql.ErrNoRows if it doesn't exist
+		SelectFromAsyncWorkflowRequestStatus(ctx context.Context, domainID string, requestID string) (*AsyncWorkflowRequestStatusRow, error)
+
+		// InsertIntoMapQItems inserts items of a MAPQ queue. Returns error if an item with the same offset already exists in the partition
+		InsertIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error)
+		// SelectFromMapQItems returns items of a MAPQ partition with offset greater than MinItemOffset, ordered by offset
+		SelectFromMapQItems(ctx context.Context, filter *MapQItemsFilter) ([]MapQItemsRow, error)
+		// RangeDeleteFromMapQItems deletes items of a MAPQ partition with offset less than or equal to inclusiveMaxItemOffset
+		RangeDeleteFromMapQItems(ctx context.Context, queueID string, partitionPath string, inclusiveMaxItemOffset int64) (sql.Result, error)
+		// ReplaceIntoMapQOffsets inserts or replaces the committed offsets of MAPQ partitions
+		ReplaceIntoMapQOffsets(ctx context.Context, rows []MapQOffsetsRow) (sql.Result, error)
+		// SelectFromMapQOffsets returns the committed offsets of all partitions of a MAPQ queue
+		SelectFromMapQOffsets(ctx context.Context, queueID string) ([]MapQOffsetsRow, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
@@ -932,6 +1018,16 @@ type (
 		Close() error
 	}
 
+	// MultiRegionAdminDB is implemented by the AdminDB of plugins whose databases can span multiple regions,
+	// the schema tool uses it to configure the regions of a database and the locality of its tables
+	MultiRegionAdminDB interface {
+		AdminDB
+		// SetDatabaseRegions sets the primary region of a database and adds the other regions to it
+		SetDatabaseRegions(database string, primaryRegion string, regions []string) error
+		// SetTableLocality sets where the data of a table is placed, e.g. GLOBAL or REGIONAL BY ROW
+		SetTableLocality(table string, locality string) error
+	}
+
 	ErrorChecker interface {
 		IsDupEntryError(err error) bool
 		IsNotFoundError(err error) bool
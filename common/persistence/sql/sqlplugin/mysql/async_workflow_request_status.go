@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	_insertAsyncWorkflowRequestStatusQuery = `INSERT INTO async_workflow_request_status (
+		domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time, expiry_time
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_updateAsyncWorkflowRequestStatusQuery = `UPDATE async_workflow_request_status SET
+	run_id = ?, state = ?, failure_reason = ?, last_updated_time = ?, expiry_time = ?
+	WHERE domain_id = ? AND request_id = ? AND expiry_time > ?`
+
+	_selectAsyncWorkflowRequestStatusQuery = `SELECT
+		domain_id, request_id, workflow_id, run_id, state, failure_reason, created_time, last_updated_time, expiry_time
+	FROM async_workflow_request_status
+	WHERE domain_id = ? AND request_id = ?`
+)
+
+// InsertIntoAsyncWorkflowRequestStatus inserts a single row into async_workflow_request_status table
+func (mdb *DB) InsertIntoAsyncWorkflowRequestStatus(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		_insertAsyncWorkflowRequestStatusQuery,
+		row.DomainID,
+		row.RequestID,
+		row.WorkflowID,
+		row.RunID,
+		row.State,
+		row.FailureReason,
+		row.CreatedTime,
+		row.LastUpdatedTime,
+		row.ExpiryTime,
+	)
+}
+
+// UpdateAsyncWorkflowRequestStatus updates a single row in async_workflow_request_status table if it hasn't expired by LastUpdatedTime
+func (mdb *DB) UpdateAsyncWorkflowRequestStatus(ctx context.Context, row *sqlplugin.AsyncWorkflowRequestStatusRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		_updateAsyncWorkflowRequestStatusQuery,
+		row.RunID,
+		row.State,
+		row.FailureReason,
+		row.LastUpdatedTime,
+		row.ExpiryTime,
+		row.DomainID,
+		row.RequestID,
+		row.LastUpdatedTime,
+	)
+}
+
+// SelectFromAsyncWorkflowRequestStatus reads a single row from async_workflow_request_status table
+func (mdb *DB) SelectFromAsyncWorkflowRequestStatus(ctx context.Context, domainID string, requestID string) (*sqlplugin.AsyncWorkflowRequestStatusRow, error) {
+	var row sqlplugin.AsyncWorkflowRequestStatusRow
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectAsyncWorkflowRequestStatusQuery, domainID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -28,13 +28,15 @@ import (
 )
 
 const (
-	executionsColumns = `shard_id, domain_id, workflow_id, run_id, next_event_id, last_write_version, data, data_encoding`
+	executionsColumns = `shard_id, domain_id, workflow_id, run_id, next_event_id, last_write_version, data, data_encoding, paused, completion_callbacks, completion_callbacks_encoding, workflow_deadline, workflow_deadline_encoding`
 
 	createExecutionQuery = `INSERT INTO executions(` + executionsColumns + `)
- VALUES(:shard_id, :domain_id, :workflow_id, :run_id, :next_event_id, :last_write_version, :data, :data_encoding)`
+ VALUES(:shard_id, :domain_id, :workflow_id, :run_id, :next_event_id, :last_write_version, :data, :data_encoding, :paused, :completion_callbacks, :completion_callbacks_encoding, :workflow_deadline, :workflow_deadline_encoding)`
 
 	updateExecutionQuery = `UPDATE executions SET
- next_event_id = :next_event_id, last_write_version = :last_write_version, data = :data, data_encoding = :data_encoding
+ next_event_id = :next_event_id, last_write_version = :last_write_version, data = :data, data_encoding = :data_encoding, paused = :paused,
+ completion_callbacks = :completion_callbacks, completion_callbacks_encoding = :completion_callbacks_encoding,
+ workflow_deadline = :workflow_deadline, workflow_deadline_encoding = :workflow_deadline_encoding
  WHERE shard_id = :shard_id AND domain_id = :domain_id AND workflow_id = :workflow_id AND run_id = :run_id`
 
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
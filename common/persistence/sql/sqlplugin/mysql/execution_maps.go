@@ -112,6 +112,8 @@ var (
 		"data_encoding",
 		"last_heartbeat_details",
 		"last_heartbeat_updated_time",
+		"priority",
+		"paused",
 	}
 	activityInfoTableName = "activity_info_maps"
 	activityInfoKey       = "schedule_id"
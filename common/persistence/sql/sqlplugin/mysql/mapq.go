@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	_insertMapQItemsQuery = `INSERT INTO mapq_items (queue_id, partition_path, item_offset, data)
+	VALUES (:queue_id, :partition_path, :item_offset, :data)`
+
+	_selectMapQItemsQuery = `SELECT queue_id, partition_path, item_offset, data
+	FROM mapq_items
+	WHERE queue_id = ? AND partition_path = ? AND item_offset > ?
+	ORDER BY item_offset ASC LIMIT ?`
+
+	_rangeDeleteMapQItemsQuery = `DELETE FROM mapq_items WHERE queue_id = ? AND partition_path = ? AND item_offset <= ?`
+
+	_replaceMapQOffsetsQuery = `REPLACE INTO mapq_offsets (queue_id, partition_path, item_offset)
+	VALUES (:queue_id, :partition_path, :item_offset)`
+
+	_selectMapQOffsetsQuery = `SELECT queue_id, partition_path, item_offset FROM mapq_offsets WHERE queue_id = ?`
+)
+
+// InsertIntoMapQItems inserts one or more rows into mapq_items table
+func (mdb *DB) InsertIntoMapQItems(ctx context.Context, rows []sqlplugin.MapQItemsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _insertMapQItemsQuery, rows)
+}
+
+// SelectFromMapQItems reads one or more rows from mapq_items table
+func (mdb *DB) SelectFromMapQItems(ctx context.Context, filter *sqlplugin.MapQItemsFilter) ([]sqlplugin.MapQItemsRow, error) {
+	var rows []sqlplugin.MapQItemsRow
+	err := mdb.driver.SelectContext(
+		ctx,
+		sqlplugin.DbDefaultShard,
+		&rows,
+		_selectMapQItemsQuery,
+		filter.QueueID,
+		filter.PartitionPath,
+		filter.MinItemOffset,
+		filter.PageSize,
+	)
+	return rows, err
+}
+
+// RangeDeleteFromMapQItems deletes one or more rows from mapq_items table
+func (mdb *DB) RangeDeleteFromMapQItems(ctx context.Context, queueID string, partitionPath string, inclusiveMaxItemOffset int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _rangeDeleteMapQItemsQuery, queueID, partitionPath, inclusiveMaxItemOffset)
+}
+
+// ReplaceIntoMapQOffsets replaces one or more rows in mapq_offsets table
+func (mdb *DB) ReplaceIntoMapQOffsets(ctx context.Context, rows []sqlplugin.MapQOffsetsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _replaceMapQOffsetsQuery, rows)
+}
+
+// SelectFromMapQOffsets reads all rows of a queue from mapq_offsets table
+func (mdb *DB) SelectFromMapQOffsets(ctx context.Context, queueID string) ([]sqlplugin.MapQOffsetsRow, error) {
+	var rows []sqlplugin.MapQOffsetsRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _selectMapQOffsetsQuery, queueID)
+	return rows, err
+}
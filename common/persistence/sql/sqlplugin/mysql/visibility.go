@@ -25,18 +25,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = ?, encoding = ?, search_attributes = ?, update_time = ? ` +
+		`WHERE domain_id = ? AND run_id = ?`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -46,7 +51,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, shard_id`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, shard_id, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -66,12 +71,21 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time, shard_id
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time, shard_id, search_attributes
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	// TemplateQueryFieldNames are the columns read by visibility queries
+	TemplateQueryFieldNames = templateOpenFieldNames + `, close_time, close_status, history_length, ` +
+		`COALESCE(cron_schedule, '') AS cron_schedule, COALESCE(execution_status, 0) AS execution_status, COALESCE(num_clusters, 0) AS num_clusters`
+
+	templateSelectByQuery = `SELECT ` + TemplateQueryFieldNames + `, ` +
+		`COALESCE(scheduled_execution_time, start_time) AS scheduled_execution_time FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -99,7 +113,8 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.ShardID,
 		row.ExecutionStatus,
 		row.CronSchedule,
-		scheduledExecutionTime)
+		scheduledExecutionTime,
+		SearchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -130,7 +145,8 @@ func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.ShardID,
 			row.ExecutionStatus,
 			row.CronSchedule,
-			scheduledExecutionTime)
+			scheduledExecutionTime,
+			SearchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -235,3 +251,95 @@ func (mdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpdateVisibility updates the memo, search attributes and update time of an existing row in visibility table
+func (mdb *DB) UpdateVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		SearchAttributesArg(row.SearchAttributes),
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
+// SelectFromVisibilityByQuery returns a page of the rows of a domain matching a visibility query
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := BuildVisibilityQuery(templateSelectByQuery, filter, &visibilityQueryDialect{converter: mdb.converter}, true)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromDateTime(rows[i].ExecutionTime)
+		rows[i].ScheduledExecutionTime = mdb.converter.FromDateTime(rows[i].ScheduledExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of a domain matching a visibility query
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := BuildVisibilityQuery(templateCountByQuery, filter, &visibilityQueryDialect{converter: mdb.converter}, false)
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// BuildVisibilityQuery appends the condition of the visibility query, and for paginated selects its
+// order and page, to a statement already filtering by domain_id
+func BuildVisibilityQuery(
+	statement string,
+	filter *sqlplugin.VisibilityQueryFilter,
+	dialect sqlplugin.VisibilityQueryDialect,
+	paginated bool,
+) (string, []interface{}) {
+	condition, orderBy, conditionArgs := filter.Query.Build(dialect, filter.After)
+	args := append([]interface{}{filter.DomainID}, conditionArgs...)
+	if condition != "" {
+		statement += " AND " + condition
+	}
+	if paginated {
+		statement += " ORDER BY " + orderBy + " LIMIT ?"
+		args = append(args, filter.PageSize)
+	}
+	return statement, args
+}
+
+// SearchAttributesArg returns the value bound to the search_attributes column,
+// which is NULL for executions without custom search attributes
+func SearchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
+
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	value := fmt.Sprintf("search_attributes->>'$.%s'", key)
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		return fmt.Sprintf("CAST(%s AS SIGNED)", value)
+	case types.IndexedValueTypeDouble:
+		return fmt.Sprintf("CAST(%s AS DOUBLE)", value)
+	default:
+		return value
+	}
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToDateTime(t)
+}
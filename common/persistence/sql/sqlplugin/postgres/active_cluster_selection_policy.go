@@ -46,12 +46,12 @@ const (
 	`
 )
 
-func (pdb *db) InsertIntoActiveClusterSelectionPolicy(ctx context.Context, row *sqlplugin.ActiveClusterSelectionPolicyRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoActiveClusterSelectionPolicy(ctx context.Context, row *sqlplugin.ActiveClusterSelectionPolicyRow) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, pdb.GetTotalNumDBShards())
 	return pdb.driver.ExecContext(ctx, dbShardID, insertActiveClusterSelectionPolicyQry, row.ShardID, row.DomainID, row.WorkflowID, row.RunID, row.Data, row.DataEncoding)
 }
 
-func (pdb *db) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filter *sqlplugin.ActiveClusterSelectionPolicyFilter) (*sqlplugin.ActiveClusterSelectionPolicyRow, error) {
+func (pdb *DB) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filter *sqlplugin.ActiveClusterSelectionPolicyFilter) (*sqlplugin.ActiveClusterSelectionPolicyRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, pdb.GetTotalNumDBShards())
 	var row sqlplugin.ActiveClusterSelectionPolicyRow
 	err := pdb.driver.GetContext(ctx, dbShardID, &row, selectActiveClusterSelectionPolicyQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -61,7 +61,7 @@ func (pdb *db) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filte
 	return &row, nil
 }
 
-func (pdb *db) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *sqlplugin.ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
+func (pdb *DB) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *sqlplugin.ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, pdb.GetTotalNumDBShards())
 	return pdb.driver.ExecContext(ctx, dbShardID, deleteActiveClusterSelectionPolicyQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
@@ -110,7 +110,7 @@ func TestInsertIntoActiveClusterSelectionPolicy(t *testing.T) {
 			mockDriver := sqldriver.NewMockDriver(ctrl)
 			tc.mockSetup(mockDriver)
 
-			pdb := &db{
+			pdb := &DB{
 				driver:      mockDriver,
 				converter:   &converter{},
 				numDBShards: numDBShards,
@@ -201,7 +201,7 @@ func TestSelectFromActiveClusterSelectionPolicy(t *testing.T) {
 			mockDriver := sqldriver.NewMockDriver(ctrl)
 			tc.mockSetup(mockDriver)
 
-			pdb := &db{
+			pdb := &DB{
 				driver:      mockDriver,
 				converter:   &converter{},
 				numDBShards: numDBShards,
@@ -290,7 +290,7 @@ func TestDeleteFromActiveClusterSelectionPolicy(t *testing.T) {
 			mockDriver := sqldriver.NewMockDriver(ctrl)
 			tc.mockSetup(mockDriver)
 
-			pdb := &db{
+			pdb := &DB{
 				driver:      mockDriver,
 				converter:   &converter{},
 				numDBShards: numDBShards,
@@ -73,7 +73,7 @@ const (
 )
 
 // HasSchemaVersionTables checks if schema metadata tables exist.
-func (pdb *db) HasSchemaVersionTables() (bool, error) {
+func (pdb *DB) HasSchemaVersionTables() (bool, error) {
 	hasVersionTable, err := pdb.hasTable(schemaVersionTableName)
 	if err != nil || !hasVersionTable {
 		return false, err
@@ -87,7 +87,7 @@ func (pdb *db) HasSchemaVersionTables() (bool, error) {
 	return true, nil
 }
 
-func (pdb *db) hasTable(name string) (bool, error) {
+func (pdb *DB) hasTable(name string) (bool, error) {
 	var count int
 	err := pdb.driver.GetForSchemaQuery(sqlplugin.DbShardUndefined, &count, hasTableQuery, name)
 	if err != nil {
@@ -97,7 +97,7 @@ func (pdb *db) hasTable(name string) (bool, error) {
 }
 
 // CreateSchemaVersionTables sets up the schema version tables
-func (pdb *db) CreateSchemaVersionTables() error {
+func (pdb *DB) CreateSchemaVersionTables() error {
 	if err := pdb.ExecSchemaOperationQuery(context.Background(), createSchemaVersionTableQuery); err != nil {
 		return err
 	}
@@ -105,44 +105,44 @@ func (pdb *db) CreateSchemaVersionTables() error {
 }
 
 // ReadSchemaVersion returns the current schema version for the keyspace
-func (pdb *db) ReadSchemaVersion(database string) (string, error) {
+func (pdb *DB) ReadSchemaVersion(database string) (string, error) {
 	var version string
 	err := pdb.driver.GetForSchemaQuery(sqlplugin.DbShardUndefined, &version, readSchemaVersionQuery, database)
 	return version, err
 }
 
 // UpdateSchemaVersion updates the schema version for the keyspace
-func (pdb *db) UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error {
+func (pdb *DB) UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error {
 	return pdb.ExecSchemaOperationQuery(context.Background(), writeSchemaVersionQuery, database, time.Now(), newVersion, minCompatibleVersion)
 }
 
 // WriteSchemaUpdateLog adds an entry to the schema update history table
-func (pdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+func (pdb *DB) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
 	now := time.Now().UTC()
 	return pdb.ExecSchemaOperationQuery(context.Background(), writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 }
 
 // ExecSchemaOperationQuery executes a sql statement for schema ONLY. DO NOT use it in other cases, otherwise it will not work for multiple SQL database.
 // For Sharded SQL, it will execute the statement for all shards
-func (pdb *db) ExecSchemaOperationQuery(ctx context.Context, stmt string, args ...interface{}) error {
+func (pdb *DB) ExecSchemaOperationQuery(ctx context.Context, stmt string, args ...interface{}) error {
 	_, err := pdb.driver.ExecDDL(ctx, sqlplugin.DbShardUndefined, stmt, args...)
 	return err
 }
 
 // ListTables returns a list of tables in this database
-func (pdb *db) ListTables(database string) ([]string, error) {
+func (pdb *DB) ListTables(database string) ([]string, error) {
 	var tables []string
 	err := pdb.driver.SelectForSchemaQuery(sqlplugin.DbShardUndefined, &tables, listTablesQuery)
 	return tables, err
 }
 
 // DropTable drops a given table from the database
-func (pdb *db) DropTable(name string) error {
+func (pdb *DB) DropTable(name string) error {
 	return pdb.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(dropTableQuery, name))
 }
 
 // DropAllTables drops all tables from this database
-func (pdb *db) DropAllTables(database string) error {
+func (pdb *DB) DropAllTables(database string) error {
 	tables, err := pdb.ListTables(database)
 	if err != nil {
 		return err
@@ -156,11 +156,11 @@ func (pdb *db) DropAllTables(database string) error {
 }
 
 // CreateDatabase creates a database if it doesn't exist
-func (pdb *db) CreateDatabase(name string) error {
+func (pdb *DB) CreateDatabase(name string) error {
 	return pdb.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(createDatabaseQuery, name))
 }
 
-func (pdb *db) DatabaseExists(name string) (bool, error) {
+func (pdb *DB) DatabaseExists(name string) (bool, error) {
 	var count int
 	err := pdb.driver.GetForSchemaQuery(sqlplugin.DbShardUndefined, &count, hasDatabaseQuery, name)
 	if err != nil {
@@ -170,6 +170,6 @@ func (pdb *db) DatabaseExists(name string) (bool, error) {
 }
 
 // DropDatabase drops a database
-func (pdb *db) DropDatabase(name string) error {
+func (pdb *DB) DropDatabase(name string) error {
 	return pdb.ExecSchemaOperationQuery(context.Background(), fmt.Sprintf(dropDatabaseQuery, name))
 }
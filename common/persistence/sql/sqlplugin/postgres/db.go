@@ -32,19 +32,20 @@ import (
 )
 
 type (
-	db struct {
+	DB struct {
 		converter   DataConverter
 		driver      sqldriver.Driver
 		numDBShards int
 	}
 )
 
-func (pdb *db) GetTotalNumDBShards() int {
+func (pdb *DB) GetTotalNumDBShards() int {
 	return pdb.numDBShards
 }
 
-var _ sqlplugin.DB = (*db)(nil)
-var _ sqlplugin.Tx = (*db)(nil)
+var _ sqlplugin.AdminDB = (*DB)(nil)
+var _ sqlplugin.DB = (*DB)(nil)
+var _ sqlplugin.Tx = (*DB)(nil)
 
 // ErrDupEntry indicates a duplicate primary key i.e. the row already exists,
 // check http://www.postgresql.org/docs/9.3/static/errcodes-appendix.html
@@ -53,20 +54,20 @@ const ErrDupEntry = "23505"
 const ErrInsufficientResources = "53000"
 const ErrTooManyConnections = "53300"
 
-func (pdb *db) IsDupEntryError(err error) bool {
+func (pdb *DB) IsDupEntryError(err error) bool {
 	sqlErr, ok := err.(*pq.Error)
 	return ok && sqlErr.Code == ErrDupEntry
 }
 
-func (pdb *db) IsNotFoundError(err error) bool {
+func (pdb *DB) IsNotFoundError(err error) bool {
 	return err == sql.ErrNoRows
 }
 
-func (pdb *db) IsTimeoutError(err error) bool {
+func (pdb *DB) IsTimeoutError(err error) bool {
 	return err == context.DeadlineExceeded
 }
 
-func (pdb *db) IsThrottlingError(err error) bool {
+func (pdb *DB) IsThrottlingError(err error) bool {
 	sqlErr, ok := err.(*pq.Error)
 	if ok {
 		if sqlErr.Code == ErrTooManyConnections ||
@@ -77,11 +78,11 @@ func (pdb *db) IsThrottlingError(err error) bool {
 	return false
 }
 
-// newDB returns an instance of DB, which is a logical
+// NewDB returns an instance of DB, which is a logical
 // connection to the underlying postgres database
 // dbShardID is needed when tx is not nil
-func newDB(driver sqldriver.Driver, numDBShards int) *db {
-	return &db{
+func NewDB(driver sqldriver.Driver, numDBShards int) *DB {
+	return &DB{
 		converter:   &converter{},
 		driver:      driver,
 		numDBShards: numDBShards,
@@ -89,45 +90,45 @@ func newDB(driver sqldriver.Driver, numDBShards int) *db {
 }
 
 // BeginTx starts a new transaction and returns a reference to the Tx object
-func (pdb *db) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error) {
+func (pdb *DB) BeginTx(ctx context.Context, dbShardID int) (sqlplugin.Tx, error) {
 	driver, err := pdb.driver.BeginTransaction(ctx, dbShardID, nil)
 	if err != nil {
 		return nil, err
 	}
-	return newDB(driver, pdb.numDBShards), nil
+	return NewDB(driver, pdb.numDBShards), nil
 }
 
 // Commit commits a previously started transaction
-func (pdb *db) Commit() error {
+func (pdb *DB) Commit() error {
 	return pdb.driver.Commit()
 }
 
 // Rollback triggers rollback of a previously started transaction
-func (pdb *db) Rollback() error {
+func (pdb *DB) Rollback() error {
 	return pdb.driver.Rollback()
 }
 
 // Close closes the connection to the mysql db
-func (pdb *db) Close() error {
+func (pdb *DB) Close() error {
 	return pdb.driver.Close()
 }
 
 // PluginName returns the name of the mysql plugin
-func (pdb *db) PluginName() string {
+func (pdb *DB) PluginName() string {
 	return PluginName
 }
 
 // SupportsTTL returns weather Postgres supports TTL
-func (pdb *db) SupportsTTL() bool {
+func (pdb *DB) SupportsTTL() bool {
 	return false
 }
 
 // MaxAllowedTTL returns the max allowed ttl Postgres supports
-func (pdb *db) MaxAllowedTTL() (*time.Duration, error) {
+func (pdb *DB) MaxAllowedTTL() (*time.Duration, error) {
 	return nil, sqlplugin.ErrTTLNotSupported
 }
 
 // SupportsTTL returns weather Postgre supports Asynchronous transaction
-func (pdb *db) SupportsAsyncTransaction() bool {
+func (pdb *DB) SupportsAsyncTransaction() bool {
 	return false
 }
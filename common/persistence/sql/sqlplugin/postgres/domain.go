@@ -60,17 +60,17 @@ const (
 var errMissingArgs = errors.New("missing one or more args for API")
 
 // InsertIntoDomain inserts a single row into domains table
-func (pdb *db) InsertIntoDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
 	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, createDomainQuery, row.ID, row.Name, row.IsGlobal, row.Data, row.DataEncoding)
 }
 
 // UpdateDomain updates a single row in domains table
-func (pdb *db) UpdateDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
+func (pdb *DB) UpdateDomain(ctx context.Context, row *sqlplugin.DomainRow) (sql.Result, error) {
 	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainQuery, row.Name, row.Data, row.DataEncoding, row.ID)
 }
 
 // SelectFromDomain reads one or more rows from domains table
-func (pdb *db) SelectFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
+func (pdb *DB) SelectFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
 	switch {
 	case filter.ID != nil || filter.Name != nil:
 		return pdb.selectFromDomain(ctx, filter)
@@ -81,7 +81,7 @@ func (pdb *db) SelectFromDomain(ctx context.Context, filter *sqlplugin.DomainFil
 	}
 }
 
-func (pdb *db) selectFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
+func (pdb *DB) selectFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
 	var err error
 	var row sqlplugin.DomainRow
 	switch {
@@ -96,7 +96,7 @@ func (pdb *db) selectFromDomain(ctx context.Context, filter *sqlplugin.DomainFil
 	return []sqlplugin.DomainRow{row}, err
 }
 
-func (pdb *db) selectAllFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
+func (pdb *DB) selectAllFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) ([]sqlplugin.DomainRow, error) {
 	var err error
 	var rows []sqlplugin.DomainRow
 	switch {
@@ -109,7 +109,7 @@ func (pdb *db) selectAllFromDomain(ctx context.Context, filter *sqlplugin.Domain
 }
 
 // DeleteFromDomain deletes a single row in domains table
-func (pdb *db) DeleteFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) (sql.Result, error) {
+func (pdb *DB) DeleteFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) (sql.Result, error) {
 	var err error
 	var result sql.Result
 	switch {
@@ -122,20 +122,20 @@ func (pdb *db) DeleteFromDomain(ctx context.Context, filter *sqlplugin.DomainFil
 }
 
 // LockDomainMetadata acquires a write lock on a single row in domain_metadata table
-func (pdb *db) LockDomainMetadata(ctx context.Context) error {
+func (pdb *DB) LockDomainMetadata(ctx context.Context) error {
 	var row sqlplugin.DomainMetadataRow
 	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row.NotificationVersion, lockDomainMetadataQuery)
 	return err
 }
 
 // SelectFromDomainMetadata reads a single row in domain_metadata table
-func (pdb *db) SelectFromDomainMetadata(ctx context.Context) (*sqlplugin.DomainMetadataRow, error) {
+func (pdb *DB) SelectFromDomainMetadata(ctx context.Context) (*sqlplugin.DomainMetadataRow, error) {
 	var row sqlplugin.DomainMetadataRow
 	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row.NotificationVersion, getDomainMetadataQuery)
 	return &row, err
 }
 
 // UpdateDomainMetadata updates a single row in domain_metadata table
-func (pdb *db) UpdateDomainMetadata(ctx context.Context, row *sqlplugin.DomainMetadataRow) (sql.Result, error) {
+func (pdb *DB) UpdateDomainMetadata(ctx context.Context, row *sqlplugin.DomainMetadataRow) (sql.Result, error) {
 	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, updateDomainMetadataQuery, row.NotificationVersion+1, row.NotificationVersion)
 }
@@ -51,7 +51,7 @@ const (
 )
 
 // InsertIntoDomainAuditLog inserts a single row into domain_audit_log table
-func (pdb *db) InsertIntoDomainAuditLog(ctx context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoDomainAuditLog(ctx context.Context, row *sqlplugin.DomainAuditLogRow) (sql.Result, error) {
 	return pdb.driver.ExecContext(
 		ctx,
 		sqlplugin.DbDefaultShard,
@@ -72,7 +72,7 @@ func (pdb *db) InsertIntoDomainAuditLog(ctx context.Context, row *sqlplugin.Doma
 }
 
 // SelectFromDomainAuditLogs returns audit log entries for a domain, operation type, and time range
-func (pdb *db) SelectFromDomainAuditLogs(
+func (pdb *DB) SelectFromDomainAuditLogs(
 	ctx context.Context,
 	filter *sqlplugin.DomainAuditLogFilter,
 ) ([]*sqlplugin.DomainAuditLogRow, error) {
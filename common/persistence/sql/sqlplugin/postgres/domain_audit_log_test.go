@@ -121,7 +121,7 @@ func TestInsertIntoDomainAuditLog(t *testing.T) {
 			mockDriver := sqldriver.NewMockDriver(ctrl)
 			tc.mockSetup(mockDriver)
 
-			pdb := &db{
+			pdb := &DB{
 				driver:    mockDriver,
 				converter: &converter{},
 			}
@@ -323,7 +323,7 @@ func TestSelectFromDomainAuditLogs(t *testing.T) {
 			mockDriver := sqldriver.NewMockDriver(ctrl)
 			tc.mockSetup(mockDriver)
 
-			pdb := &db{
+			pdb := &DB{
 				driver:    mockDriver,
 				converter: &converter{},
 			}
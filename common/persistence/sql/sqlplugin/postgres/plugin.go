@@ -60,7 +60,7 @@ func (d *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(driver, cfg.NumShards), nil
+	return NewDB(driver, cfg.NumShards), nil
 }
 
 // CreateAdminDB initialize the adminDB object
@@ -69,7 +69,7 @@ func (d *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(driver, cfg.NumShards), nil
+	return NewDB(driver, cfg.NumShards), nil
 }
 
 func (d *plugin) GetSchema(dbType persistence.DBType) (persistence.Schema, error) {
@@ -106,7 +106,7 @@ task_type = :task_type
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
-func (pdb *db) InsertIntoTasks(ctx context.Context, rows []sqlplugin.TasksRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoTasks(ctx context.Context, rows []sqlplugin.TasksRow) (sql.Result, error) {
 	if len(rows) == 0 {
 		return nil, nil
 	}
@@ -114,7 +114,7 @@ func (pdb *db) InsertIntoTasks(ctx context.Context, rows []sqlplugin.TasksRow) (
 }
 
 // SelectFromTasks reads one or more rows from tasks table
-func (pdb *db) SelectFromTasks(ctx context.Context, filter *sqlplugin.TasksFilter) ([]sqlplugin.TasksRow, error) {
+func (pdb *DB) SelectFromTasks(ctx context.Context, filter *sqlplugin.TasksFilter) ([]sqlplugin.TasksRow, error) {
 	var err error
 	var rows []sqlplugin.TasksRow
 	switch {
@@ -132,7 +132,7 @@ func (pdb *db) SelectFromTasks(ctx context.Context, filter *sqlplugin.TasksFilte
 }
 
 // DeleteFromTasks deletes one or more rows from tasks table
-func (pdb *db) DeleteFromTasks(ctx context.Context, filter *sqlplugin.TasksFilter) (sql.Result, error) {
+func (pdb *DB) DeleteFromTasks(ctx context.Context, filter *sqlplugin.TasksFilter) (sql.Result, error) {
 	if filter.TaskIDLessThanEquals != nil {
 		if filter.Limit == nil || *filter.Limit == 0 {
 			return nil, fmt.Errorf("missing limit parameter")
@@ -143,7 +143,7 @@ func (pdb *db) DeleteFromTasks(ctx context.Context, filter *sqlplugin.TasksFilte
 	return pdb.driver.ExecContext(ctx, filter.ShardID, deleteTaskQry, filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskID)
 }
 
-func (pdb *db) GetTasksCount(ctx context.Context, filter *sqlplugin.TasksFilter) (int64, error) {
+func (pdb *DB) GetTasksCount(ctx context.Context, filter *sqlplugin.TasksFilter) (int64, error) {
 	var size []int64
 	if err := pdb.driver.SelectContext(ctx, filter.ShardID, &size, getTasksCountQry, filter.DomainID, filter.TaskListName, filter.TaskType, *filter.MinTaskID); err != nil {
 		return 0, err
@@ -151,7 +151,7 @@ func (pdb *db) GetTasksCount(ctx context.Context, filter *sqlplugin.TasksFilter)
 	return size[0], nil
 }
 
-func (pdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasksFilter) ([]sqlplugin.TaskKeyRow, error) {
+func (pdb *DB) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasksFilter) ([]sqlplugin.TaskKeyRow, error) {
 	if filter.Limit == nil || *filter.Limit == 0 {
 		return nil, fmt.Errorf("missing limit parameter")
 	}
@@ -164,17 +164,17 @@ func (pdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasks
 }
 
 // InsertIntoTaskLists inserts one or more rows into task_lists table
-func (pdb *db) InsertIntoTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
 	return pdb.driver.NamedExecContext(ctx, row.ShardID, createTaskListQry, row)
 }
 
 // UpdateTaskLists updates a row in task_lists table
-func (pdb *db) UpdateTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
+func (pdb *DB) UpdateTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
 	return pdb.driver.NamedExecContext(ctx, row.ShardID, updateTaskListQry, row)
 }
 
 // SelectFromTaskLists reads one or more rows from task_lists table
-func (pdb *db) SelectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+func (pdb *DB) SelectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
 	switch {
 	case filter.DomainID != nil && filter.Name != nil && filter.TaskType != nil:
 		return pdb.selectFromTaskLists(ctx, filter)
@@ -185,7 +185,7 @@ func (pdb *db) SelectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskLi
 	}
 }
 
-func (pdb *db) selectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+func (pdb *DB) selectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
 	var err error
 	var row sqlplugin.TaskListsRow
 	err = pdb.driver.GetContext(ctx, filter.ShardID, &row, getTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
@@ -195,7 +195,7 @@ func (pdb *db) selectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskLi
 	return []sqlplugin.TaskListsRow{row}, err
 }
 
-func (pdb *db) rangeSelectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+func (pdb *DB) rangeSelectFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
 	var err error
 	var rows []sqlplugin.TaskListsRow
 	err = pdb.driver.SelectContext(ctx, filter.ShardID, &rows, listTaskListQry,
@@ -210,28 +210,28 @@ func (pdb *db) rangeSelectFromTaskLists(ctx context.Context, filter *sqlplugin.T
 }
 
 // DeleteFromTaskLists deletes a row from task_lists table
-func (pdb *db) DeleteFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (sql.Result, error) {
+func (pdb *DB) DeleteFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (sql.Result, error) {
 	return pdb.driver.ExecContext(ctx, filter.ShardID, deleteTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType, *filter.RangeID)
 }
 
 // LockTaskLists locks a row in task_lists table
-func (pdb *db) LockTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (int64, error) {
+func (pdb *DB) LockTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (int64, error) {
 	var rangeID int64
 	err := pdb.driver.GetContext(ctx, filter.ShardID, &rangeID, lockTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType)
 	return rangeID, err
 }
 
 // InsertIntoTasksWithTTL is not supported in Postgres
-func (pdb *db) InsertIntoTasksWithTTL(_ context.Context, _ []sqlplugin.TasksRowWithTTL) (sql.Result, error) {
+func (pdb *DB) InsertIntoTasksWithTTL(_ context.Context, _ []sqlplugin.TasksRowWithTTL) (sql.Result, error) {
 	return nil, sqlplugin.ErrTTLNotSupported
 }
 
 // InsertIntoTaskListsWithTTL is not supported in Postgres
-func (pdb *db) InsertIntoTaskListsWithTTL(_ context.Context, _ *sqlplugin.TaskListsRowWithTTL) (sql.Result, error) {
+func (pdb *DB) InsertIntoTaskListsWithTTL(_ context.Context, _ *sqlplugin.TaskListsRowWithTTL) (sql.Result, error) {
 	return nil, sqlplugin.ErrTTLNotSupported
 }
 
 // UpdateTaskListsWithTTL is not supported in Postgres
-func (pdb *db) UpdateTaskListsWithTTL(_ context.Context, _ *sqlplugin.TaskListsRowWithTTL) (sql.Result, error) {
+func (pdb *DB) UpdateTaskListsWithTTL(_ context.Context, _ *sqlplugin.TaskListsRowWithTTL) (sql.Result, error) {
 	return nil, sqlplugin.ErrTTLNotSupported
 }
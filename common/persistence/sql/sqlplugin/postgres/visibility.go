@@ -26,19 +26,23 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -55,7 +59,11 @@ const (
 				shard_id = excluded.shard_id,
 				execution_status = excluded.execution_status,
 				cron_schedule = excluded.cron_schedule,
-				scheduled_execution_time = excluded.scheduled_execution_time`
+				scheduled_execution_time = excluded.scheduled_execution_time,
+				search_attributes = excluded.search_attributes`
+
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = $1, encoding = $2, search_attributes = $3, update_time = $4 ` +
+		`WHERE domain_id = $5 AND run_id = $6`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -72,7 +80,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT $7`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, shard_id`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, shard_id, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -92,19 +100,27 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = $1` + templateConditions2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time, shard_id
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron, update_time, shard_id, search_attributes
 		 FROM executions_visibility
 		 WHERE domain_id = $1 AND close_status IS NOT NULL
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	// visibility queries are built with ? placeholders and rebound before execution
+	templateSelectByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length, ` +
+		`COALESCE(cron_schedule, '') AS cron_schedule, COALESCE(execution_status, 0) AS execution_status, ` +
+		`COALESCE(num_clusters, 0) AS num_clusters, COALESCE(scheduled_execution_time, start_time) AS scheduled_execution_time ` +
+		`FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
-func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+func (pdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
 	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
 	scheduledExecutionTime := pdb.converter.ToPostgresDateTime(row.ScheduledExecutionTime)
@@ -123,11 +139,12 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.ShardID,
 		row.ExecutionStatus,
 		row.CronSchedule,
-		scheduledExecutionTime)
+		scheduledExecutionTime,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
-func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+func (pdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
 	switch {
 	case row.CloseStatus != nil && row.CloseTime != nil && row.HistoryLength != nil:
@@ -152,20 +169,21 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			row.ShardID,
 			row.ExecutionStatus,
 			row.CronSchedule,
-			scheduledExecutionTime)
+			scheduledExecutionTime,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
 // DeleteFromVisibility deletes a row from visibility table if it exist
-func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+func (pdb *DB) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
 	return pdb.driver.ExecContext(ctx, dbShardID, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
 // SelectFromVisibility reads one or more rows from visibility table
-func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
+func (pdb *DB) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
 	var err error
 	var rows []sqlplugin.VisibilityRow
@@ -253,3 +271,89 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// UpdateVisibility updates the memo, search attributes and update time of an existing row in visibility table
+func (pdb *DB) UpdateVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		searchAttributesArg(row.SearchAttributes),
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
+// SelectFromVisibilityByQuery returns a page of the rows of a domain matching a visibility query
+func (pdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	condition, orderBy, conditionArgs := filter.Query.Build(&visibilityQueryDialect{converter: pdb.converter}, filter.After)
+	query := templateSelectByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	query += " ORDER BY " + orderBy + " LIMIT ?"
+	args := append([]interface{}{filter.DomainID}, conditionArgs...)
+	args = append(args, filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, sqlx.Rebind(sqlx.BindType(PluginName), query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		rows[i].ScheduledExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ScheduledExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of a domain matching a visibility query
+func (pdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	condition, _, conditionArgs := filter.Query.Build(&visibilityQueryDialect{converter: pdb.converter}, nil)
+	query := templateCountByQuery
+	if condition != "" {
+		query += " AND " + condition
+	}
+	args := append([]interface{}{filter.DomainID}, conditionArgs...)
+
+	var count int64
+	err := pdb.driver.GetContext(ctx, dbShardID, &count, sqlx.Rebind(sqlx.BindType(PluginName), query), args...)
+	return count, err
+}
+
+// searchAttributesArg returns the value bound to the search_attributes column,
+// which is NULL for executions without custom search attributes
+func searchAttributesArg(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
+
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	value := fmt.Sprintf("(search_attributes->>'%s')", key)
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		return value + "::BIGINT"
+	case types.IndexedValueTypeDouble:
+		return value + "::DOUBLE PRECISION"
+	default:
+		return value
+	}
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToPostgresDateTime(t)
+}
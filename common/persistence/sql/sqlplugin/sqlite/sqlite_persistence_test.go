@@ -121,3 +121,11 @@ func TestSQLiteDomainAuditPersistence(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestSQLiteAsyncWorkflowRequestStatusPersistence(t *testing.T) {
+	s := new(pt.AsyncWorkflowRequestStatusPersistenceSuite)
+	option := GetTestClusterOption()
+	s.TestBase = pt.NewTestBaseWithSQL(t, option)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
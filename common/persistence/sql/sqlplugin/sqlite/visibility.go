@@ -25,20 +25,29 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateSelectByQuery = `SELECT ` + mysql.TemplateQueryFieldNames + `, scheduled_execution_time FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
 )
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
 	row.StartTime = mdb.converter.ToDateTime(row.StartTime)
+	scheduledExecutionTime := mdb.converter.ToDateTime(row.ScheduledExecutionTime)
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
 	return mdb.driver.ExecContext(ctx,
 		dbShardID,
@@ -54,5 +63,52 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron,
 		row.NumClusters,
 		row.UpdateTime,
-		row.ShardID)
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		scheduledExecutionTime,
+		mysql.SearchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery returns a page of the rows of a domain matching a visibility query
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := mysql.BuildVisibilityQuery(templateSelectByQuery, filter, &visibilityQueryDialect{converter: mdb.converter}, true)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows of a domain matching a visibility query
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := mysql.BuildVisibilityQuery(templateCountByQuery, filter, &visibilityQueryDialect{converter: mdb.converter}, false)
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+type visibilityQueryDialect struct {
+	converter mysql.DataConverter
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	path := fmt.Sprintf("'$.%s'", key)
+	switch valueType {
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		return fmt.Sprintf("CAST(json_extract(search_attributes, %s) AS INTEGER)", path)
+	case types.IndexedValueTypeDouble:
+		return fmt.Sprintf("CAST(json_extract(search_attributes, %s) AS REAL)", path)
+	case types.IndexedValueTypeBool:
+		// json_extract returns booleans as 0 and 1, json_type as 'true' and 'false'
+		return fmt.Sprintf("json_type(search_attributes, %s)", path)
+	default:
+		return fmt.Sprintf("json_extract(search_attributes, %s)", path)
+	}
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToDateTime(t)
 }
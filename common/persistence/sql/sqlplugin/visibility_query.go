@@ -0,0 +1,719 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+type (
+	// VisibilityQueryDialect renders the parts of a visibility query which differ between databases
+	VisibilityQueryDialect interface {
+		// SearchAttribute returns an expression reading a custom search attribute from the
+		// search_attributes JSON column. Keyword, string and bool attributes must be read as
+		// text (bools as 'true' or 'false'), int and datetime attributes as integers and
+		// double attributes as floating point numbers.
+		SearchAttribute(key string, valueType types.IndexedValueType) string
+		// DateTime converts a time bound to a datetime column
+		DateTime(t time.Time) time.Time
+	}
+
+	// VisibilityQuery is a query of the Cadence visibility query language which was validated
+	// against the search attributes known to the cluster. It is translated to SQL by each plugin
+	// through its VisibilityQueryDialect.
+	VisibilityQuery struct {
+		where   visibilityCondition
+		orderBy []visibilityOrder
+	}
+
+	// VisibilityQueryCursor is the position of an execution in the order of a visibility query,
+	// which the next page starts after: the values of the ORDER BY fields of the execution, nil
+	// for missing values, followed by its run ID.
+	VisibilityQueryCursor struct {
+		SortValues []interface{}
+		RunID      string
+	}
+
+	visibilityField struct {
+		// column is set for system search attributes stored in their own column
+		column string
+		// key is set for custom search attributes stored in the search_attributes column
+		key       string
+		valueType types.IndexedValueType
+		isTime    bool
+		// nullable is set for fields which may be missing from an execution
+		nullable bool
+		// sortExpression replaces the column when ordering, so the sort key matches the value read back by queries
+		sortExpression string
+	}
+
+	visibilityCondition interface {
+		render(dialect VisibilityQueryDialect, args []interface{}) (string, []interface{})
+	}
+
+	visibilityLogicalCondition struct {
+		operator    string
+		left, right visibilityCondition
+	}
+
+	visibilityComparisonCondition struct {
+		field    visibilityField
+		operator string
+		values   []interface{}
+	}
+
+	visibilityRangeCondition struct {
+		field    visibilityField
+		not      bool
+		from, to interface{}
+	}
+
+	visibilityNullCondition struct {
+		field  visibilityField
+		isNull bool
+	}
+
+	visibilityOrder struct {
+		field      visibilityField
+		descending bool
+	}
+)
+
+// missingValue is the literal the query language uses to match executions without a value, e.g. "CloseTime = missing"
+const missingValue = "missing"
+
+var (
+	searchAttributeKeyPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// visibilitySystemColumns maps the system search attributes to the executions_visibility columns
+	visibilitySystemColumns = map[string]visibilityField{
+		definition.DomainID:               {column: "domain_id", valueType: types.IndexedValueTypeKeyword},
+		definition.WorkflowID:             {column: "workflow_id", valueType: types.IndexedValueTypeKeyword},
+		definition.RunID:                  {column: "run_id", valueType: types.IndexedValueTypeKeyword},
+		definition.WorkflowType:           {column: "workflow_type_name", valueType: types.IndexedValueTypeKeyword},
+		definition.StartTime:              {column: "start_time", valueType: types.IndexedValueTypeDatetime, isTime: true},
+		definition.ExecutionTime:          {column: "execution_time", valueType: types.IndexedValueTypeDatetime, isTime: true},
+		definition.CloseTime:              {column: "close_time", valueType: types.IndexedValueTypeDatetime, isTime: true, nullable: true},
+		definition.UpdateTime:             {column: "update_time", valueType: types.IndexedValueTypeDatetime, isTime: true},
+		definition.ScheduledExecutionTime: {column: "scheduled_execution_time", valueType: types.IndexedValueTypeDatetime, isTime: true, sortExpression: "COALESCE(scheduled_execution_time, start_time)"},
+		definition.CloseStatus:            {column: "close_status", valueType: types.IndexedValueTypeInt, nullable: true},
+		definition.ExecutionStatus:        {column: "execution_status", valueType: types.IndexedValueTypeInt, sortExpression: "COALESCE(execution_status, 0)"},
+		definition.HistoryLength:          {column: "history_length", valueType: types.IndexedValueTypeInt, nullable: true},
+		definition.NumClusters:            {column: "num_clusters", valueType: types.IndexedValueTypeInt, sortExpression: "COALESCE(num_clusters, 0)"},
+		definition.IsCron:                 {column: "is_cron", valueType: types.IndexedValueTypeBool},
+		definition.CronSchedule:           {column: "cron_schedule", valueType: types.IndexedValueTypeKeyword, sortExpression: "COALESCE(cron_schedule, '')"},
+	}
+
+	// defaultVisibilityOrder orders queries without an ORDER BY clause
+	defaultVisibilityOrder = []visibilityOrder{{field: visibilitySystemColumns[definition.StartTime], descending: true}}
+)
+
+// ParseVisibilityQuery validates a visibility query against the types of the valid search attributes.
+// Custom search attributes may carry the "Attr." prefix added by the frontend query validator.
+func ParseVisibilityQuery(query string, searchAttributeTypes map[string]types.IndexedValueType) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{}, nil
+	}
+	placeholderQuery := "SELECT * FROM dummy WHERE " + query
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = "SELECT * FROM dummy " + query
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query: " + err.Error()}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+
+	p := &visibilityQueryParser{searchAttributeTypes: searchAttributeTypes}
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		if result.where, err = p.parseCondition(sel.Where.Expr); err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	for _, order := range sel.OrderBy {
+		colName, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, &types.BadRequestError{Message: "invalid order by expression"}
+		}
+		field, err := p.parseField(colName)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		result.orderBy = append(result.orderBy, visibilityOrder{
+			field:      field,
+			descending: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return result, nil
+}
+
+// Build translates the query into a WHERE condition, which is empty when the query has no
+// filter, and an ORDER BY list, which always ends with run_id to make the order deterministic.
+// Missing values sort after all others on every database. When after is set, the condition
+// only matches the rows following that position in the order.
+// Placeholders are rendered as '?' and the returned args are bound in order.
+func (q *VisibilityQuery) Build(dialect VisibilityQueryDialect, after *VisibilityQueryCursor) (string, string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	if q.where != nil {
+		var condition string
+		condition, args = q.where.render(dialect, args)
+		conditions = append(conditions, condition)
+	}
+	if after != nil {
+		var condition string
+		condition, args = q.renderAfter(dialect, after, args)
+		conditions = append(conditions, condition)
+	}
+
+	orders := q.orders()
+	orderBy := make([]string, 0, 2*len(orders)+1)
+	for _, order := range orders {
+		key := order.field.renderSortKey(dialect)
+		if order.field.nullable {
+			orderBy = append(orderBy, key+" IS NULL")
+		}
+		direction := "ASC"
+		if order.descending {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, key+" "+direction)
+	}
+	orderBy = append(orderBy, "run_id")
+	return strings.Join(conditions, " AND "), strings.Join(orderBy, ", "), args
+}
+
+// Cursor returns the position of a row returned by the query, which the next page starts after
+func (q *VisibilityQuery) Cursor(row *VisibilityRow) (*VisibilityQueryCursor, error) {
+	var searchAttributes map[string]interface{}
+	if len(row.SearchAttributes) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(row.SearchAttributes))
+		decoder.UseNumber()
+		if err := decoder.Decode(&searchAttributes); err != nil {
+			return nil, fmt.Errorf("failed to decode search attributes: %v", err)
+		}
+	}
+
+	orders := q.orders()
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		if order.field.column != "" {
+			values[i] = order.field.columnValue(row)
+			continue
+		}
+		value, err := order.field.searchAttributeValue(searchAttributes[order.field.key])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return &VisibilityQueryCursor{SortValues: values, RunID: row.RunID}, nil
+}
+
+// NewCursor validates a position decoded from a page token against the ORDER BY fields of the
+// query and converts its values, decoded from JSON with json.Number, back to the types of the fields.
+func (q *VisibilityQuery) NewCursor(sortValues []interface{}, runID string) (*VisibilityQueryCursor, error) {
+	orders := q.orders()
+	if len(sortValues) != len(orders) || runID == "" {
+		return nil, fmt.Errorf("page token does not match the order of the query")
+	}
+	values := make([]interface{}, len(orders))
+	for i, order := range orders {
+		value, err := order.field.decodeSortValue(sortValues[i])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return &VisibilityQueryCursor{SortValues: values, RunID: runID}, nil
+}
+
+func (q *VisibilityQuery) orders() []visibilityOrder {
+	if len(q.orderBy) == 0 {
+		return defaultVisibilityOrder
+	}
+	return q.orderBy
+}
+
+// renderAfter renders the condition matching the rows after the cursor in the order of the query:
+// rows equal to the cursor on a prefix of the sort keys and after it on the next key, where
+// missing values sort last and run_id breaks the remaining ties.
+func (q *VisibilityQuery) renderAfter(dialect VisibilityQueryDialect, cursor *VisibilityQueryCursor, args []interface{}) (string, []interface{}) {
+	var alternatives, equal []string
+	var equalArgs []interface{}
+	for i, order := range q.orders() {
+		key := order.field.renderSortKey(dialect)
+		value := cursor.SortValues[i]
+		if value == nil {
+			// only rows missing the value too follow a missing value on this key
+			equal = append(equal, key+" IS NULL")
+			continue
+		}
+
+		operator := ">"
+		if order.descending {
+			operator = "<"
+		}
+		next := fmt.Sprintf("%s %s ?", key, operator)
+		if order.field.nullable {
+			next = fmt.Sprintf("(%s OR %s IS NULL)", next, key)
+		}
+		alternatives = append(alternatives, strings.Join(append(append([]string{}, equal...), next), " AND "))
+		args = append(append(args, equalArgs...), renderVisibilityValue(dialect, value))
+
+		equal = append(equal, key+" = ?")
+		equalArgs = append(equalArgs, renderVisibilityValue(dialect, value))
+	}
+	alternatives = append(alternatives, strings.Join(append(equal, "run_id > ?"), " AND "))
+	args = append(append(args, equalArgs...), cursor.RunID)
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
+type visibilityQueryParser struct {
+	searchAttributeTypes map[string]types.IndexedValueType
+}
+
+func (p *visibilityQueryParser) parseCondition(expr sqlparser.Expr) (visibilityCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return p.parseLogicalCondition("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return p.parseLogicalCondition("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		return p.parseCondition(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.parseComparison(expr)
+	case *sqlparser.RangeCond:
+		return p.parseRange(expr)
+	case *sqlparser.IsExpr:
+		return p.parseIs(expr)
+	default:
+		return nil, fmt.Errorf("invalid where clause: %s", sqlparser.String(expr))
+	}
+}
+
+func (p *visibilityQueryParser) parseLogicalCondition(operator string, left, right sqlparser.Expr) (visibilityCondition, error) {
+	leftCondition, err := p.parseCondition(left)
+	if err != nil {
+		return nil, err
+	}
+	rightCondition, err := p.parseCondition(right)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityLogicalCondition{operator: operator, left: leftCondition, right: rightCondition}, nil
+}
+
+func (p *visibilityQueryParser) parseComparison(expr *sqlparser.ComparisonExpr) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid comparison expression: %s", sqlparser.String(expr))
+	}
+	field, err := p.parseField(colName)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok := expr.Right.(*sqlparser.ColName); ok && strings.EqualFold(value.Name.String(), missingValue) {
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return &visibilityNullCondition{field: field, isNull: true}, nil
+		case sqlparser.NotEqualStr:
+			return &visibilityNullCondition{field: field, isNull: false}, nil
+		default:
+			return nil, fmt.Errorf("operator %q is not supported for %s", expr.Operator, missingValue)
+		}
+	}
+
+	var valueExprs []sqlparser.Expr
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		valueExprs = []sqlparser.Expr{expr.Right}
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		if field.valueType == types.IndexedValueTypeBool {
+			return nil, fmt.Errorf("operator %q is not supported for bool search attribute %s", expr.Operator, colName.Name.String())
+		}
+		valueExprs = []sqlparser.Expr{expr.Right}
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if field.valueType != types.IndexedValueTypeKeyword && field.valueType != types.IndexedValueTypeString {
+			return nil, fmt.Errorf("operator %q is only supported for keyword and string search attributes", expr.Operator)
+		}
+		valueExprs = []sqlparser.Expr{expr.Right}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok || len(tuple) == 0 {
+			return nil, fmt.Errorf("invalid %s expression: %s", expr.Operator, sqlparser.String(expr))
+		}
+		valueExprs = tuple
+	default:
+		return nil, fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+
+	values := make([]interface{}, 0, len(valueExprs))
+	for _, valueExpr := range valueExprs {
+		value, err := parseVisibilityValue(field, colName.Name.String(), valueExpr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return &visibilityComparisonCondition{field: field, operator: expr.Operator, values: values}, nil
+}
+
+func (p *visibilityQueryParser) parseRange(expr *sqlparser.RangeCond) (visibilityCondition, error) {
+	colName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid range expression: %s", sqlparser.String(expr))
+	}
+	field, err := p.parseField(colName)
+	if err != nil {
+		return nil, err
+	}
+	if field.valueType == types.IndexedValueTypeBool {
+		return nil, fmt.Errorf("range is not supported for bool search attribute %s", colName.Name.String())
+	}
+	from, err := parseVisibilityValue(field, colName.Name.String(), expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseVisibilityValue(field, colName.Name.String(), expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityRangeCondition{field: field, not: expr.Operator == sqlparser.NotBetweenStr, from: from, to: to}, nil
+}
+
+func (p *visibilityQueryParser) parseIs(expr *sqlparser.IsExpr) (visibilityCondition, error) {
+	colName, ok := expr.Expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid is expression: %s", sqlparser.String(expr))
+	}
+	field, err := p.parseField(colName)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return &visibilityNullCondition{field: field, isNull: true}, nil
+	case sqlparser.IsNotNullStr:
+		return &visibilityNullCondition{field: field, isNull: false}, nil
+	default:
+		return nil, fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+}
+
+func (p *visibilityQueryParser) parseField(colName *sqlparser.ColName) (visibilityField, error) {
+	name := colName.Name.String()
+	if field, ok := visibilitySystemColumns[name]; ok {
+		return field, nil
+	}
+	if definition.IsSystemIndexedKey(name) {
+		return visibilityField{}, fmt.Errorf("search attribute %q is not supported by SQL visibility", name)
+	}
+
+	key := strings.TrimPrefix(name, definition.Attr+".")
+	if !searchAttributeKeyPattern.MatchString(key) {
+		return visibilityField{}, fmt.Errorf("invalid search attribute %q", name)
+	}
+	valueType, ok := p.searchAttributeTypes[key]
+	if !ok {
+		return visibilityField{}, fmt.Errorf("invalid search attribute %q", name)
+	}
+	return visibilityField{key: key, valueType: valueType, nullable: true}, nil
+}
+
+// parseVisibilityValue converts a literal of the query to the value bound for the field:
+// strings for keyword and string fields, int64 for int fields and the unix nanos of datetime
+// search attributes, float64 for doubles, time.Time for datetime columns and bools for is_cron.
+// Bool search attributes are compared as 'true' or 'false' text.
+func parseVisibilityValue(field visibilityField, name string, expr sqlparser.Expr) (interface{}, error) {
+	var raw string
+	switch value := expr.(type) {
+	case *sqlparser.SQLVal:
+		raw = string(value.Val)
+	case sqlparser.BoolVal:
+		raw = strconv.FormatBool(bool(value))
+	default:
+		return nil, fmt.Errorf("invalid value for search attribute %s: %s", name, sqlparser.String(expr))
+	}
+
+	invalidValue := fmt.Errorf("invalid value %q for search attribute %s", raw, name)
+	switch {
+	case field.column == "close_status":
+		var status types.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(raw)); err != nil {
+			return nil, invalidValue
+		}
+		return int64(*thrift.FromWorkflowExecutionCloseStatus(&status)), nil
+	case field.column == "execution_status":
+		var status types.WorkflowExecutionStatus
+		if err := status.UnmarshalText([]byte(raw)); err != nil {
+			return nil, invalidValue
+		}
+		return int64(status), nil
+	}
+
+	switch field.valueType {
+	case types.IndexedValueTypeKeyword, types.IndexedValueTypeString:
+		return raw, nil
+	case types.IndexedValueTypeInt:
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, invalidValue
+		}
+		return value, nil
+	case types.IndexedValueTypeDouble:
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, invalidValue
+		}
+		return value, nil
+	case types.IndexedValueTypeBool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, invalidValue
+		}
+		if field.column != "" {
+			return value, nil
+		}
+		return strconv.FormatBool(value), nil
+	case types.IndexedValueTypeDatetime:
+		nanos, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			t, err := time.Parse(time.RFC3339Nano, raw)
+			if err != nil {
+				return nil, invalidValue
+			}
+			nanos = t.UnixNano()
+		}
+		if field.isTime {
+			return time.Unix(0, nanos).UTC(), nil
+		}
+		return nanos, nil
+	default:
+		return nil, invalidValue
+	}
+}
+
+func (f visibilityField) render(dialect VisibilityQueryDialect) string {
+	if f.column != "" {
+		return f.column
+	}
+	return dialect.SearchAttribute(f.key, f.valueType)
+}
+
+func (f visibilityField) renderSortKey(dialect VisibilityQueryDialect) string {
+	if f.sortExpression != "" {
+		return f.sortExpression
+	}
+	return f.render(dialect)
+}
+
+// columnValue returns the sort key of a system column as read back from the row
+func (f visibilityField) columnValue(row *VisibilityRow) interface{} {
+	switch f.column {
+	case "domain_id":
+		return row.DomainID
+	case "workflow_id":
+		return row.WorkflowID
+	case "run_id":
+		return row.RunID
+	case "workflow_type_name":
+		return row.WorkflowTypeName
+	case "start_time":
+		return row.StartTime
+	case "execution_time":
+		return row.ExecutionTime
+	case "close_time":
+		if row.CloseTime == nil {
+			return nil
+		}
+		return *row.CloseTime
+	case "update_time":
+		return row.UpdateTime
+	case "scheduled_execution_time":
+		return row.ScheduledExecutionTime
+	case "close_status":
+		if row.CloseStatus == nil {
+			return nil
+		}
+		return int64(*row.CloseStatus)
+	case "execution_status":
+		return int64(row.ExecutionStatus)
+	case "history_length":
+		if row.HistoryLength == nil {
+			return nil
+		}
+		return *row.HistoryLength
+	case "num_clusters":
+		return int64(row.NumClusters)
+	case "is_cron":
+		return row.IsCron
+	case "cron_schedule":
+		return row.CronSchedule
+	default:
+		return nil
+	}
+}
+
+// searchAttributeValue returns the sort key of a custom search attribute decoded from the
+// search_attributes column, with the type the dialect reads it as. Array values are sorted
+// by their JSON text.
+func (f visibilityField) searchAttributeValue(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	invalidValue := fmt.Errorf("invalid value %v for search attribute %s", value, f.key)
+	switch f.valueType {
+	case types.IndexedValueTypeKeyword, types.IndexedValueTypeString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, invalidValue
+		}
+		return string(data), nil
+	case types.IndexedValueTypeInt, types.IndexedValueTypeDatetime:
+		if n, ok := value.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				return i, nil
+			}
+		}
+		return nil, invalidValue
+	case types.IndexedValueTypeDouble:
+		if n, ok := value.(json.Number); ok {
+			if d, err := n.Float64(); err == nil {
+				return d, nil
+			}
+		}
+		return nil, invalidValue
+	case types.IndexedValueTypeBool:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+		return nil, invalidValue
+	default:
+		return nil, invalidValue
+	}
+}
+
+// decodeSortValue converts a sort key decoded from a page token back to the type of the field
+func (f visibilityField) decodeSortValue(value interface{}) (interface{}, error) {
+	invalidValue := fmt.Errorf("invalid sort value %v in page token", value)
+	if value == nil {
+		if !f.nullable {
+			return nil, invalidValue
+		}
+		return nil, nil
+	}
+	switch {
+	case f.isTime:
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return t, nil
+			}
+		}
+	case f.valueType == types.IndexedValueTypeBool && f.column != "":
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case f.valueType == types.IndexedValueTypeKeyword, f.valueType == types.IndexedValueTypeString, f.valueType == types.IndexedValueTypeBool:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case f.valueType == types.IndexedValueTypeInt, f.valueType == types.IndexedValueTypeDatetime:
+		if n, ok := value.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				return i, nil
+			}
+		}
+	case f.valueType == types.IndexedValueTypeDouble:
+		if n, ok := value.(json.Number); ok {
+			if d, err := n.Float64(); err == nil {
+				return d, nil
+			}
+		}
+	}
+	return nil, invalidValue
+}
+
+func renderVisibilityValue(dialect VisibilityQueryDialect, value interface{}) interface{} {
+	if t, ok := value.(time.Time); ok {
+		return dialect.DateTime(t)
+	}
+	return value
+}
+
+func (c *visibilityLogicalCondition) render(dialect VisibilityQueryDialect, args []interface{}) (string, []interface{}) {
+	left, args := c.left.render(dialect, args)
+	right, args := c.right.render(dialect, args)
+	return fmt.Sprintf("(%s %s %s)", left, c.operator, right), args
+}
+
+func (c *visibilityComparisonCondition) render(dialect VisibilityQueryDialect, args []interface{}) (string, []interface{}) {
+	placeholders := make([]string, len(c.values))
+	for i, value := range c.values {
+		placeholders[i] = "?"
+		args = append(args, renderVisibilityValue(dialect, value))
+	}
+	field := c.field.render(dialect)
+	switch c.operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return fmt.Sprintf("%s %s (%s)", field, strings.ToUpper(c.operator), strings.Join(placeholders, ", ")), args
+	default:
+		return fmt.Sprintf("%s %s ?", field, strings.ToUpper(c.operator)), args
+	}
+}
+
+func (c *visibilityRangeCondition) render(dialect VisibilityQueryDialect, args []interface{}) (string, []interface{}) {
+	operator := "BETWEEN"
+	if c.not {
+		operator = "NOT BETWEEN"
+	}
+	args = append(args, renderVisibilityValue(dialect, c.from), renderVisibilityValue(dialect, c.to))
+	return fmt.Sprintf("%s %s ? AND ?", c.field.render(dialect), operator), args
+}
+
+func (c *visibilityNullCondition) render(dialect VisibilityQueryDialect, args []interface{}) (string, []interface{}) {
+	if c.isNull {
+		return c.field.render(dialect) + " IS NULL", args
+	}
+	return c.field.render(dialect) + " IS NOT NULL", args
+}
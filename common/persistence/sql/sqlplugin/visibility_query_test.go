@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type testVisibilityQueryDialect struct{}
+
+func (testVisibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	return fmt.Sprintf("sa(%s, %s)", key, valueType.String())
+}
+
+func (testVisibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return t.Add(time.Hour)
+}
+
+func TestParseVisibilityQuery(t *testing.T) {
+	searchAttributeTypes := map[string]types.IndexedValueType{
+		"CustomKeywordField":  types.IndexedValueTypeKeyword,
+		"CustomStringField":   types.IndexedValueTypeString,
+		"CustomIntField":      types.IndexedValueTypeInt,
+		"CustomDoubleField":   types.IndexedValueTypeDouble,
+		"CustomBoolField":     types.IndexedValueTypeBool,
+		"CustomDatetimeField": types.IndexedValueTypeDatetime,
+	}
+	startTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		query             string
+		expectedCondition string
+		expectedOrderBy   string
+		expectedArgs      []interface{}
+		expectedErr       bool
+	}{
+		{
+			name:            "empty query",
+			query:           "",
+			expectedOrderBy: "start_time DESC, run_id",
+		},
+		{
+			name:            "order by only",
+			query:           "order by CustomIntField asc, CloseTime desc",
+			expectedOrderBy: "sa(CustomIntField, INT) IS NULL, sa(CustomIntField, INT) ASC, close_time IS NULL, close_time DESC, run_id",
+		},
+		{
+			name:              "system attributes",
+			query:             "WorkflowID = 'wid' and (WorkflowType != 'type' or HistoryLength > 10)",
+			expectedCondition: "(workflow_id = ? AND (workflow_type_name != ? OR history_length > ?))",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{"wid", "type", int64(10)},
+		},
+		{
+			name:              "custom attributes prefixed by the query validator",
+			query:             "`Attr.CustomKeywordField` = 'foo' and `Attr.CustomStringField` like '%bar%'",
+			expectedCondition: "(sa(CustomKeywordField, KEYWORD) = ? AND sa(CustomStringField, STRING) LIKE ?)",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{"foo", "%bar%"},
+		},
+		{
+			name:              "typed values",
+			query:             "CustomIntField in (1, 2) and CustomDoubleField <= 1.5 and CustomBoolField = true and IsCron = false",
+			expectedCondition: "(((sa(CustomIntField, INT) IN (?, ?) AND sa(CustomDoubleField, DOUBLE) <= ?) AND sa(CustomBoolField, BOOL) = ?) AND is_cron = ?)",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{int64(1), int64(2), 1.5, "true", false},
+		},
+		{
+			name:              "statuses",
+			query:             "CloseStatus = 'TERMINATED' or ExecutionStatus = 'STARTED'",
+			expectedCondition: "(close_status = ? OR execution_status = ?)",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{int64(3), int64(types.WorkflowExecutionStatusStarted)},
+		},
+		{
+			name:              "datetimes",
+			query:             "StartTime between '2024-01-02T03:04:05Z' and 1704164645000000001 and CustomDatetimeField > '2024-01-02T03:04:05Z'",
+			expectedCondition: "(start_time BETWEEN ? AND ? AND sa(CustomDatetimeField, DATETIME) > ?)",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{startTime.Add(time.Hour), startTime.Add(time.Hour + time.Nanosecond), startTime.UnixNano()},
+		},
+		{
+			name:              "missing values",
+			query:             "CloseTime = missing and CustomKeywordField != missing and CustomIntField is null",
+			expectedCondition: "((close_time IS NULL AND sa(CustomKeywordField, KEYWORD) IS NOT NULL) AND sa(CustomIntField, INT) IS NULL)",
+			expectedOrderBy:   "start_time DESC, run_id",
+		},
+		{
+			name:              "not between and not in",
+			query:             "CustomIntField not between 1 and 5 and CustomKeywordField not in ('a')",
+			expectedCondition: "(sa(CustomIntField, INT) NOT BETWEEN ? AND ? AND sa(CustomKeywordField, KEYWORD) NOT IN (?))",
+			expectedOrderBy:   "start_time DESC, run_id",
+			expectedArgs:      []interface{}{int64(1), int64(5), "a"},
+		},
+		{
+			name:        "unknown attribute",
+			query:       "UnknownField = 'foo'",
+			expectedErr: true,
+		},
+		{
+			name:        "system attribute without column",
+			query:       "TaskList = 'tl'",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid int value",
+			query:       "CustomIntField = 'foo'",
+			expectedErr: true,
+		},
+		{
+			name:        "like on int attribute",
+			query:       "CustomIntField like '1%'",
+			expectedErr: true,
+		},
+		{
+			name:        "range on bool attribute",
+			query:       "CustomBoolField > true",
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported expression",
+			query:       "CustomIntField + 1 = 2",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid syntax",
+			query:       "WorkflowID = ",
+			expectedErr: true,
+		},
+		{
+			name:        "limit",
+			query:       "WorkflowID = 'wid' limit 10",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, searchAttributeTypes)
+			if test.expectedErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			condition, orderBy, args := query.Build(testVisibilityQueryDialect{}, nil)
+			assert.Equal(t, test.expectedCondition, condition)
+			assert.Equal(t, test.expectedOrderBy, orderBy)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestVisibilityQueryCursor(t *testing.T) {
+	searchAttributeTypes := map[string]types.IndexedValueType{
+		"CustomIntField": types.IndexedValueTypeInt,
+	}
+	closeTime := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	tests := []struct {
+		name              string
+		query             string
+		row               VisibilityRow
+		expectedValues    []interface{}
+		expectedCondition string
+		expectedArgs      []interface{}
+	}{
+		{
+			name:              "default order",
+			query:             "WorkflowType = 'type'",
+			row:               VisibilityRow{RunID: "rid", StartTime: closeTime},
+			expectedValues:    []interface{}{closeTime},
+			expectedCondition: "workflow_type_name = ? AND (start_time < ? OR start_time = ? AND run_id > ?)",
+			expectedArgs:      []interface{}{"type", closeTime.Add(time.Hour), closeTime.Add(time.Hour), "rid"},
+		},
+		{
+			name:              "missing values sort last",
+			query:             "order by CloseTime desc, CustomIntField asc",
+			row:               VisibilityRow{RunID: "rid", CloseTime: &closeTime},
+			expectedValues:    []interface{}{closeTime, nil},
+			expectedCondition: "((close_time < ? OR close_time IS NULL) OR close_time = ? AND sa(CustomIntField, INT) IS NULL AND run_id > ?)",
+			expectedArgs:      []interface{}{closeTime.Add(time.Hour), closeTime.Add(time.Hour), "rid"},
+		},
+		{
+			name:           "custom and coalesced attributes",
+			query:          "order by CustomIntField desc, CronSchedule",
+			row:            VisibilityRow{RunID: "rid", CronSchedule: "* * * * *", SearchAttributes: []byte(`{"CustomIntField":9007199254740993}`)},
+			expectedValues: []interface{}{int64(9007199254740993), "* * * * *"},
+			expectedCondition: "((sa(CustomIntField, INT) < ? OR sa(CustomIntField, INT) IS NULL) OR " +
+				"sa(CustomIntField, INT) = ? AND COALESCE(cron_schedule, '') > ? OR " +
+				"sa(CustomIntField, INT) = ? AND COALESCE(cron_schedule, '') = ? AND run_id > ?)",
+			expectedArgs: []interface{}{int64(9007199254740993), int64(9007199254740993), "* * * * *", int64(9007199254740993), "* * * * *", "rid"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, searchAttributeTypes)
+			require.NoError(t, err)
+			cursor, err := query.Cursor(&test.row)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedValues, cursor.SortValues)
+
+			// the cursor survives the JSON round trip of page tokens
+			data, err := json.Marshal(cursor)
+			require.NoError(t, err)
+			var decoded VisibilityQueryCursor
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.UseNumber()
+			require.NoError(t, decoder.Decode(&decoded))
+			after, err := query.NewCursor(decoded.SortValues, decoded.RunID)
+			require.NoError(t, err)
+			assert.Equal(t, cursor, after)
+
+			condition, _, args := query.Build(testVisibilityQueryDialect{}, after)
+			assert.Equal(t, test.expectedCondition, condition)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestVisibilityQueryNewCursorInvalid(t *testing.T) {
+	query, err := ParseVisibilityQuery("order by StartTime", nil)
+	require.NoError(t, err)
+
+	_, err = query.NewCursor([]interface{}{"2024-01-02T03:04:05Z", "extra"}, "rid")
+	assert.Error(t, err)
+	_, err = query.NewCursor([]interface{}{nil}, "rid")
+	assert.Error(t, err)
+	_, err = query.NewCursor([]interface{}{json.Number("1")}, "rid")
+	assert.Error(t, err)
+	_, err = query.NewCursor([]interface{}{"2024-01-02T03:04:05Z"}, "")
+	assert.Error(t, err)
+}
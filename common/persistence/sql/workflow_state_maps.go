@@ -100,6 +100,8 @@ func updateActivityInfos(
 				LastHeartbeatDetails:     activityInfo.Details,
 				Data:                     blob.Data,
 				DataEncoding:             string(blob.Encoding),
+				Priority:                 activityInfo.Priority,
+				Paused:                   activityInfo.Paused,
 			}
 		}
 
@@ -185,6 +187,8 @@ func getActivityInfoMap(
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
 			LastFailureCategory:      decoded.RetryLastFailureCategory,
 			LastRetryIntervalSeconds: decoded.RetryLastRetryIntervalSeconds,
+			Priority:                 row.Priority,
+			Paused:                   row.Paused,
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, constants.EncodingType(decoded.GetStartedEventEncoding()))
@@ -221,6 +221,15 @@ type (
 		TaskList         string
 	}
 
+	// CompletionCallbackTask identifies a transfer task for delivering one of the completion callbacks
+	// registered at workflow start once the workflow is closed
+	CompletionCallbackTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList      string
+		CallbackIndex int64 // index of the callback in the completion callbacks of the execution info
+	}
+
 	// ActivityTimeoutTask identifies a timeout task.
 	ActivityTimeoutTask struct {
 		WorkflowIdentifier
@@ -256,6 +265,21 @@ type (
 		TaskList    string
 	}
 
+	// WorkflowDeadlineTask identifies a timer task requesting the cancellation of a workflow past its deadline
+	WorkflowDeadlineTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList string
+	}
+
+	// WorkflowDeadlineTerminateTask identifies a timer task terminating a workflow which did not
+	// close within the grace period of its deadline
+	WorkflowDeadlineTerminateTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList string
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		WorkflowIdentifier
@@ -296,10 +320,13 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*CompletionCallbackTask)(nil)
 	_ Task = (*ActivityTimeoutTask)(nil)
 	_ Task = (*UserTimerTask)(nil)
 	_ Task = (*ActivityRetryTimerTask)(nil)
 	_ Task = (*WorkflowBackoffTimerTask)(nil)
+	_ Task = (*WorkflowDeadlineTask)(nil)
+	_ Task = (*WorkflowDeadlineTerminateTask)(nil)
 	_ Task = (*HistoryReplicationTask)(nil)
 	_ Task = (*SyncActivityTask)(nil)
 	_ Task = (*FailoverMarkerTask)(nil)
@@ -1030,6 +1057,106 @@ func (r *WorkflowBackoffTimerTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("workflow backoff timer task is not replication task")
 }
 
+// GetTaskType returns the type of the workflow deadline task
+func (u *WorkflowDeadlineTask) GetTaskType() int {
+	return TaskTypeWorkflowDeadline
+}
+
+func (u *WorkflowDeadlineTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTimer
+}
+
+func (u *WorkflowDeadlineTask) GetTaskKey() HistoryTaskKey {
+	return NewHistoryTaskKey(u.VisibilityTimestamp, u.TaskID)
+}
+
+func (u *WorkflowDeadlineTask) GetTaskList() string {
+	return u.TaskList
+}
+
+func (u *WorkflowDeadlineTask) GetOriginalTaskList() string {
+	return u.TaskList
+}
+
+func (u *WorkflowDeadlineTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (u *WorkflowDeadlineTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize() + uint64(len(u.TaskList))
+}
+
+func (u *WorkflowDeadlineTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("workflow deadline task is not transfer task")
+}
+
+func (u *WorkflowDeadlineTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return &TimerTaskInfo{
+		TaskType:            TaskTypeWorkflowDeadline,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+		TaskList:            u.TaskList,
+	}, nil
+}
+
+func (u *WorkflowDeadlineTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("workflow deadline task is not replication task")
+}
+
+// GetTaskType returns the type of the workflow deadline terminate task
+func (u *WorkflowDeadlineTerminateTask) GetTaskType() int {
+	return TaskTypeWorkflowDeadlineTerminate
+}
+
+func (u *WorkflowDeadlineTerminateTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTimer
+}
+
+func (u *WorkflowDeadlineTerminateTask) GetTaskKey() HistoryTaskKey {
+	return NewHistoryTaskKey(u.VisibilityTimestamp, u.TaskID)
+}
+
+func (u *WorkflowDeadlineTerminateTask) GetTaskList() string {
+	return u.TaskList
+}
+
+func (u *WorkflowDeadlineTerminateTask) GetOriginalTaskList() string {
+	return u.TaskList
+}
+
+func (u *WorkflowDeadlineTerminateTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (u *WorkflowDeadlineTerminateTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize() + uint64(len(u.TaskList))
+}
+
+func (u *WorkflowDeadlineTerminateTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("workflow deadline terminate task is not transfer task")
+}
+
+func (u *WorkflowDeadlineTerminateTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return &TimerTaskInfo{
+		TaskType:            TaskTypeWorkflowDeadlineTerminate,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+		TaskList:            u.TaskList,
+	}, nil
+}
+
+func (u *WorkflowDeadlineTerminateTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("workflow deadline terminate task is not replication task")
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetTaskType() int {
 	return TaskTypeWorkflowTimeout
@@ -1415,6 +1542,60 @@ func (u *RecordWorkflowClosedTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("record workflow closed task is not replication task")
 }
 
+// GetType returns the type of the completion callback task
+func (u *CompletionCallbackTask) GetTaskType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+func (u *CompletionCallbackTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTransfer
+}
+
+func (u *CompletionCallbackTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(u.TaskID)
+}
+
+func (u *CompletionCallbackTask) GetTaskList() string {
+	return u.TaskList
+}
+
+func (u *CompletionCallbackTask) GetOriginalTaskList() string {
+	return u.TaskList
+}
+
+func (u *CompletionCallbackTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (u *CompletionCallbackTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize() + uint64(len(u.TaskList)) + 8
+}
+
+func (u *CompletionCallbackTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return &TransferTaskInfo{
+		TaskType:            TransferTaskTypeCompletionCallback,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+		TaskList:            u.TaskList,
+		TargetDomainID:      u.DomainID,
+		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
+		TargetRunID:         TransferTaskTransferTargetRunID,
+		ScheduleID:          u.CallbackIndex,
+	}, nil
+}
+
+func (u *CompletionCallbackTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not timer task")
+}
+
+func (u *CompletionCallbackTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not replication task")
+}
+
 // GetType returns the type of the history replication task
 func (a *HistoryReplicationTask) GetTaskType() int {
 	return ReplicationTaskTypeHistory
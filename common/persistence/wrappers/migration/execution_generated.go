@@ -0,0 +1,373 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/migration.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package migration
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	_sourcePersistence "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// migrationExecutionManager implements _sourcePersistence.ExecutionManager interface, it routes calls between the source
+// and the target datastore of a persistence migration.
+type migrationExecutionManager struct {
+	source        _sourcePersistence.ExecutionManager
+	target        _sourcePersistence.ExecutionManager
+	router        Router
+	metricsClient metrics.Client
+	logger        log.Logger
+}
+
+// NewExecutionManager creates a new instance of ExecutionManager migrating from source to target.
+func NewExecutionManager(
+	source persistence.ExecutionManager,
+	target persistence.ExecutionManager,
+	router Router,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) persistence.ExecutionManager {
+	return &migrationExecutionManager{
+		source:        source,
+		target:        target,
+		router:        router,
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+func (c *migrationExecutionManager) Close() {
+	c.source.Close()
+	c.target.Close()
+}
+
+func (c *migrationExecutionManager) CompleteHistoryTask(ctx context.Context, request *_sourcePersistence.CompleteHistoryTaskRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CompleteHistoryTask(ctx, request)
+	}
+	err = c.source.CompleteHistoryTask(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.CompleteHistoryTask") {
+		return
+	}
+	mirrorErr := c.target.CompleteHistoryTask(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.CompleteHistoryTask", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *_sourcePersistence.ConflictResolveWorkflowExecutionRequest) (cp1 *_sourcePersistence.ConflictResolveWorkflowExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ConflictResolveWorkflowExecution(ctx, request)
+	}
+	cp1, err = c.source.ConflictResolveWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.ConflictResolveWorkflowExecution") {
+		return
+	}
+	_, mirrorErr := c.target.ConflictResolveWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.ConflictResolveWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *_sourcePersistence.CreateFailoverMarkersRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CreateFailoverMarkerTasks(ctx, request)
+	}
+	err = c.source.CreateFailoverMarkerTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.CreateFailoverMarkerTasks") {
+		return
+	}
+	mirrorErr := c.target.CreateFailoverMarkerTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.CreateFailoverMarkerTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) CreateHistoryTasks(ctx context.Context, request *_sourcePersistence.CreateHistoryTasksRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CreateHistoryTasks(ctx, request)
+	}
+	err = c.source.CreateHistoryTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.CreateHistoryTasks") {
+		return
+	}
+	mirrorErr := c.target.CreateHistoryTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.CreateHistoryTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) CreateWorkflowExecution(ctx context.Context, request *_sourcePersistence.CreateWorkflowExecutionRequest) (cp1 *_sourcePersistence.CreateWorkflowExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CreateWorkflowExecution(ctx, request)
+	}
+	cp1, err = c.source.CreateWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.CreateWorkflowExecution") {
+		return
+	}
+	_, mirrorErr := c.target.CreateWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.CreateWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) DeleteActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.DeleteActiveClusterSelectionPolicyRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteActiveClusterSelectionPolicy(ctx, request)
+	}
+	err = c.source.DeleteActiveClusterSelectionPolicy(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.DeleteActiveClusterSelectionPolicy") {
+		return
+	}
+	mirrorErr := c.target.DeleteActiveClusterSelectionPolicy(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.DeleteActiveClusterSelectionPolicy", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteCurrentWorkflowExecution(ctx, request)
+	}
+	err = c.source.DeleteCurrentWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.DeleteCurrentWorkflowExecution") {
+		return
+	}
+	mirrorErr := c.target.DeleteCurrentWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.DeleteCurrentWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.DeleteReplicationTaskFromDLQRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteReplicationTaskFromDLQ(ctx, request)
+	}
+	err = c.source.DeleteReplicationTaskFromDLQ(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.DeleteReplicationTaskFromDLQ") {
+		return
+	}
+	mirrorErr := c.target.DeleteReplicationTaskFromDLQ(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.DeleteReplicationTaskFromDLQ", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteWorkflowExecutionRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteWorkflowExecution(ctx, request)
+	}
+	err = c.source.DeleteWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.DeleteWorkflowExecution") {
+		return
+	}
+	mirrorErr := c.target.DeleteWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.DeleteWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) FetchWorkflowTimerTasksForCleanup(ctx context.Context, request *_sourcePersistence.FetchWorkflowTimerTasksForCleanupRequest) (ha1 []_sourcePersistence.HistoryTaskKey, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.FetchWorkflowTimerTasksForCleanup(ctx, request)
+	}
+	ha1, err = c.source.FetchWorkflowTimerTasksForCleanup(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.FetchWorkflowTimerTasksForCleanup") {
+		return
+	}
+	_, mirrorErr := c.target.FetchWorkflowTimerTasksForCleanup(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.FetchWorkflowTimerTasksForCleanup", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.GetActiveClusterSelectionPolicyRequest) (ap1 *types.ActiveClusterSelectionPolicy, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetActiveClusterSelectionPolicy(ctx, request)
+	}
+	ap1, err = c.source.GetActiveClusterSelectionPolicy(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetActiveClusterSelectionPolicy") {
+		return
+	}
+	_, mirrorErr := c.target.GetActiveClusterSelectionPolicy(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetActiveClusterSelectionPolicy", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetCurrentExecution(ctx context.Context, request *_sourcePersistence.GetCurrentExecutionRequest) (gp1 *_sourcePersistence.GetCurrentExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetCurrentExecution(ctx, request)
+	}
+	gp1, err = c.source.GetCurrentExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetCurrentExecution") {
+		return
+	}
+	_, mirrorErr := c.target.GetCurrentExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetCurrentExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetHistoryTasks(ctx context.Context, request *_sourcePersistence.GetHistoryTasksRequest) (gp1 *_sourcePersistence.GetHistoryTasksResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetHistoryTasks(ctx, request)
+	}
+	gp1, err = c.source.GetHistoryTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetHistoryTasks") {
+		return
+	}
+	_, mirrorErr := c.target.GetHistoryTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetHistoryTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetName() (s1 string) {
+	return c.source.GetName()
+}
+
+func (c *migrationExecutionManager) GetReplicationDLQSize(ctx context.Context, request *_sourcePersistence.GetReplicationDLQSizeRequest) (gp1 *_sourcePersistence.GetReplicationDLQSizeResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetReplicationDLQSize(ctx, request)
+	}
+	gp1, err = c.source.GetReplicationDLQSize(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetReplicationDLQSize") {
+		return
+	}
+	_, mirrorErr := c.target.GetReplicationDLQSize(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetReplicationDLQSize", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *_sourcePersistence.GetReplicationTasksFromDLQRequest) (gp1 *_sourcePersistence.GetReplicationDLQTasksResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetReplicationTasksFromDLQ(ctx, request)
+	}
+	gp1, err = c.source.GetReplicationTasksFromDLQ(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetReplicationTasksFromDLQ") {
+		return
+	}
+	_, mirrorErr := c.target.GetReplicationTasksFromDLQ(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetReplicationTasksFromDLQ", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) GetWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetWorkflowExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetWorkflowExecution(ctx, request)
+	}
+	gp1, err = c.source.GetWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.GetWorkflowExecution") {
+		return
+	}
+	_, mirrorErr := c.target.GetWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.GetWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *_sourcePersistence.IsWorkflowExecutionExistsRequest) (ip1 *_sourcePersistence.IsWorkflowExecutionExistsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.IsWorkflowExecutionExists(ctx, request)
+	}
+	ip1, err = c.source.IsWorkflowExecutionExists(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.IsWorkflowExecutionExists") {
+		return
+	}
+	_, mirrorErr := c.target.IsWorkflowExecutionExists(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.IsWorkflowExecutionExists", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) ListConcreteExecutions(ctx context.Context, request *_sourcePersistence.ListConcreteExecutionsRequest) (lp1 *_sourcePersistence.ListConcreteExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListConcreteExecutions(ctx, request)
+	}
+	lp1, err = c.source.ListConcreteExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.ListConcreteExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ListConcreteExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.ListConcreteExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) ListCurrentExecutions(ctx context.Context, request *_sourcePersistence.ListCurrentExecutionsRequest) (lp1 *_sourcePersistence.ListCurrentExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListCurrentExecutions(ctx, request)
+	}
+	lp1, err = c.source.ListCurrentExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.ListCurrentExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ListCurrentExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.ListCurrentExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *_sourcePersistence.PutReplicationTaskToDLQRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.PutReplicationTaskToDLQ(ctx, request)
+	}
+	err = c.source.PutReplicationTaskToDLQ(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.PutReplicationTaskToDLQ") {
+		return
+	}
+	mirrorErr := c.target.PutReplicationTaskToDLQ(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.PutReplicationTaskToDLQ", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) RangeCompleteHistoryTask(ctx context.Context, request *_sourcePersistence.RangeCompleteHistoryTaskRequest) (rp1 *_sourcePersistence.RangeCompleteHistoryTaskResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.RangeCompleteHistoryTask(ctx, request)
+	}
+	rp1, err = c.source.RangeCompleteHistoryTask(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.RangeCompleteHistoryTask") {
+		return
+	}
+	_, mirrorErr := c.target.RangeCompleteHistoryTask(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.RangeCompleteHistoryTask", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *_sourcePersistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	}
+	rp1, err = c.source.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.RangeDeleteReplicationTaskFromDLQ") {
+		return
+	}
+	_, mirrorErr := c.target.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", request, mirrorErr)
+	return
+}
+
+func (c *migrationExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpdateWorkflowExecutionRequest) (up1 *_sourcePersistence.UpdateWorkflowExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.UpdateWorkflowExecution(ctx, request)
+	}
+	up1, err = c.source.UpdateWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("ExecutionManager.UpdateWorkflowExecution") {
+		return
+	}
+	_, mirrorErr := c.target.UpdateWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "ExecutionManager.UpdateWorkflowExecution", request, mirrorErr)
+	return
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Mode is the stage of a persistence migration, it decides which datastore serves a call
+type Mode string
+
+const (
+	// ModeSource serves all calls from the source datastore
+	ModeSource Mode = "source"
+	// ModeDualWrite serves reads from the source datastore and mirrors successful writes to the target datastore
+	ModeDualWrite Mode = "dual-write"
+	// ModeTarget serves all calls from the target datastore
+	ModeTarget Mode = "target"
+)
+
+// Modes lists all migration modes in the order a migration goes through them
+var Modes = []Mode{ModeSource, ModeDualWrite, ModeTarget}
+
+// ParseMode converts a dynamic config value into a Mode
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case ModeSource, ModeDualWrite, ModeTarget:
+		return m, nil
+	}
+	return ModeSource, fmt.Errorf("unknown persistence migration mode %q, valid modes are %v", s, Modes)
+}
+
+// Router decides the migration mode of a persistence request
+type Router interface {
+	Mode(request interface{}) Mode
+	// MirrorFailed is called when the request was written to the source datastore but not to the target datastore
+	MirrorFailed(request interface{})
+}
+
+type (
+	globalRouter struct {
+		mode   dynamicproperties.StringPropertyFn
+		logger log.Logger
+	}
+
+	shardRouter struct {
+		globalRouter
+		shardMode    dynamicproperties.StringPropertyFnWithShardIDFilter
+		verification ShardVerification
+		// unverified are the shards already logged as not verified
+		unverified sync.Map
+	}
+)
+
+// NewGlobalRouter returns a Router for data that is not owned by a history shard
+func NewGlobalRouter(mode dynamicproperties.StringPropertyFn, logger log.Logger) Router {
+	return &globalRouter{mode: mode, logger: logger}
+}
+
+// NewShardRouter returns a Router that migrates data shard by shard.
+// A shard in ModeTarget keeps being dual-written until the verification reports it, so that it's only
+// switched once a scan found no difference. Requests that are not bound to a shard, e.g. scans over
+// all history trees, follow the global mode.
+func NewShardRouter(
+	shardMode dynamicproperties.StringPropertyFnWithShardIDFilter,
+	globalMode dynamicproperties.StringPropertyFn,
+	verification ShardVerification,
+	logger log.Logger,
+) Router {
+	return &shardRouter{
+		globalRouter: globalRouter{mode: globalMode, logger: logger},
+		shardMode:    shardMode,
+		verification: verification,
+	}
+}
+
+func (r *globalRouter) Mode(interface{}) Mode {
+	return r.parse(r.mode())
+}
+
+func (r *globalRouter) MirrorFailed(interface{}) {}
+
+func (r *globalRouter) parse(value string) Mode {
+	mode, err := ParseMode(value)
+	if err != nil {
+		r.logger.Error("Invalid persistence migration mode, falling back to source", tag.Error(err))
+	}
+	return mode
+}
+
+func (r *shardRouter) Mode(request interface{}) Mode {
+	shardID, ok := requestShardID(request)
+	if !ok {
+		return r.globalRouter.Mode(request)
+	}
+	mode := r.parse(r.shardMode(shardID))
+	if mode == ModeTarget && !r.verification.IsVerified(shardID) {
+		if _, logged := r.unverified.LoadOrStore(shardID, struct{}{}); !logged {
+			r.logger.Warn("Shard is not verified for the persistence migration target, it keeps being dual-written", tag.ShardID(shardID))
+		}
+		return ModeDualWrite
+	}
+	return mode
+}
+
+// MirrorFailed unverifies the shard of the request, the target datastore missed a write so the shard has
+// to be copied and verified again before it can be switched
+func (r *shardRouter) MirrorFailed(request interface{}) {
+	shardID, ok := requestShardID(request)
+	if !ok || !r.verification.IsVerified(shardID) {
+		return
+	}
+	r.logger.Warn("Failed to mirror a write to a verified shard, the shard is no longer verified", tag.ShardID(shardID))
+	r.verification.Unverify(shardID)
+}
+
+func requestShardID(request interface{}) (int, bool) {
+	var shardID persistence.ShardID
+	switch r := request.(type) {
+	case *persistence.GetShardRequest:
+		return r.ShardID, true
+	case *persistence.CreateShardRequest:
+		return r.ShardInfo.ShardID, true
+	case *persistence.UpdateShardRequest:
+		return r.ShardInfo.ShardID, true
+	case *persistence.CreateWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.GetWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.UpdateWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.ConflictResolveWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.DeleteWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.DeleteCurrentWorkflowExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.GetCurrentExecutionRequest:
+		shardID = r.ShardID
+	case *persistence.IsWorkflowExecutionExistsRequest:
+		shardID = r.ShardID
+	case *persistence.ListConcreteExecutionsRequest:
+		shardID = r.ShardID
+	case *persistence.ListCurrentExecutionsRequest:
+		shardID = r.ShardID
+	case *persistence.PutReplicationTaskToDLQRequest:
+		shardID = r.ShardID
+	case *persistence.GetReplicationTasksFromDLQRequest:
+		shardID = r.ShardID
+	case *persistence.GetReplicationDLQSizeRequest:
+		shardID = r.ShardID
+	case *persistence.DeleteReplicationTaskFromDLQRequest:
+		shardID = r.ShardID
+	case *persistence.RangeDeleteReplicationTaskFromDLQRequest:
+		shardID = r.ShardID
+	case *persistence.CreateFailoverMarkersRequest:
+		shardID = r.ShardID
+	case *persistence.CreateHistoryTasksRequest:
+		shardID = r.ShardID
+	case *persistence.GetHistoryTasksRequest:
+		shardID = r.ShardID
+	case *persistence.CompleteHistoryTaskRequest:
+		shardID = r.ShardID
+	case *persistence.RangeCompleteHistoryTaskRequest:
+		shardID = r.ShardID
+	case *persistence.FetchWorkflowTimerTasksForCleanupRequest:
+		shardID = r.ShardID
+	case *persistence.GetActiveClusterSelectionPolicyRequest:
+		shardID = r.ShardID
+	case *persistence.DeleteActiveClusterSelectionPolicyRequest:
+		shardID = r.ShardID
+	case *persistence.AppendHistoryNodesRequest:
+		shardID = r.ShardID
+	case *persistence.ReadHistoryBranchRequest:
+		shardID = r.ShardID
+	case *persistence.ForkHistoryBranchRequest:
+		shardID = r.ShardID
+	case *persistence.DeleteHistoryBranchRequest:
+		shardID = r.ShardID
+	case *persistence.GetHistoryTreeRequest:
+		shardID = r.ShardID
+	}
+	if shardID == nil {
+		return 0, false
+	}
+	return *shardID, true
+}
+
+// mirroredOperations are the writes that are repeated on the target datastore in ModeDualWrite.
+// Everything else only goes to the datastore that serves reads. In particular:
+//   - ForkHistoryBranch generates a new branch ID, so it can't be repeated. The nodes appended to the
+//     forked branch are still mirrored, and the branch token carries the ancestors needed to read them back.
+//   - Task list leases and tasks are owned by the range ID of the store that issued them, they are
+//     recreated on the target instead, see docs/persistence-migration.md.
+var mirroredOperations = map[string]bool{
+	"ShardManager.CreateShard": true,
+	"ShardManager.UpdateShard": true,
+
+	"ExecutionManager.CreateWorkflowExecution":            true,
+	"ExecutionManager.UpdateWorkflowExecution":            true,
+	"ExecutionManager.ConflictResolveWorkflowExecution":   true,
+	"ExecutionManager.DeleteWorkflowExecution":            true,
+	"ExecutionManager.DeleteCurrentWorkflowExecution":     true,
+	"ExecutionManager.PutReplicationTaskToDLQ":            true,
+	"ExecutionManager.DeleteReplicationTaskFromDLQ":       true,
+	"ExecutionManager.RangeDeleteReplicationTaskFromDLQ":  true,
+	"ExecutionManager.CreateFailoverMarkerTasks":          true,
+	"ExecutionManager.CreateHistoryTasks":                 true,
+	"ExecutionManager.CompleteHistoryTask":                true,
+	"ExecutionManager.RangeCompleteHistoryTask":           true,
+	"ExecutionManager.DeleteActiveClusterSelectionPolicy": true,
+
+	"HistoryManager.AppendHistoryNodes":  true,
+	"HistoryManager.DeleteHistoryBranch": true,
+
+	"DomainManager.CreateDomain":       true,
+	"DomainManager.UpdateDomain":       true,
+	"DomainManager.DeleteDomain":       true,
+	"DomainManager.DeleteDomainByName": true,
+
+	"VisibilityManager.RecordWorkflowExecutionStarted":       true,
+	"VisibilityManager.RecordWorkflowExecutionClosed":        true,
+	"VisibilityManager.RecordWorkflowExecutionUninitialized": true,
+	"VisibilityManager.UpsertWorkflowExecution":              true,
+	"VisibilityManager.DeleteWorkflowExecution":              true,
+	"VisibilityManager.DeleteUninitializedWorkflowExecution": true,
+}
+
+func isMirrored(operation string) bool {
+	return mirroredOperations[operation]
+}
+
+// mirror reports the outcome of repeating a write on the target datastore.
+// Failures are not returned to the caller: the source datastore is still the source of truth,
+// and the execution copy pass brings the target back in sync. The router unverifies the shard
+// of a failed write, so that it isn't switched to the target before it's copied and verified again.
+func mirror(router Router, metricsClient metrics.Client, logger log.Logger, operation string, request interface{}, err error) {
+	scope := metricsClient.Scope(metrics.PersistenceMigrationScope, metrics.PersistenceOperationTag(operation))
+	scope.IncCounter(metrics.PersistenceMigrationMirroredRequests)
+	if err != nil {
+		scope.IncCounter(metrics.PersistenceMigrationMirrorFailures)
+		logger.Warn("Failed to mirror persistence write to migration target", tag.OperationName(operation), tag.Error(err))
+		router.MirrorFailed(request)
+	}
+}
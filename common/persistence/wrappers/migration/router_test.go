@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestParseMode(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    Mode
+		wantErr bool
+	}{
+		"source":     {value: "source", want: ModeSource},
+		"dual-write": {value: "dual-write", want: ModeDualWrite},
+		"target":     {value: "target", want: ModeTarget},
+		"empty":      {value: "", want: ModeSource, wantErr: true},
+		"unknown":    {value: "cassandra", want: ModeSource, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseMode(tc.value)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestShardRouter(t *testing.T) {
+	shardModes := map[int]string{
+		1: "dual-write",
+		2: "target",
+		3: "invalid",
+		4: "target",
+	}
+	router := NewShardRouter(
+		func(shardID int) string {
+			if mode, ok := shardModes[shardID]; ok {
+				return mode
+			}
+			return "source"
+		},
+		dynamicproperties.GetStringPropertyFn("target"),
+		NewStaticShardVerification(2),
+		testlogger.New(t),
+	)
+
+	tests := map[string]struct {
+		request interface{}
+		want    Mode
+	}{
+		"get shard": {
+			request: &persistence.GetShardRequest{ShardID: 1},
+			want:    ModeDualWrite,
+		},
+		"update shard": {
+			request: &persistence.UpdateShardRequest{ShardInfo: &persistence.ShardInfo{ShardID: 2}},
+			want:    ModeTarget,
+		},
+		"execution in a source shard": {
+			request: &persistence.UpdateWorkflowExecutionRequest{ShardID: common.IntPtr(0)},
+			want:    ModeSource,
+		},
+		"execution in a dual-write shard": {
+			request: &persistence.GetWorkflowExecutionRequest{ShardID: common.IntPtr(1)},
+			want:    ModeDualWrite,
+		},
+		"history in a target shard": {
+			request: &persistence.AppendHistoryNodesRequest{ShardID: common.IntPtr(2)},
+			want:    ModeTarget,
+		},
+		"unverified target shard is dual-written": {
+			request: &persistence.GetWorkflowExecutionRequest{ShardID: common.IntPtr(4)},
+			want:    ModeDualWrite,
+		},
+		"invalid shard mode falls back to source": {
+			request: &persistence.AppendHistoryNodesRequest{ShardID: common.IntPtr(3)},
+			want:    ModeSource,
+		},
+		"request without shard follows the global mode": {
+			request: &persistence.GetAllHistoryTreeBranchesRequest{},
+			want:    ModeTarget,
+		},
+		"nil shard follows the global mode": {
+			request: &persistence.ReadHistoryBranchRequest{},
+			want:    ModeTarget,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, router.Mode(tc.request))
+		})
+	}
+}
+
+func TestExecutionManagerRouting(t *testing.T) {
+	errSource := errors.New("source error")
+	errTarget := errors.New("target error")
+	updateRequest := &persistence.UpdateWorkflowExecutionRequest{ShardID: common.IntPtr(1)}
+	getRequest := &persistence.GetWorkflowExecutionRequest{ShardID: common.IntPtr(1)}
+
+	tests := map[string]struct {
+		mode           Mode
+		read           bool
+		setup          func(source, target *persistence.MockExecutionManager)
+		wantErr        error
+		wantUnverified bool
+	}{
+		"source mode writes to source only": {
+			mode: ModeSource,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				source.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+			},
+		},
+		"dual-write mode mirrors writes": {
+			mode: ModeDualWrite,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				source.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+				target.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+			},
+		},
+		"dual-write mode ignores mirror failures and unverifies the shard": {
+			mode: ModeDualWrite,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				source.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+				target.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(nil, errTarget)
+			},
+			wantUnverified: true,
+		},
+		"dual-write mode doesn't mirror failed writes": {
+			mode: ModeDualWrite,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				source.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(nil, errSource)
+			},
+			wantErr: errSource,
+		},
+		"dual-write mode reads from source": {
+			mode: ModeDualWrite,
+			read: true,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				source.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest).Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+			},
+		},
+		"target mode writes to target only": {
+			mode: ModeTarget,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				target.EXPECT().UpdateWorkflowExecution(gomock.Any(), updateRequest).Return(nil, errTarget)
+			},
+			wantErr: errTarget,
+		},
+		"target mode reads from target": {
+			mode: ModeTarget,
+			read: true,
+			setup: func(source, target *persistence.MockExecutionManager) {
+				target.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest).Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			source := persistence.NewMockExecutionManager(ctrl)
+			target := persistence.NewMockExecutionManager(ctrl)
+			tc.setup(source, target)
+
+			verification := NewStaticShardVerification(1)
+			router := NewShardRouter(
+				dynamicproperties.GetStringPropertyFnFilteredByShardID(string(tc.mode)),
+				dynamicproperties.GetStringPropertyFn(string(ModeSource)),
+				verification,
+				testlogger.New(t),
+			)
+			manager := NewExecutionManager(source, target, router, metrics.NewNoopMetricsClient(), testlogger.New(t))
+
+			var err error
+			if tc.read {
+				_, err = manager.GetWorkflowExecution(context.Background(), getRequest)
+			} else {
+				_, err = manager.UpdateWorkflowExecution(context.Background(), updateRequest)
+			}
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, !tc.wantUnverified, verification.IsVerified(1))
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	source := persistence.NewMockShardManager(ctrl)
+	target := persistence.NewMockShardManager(ctrl)
+	source.EXPECT().Close()
+	target.EXPECT().Close()
+
+	router := NewGlobalRouter(dynamicproperties.GetStringPropertyFn(string(ModeSource)), testlogger.New(t))
+	NewShardManager(source, target, router, metrics.NewNoopMetricsClient(), testlogger.New(t)).Close()
+}
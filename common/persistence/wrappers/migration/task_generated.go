@@ -0,0 +1,204 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/migration.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package migration
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	_sourcePersistence "github.com/uber/cadence/common/persistence"
+)
+
+// migrationTaskManager implements _sourcePersistence.TaskManager interface, it routes calls between the source
+// and the target datastore of a persistence migration.
+type migrationTaskManager struct {
+	source        _sourcePersistence.TaskManager
+	target        _sourcePersistence.TaskManager
+	router        Router
+	metricsClient metrics.Client
+	logger        log.Logger
+}
+
+// NewTaskManager creates a new instance of TaskManager migrating from source to target.
+func NewTaskManager(
+	source persistence.TaskManager,
+	target persistence.TaskManager,
+	router Router,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) persistence.TaskManager {
+	return &migrationTaskManager{
+		source:        source,
+		target:        target,
+		router:        router,
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+func (c *migrationTaskManager) Close() {
+	c.source.Close()
+	c.target.Close()
+}
+
+func (c *migrationTaskManager) CompleteTask(ctx context.Context, request *_sourcePersistence.CompleteTaskRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CompleteTask(ctx, request)
+	}
+	err = c.source.CompleteTask(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.CompleteTask") {
+		return
+	}
+	mirrorErr := c.target.CompleteTask(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.CompleteTask", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) CompleteTasksLessThan(ctx context.Context, request *_sourcePersistence.CompleteTasksLessThanRequest) (cp1 *_sourcePersistence.CompleteTasksLessThanResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CompleteTasksLessThan(ctx, request)
+	}
+	cp1, err = c.source.CompleteTasksLessThan(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.CompleteTasksLessThan") {
+		return
+	}
+	_, mirrorErr := c.target.CompleteTasksLessThan(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.CompleteTasksLessThan", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) CreateTasks(ctx context.Context, request *_sourcePersistence.CreateTasksRequest) (cp1 *_sourcePersistence.CreateTasksResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CreateTasks(ctx, request)
+	}
+	cp1, err = c.source.CreateTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.CreateTasks") {
+		return
+	}
+	_, mirrorErr := c.target.CreateTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.CreateTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) DeleteTaskList(ctx context.Context, request *_sourcePersistence.DeleteTaskListRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteTaskList(ctx, request)
+	}
+	err = c.source.DeleteTaskList(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.DeleteTaskList") {
+		return
+	}
+	mirrorErr := c.target.DeleteTaskList(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.DeleteTaskList", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) GetName() (s1 string) {
+	return c.source.GetName()
+}
+
+func (c *migrationTaskManager) GetOrphanTasks(ctx context.Context, request *_sourcePersistence.GetOrphanTasksRequest) (gp1 *_sourcePersistence.GetOrphanTasksResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetOrphanTasks(ctx, request)
+	}
+	gp1, err = c.source.GetOrphanTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.GetOrphanTasks") {
+		return
+	}
+	_, mirrorErr := c.target.GetOrphanTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.GetOrphanTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) GetTaskList(ctx context.Context, request *_sourcePersistence.GetTaskListRequest) (gp1 *_sourcePersistence.GetTaskListResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetTaskList(ctx, request)
+	}
+	gp1, err = c.source.GetTaskList(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.GetTaskList") {
+		return
+	}
+	_, mirrorErr := c.target.GetTaskList(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.GetTaskList", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) GetTaskListSize(ctx context.Context, request *_sourcePersistence.GetTaskListSizeRequest) (gp1 *_sourcePersistence.GetTaskListSizeResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetTaskListSize(ctx, request)
+	}
+	gp1, err = c.source.GetTaskListSize(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.GetTaskListSize") {
+		return
+	}
+	_, mirrorErr := c.target.GetTaskListSize(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.GetTaskListSize", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) GetTasks(ctx context.Context, request *_sourcePersistence.GetTasksRequest) (gp1 *_sourcePersistence.GetTasksResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetTasks(ctx, request)
+	}
+	gp1, err = c.source.GetTasks(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.GetTasks") {
+		return
+	}
+	_, mirrorErr := c.target.GetTasks(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.GetTasks", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) LeaseTaskList(ctx context.Context, request *_sourcePersistence.LeaseTaskListRequest) (lp1 *_sourcePersistence.LeaseTaskListResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.LeaseTaskList(ctx, request)
+	}
+	lp1, err = c.source.LeaseTaskList(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.LeaseTaskList") {
+		return
+	}
+	_, mirrorErr := c.target.LeaseTaskList(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.LeaseTaskList", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) ListTaskList(ctx context.Context, request *_sourcePersistence.ListTaskListRequest) (lp1 *_sourcePersistence.ListTaskListResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListTaskList(ctx, request)
+	}
+	lp1, err = c.source.ListTaskList(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.ListTaskList") {
+		return
+	}
+	_, mirrorErr := c.target.ListTaskList(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.ListTaskList", request, mirrorErr)
+	return
+}
+
+func (c *migrationTaskManager) UpdateTaskList(ctx context.Context, request *_sourcePersistence.UpdateTaskListRequest) (up1 *_sourcePersistence.UpdateTaskListResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.UpdateTaskList(ctx, request)
+	}
+	up1, err = c.source.UpdateTaskList(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("TaskManager.UpdateTaskList") {
+		return
+	}
+	_, mirrorErr := c.target.UpdateTaskList(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "TaskManager.UpdateTaskList", request, mirrorErr)
+	return
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// verifiedShardsEntry is the config store entry with the list of verified shards
+	verifiedShardsEntry = "verifiedShards"
+
+	verificationRefreshInterval = 10 * time.Second
+	verificationFetchTimeout    = 5 * time.Second
+	verificationUpdateTimeout   = 5 * time.Second
+	verificationUpdateAttempts  = 3
+)
+
+type (
+	// ShardVerification tells which shards were scanned without finding a difference between the datastores.
+	// A shard is only served by the target datastore once it is verified.
+	ShardVerification interface {
+		IsVerified(shardID int) bool
+		// Unverify removes a shard from the verified shards, so that it's copied and verified again
+		// before it's served by the target datastore
+		Unverify(shardID int)
+	}
+
+	shardVerification struct {
+		manager     persistence.ConfigStoreManager
+		timeSource  clock.TimeSource
+		logger      log.Logger
+		shards      atomic.Pointer[verifiedShards]
+		refreshedAt atomic.Int64
+		refreshing  atomic.Bool
+	}
+
+	// verifiedShards are the shards recorded in a version of the config store entry
+	verifiedShards struct {
+		shards  map[int]struct{}
+		version int64
+	}
+
+	staticShardVerification struct {
+		sync.RWMutex
+		shards map[int]struct{}
+	}
+)
+
+// NewShardVerification returns a ShardVerification that reads the verified shards from the config store
+// and refreshes them in the background
+func NewShardVerification(manager persistence.ConfigStoreManager, timeSource clock.TimeSource, logger log.Logger) ShardVerification {
+	v := &shardVerification{
+		manager:    manager,
+		timeSource: timeSource,
+		logger:     logger,
+	}
+	v.shards.Store(&verifiedShards{shards: map[int]struct{}{}})
+	v.refreshedAt.Store(timeSource.Now().UnixNano())
+	v.refresh()
+	return v
+}
+
+// NewStaticShardVerification returns a ShardVerification of a fixed set of shards that is not persisted
+func NewStaticShardVerification(shardIDs ...int) ShardVerification {
+	v := &staticShardVerification{shards: make(map[int]struct{}, len(shardIDs))}
+	for _, shardID := range shardIDs {
+		v.shards[shardID] = struct{}{}
+	}
+	return v
+}
+
+func (v *staticShardVerification) IsVerified(shardID int) bool {
+	v.RLock()
+	defer v.RUnlock()
+	_, ok := v.shards[shardID]
+	return ok
+}
+
+func (v *staticShardVerification) Unverify(shardID int) {
+	v.Lock()
+	defer v.Unlock()
+	delete(v.shards, shardID)
+}
+
+func (v *shardVerification) IsVerified(shardID int) bool {
+	now := v.timeSource.Now()
+	if now.Sub(time.Unix(0, v.refreshedAt.Load())) >= verificationRefreshInterval && v.refreshing.CompareAndSwap(false, true) {
+		v.refreshedAt.Store(now.UnixNano())
+		go func() {
+			defer v.refreshing.Store(false)
+			v.refresh()
+		}()
+	}
+	_, ok := v.shards.Load().shards[shardID]
+	return ok
+}
+
+// Unverify removes the shard from the config store first, so that a refresh can't bring it back.
+// The shard is removed locally even if the config store can't be updated, which keeps it dual-written
+// by this host until the next refresh.
+func (v *shardVerification) Unverify(shardID int) {
+	ctx, cancel := context.WithTimeout(context.Background(), verificationUpdateTimeout)
+	defer cancel()
+	shards, version, err := updateVerifiedShards(ctx, v.manager, func(shards map[int]struct{}) {
+		delete(shards, shardID)
+	})
+	if err == nil {
+		v.store(shards, version)
+		return
+	}
+	v.logger.Error("Failed to remove the shard from the shards verified for persistence migration", tag.ShardID(shardID), tag.Error(err))
+	for {
+		current := v.shards.Load()
+		remaining := make(map[int]struct{}, len(current.shards))
+		for id := range current.shards {
+			remaining[id] = struct{}{}
+		}
+		delete(remaining, shardID)
+		if v.shards.CompareAndSwap(current, &verifiedShards{shards: remaining, version: current.version}) {
+			return
+		}
+	}
+}
+
+func (v *shardVerification) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), verificationFetchTimeout)
+	defer cancel()
+	shards, version, err := FetchVerifiedShards(ctx, v.manager)
+	if err != nil {
+		v.logger.Error("Failed to fetch the shards verified for persistence migration", tag.Error(err))
+		return
+	}
+	v.store(shards, version)
+}
+
+// store replaces the verified shards unless a newer version was stored meanwhile
+func (v *shardVerification) store(shards map[int]struct{}, version int64) {
+	next := &verifiedShards{shards: shards, version: version}
+	for {
+		current := v.shards.Load()
+		if current.version > version || v.shards.CompareAndSwap(current, next) {
+			return
+		}
+	}
+}
+
+// FetchVerifiedShards returns the verified shards and the version of the config store entry they were read from
+func FetchVerifiedShards(ctx context.Context, manager persistence.ConfigStoreManager) (map[int]struct{}, int64, error) {
+	resp, err := manager.FetchDynamicConfig(ctx, persistence.PersistenceMigrationConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	shards := map[int]struct{}{}
+	if resp == nil || resp.Snapshot == nil {
+		return shards, 0, nil
+	}
+	if resp.Snapshot.Values == nil {
+		return shards, resp.Snapshot.Version, nil
+	}
+	for _, entry := range resp.Snapshot.Values.Entries {
+		if entry == nil || entry.Name != verifiedShardsEntry {
+			continue
+		}
+		for _, value := range entry.Values {
+			if value == nil {
+				continue
+			}
+			var shardIDs []int
+			if err := json.Unmarshal(value.Value.GetData(), &shardIDs); err != nil {
+				return nil, 0, fmt.Errorf("decode verified shards: %w", err)
+			}
+			for _, shardID := range shardIDs {
+				shards[shardID] = struct{}{}
+			}
+		}
+	}
+	return shards, resp.Snapshot.Version, nil
+}
+
+// AddVerifiedShards records the shards as verified
+func AddVerifiedShards(ctx context.Context, manager persistence.ConfigStoreManager, shardIDs []int) error {
+	_, _, err := updateVerifiedShards(ctx, manager, func(shards map[int]struct{}) {
+		for _, shardID := range shardIDs {
+			shards[shardID] = struct{}{}
+		}
+	})
+	return err
+}
+
+// RemoveVerifiedShards removes the shards from the verified shards. Shards are only removed when a write
+// mirrored to the target datastore failed while they were still dual-written, a shard that is already
+// served by the target datastore doesn't go back to the source.
+func RemoveVerifiedShards(ctx context.Context, manager persistence.ConfigStoreManager, shardIDs []int) error {
+	_, _, err := updateVerifiedShards(ctx, manager, func(shards map[int]struct{}) {
+		for _, shardID := range shardIDs {
+			delete(shards, shardID)
+		}
+	})
+	return err
+}
+
+// updateVerifiedShards applies the update to the verified shards and returns the shards and the version it recorded
+func updateVerifiedShards(
+	ctx context.Context,
+	manager persistence.ConfigStoreManager,
+	update func(shards map[int]struct{}),
+) (map[int]struct{}, int64, error) {
+	var err error
+	for attempt := 0; attempt < verificationUpdateAttempts; attempt++ {
+		var shards map[int]struct{}
+		var version int64
+		shards, version, err = tryUpdateVerifiedShards(ctx, manager, update)
+		var conditionFailed *persistence.ConditionFailedError
+		if !errors.As(err, &conditionFailed) {
+			return shards, version, err
+		}
+	}
+	return nil, 0, err
+}
+
+func tryUpdateVerifiedShards(
+	ctx context.Context,
+	manager persistence.ConfigStoreManager,
+	update func(shards map[int]struct{}),
+) (map[int]struct{}, int64, error) {
+	shards, version, err := FetchVerifiedShards(ctx, manager)
+	if err != nil {
+		return nil, 0, err
+	}
+	update(shards)
+	verified := make([]int, 0, len(shards))
+	for shardID := range shards {
+		verified = append(verified, shardID)
+	}
+	sort.Ints(verified)
+	data, err := json.Marshal(verified)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = manager.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{
+		Snapshot: &persistence.DynamicConfigSnapshot{
+			Version: version + 1,
+			Values: &types.DynamicConfigBlob{
+				Entries: []*types.DynamicConfigEntry{{
+					Name: verifiedShardsEntry,
+					Values: []*types.DynamicConfigValue{{
+						Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: data},
+					}},
+				}},
+			},
+		},
+	}, persistence.PersistenceMigrationConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	return shards, version + 1, nil
+}
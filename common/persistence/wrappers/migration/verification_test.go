@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func verifiedShardsSnapshot(version int64, data string) *persistence.FetchDynamicConfigResponse {
+	return &persistence.FetchDynamicConfigResponse{Snapshot: &persistence.DynamicConfigSnapshot{
+		Version: version,
+		Values: &types.DynamicConfigBlob{Entries: []*types.DynamicConfigEntry{{
+			Name:   verifiedShardsEntry,
+			Values: []*types.DynamicConfigValue{{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(data)}}},
+		}}},
+	}}
+}
+
+func TestFetchVerifiedShards(t *testing.T) {
+	tests := map[string]struct {
+		resp        *persistence.FetchDynamicConfigResponse
+		err         error
+		wantShards  map[int]struct{}
+		wantVersion int64
+		wantErr     bool
+	}{
+		"no entry": {
+			wantShards: map[int]struct{}{},
+		},
+		"verified shards": {
+			resp:        verifiedShardsSnapshot(3, "[1,4]"),
+			wantShards:  map[int]struct{}{1: {}, 4: {}},
+			wantVersion: 3,
+		},
+		"invalid entry": {
+			resp:    verifiedShardsSnapshot(3, "1,4"),
+			wantErr: true,
+		},
+		"fetch error": {
+			err:     errors.New("fetch failed"),
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+			manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(tc.resp, tc.err)
+
+			shards, version, err := FetchVerifiedShards(context.Background(), manager)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantShards, shards)
+			assert.Equal(t, tc.wantVersion, version)
+		})
+	}
+}
+
+func TestAddVerifiedShards(t *testing.T) {
+	manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+	gomock.InOrder(
+		manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(3, "[4]"), nil),
+		manager.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), persistence.PersistenceMigrationConfig).
+			Return(&persistence.ConditionFailedError{}),
+		manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(4, "[4,5]"), nil),
+		manager.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), persistence.PersistenceMigrationConfig).
+			DoAndReturn(func(_ context.Context, request *persistence.UpdateDynamicConfigRequest, _ persistence.ConfigType) error {
+				assert.Equal(t, verifiedShardsSnapshot(5, "[1,4,5]").Snapshot, request.Snapshot)
+				return nil
+			}),
+	)
+	assert.NoError(t, AddVerifiedShards(context.Background(), manager, []int{1, 4}))
+}
+
+func TestRemoveVerifiedShards(t *testing.T) {
+	manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+	manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(3, "[1,4,5]"), nil)
+	manager.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), persistence.PersistenceMigrationConfig).
+		DoAndReturn(func(_ context.Context, request *persistence.UpdateDynamicConfigRequest, _ persistence.ConfigType) error {
+			assert.Equal(t, verifiedShardsSnapshot(4, "[5]").Snapshot, request.Snapshot)
+			return nil
+		})
+	assert.NoError(t, RemoveVerifiedShards(context.Background(), manager, []int{1, 4}))
+}
+
+func TestShardVerificationUnverify(t *testing.T) {
+	tests := map[string]struct {
+		updateErr error
+	}{
+		"recorded":     {},
+		"not recorded": {updateErr: errors.New("update failed")},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+			manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(1, "[1,2]"), nil).Times(2)
+			manager.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), persistence.PersistenceMigrationConfig).Return(tc.updateErr)
+			verification := NewShardVerification(manager, clock.NewMockedTimeSource(), testlogger.New(t))
+			require.True(t, verification.IsVerified(1))
+
+			verification.Unverify(1)
+			assert.False(t, verification.IsVerified(1))
+			assert.True(t, verification.IsVerified(2))
+		})
+	}
+}
+
+func TestShardVerificationIgnoresStaleRefresh(t *testing.T) {
+	manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+	timeSource := clock.NewMockedTimeSource()
+	v := &shardVerification{manager: manager, timeSource: timeSource, logger: testlogger.New(t)}
+	v.refreshedAt.Store(timeSource.Now().UnixNano())
+	v.shards.Store(&verifiedShards{shards: map[int]struct{}{2: {}}, version: 2})
+
+	manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(1, "[1,2]"), nil)
+	v.refresh()
+	assert.False(t, v.IsVerified(1))
+	assert.True(t, v.IsVerified(2))
+}
+
+func TestShardVerificationRefresh(t *testing.T) {
+	manager := persistence.NewMockConfigStoreManager(gomock.NewController(t))
+	timeSource := clock.NewMockedTimeSource()
+	manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(1, "[1]"), nil)
+	verification := NewShardVerification(manager, timeSource, testlogger.New(t))
+	assert.True(t, verification.IsVerified(1))
+	assert.False(t, verification.IsVerified(2))
+
+	manager.EXPECT().FetchDynamicConfig(gomock.Any(), persistence.PersistenceMigrationConfig).Return(verifiedShardsSnapshot(2, "[1,2]"), nil)
+	timeSource.Advance(verificationRefreshInterval)
+	assert.Eventually(t, func() bool { return verification.IsVerified(2) }, time.Second, 10*time.Millisecond)
+}
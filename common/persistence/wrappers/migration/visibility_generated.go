@@ -0,0 +1,288 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/migration.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package migration
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	_sourcePersistence "github.com/uber/cadence/common/persistence"
+)
+
+// migrationVisibilityManager implements _sourcePersistence.VisibilityManager interface, it routes calls between the source
+// and the target datastore of a persistence migration.
+type migrationVisibilityManager struct {
+	source        _sourcePersistence.VisibilityManager
+	target        _sourcePersistence.VisibilityManager
+	router        Router
+	metricsClient metrics.Client
+	logger        log.Logger
+}
+
+// NewVisibilityManager creates a new instance of VisibilityManager migrating from source to target.
+func NewVisibilityManager(
+	source persistence.VisibilityManager,
+	target persistence.VisibilityManager,
+	router Router,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) persistence.VisibilityManager {
+	return &migrationVisibilityManager{
+		source:        source,
+		target:        target,
+		router:        router,
+		metricsClient: metricsClient,
+		logger:        logger,
+	}
+}
+
+func (c *migrationVisibilityManager) Close() {
+	c.source.Close()
+	c.target.Close()
+}
+
+func (c *migrationVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *_sourcePersistence.CountWorkflowExecutionsRequest) (cp1 *_sourcePersistence.CountWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.CountWorkflowExecutions(ctx, request)
+	}
+	cp1, err = c.source.CountWorkflowExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.CountWorkflowExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.CountWorkflowExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.CountWorkflowExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteUninitializedWorkflowExecution(ctx, request)
+	}
+	err = c.source.DeleteUninitializedWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.DeleteUninitializedWorkflowExecution") {
+		return
+	}
+	mirrorErr := c.target.DeleteUninitializedWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.DeleteUninitializedWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.DeleteWorkflowExecution(ctx, request)
+	}
+	err = c.source.DeleteWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.DeleteWorkflowExecution") {
+		return
+	}
+	mirrorErr := c.target.DeleteWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.DeleteWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetClosedWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetClosedWorkflowExecutionResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.GetClosedWorkflowExecution(ctx, request)
+	}
+	gp1, err = c.source.GetClosedWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.GetClosedWorkflowExecution") {
+		return
+	}
+	_, mirrorErr := c.target.GetClosedWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.GetClosedWorkflowExecution", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) GetName() (s1 string) {
+	return c.source.GetName()
+}
+
+func (c *migrationVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListClosedWorkflowExecutions(ctx, request)
+	}
+	lp1, err = c.source.ListClosedWorkflowExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListClosedWorkflowExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ListClosedWorkflowExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *_sourcePersistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	}
+	lp1, err = c.source.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListClosedWorkflowExecutionsByStatus") {
+		return
+	}
+	_, mirrorErr := c.target.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListClosedWorkflowExecutionsByType(ctx, request)
+	}
+	lp1, err = c.source.ListClosedWorkflowExecutionsByType(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListClosedWorkflowExecutionsByType") {
+		return
+	}
+	_, mirrorErr := c.target.ListClosedWorkflowExecutionsByType(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByType", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	}
+	lp1, err = c.source.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID") {
+		return
+	}
+	_, mirrorErr := c.target.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListOpenWorkflowExecutions(ctx, request)
+	}
+	lp1, err = c.source.ListOpenWorkflowExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListOpenWorkflowExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ListOpenWorkflowExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListOpenWorkflowExecutionsByType(ctx, request)
+	}
+	lp1, err = c.source.ListOpenWorkflowExecutionsByType(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListOpenWorkflowExecutionsByType") {
+		return
+	}
+	_, mirrorErr := c.target.ListOpenWorkflowExecutionsByType(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByType", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	}
+	lp1, err = c.source.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID") {
+		return
+	}
+	_, mirrorErr := c.target.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ListWorkflowExecutions(ctx, request)
+	}
+	lp1, err = c.source.ListWorkflowExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ListWorkflowExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ListWorkflowExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ListWorkflowExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionClosedRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.RecordWorkflowExecutionClosed(ctx, request)
+	}
+	err = c.source.RecordWorkflowExecutionClosed(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.RecordWorkflowExecutionClosed") {
+		return
+	}
+	mirrorErr := c.target.RecordWorkflowExecutionClosed(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionClosed", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionStartedRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.RecordWorkflowExecutionStarted(ctx, request)
+	}
+	err = c.source.RecordWorkflowExecutionStarted(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.RecordWorkflowExecutionStarted") {
+		return
+	}
+	mirrorErr := c.target.RecordWorkflowExecutionStarted(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionStarted", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.RecordWorkflowExecutionUninitialized(ctx, request)
+	}
+	err = c.source.RecordWorkflowExecutionUninitialized(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.RecordWorkflowExecutionUninitialized") {
+		return
+	}
+	mirrorErr := c.target.RecordWorkflowExecutionUninitialized(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionUninitialized", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.ScanWorkflowExecutions(ctx, request)
+	}
+	lp1, err = c.source.ScanWorkflowExecutions(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.ScanWorkflowExecutions") {
+		return
+	}
+	_, mirrorErr := c.target.ScanWorkflowExecutions(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.ScanWorkflowExecutions", request, mirrorErr)
+	return
+}
+
+func (c *migrationVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpsertWorkflowExecutionRequest) (err error) {
+	mode := c.router.Mode(request)
+	if mode == ModeTarget {
+		return c.target.UpsertWorkflowExecution(ctx, request)
+	}
+	err = c.source.UpsertWorkflowExecution(ctx, request)
+	if err != nil || mode != ModeDualWrite || !isMirrored("VisibilityManager.UpsertWorkflowExecution") {
+		return
+	}
+	mirrorErr := c.target.UpsertWorkflowExecution(ctx, request)
+	mirror(c.router, c.metricsClient, c.logger, "VisibilityManager.UpsertWorkflowExecution", request, mirrorErr)
+	return
+}
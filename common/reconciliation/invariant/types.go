@@ -66,6 +66,9 @@ const (
 	// MismatchedRecords checks that current and concrete execution records agree on close status
 	MismatchedRecords Name = "mismatched_records"
 
+	// PersistenceMigration checks that an execution was copied to the persistence migration target store
+	PersistenceMigration Name = "persistence_migration"
+
 	// CollectionMutableState is the collection of invariants relating to mutable state
 	CollectionMutableState Collection = 0
 	// CollectionHistory is the collection  of invariants relating to history
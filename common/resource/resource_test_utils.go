@@ -108,7 +108,9 @@ type (
 		ExecutionMgr    *mocks.ExecutionManager
 		PersistenceBean *persistenceClient.MockBean
 
-		HistoryTaskDLQMgr *persistence.MockHistoryTaskDLQManager
+		HistoryTaskDLQMgr      *persistence.MockHistoryTaskDLQManager
+		AsyncWorkflowQueueMgr  *persistence.MockQueueManager
+		AsyncWorkflowStatusMgr *persistence.MockAsyncWorkflowRequestStatusManager
 
 		IsolationGroups        *isolationgroup.MockState
 		IsolationGroupStore    configstore.Client
@@ -177,6 +179,10 @@ func NewTest(
 	// shard after inserting the task. Tests that care can override on the exposed HistoryTaskDLQMgr.
 	historyTaskDLQMgr.EXPECT().CreateHistoryDLQAckLevelIfNotExists(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	persistenceBean.EXPECT().GetHistoryTaskDLQManager().Return(historyTaskDLQMgr).AnyTimes()
+	asyncWorkflowQueueMgr := persistence.NewMockQueueManager(controller)
+	persistenceBean.EXPECT().GetAsyncWorkflowQueueManager().Return(asyncWorkflowQueueMgr).AnyTimes()
+	asyncWorkflowStatusMgr := persistence.NewMockAsyncWorkflowRequestStatusManager(controller)
+	persistenceBean.EXPECT().GetAsyncWorkflowRequestStatusManager().Return(asyncWorkflowStatusMgr).AnyTimes()
 
 	isolationGroupMock := isolationgroup.NewMockState(controller)
 	isolationGroupMock.EXPECT().Stop().AnyTimes()
@@ -234,6 +240,9 @@ func NewTest(
 		HistoryTaskDLQMgr: historyTaskDLQMgr,
 		IsolationGroups:   isolationGroupMock,
 
+		AsyncWorkflowQueueMgr:  asyncWorkflowQueueMgr,
+		AsyncWorkflowStatusMgr: asyncWorkflowStatusMgr,
+
 		// logger
 
 		Logger: logger,
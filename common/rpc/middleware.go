@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
@@ -249,16 +250,26 @@ func (m *ForwardPartitionConfigMiddleware) Call(ctx context.Context, request *tr
 }
 
 // ClientPartitionConfigMiddleware stores the partition config and isolation group of the request into the context
-// It reads a header from client request and uses it as the isolation group
+// It reads headers from client request and uses them as the isolation group, the task priority and the task fairness key
 type ClientPartitionConfigMiddleware struct{}
 
 func (m *ClientPartitionConfigMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, h transport.UnaryHandler) error {
+	partitionConfig := map[string]string{}
 	zone, _ := req.Headers.Get(common.ClientIsolationGroupHeaderName)
 	if zone != "" {
-		ctx = isolationgroup.ContextWithConfig(ctx, map[string]string{
-			isolationgroup.GroupKey: zone,
-		})
+		partitionConfig[isolationgroup.GroupKey] = zone
 		ctx = isolationgroup.ContextWithIsolationGroup(ctx, zone)
 	}
+	priority, _ := req.Headers.Get(common.ClientTaskPriorityHeaderName)
+	if priority != "" {
+		partitionConfig[constants.PartitionConfigKeyForTaskPriority] = priority
+	}
+	fairnessKey, _ := req.Headers.Get(common.ClientTaskFairnessKeyHeaderName)
+	if fairnessKey != "" {
+		partitionConfig[constants.PartitionConfigKeyForTaskFairnessKey] = fairnessKey
+	}
+	if len(partitionConfig) > 0 {
+		ctx = isolationgroup.ContextWithConfig(ctx, partitionConfig)
+	}
 	return h.Handle(ctx, req, resw)
 }
@@ -36,6 +36,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
@@ -317,6 +318,34 @@ func TestClientPartitionConfigMiddleware(t *testing.T) {
 		assert.Equal(t, "dca1", isolationgroup.IsolationGroupFromContext(h.ctx))
 	})
 
+	t.Run("it sets the task priority", func(t *testing.T) {
+		m := &ClientPartitionConfigMiddleware{}
+		h := &fakeHandler{}
+		headers := transport.NewHeaders().
+			With(common.ClientIsolationGroupHeaderName, "dca1").
+			With(common.ClientTaskPriorityHeaderName, "3")
+		err := m.Handle(context.Background(), &transport.Request{Headers: headers}, nil, h)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			isolationgroup.GroupKey:                     "dca1",
+			constants.PartitionConfigKeyForTaskPriority: "3",
+		}, isolationgroup.ConfigFromContext(h.ctx))
+		assert.Equal(t, "dca1", isolationgroup.IsolationGroupFromContext(h.ctx))
+	})
+
+	t.Run("it sets the task fairness key", func(t *testing.T) {
+		m := &ClientPartitionConfigMiddleware{}
+		h := &fakeHandler{}
+		headers := transport.NewHeaders().
+			With(common.ClientTaskFairnessKeyHeaderName, "tenant-a")
+		err := m.Handle(context.Background(), &transport.Request{Headers: headers}, nil, h)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a",
+		}, isolationgroup.ConfigFromContext(h.ctx))
+		assert.Equal(t, "", isolationgroup.IsolationGroupFromContext(h.ctx))
+	})
+
 	t.Run("noop when header is empty", func(t *testing.T) {
 		m := &ClientPartitionConfigMiddleware{}
 		h := &fakeHandler{}
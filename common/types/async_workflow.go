@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AsyncWorkflowRequestState is the processing state of a request sent through an async workflow API.
+type AsyncWorkflowRequestState int32
+
+const (
+	AsyncWorkflowRequestStateInvalid AsyncWorkflowRequestState = iota
+	AsyncWorkflowRequestStateQueued                            // Accepted and waiting in the queue
+	AsyncWorkflowRequestStateStarted                           // Workflow started, RunID is set
+	AsyncWorkflowRequestStateFailed                            // Could not be processed, FailureReason is set
+)
+
+func (e AsyncWorkflowRequestState) Ptr() *AsyncWorkflowRequestState { return &e }
+
+func (e AsyncWorkflowRequestState) String() string {
+	switch e {
+	case AsyncWorkflowRequestStateInvalid:
+		return "INVALID"
+	case AsyncWorkflowRequestStateQueued:
+		return "QUEUED"
+	case AsyncWorkflowRequestStateStarted:
+		return "STARTED"
+	case AsyncWorkflowRequestStateFailed:
+		return "FAILED"
+	}
+	return fmt.Sprintf("AsyncWorkflowRequestState(%d)", int32(e))
+}
+
+func (e *AsyncWorkflowRequestState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = AsyncWorkflowRequestStateInvalid
+	case "QUEUED":
+		*e = AsyncWorkflowRequestStateQueued
+	case "STARTED":
+		*e = AsyncWorkflowRequestStateStarted
+	case "FAILED":
+		*e = AsyncWorkflowRequestStateFailed
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "AsyncWorkflowRequestState", err)
+		}
+		*e = AsyncWorkflowRequestState(val)
+	}
+	return nil
+}
+
+func (e AsyncWorkflowRequestState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// DescribeAsyncWorkflowRequestRequest is the request to look up a request sent through
+// StartWorkflowExecutionAsync or SignalWithStartWorkflowExecutionAsync.
+type DescribeAsyncWorkflowRequestRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+func (v *DescribeAsyncWorkflowRequestRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// DescribeAsyncWorkflowRequestResponse reports the state of an async workflow request.
+type DescribeAsyncWorkflowRequestResponse struct {
+	State           AsyncWorkflowRequestState `json:"state,omitempty"`
+	WorkflowID      string                    `json:"workflowId,omitempty"`
+	RunID           string                    `json:"runId,omitempty"`
+	FailureReason   string                    `json:"failureReason,omitempty"`
+	CreatedTime     time.Time                 `json:"createdTime,omitempty"`
+	LastUpdatedTime time.Time                 `json:"lastUpdatedTime,omitempty"`
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetState() (o AsyncWorkflowRequestState) {
+	if v != nil {
+		return v.State
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetFailureReason() (o string) {
+	if v != nil {
+		return v.FailureReason
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetCreatedTime() (o time.Time) {
+	if v != nil {
+		return v.CreatedTime
+	}
+	return
+}
+
+func (v *DescribeAsyncWorkflowRequestResponse) GetLastUpdatedTime() (o time.Time) {
+	if v != nil {
+		return v.LastUpdatedTime
+	}
+	return
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncWorkflowRequestState_TextRoundTrip(t *testing.T) {
+	for _, state := range []AsyncWorkflowRequestState{
+		AsyncWorkflowRequestStateInvalid,
+		AsyncWorkflowRequestStateQueued,
+		AsyncWorkflowRequestStateStarted,
+		AsyncWorkflowRequestStateFailed,
+	} {
+		text, err := state.MarshalText()
+		require.NoError(t, err)
+		var got AsyncWorkflowRequestState
+		require.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, state, got)
+	}
+
+	var state AsyncWorkflowRequestState
+	assert.NoError(t, state.UnmarshalText([]byte("7")))
+	assert.Equal(t, "AsyncWorkflowRequestState(7)", state.String())
+	assert.Error(t, state.UnmarshalText([]byte("unknown")))
+}
+
+func TestDescribeAsyncWorkflowRequest_NilGetters(t *testing.T) {
+	var req *DescribeAsyncWorkflowRequestRequest
+	assert.Equal(t, "", req.GetDomain())
+	assert.Equal(t, "", req.GetRequestID())
+
+	var resp *DescribeAsyncWorkflowRequestResponse
+	assert.Equal(t, AsyncWorkflowRequestStateInvalid, resp.GetState())
+	assert.Equal(t, "", resp.GetWorkflowID())
+	assert.Equal(t, "", resp.GetRunID())
+	assert.Equal(t, "", resp.GetFailureReason())
+	assert.True(t, resp.GetCreatedTime().IsZero())
+	assert.True(t, resp.GetLastUpdatedTime().IsZero())
+}
+
+func TestDescribeAsyncWorkflowRequest_Getters(t *testing.T) {
+	req := &DescribeAsyncWorkflowRequestRequest{Domain: "test-domain", RequestID: "request-id"}
+	assert.Equal(t, "test-domain", req.GetDomain())
+	assert.Equal(t, "request-id", req.GetRequestID())
+
+	now := time.Unix(1700000000, 0)
+	resp := &DescribeAsyncWorkflowRequestResponse{
+		State:           AsyncWorkflowRequestStateFailed,
+		WorkflowID:      "wf-1",
+		RunID:           "run-1",
+		FailureReason:   "reason",
+		CreatedTime:     now,
+		LastUpdatedTime: now.Add(time.Second),
+	}
+	assert.Equal(t, AsyncWorkflowRequestStateFailed, resp.GetState())
+	assert.Equal(t, "wf-1", resp.GetWorkflowID())
+	assert.Equal(t, "run-1", resp.GetRunID())
+	assert.Equal(t, "reason", resp.GetFailureReason())
+	assert.Equal(t, now, resp.GetCreatedTime())
+	assert.Equal(t, now.Add(time.Second), resp.GetLastUpdatedTime())
+}
@@ -271,6 +271,28 @@ func (v *ParentExecutionInfo) GetExecution() (o *WorkflowExecution) {
 	return
 }
 
+// HistoryPauseActivityRequest is an internal type (TBD...)
+type HistoryPauseActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	PauseRequest *PauseActivityRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetPauseRequest() (o *PauseActivityRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
 // PollMutableStateRequest is an internal type (TBD...)
 type PollMutableStateRequest struct {
 	DomainUUID          string              `json:"domainUUID,omitempty"`
@@ -805,6 +827,28 @@ func (v *HistoryRequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o
 	return
 }
 
+// HistoryResetActivityRequest is an internal type (TBD...)
+type HistoryResetActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	ResetRequest *ResetActivityRequest `json:"resetRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetResetRequest is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetResetRequest() (o *ResetActivityRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
+	}
+	return
+}
+
 // HistoryResetStickyTaskListRequest is an internal type (TBD...)
 type HistoryResetStickyTaskListRequest struct {
 	DomainUUID string             `json:"domainUUID,omitempty"`
@@ -1271,6 +1315,50 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetChildWorkflowOnly() (o boo
 	return
 }
 
+// HistoryUnpauseActivityRequest is an internal type (TBD...)
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID     string                  `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseActivityRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetUnpauseRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryUpdateActivityOptionsRequest is an internal type (TBD...)
+type HistoryUpdateActivityOptionsRequest struct {
+	DomainUUID    string                        `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateActivityOptionsRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetUpdateRequest() (o *UpdateActivityOptionsRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
 // GetFailoverInfoRequest is an internal type (TBD...)
 type GetFailoverInfoRequest struct {
 	DomainID string `json:"domainID,omitempty"`
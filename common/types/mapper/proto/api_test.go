@@ -1724,7 +1724,7 @@ func TestPendingActivityInfoArrayFuzz(t *testing.T) {
 	// FromFailure only creates a Failure object if reason is non-nil, so details without reason are dropped
 	// Excluding both fields from comparison to handle this asymmetry
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfoArray, ToPendingActivityInfoArray,
-		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions"),
+		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions", "Paused"),
 	)
 }
 
@@ -1812,6 +1812,7 @@ func TestDescribeTaskListResponseFuzz(t *testing.T) {
 
 func TestStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionAsyncRequest, ToStartWorkflowExecutionAsyncRequest,
+		testutils.WithExcludedFields("Priority", "FairnessKey", "CompletionCallbacks", "Deadline"), // not yet part of the IDL
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
@@ -1863,7 +1864,9 @@ func TestDecisionTaskStartedEventAttributesFuzz(t *testing.T) {
 }
 
 func TestResetWorkflowExecutionResponseFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromResetWorkflowExecutionResponse, ToResetWorkflowExecutionResponse)
+	testutils.RunMapperFuzzTest(t, FromResetWorkflowExecutionResponse, ToResetWorkflowExecutionResponse,
+		testutils.WithExcludedFields("DryRunResult"), // not yet part of the IDL
+	)
 }
 
 func TestRespondActivityTaskCompletedByIDRequestFuzz(t *testing.T) {
@@ -2099,7 +2102,9 @@ func TestHistoryFuzz(t *testing.T) {
 }
 
 func TestResetWorkflowExecutionRequestFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromResetWorkflowExecutionRequest, ToResetWorkflowExecutionRequest)
+	testutils.RunMapperFuzzTest(t, FromResetWorkflowExecutionRequest, ToResetWorkflowExecutionRequest,
+		testutils.WithExcludedFields("SignalNamesToReapply", "ReapplyCancelRequests", "DryRun"), // not yet part of the IDL
+	)
 }
 
 func TestRespondActivityTaskCanceledByIDRequestFuzz(t *testing.T) {
@@ -2371,6 +2376,7 @@ func TestResetPointsFuzz(t *testing.T) {
 
 func TestSignalWithStartWorkflowExecutionRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionRequest, ToSignalWithStartWorkflowExecutionRequest,
+		testutils.WithExcludedFields("Priority", "FairnessKey"), // not yet part of the IDL
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
@@ -2551,7 +2557,9 @@ func TestScanWorkflowExecutionsResponseFuzz(t *testing.T) {
 }
 
 func TestScheduleActivityTaskDecisionAttributesFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromScheduleActivityTaskDecisionAttributes, ToScheduleActivityTaskDecisionAttributes)
+	testutils.RunMapperFuzzTest(t, FromScheduleActivityTaskDecisionAttributes, ToScheduleActivityTaskDecisionAttributes,
+		testutils.WithExcludedFields("Priority"), // not yet part of the IDL
+	)
 }
 
 func TestTaskListFuzz(t *testing.T) {
@@ -2588,6 +2596,7 @@ func TestActivityLocalDispatchInfoFuzz(t *testing.T) {
 
 func TestStartWorkflowExecutionRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionRequest, ToStartWorkflowExecutionRequest,
+		testutils.WithExcludedFields("Priority", "FairnessKey", "CompletionCallbacks", "Deadline"), // not yet part of the IDL
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
@@ -2742,6 +2751,7 @@ func TestSignalExternalWorkflowExecutionDecisionAttributesFuzz(t *testing.T) {
 
 func TestSignalWithStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionAsyncRequest, ToSignalWithStartWorkflowExecutionAsyncRequest,
+		testutils.WithExcludedFields("Priority", "FairnessKey"), // not yet part of the IDL
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
@@ -2782,7 +2792,9 @@ func TestGetClusterInfoResponseFuzz(t *testing.T) {
 }
 
 func TestSignalWithStartWorkflowExecutionAsyncResponseFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionAsyncResponse, ToSignalWithStartWorkflowExecutionAsyncResponse)
+	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionAsyncResponse, ToSignalWithStartWorkflowExecutionAsyncResponse,
+		testutils.WithExcludedFields("RequestID"), // not yet part of the IDL
+	)
 }
 
 func TestDescribeDomainResponseFuzz(t *testing.T) {
@@ -2905,6 +2917,7 @@ func PendingActivityInfoFuzzer(a *types.PendingActivityInfo, c fuzz.Continue) {
 func TestPendingActivityInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfo, ToPendingActivityInfo,
 		testutils.WithCustomFuncs(PendingActivityInfoFuzzer),
+		testutils.WithExcludedFields("Paused"), // not yet part of the IDL
 	)
 }
 
@@ -2980,7 +2993,9 @@ func TestRetryPolicyFuzz(t *testing.T) {
 }
 
 func TestStartWorkflowExecutionAsyncResponseFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionAsyncResponse, ToStartWorkflowExecutionAsyncResponse)
+	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionAsyncResponse, ToStartWorkflowExecutionAsyncResponse,
+		testutils.WithExcludedFields("RequestID"), // not yet part of the IDL
+	)
 }
 
 func TestAPITaskListPartitionConfigFuzz(t *testing.T) {
@@ -3081,7 +3096,7 @@ func TestStartChildWorkflowExecutionDecisionAttributesFuzz(t *testing.T) {
 func TestActivityTaskScheduledEventAttributesFuzz(t *testing.T) {
 	// [BUG] Non-symmetric mapping: An empty string domain becomes nil, but the return trip translates it back to nil - not empty string
 	testutils.RunMapperFuzzTest(t, FromActivityTaskScheduledEventAttributes, ToActivityTaskScheduledEventAttributes,
-		testutils.WithExcludedFields("Domain"),
+		testutils.WithExcludedFields("Domain", "Priority"), // Priority is not yet part of the IDL
 	)
 }
 
@@ -951,6 +951,162 @@ func ToHistoryRefreshWorkflowTasksRequest(t *historyv1.RefreshWorkflowTasksReque
 	}
 }
 
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil || t.PauseRequest == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		Domain:            t.PauseRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.PauseRequest.WorkflowExecution),
+		ActivityId:        t.PauseRequest.ActivityID,
+		Reason:            t.PauseRequest.Reason,
+		Identity:          t.PauseRequest.Identity,
+		RequestId:         t.PauseRequest.RequestID,
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID: t.DomainId,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil || t.UnpauseRequest == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		Domain:            t.UnpauseRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.UnpauseRequest.WorkflowExecution),
+		ActivityId:        t.UnpauseRequest.ActivityID,
+		Reason:            t.UnpauseRequest.Reason,
+		Identity:          t.UnpauseRequest.Identity,
+		RequestId:         t.UnpauseRequest.RequestID,
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID: t.DomainId,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *historyv1.ResetActivityRequest {
+	if t == nil || t.ResetRequest == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityRequest{
+		Domain:            t.ResetRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.ResetRequest.WorkflowExecution),
+		ActivityId:        t.ResetRequest.ActivityID,
+		Reason:            t.ResetRequest.Reason,
+		Identity:          t.ResetRequest.Identity,
+		RequestId:         t.ResetRequest.RequestID,
+	}
+}
+
+func ToHistoryResetActivityRequest(t *historyv1.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID: t.DomainId,
+		ResetRequest: &types.ResetActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
+func FromActivityOptions(t *types.ActivityOptions) *historyv1.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityOptions{
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func ToActivityOptions(t *historyv1.ActivityOptions) *types.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityOptions{
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *historyv1.UpdateActivityOptionsRequest {
+	if t == nil || t.UpdateRequest == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsRequest{
+		Domain:            t.UpdateRequest.Domain,
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.UpdateRequest.WorkflowExecution),
+		ActivityId:        t.UpdateRequest.ActivityID,
+		Options:           FromActivityOptions(t.UpdateRequest.Options),
+		Reason:            t.UpdateRequest.Reason,
+		Identity:          t.UpdateRequest.Identity,
+		RequestId:         t.UpdateRequest.RequestID,
+	}
+}
+
+func ToHistoryUpdateActivityOptionsRequest(t *historyv1.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: t.DomainId,
+		UpdateRequest: &types.UpdateActivityOptionsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Options:           ToActivityOptions(t.Options),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		},
+	}
+}
+
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *historyv1.RemoveSignalMutableStateRequest {
 	if t == nil {
 		return nil
@@ -1354,6 +1510,8 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 		PartitionConfig:          t.PartitionConfig,
+		CompletionCallbacks:      FromCompletionCallbackArray(t.StartRequest.GetCompletionCallbacks()),
+		Deadline:                 FromWorkflowDeadline(t.StartRequest.GetDeadline()),
 	}
 }
 
@@ -1361,8 +1519,13 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	if t == nil {
 		return nil
 	}
+	startRequest := ToStartWorkflowExecutionRequest(t.Request)
+	if startRequest != nil {
+		startRequest.CompletionCallbacks = ToCompletionCallbackArray(t.CompletionCallbacks)
+		startRequest.Deadline = ToWorkflowDeadline(t.Deadline)
+	}
 	return &types.HistoryStartWorkflowExecutionRequest{
-		StartRequest:                    ToStartWorkflowExecutionRequest(t.Request),
+		StartRequest:                    startRequest,
 		DomainUUID:                      t.DomainId,
 		ParentExecutionInfo:             ToParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                         t.Attempt,
@@ -1376,6 +1539,54 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	}
 }
 
+func FromCompletionCallbackArray(t []*types.CompletionCallback) []*historyv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = &historyv1.CompletionCallback{
+			Url:    t[i].GetURL(),
+			Header: t[i].GetHeader(),
+		}
+	}
+	return v
+}
+
+func ToCompletionCallbackArray(t []*historyv1.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = &types.CompletionCallback{
+			URL:    t[i].GetUrl(),
+			Header: t[i].GetHeader(),
+		}
+	}
+	return v
+}
+
+func FromWorkflowDeadline(t *types.WorkflowDeadline) *historyv1.WorkflowDeadline {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.WorkflowDeadline{
+		Deadline:    secondsToDuration(common.Int32Ptr(t.DeadlineSeconds)),
+		GracePeriod: secondsToDuration(common.Int32Ptr(t.GracePeriodSeconds)),
+	}
+}
+
+func ToWorkflowDeadline(t *historyv1.WorkflowDeadline) *types.WorkflowDeadline {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowDeadline{
+		DeadlineSeconds:    common.Int32Default(durationToSeconds(t.Deadline)),
+		GracePeriodSeconds: common.Int32Default(durationToSeconds(t.GracePeriod)),
+	}
+}
+
 func FromHistoryStartWorkflowExecutionResponse(t *types.StartWorkflowExecutionResponse) *historyv1.StartWorkflowExecutionResponse {
 	if t == nil {
 		return nil
@@ -133,6 +133,12 @@ func TestHistoryNotifyFailoverMarkersRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryNotifyFailoverMarkersRequest(FromHistoryNotifyFailoverMarkersRequest(item)))
 	}
 }
+func TestHistoryPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryPauseActivityRequest(&types.HistoryPauseActivityRequest{}))
+}
 func TestHistoryPollMutableStateRequest(t *testing.T) {
 	for _, item := range []*types.PollMutableStateRequest{nil, {}, &testdata.HistoryPollMutableStateRequest} {
 		assert.Equal(t, item, ToHistoryPollMutableStateRequest(FromHistoryPollMutableStateRequest(item)))
@@ -236,6 +242,12 @@ func TestHistoryRequestCancelWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRequestCancelWorkflowExecutionRequest(FromHistoryRequestCancelWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistoryResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryResetActivityRequest(&types.HistoryResetActivityRequest{}))
+}
 func TestHistoryResetQueueRequest(t *testing.T) {
 	for _, item := range []*types.ResetQueueRequest{nil, {}, &testdata.HistoryResetQueueRequest} {
 		assert.Equal(t, item, ToHistoryResetQueueRequest(FromHistoryResetQueueRequest(item)))
@@ -311,6 +323,30 @@ func TestHistoryStartWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
 	}
 }
+
+func TestHistoryStartWorkflowExecutionRequestCompletionCallbacks(t *testing.T) {
+	item := &types.HistoryStartWorkflowExecutionRequest{
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain: testdata.DomainName,
+			CompletionCallbacks: []*types.CompletionCallback{
+				{URL: "https://callbacks.example.com/done", Header: map[string]string{"Authorization": "Bearer token"}},
+				{URL: "https://callbacks.example.com/other"},
+			},
+		},
+		DomainUUID: testdata.DomainID,
+	}
+	assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
+}
+func TestHistoryStartWorkflowExecutionRequestDeadline(t *testing.T) {
+	item := &types.HistoryStartWorkflowExecutionRequest{
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:   testdata.DomainName,
+			Deadline: &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: 10},
+		},
+		DomainUUID: testdata.DomainID,
+	}
+	assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
+}
 func TestHistoryStartWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.HistoryStartWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionResponse(FromHistoryStartWorkflowExecutionResponse(item)))
@@ -332,6 +368,23 @@ func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	}
 }
 
+func TestHistoryUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUnpauseActivityRequest(&types.HistoryUnpauseActivityRequest{}))
+}
+func TestHistoryUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateActivityOptionsRequest{nil, &testdata.HistoryUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsRequest(FromHistoryUpdateActivityOptionsRequest(item)))
+	}
+	assert.Nil(t, FromHistoryUpdateActivityOptionsRequest(&types.HistoryUpdateActivityOptionsRequest{}))
+}
+func TestActivityOptions(t *testing.T) {
+	for _, item := range []*types.ActivityOptions{nil, {}, &testdata.ActivityOptions} {
+		assert.Equal(t, item, ToActivityOptions(FromActivityOptions(item)))
+	}
+}
 func TestHistoryGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.HistoryGetCrossClusterTasksRequest} {
 		assert.Equal(t, item, ToHistoryGetCrossClusterTasksRequest(FromHistoryGetCrossClusterTasksRequest(item)))
@@ -607,11 +660,15 @@ func TestHistoryResetStickyTaskListRequestFuzz(t *testing.T) {
 }
 
 func TestHistoryResetWorkflowExecutionRequestFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromHistoryResetWorkflowExecutionRequest, ToHistoryResetWorkflowExecutionRequest)
+	testutils.RunMapperFuzzTest(t, FromHistoryResetWorkflowExecutionRequest, ToHistoryResetWorkflowExecutionRequest,
+		testutils.WithExcludedFields("SignalNamesToReapply", "ReapplyCancelRequests", "DryRun"), // not yet part of the IDL
+	)
 }
 
 func TestHistoryResetWorkflowExecutionResponseFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromHistoryResetWorkflowExecutionResponse, ToHistoryResetWorkflowExecutionResponse)
+	testutils.RunMapperFuzzTest(t, FromHistoryResetWorkflowExecutionResponse, ToHistoryResetWorkflowExecutionResponse,
+		testutils.WithExcludedFields("DryRunResult"), // not yet part of the IDL
+	)
 }
 
 func TestHistoryRespondActivityTaskCanceledRequestFuzz(t *testing.T) {
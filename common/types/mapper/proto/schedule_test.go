@@ -85,9 +85,18 @@ func TestScheduleListEntry(t *testing.T) {
 	}
 }
 
+// scheduleFieldsNotInIDL lists schedule type fields that are not yet part of
+// the public IDL and therefore do not survive a round trip through the mappers.
+var scheduleFieldsNotInIDL = []string{
+	"Intervals", "Calendars", "ExcludeCalendars",
+	"SignalWorkflow", "SignalWithStartWorkflow",
+	"RecentActions", "FutureActionTimes",
+}
+
 func TestScheduleSpecFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleSpec, ToScheduleSpec,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
@@ -100,6 +109,7 @@ func TestStartWorkflowActionFuzz(t *testing.T) {
 func TestScheduleActionFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleAction, ToScheduleAction,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
@@ -130,6 +140,7 @@ func TestBackfillInfoFuzz(t *testing.T) {
 func TestScheduleInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleInfo, ToScheduleInfo,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
@@ -206,6 +217,7 @@ func TestDeleteScheduleResponse(t *testing.T) {
 func TestCreateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromCreateScheduleRequest, ToCreateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
@@ -218,12 +230,14 @@ func TestDescribeScheduleRequestFuzz(t *testing.T) {
 func TestDescribeScheduleResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromDescribeScheduleResponse, ToDescribeScheduleResponse,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
 func TestUpdateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromUpdateScheduleRequest, ToUpdateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		testutils.WithExcludedFields(scheduleFieldsNotInIDL...),
 	)
 }
 
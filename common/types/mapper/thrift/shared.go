@@ -5857,6 +5857,7 @@ func ToSignalWithStartWorkflowExecutionAsyncRequest(t *shared.SignalWithStartWor
 	}
 }
 
+// FromSignalWithStartWorkflowExecutionAsyncResponse converts internal SignalWithStartWorkflowExecutionAsyncResponse type to thrift
 func FromSignalWithStartWorkflowExecutionAsyncResponse(t *types.SignalWithStartWorkflowExecutionAsyncResponse) *shared.SignalWithStartWorkflowExecutionAsyncResponse {
 	if t == nil {
 		return nil
@@ -5889,6 +5890,7 @@ func ToStartWorkflowExecutionAsyncRequest(t *shared.StartWorkflowExecutionAsyncR
 	}
 }
 
+// FromStartWorkflowExecutionAsyncResponse converts internal StartWorkflowExecutionAsyncResponse type to thrift
 func FromStartWorkflowExecutionAsyncResponse(t *types.StartWorkflowExecutionAsyncResponse) *shared.StartWorkflowExecutionAsyncResponse {
 	if t == nil {
 		return nil
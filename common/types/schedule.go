@@ -139,14 +139,79 @@ func (e ScheduleCatchUpPolicy) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
+// ScheduleActionOutcome describes what the scheduler did with a single fire.
+type ScheduleActionOutcome int32
+
+const (
+	ScheduleActionOutcomeInvalid  ScheduleActionOutcome = iota
+	ScheduleActionOutcomeStarted                        // Target workflow started
+	ScheduleActionOutcomeSignaled                       // Signal delivered by a signal or signal-with-start action
+	ScheduleActionOutcomeSkipped                        // Dropped by the overlap policy, a full buffer, or a missing target
+	ScheduleActionOutcomeBuffered                       // Deferred by the Buffer overlap policy
+	ScheduleActionOutcomeFailed                         // Could not be processed after retries
+)
+
+func (e ScheduleActionOutcome) Ptr() *ScheduleActionOutcome { return &e }
+
+func (e ScheduleActionOutcome) String() string {
+	switch e {
+	case ScheduleActionOutcomeInvalid:
+		return "INVALID"
+	case ScheduleActionOutcomeStarted:
+		return "STARTED"
+	case ScheduleActionOutcomeSignaled:
+		return "SIGNALED"
+	case ScheduleActionOutcomeSkipped:
+		return "SKIPPED"
+	case ScheduleActionOutcomeBuffered:
+		return "BUFFERED"
+	case ScheduleActionOutcomeFailed:
+		return "FAILED"
+	}
+	return fmt.Sprintf("ScheduleActionOutcome(%d)", int32(e))
+}
+
+func (e *ScheduleActionOutcome) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = ScheduleActionOutcomeInvalid
+	case "STARTED":
+		*e = ScheduleActionOutcomeStarted
+	case "SIGNALED":
+		*e = ScheduleActionOutcomeSignaled
+	case "SKIPPED":
+		*e = ScheduleActionOutcomeSkipped
+	case "BUFFERED":
+		*e = ScheduleActionOutcomeBuffered
+	case "FAILED":
+		*e = ScheduleActionOutcomeFailed
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleActionOutcome", err)
+		}
+		*e = ScheduleActionOutcome(val)
+	}
+	return nil
+}
+
+func (e ScheduleActionOutcome) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
 // --- Core Types ---
 
 // ScheduleSpec defines when a schedule should trigger.
+// The fire times are the union of CronExpression, Intervals and Calendars,
+// minus any time matched by ExcludeCalendars, bounded by StartTime/EndTime.
 type ScheduleSpec struct {
-	CronExpression string        `json:"cronExpression,omitempty"`
-	StartTime      time.Time     `json:"startTime,omitempty"`
-	EndTime        time.Time     `json:"endTime,omitempty"`
-	Jitter         time.Duration `json:"jitter,omitempty"`
+	CronExpression   string                  `json:"cronExpression,omitempty"`
+	StartTime        time.Time               `json:"startTime,omitempty"`
+	EndTime          time.Time               `json:"endTime,omitempty"`
+	Jitter           time.Duration           `json:"jitter,omitempty"`
+	Intervals        []*ScheduleIntervalSpec `json:"intervals,omitempty"`
+	Calendars        []*ScheduleCalendarSpec `json:"calendars,omitempty"`
+	ExcludeCalendars []*ScheduleCalendarSpec `json:"excludeCalendars,omitempty"`
 }
 
 func (v *ScheduleSpec) GetCronExpression() (o string) {
@@ -177,6 +242,126 @@ func (v *ScheduleSpec) GetJitter() (o time.Duration) {
 	return
 }
 
+func (v *ScheduleSpec) GetIntervals() (o []*ScheduleIntervalSpec) {
+	if v != nil {
+		return v.Intervals
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.Calendars
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetExcludeCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.ExcludeCalendars
+	}
+	return
+}
+
+// ScheduleIntervalSpec fires every Interval, aligned to Anchor: the fire times
+// are Anchor + k*Interval for every integer k. A zero Anchor aligns fires to
+// the Unix epoch.
+type ScheduleIntervalSpec struct {
+	Interval time.Duration `json:"interval,omitempty"`
+	Anchor   time.Time     `json:"anchor,omitempty"`
+}
+
+func (v *ScheduleIntervalSpec) GetInterval() (o time.Duration) {
+	if v != nil {
+		return v.Interval
+	}
+	return
+}
+
+func (v *ScheduleIntervalSpec) GetAnchor() (o time.Time) {
+	if v != nil {
+		return v.Anchor
+	}
+	return
+}
+
+// ScheduleCalendarSpec is a structured alternative to a cron expression.
+// Each field accepts cron field syntax ("*", "5", "1-5", "*/15", "MON-FRI", "1,15").
+// DayOfMonth additionally accepts "L" (last day of the month) and "LW" (last
+// weekday of the month). Year accepts values and ranges ("2026", "2026-2028").
+//
+// Unset fields default to "*", except that Second, Minute and Hour default to
+// "0" when the spec is used in ScheduleSpec.Calendars, so {Hour: "6"} fires
+// once a day at 06:00:00. In ScheduleSpec.ExcludeCalendars every unset field
+// means "*", so {Month: "12", DayOfMonth: "25"} excludes the whole day.
+// TimeZone is an IANA name; empty means UTC.
+type ScheduleCalendarSpec struct {
+	Second     string `json:"second,omitempty"`
+	Minute     string `json:"minute,omitempty"`
+	Hour       string `json:"hour,omitempty"`
+	DayOfMonth string `json:"dayOfMonth,omitempty"`
+	Month      string `json:"month,omitempty"`
+	DayOfWeek  string `json:"dayOfWeek,omitempty"`
+	Year       string `json:"year,omitempty"`
+	TimeZone   string `json:"timeZone,omitempty"`
+}
+
+func (v *ScheduleCalendarSpec) GetSecond() (o string) {
+	if v != nil {
+		return v.Second
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMinute() (o string) {
+	if v != nil {
+		return v.Minute
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetHour() (o string) {
+	if v != nil {
+		return v.Hour
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfMonth() (o string) {
+	if v != nil {
+		return v.DayOfMonth
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMonth() (o string) {
+	if v != nil {
+		return v.Month
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfWeek() (o string) {
+	if v != nil {
+		return v.DayOfWeek
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetYear() (o string) {
+	if v != nil {
+		return v.Year
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetTimeZone() (o string) {
+	if v != nil {
+		return v.TimeZone
+	}
+	return
+}
+
 // StartWorkflowAction defines a workflow to start when the schedule triggers.
 // Input, Memo, and SearchAttributes must JSON-round-trip: the scheduler workflow
 // encodes types.ScheduleAction with encoding/json (create input, update signals,
@@ -228,10 +413,76 @@ func (v *StartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
 	return
 }
 
+// SignalWorkflowAction defines a signal to send to an existing workflow when
+// the schedule triggers. An empty RunID targets the current run of WorkflowID.
+// Fires for which the target workflow does not exist or has already closed
+// are counted as skipped.
+type SignalWorkflowAction struct {
+	WorkflowID string `json:"workflowId,omitempty"`
+	RunID      string `json:"runId,omitempty"`
+	SignalName string `json:"signalName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+}
+
+func (v *SignalWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+// SignalWithStartWorkflowAction defines a signal to send to WorkflowID when
+// the schedule triggers, starting the workflow from StartWorkflow first if it
+// is not running. StartWorkflow.WorkflowIDPrefix is ignored: every fire
+// targets the same WorkflowID.
+type SignalWithStartWorkflowAction struct {
+	WorkflowID    string               `json:"workflowId,omitempty"`
+	SignalName    string               `json:"signalName,omitempty"`
+	SignalInput   []byte               `json:"signalInput,omitempty"`
+	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+}
+
+func (v *SignalWithStartWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetStartWorkflow() *StartWorkflowAction {
+	if v != nil {
+		return v.StartWorkflow
+	}
+	return nil
+}
+
 // ScheduleAction defines the action to take when the schedule triggers.
 // Exactly one action field must be set.
 type ScheduleAction struct {
-	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+	StartWorkflow           *StartWorkflowAction           `json:"startWorkflow,omitempty"`
+	SignalWorkflow          *SignalWorkflowAction          `json:"signalWorkflow,omitempty"`
+	SignalWithStartWorkflow *SignalWithStartWorkflowAction `json:"signalWithStartWorkflow,omitempty"`
 }
 
 func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
@@ -241,6 +492,20 @@ func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
 	return nil
 }
 
+func (v *ScheduleAction) GetSignalWorkflow() *SignalWorkflowAction {
+	if v != nil {
+		return v.SignalWorkflow
+	}
+	return nil
+}
+
+func (v *ScheduleAction) GetSignalWithStartWorkflow() *SignalWithStartWorkflowAction {
+	if v != nil {
+		return v.SignalWithStartWorkflow
+	}
+	return nil
+}
+
 // SchedulePolicies configures schedule behavior.
 type SchedulePolicies struct {
 	OverlapPolicy    ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
@@ -385,18 +650,69 @@ func (v *BackfillInfo) GetRunsTotal() (o int32) {
 	return
 }
 
+// ScheduleActionResult records the outcome of a single schedule fire.
+// ScheduledTime is the nominal fire time; ActualTime is when the scheduler
+// acted on it and is zero for fires that were buffered or dropped before
+// reaching the target. WorkflowExecution is the run started or signaled by
+// the fire or, for skipped fires, the run that caused the skip when known.
+// Overlapped reports that the previous target workflow was still running
+// when the fire was processed. IsManualTrigger marks fires requested through
+// TriggerSchedule rather than the spec or a backfill.
+type ScheduleActionResult struct {
+	ScheduledTime     time.Time             `json:"scheduledTime,omitempty"`
+	ActualTime        time.Time             `json:"actualTime,omitempty"`
+	Outcome           ScheduleActionOutcome `json:"outcome,omitempty"`
+	WorkflowExecution *WorkflowExecution    `json:"workflowExecution,omitempty"`
+	Overlapped        bool                  `json:"overlapped,omitempty"`
+	IsBackfill        bool                  `json:"isBackfill,omitempty"`
+	BackfillID        string                `json:"backfillId,omitempty"`
+	IsManualTrigger   bool                  `json:"isManualTrigger,omitempty"`
+}
+
+func (v *ScheduleActionResult) GetScheduledTime() (o time.Time) {
+	if v != nil {
+		return v.ScheduledTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetActualTime() (o time.Time) {
+	if v != nil {
+		return v.ActualTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetOutcome() (o ScheduleActionOutcome) {
+	if v != nil {
+		return v.Outcome
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetWorkflowExecution() *WorkflowExecution {
+	if v != nil {
+		return v.WorkflowExecution
+	}
+	return nil
+}
+
 // ScheduleInfo provides runtime information about the schedule.
+// RecentActions lists the most recent fires, oldest first; FutureActionTimes
+// lists the next upcoming fire times and is empty while the schedule is paused.
 type ScheduleInfo struct {
-	LastRunTime          time.Time       `json:"lastRunTime,omitempty"`
-	NextRunTime          time.Time       `json:"nextRunTime,omitempty"`
-	TotalRuns            int64           `json:"totalRuns,omitempty"`
-	MissedRuns           int64           `json:"missedRuns,omitempty"`
-	SkippedRuns          int64           `json:"skippedRuns,omitempty"`
-	CreateTime           time.Time       `json:"createTime,omitempty"`
-	LastUpdateTime       time.Time       `json:"lastUpdateTime,omitempty"`
-	OngoingBackfills     []*BackfillInfo `json:"ongoingBackfills,omitempty"`
-	BufferedFireCount    int64           `json:"bufferedFireCount,omitempty"`
-	RunningWorkflowCount int64           `json:"runningWorkflowCount,omitempty"`
+	LastRunTime          time.Time               `json:"lastRunTime,omitempty"`
+	NextRunTime          time.Time               `json:"nextRunTime,omitempty"`
+	TotalRuns            int64                   `json:"totalRuns,omitempty"`
+	MissedRuns           int64                   `json:"missedRuns,omitempty"`
+	SkippedRuns          int64                   `json:"skippedRuns,omitempty"`
+	CreateTime           time.Time               `json:"createTime,omitempty"`
+	LastUpdateTime       time.Time               `json:"lastUpdateTime,omitempty"`
+	OngoingBackfills     []*BackfillInfo         `json:"ongoingBackfills,omitempty"`
+	BufferedFireCount    int64                   `json:"bufferedFireCount,omitempty"`
+	RunningWorkflowCount int64                   `json:"runningWorkflowCount,omitempty"`
+	RecentActions        []*ScheduleActionResult `json:"recentActions,omitempty"`
+	FutureActionTimes    []time.Time             `json:"futureActionTimes,omitempty"`
 }
 
 func (v *ScheduleInfo) GetLastRunTime() (o time.Time) {
@@ -469,6 +785,20 @@ func (v *ScheduleInfo) GetRunningWorkflowCount() (o int64) {
 	return
 }
 
+func (v *ScheduleInfo) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil {
+		return v.RecentActions
+	}
+	return
+}
+
+func (v *ScheduleInfo) GetFutureActionTimes() (o []time.Time) {
+	if v != nil {
+		return v.FutureActionTimes
+	}
+	return
+}
+
 func (v *StartWorkflowAction) GetInput() (o []byte) {
 	if v != nil {
 		return v.Input
@@ -470,3 +470,36 @@ func (v *BackfillScheduleRequest) GetBackfillID() (o string) {
 
 // BackfillScheduleResponse is the response for triggering a backfill.
 type BackfillScheduleResponse struct{}
+
+// TriggerScheduleRequest is the request to fire a schedule's action immediately.
+// OverlapPolicy overrides the schedule's policy for this fire only; INVALID
+// (the zero value) inherits the schedule's configured policy.
+type TriggerScheduleRequest struct {
+	Domain        string                `json:"domain,omitempty"`
+	ScheduleID    string                `json:"scheduleId,omitempty"`
+	OverlapPolicy ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
+func (v *TriggerScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil {
+		return v.OverlapPolicy
+	}
+	return
+}
+
+// TriggerScheduleResponse is the response for triggering a schedule.
+type TriggerScheduleResponse struct{}
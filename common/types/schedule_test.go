@@ -175,3 +175,47 @@ func TestScheduleCatchUpPolicy_Ptr(t *testing.T) {
 	ptr := val.Ptr()
 	assert.Equal(t, &val, ptr)
 }
+
+func TestScheduleActionOutcome_RoundTrip(t *testing.T) {
+	for _, val := range []ScheduleActionOutcome{
+		ScheduleActionOutcomeInvalid,
+		ScheduleActionOutcomeStarted,
+		ScheduleActionOutcomeSignaled,
+		ScheduleActionOutcomeSkipped,
+		ScheduleActionOutcomeBuffered,
+		ScheduleActionOutcomeFailed,
+	} {
+		b, err := val.MarshalText()
+		assert.NoError(t, err)
+		var got ScheduleActionOutcome
+		err = got.UnmarshalText(b)
+		assert.NoError(t, err)
+		assert.Equal(t, val, got)
+	}
+}
+
+func TestScheduleActionOutcome_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want ScheduleActionOutcome
+		err  bool
+	}{
+		{name: "lowercase", text: "skipped", want: ScheduleActionOutcomeSkipped},
+		{name: "numeric", text: "2", want: ScheduleActionOutcomeSignaled},
+		{name: "unknown", text: "UNKNOWN", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ScheduleActionOutcome
+			err := got.UnmarshalText([]byte(tt.text))
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+	assert.Equal(t, "ScheduleActionOutcome(99)", ScheduleActionOutcome(99).String())
+}
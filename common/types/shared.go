@@ -53,6 +53,55 @@ func (v *ActivityLocalDispatchInfo) GetScheduledTimestamp() (o int64) {
 	return
 }
 
+// ActivityOptions is an internal type (TBD...)
+type ActivityOptions struct {
+	ScheduleToCloseTimeoutSeconds *int32       `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32       `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32       `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32       `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityOptions) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *ActivityOptions) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
 // ActivityTaskCancelRequestedEventAttributes is an internal type (TBD...)
 type ActivityTaskCancelRequestedEventAttributes struct {
 	ActivityID                   string `json:"activityId,omitempty"`
@@ -272,6 +321,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -282,6 +332,14 @@ func (v *ActivityTaskScheduledEventAttributes) GetActivityID() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetActivityType is an internal getter (TBD...)
 func (v *ActivityTaskScheduledEventAttributes) GetActivityType() (o *ActivityType) {
 	if v != nil && v.ActivityType != nil {
@@ -913,6 +971,28 @@ func (v *ClusterReplicationConfiguration) ByteSize() uint64 {
 	return size
 }
 
+// CompletionCallback is an internal type (TBD...)
+type CompletionCallback struct {
+	URL    string            `json:"url,omitempty"`
+	Header map[string]string `json:"header,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallback) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetHeader is an internal getter (TBD...)
+func (v *CompletionCallback) GetHeader() (o map[string]string) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
 // CompleteWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	Result []byte `json:"result,omitempty"`
@@ -1046,6 +1126,14 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetSearchAttributes()
 	return
 }
 
+// GetHeader is an internal getter (TBD...)
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
 // CountWorkflowExecutionsRequest is an internal type (TBD...)
 type CountWorkflowExecutionsRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -3650,6 +3738,14 @@ type Header struct {
 	Fields map[string][]byte `json:"fields,omitempty"`
 }
 
+// GetFields is an internal getter (TBD...)
+func (v *Header) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
+	}
+	return
+}
+
 // History is an internal type (TBD...)
 type History struct {
 	Events []*HistoryEvent `json:"events,omitempty"`
@@ -4792,6 +4888,113 @@ const (
 	ParentClosePolicyTerminate
 )
 
+// PauseActivityRequest is an internal type (TBD...)
+type PauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // PendingActivityInfo is an internal type (TBD...)
 type PendingActivityInfo struct {
 	ActivityID             string                `json:"activityID,omitempty"`
@@ -4810,6 +5013,7 @@ type PendingActivityInfo struct {
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions     *FailureOptions       `json:"lastFailureOptions,omitempty"`
 	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	Paused                 bool                  `json:"paused,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -4900,6 +5104,14 @@ func (v *PendingActivityInfo) GetScheduleID() (o int64) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -6037,6 +6249,64 @@ func (v *RequestCancelWorkflowExecutionRequest) ByteSize() uint64 {
 	return 0
 }
 
+// ResetActivityRequest is an internal type (TBD...)
+type ResetActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // ResetPointInfo is an internal type (TBD...)
 type ResetPointInfo struct {
 	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
@@ -6190,6 +6460,9 @@ type ResetWorkflowExecutionRequest struct {
 	DecisionFinishEventID int64              `json:"decisionFinishEventId,omitempty"`
 	RequestID             string             `json:"requestId,omitempty"`
 	SkipSignalReapply     bool               `json:"skipSignalReapply,omitempty"`
+	SignalNamesToReapply  []string           `json:"signalNamesToReapply,omitempty"`
+	ReapplyCancelRequests bool               `json:"reapplyCancelRequests,omitempty"`
+	DryRun                bool               `json:"dryRun,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6240,6 +6513,30 @@ func (v *ResetWorkflowExecutionRequest) GetSkipSignalReapply() (o bool) {
 	return
 }
 
+// GetSignalNamesToReapply is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetSignalNamesToReapply() (o []string) {
+	if v != nil && v.SignalNamesToReapply != nil {
+		return v.SignalNamesToReapply
+	}
+	return
+}
+
+// GetReapplyCancelRequests is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetReapplyCancelRequests() (o bool) {
+	if v != nil {
+		return v.ReapplyCancelRequests
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ResetWorkflowExecutionRequest) ByteSize() uint64 {
 	return 0
@@ -6247,7 +6544,8 @@ func (v *ResetWorkflowExecutionRequest) ByteSize() uint64 {
 
 // ResetWorkflowExecutionResponse is an internal type (TBD...)
 type ResetWorkflowExecutionResponse struct {
-	RunID string `json:"runId,omitempty"`
+	RunID        string                              `json:"runId,omitempty"`
+	DryRunResult *ResetWorkflowExecutionDryRunResult `json:"dryRunResult,omitempty"`
 }
 
 // GetRunID is an internal getter (TBD...)
@@ -6258,11 +6556,53 @@ func (v *ResetWorkflowExecutionResponse) GetRunID() (o string) {
 	return
 }
 
+// GetDryRunResult is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionResponse) GetDryRunResult() (o *ResetWorkflowExecutionDryRunResult) {
+	if v != nil && v.DryRunResult != nil {
+		return v.DryRunResult
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ResetWorkflowExecutionResponse) ByteSize() uint64 {
 	return 0
 }
 
+// ResetWorkflowExecutionDryRunResult describes the reset of a workflow without committing it.
+// The history of the reset run is the history of the base run up to the reset point followed by NewEvents.
+// The events after the reset point, in the base run and the runs it continued as new to, are either
+// reapplied to the reset run or dropped.
+type ResetWorkflowExecutionDryRunResult struct {
+	NewEvents       []*HistoryEvent `json:"newEvents,omitempty"`
+	ReappliedEvents []*HistoryEvent `json:"reappliedEvents,omitempty"`
+	DroppedEvents   []*HistoryEvent `json:"droppedEvents,omitempty"`
+}
+
+// GetNewEvents is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionDryRunResult) GetNewEvents() (o []*HistoryEvent) {
+	if v != nil && v.NewEvents != nil {
+		return v.NewEvents
+	}
+	return
+}
+
+// GetReappliedEvents is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionDryRunResult) GetReappliedEvents() (o []*HistoryEvent) {
+	if v != nil && v.ReappliedEvents != nil {
+		return v.ReappliedEvents
+	}
+	return
+}
+
+// GetDroppedEvents is an internal getter (TBD...)
+func (v *ResetWorkflowExecutionDryRunResult) GetDroppedEvents() (o []*HistoryEvent) {
+	if v != nil && v.DroppedEvents != nil {
+		return v.DroppedEvents
+	}
+	return
+}
+
 // RespondActivityTaskCanceledByIDRequest is an internal type (TBD...)
 type RespondActivityTaskCanceledByIDRequest struct {
 	Domain     string `json:"domain,omitempty"`
@@ -6801,6 +7141,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -6811,6 +7152,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetActivityID() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetActivityType is an internal getter (TBD...)
 func (v *ScheduleActivityTaskDecisionAttributes) GetActivityType() (o *ActivityType) {
 	if v != nil && v.ActivityType != nil {
@@ -7058,6 +7407,8 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            *int32                        `json:"priority,omitempty"`
+	FairnessKey                         string                        `json:"fairnessKey,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7068,6 +7419,22 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetDomain() (o string) {
 	return
 }
 
+// GetFairnessKey is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetWorkflowID is an internal getter (TBD...)
 func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowID() (o string) {
 	if v != nil {
@@ -7163,6 +7530,16 @@ type SignalWithStartWorkflowExecutionAsyncRequest struct {
 
 // SignalWithStartWorkflowExecutionAsyncResponse is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionAsyncResponse struct {
+	// RequestID identifies the queued request for DescribeAsyncWorkflowRequest
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionAsyncResponse) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
 }
 
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
@@ -7534,6 +7911,10 @@ type StartWorkflowExecutionRequest struct {
 	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	Priority                            *int32                        `json:"priority,omitempty"`
+	FairnessKey                         string                        `json:"fairnessKey,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback         `json:"completionCallbacks,omitempty"`
+	Deadline                            *WorkflowDeadline             `json:"deadline,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7544,6 +7925,38 @@ func (v *StartWorkflowExecutionRequest) GetDomain() (o string) {
 	return
 }
 
+// GetDeadline is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetDeadline() (o *WorkflowDeadline) {
+	if v != nil && v.Deadline != nil {
+		return v.Deadline
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+	return
+}
+
 // GetTaskList is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetTaskList() (o *TaskList) {
 	if v != nil && v.TaskList != nil {
@@ -7650,6 +8063,16 @@ type StartWorkflowExecutionAsyncRequest struct {
 }
 
 type StartWorkflowExecutionAsyncResponse struct {
+	// RequestID identifies the queued request for DescribeAsyncWorkflowRequest
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *StartWorkflowExecutionAsyncResponse) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
 }
 
 // RestartWorkflowExecutionResponse is an internal type (TBD...)
@@ -8136,6 +8559,73 @@ type TransientDecisionInfo struct {
 	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
+// UpdateActivityOptionsRequest is an internal type (TBD...)
+type UpdateActivityOptionsRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Options           *ActivityOptions   `json:"options,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetOptions is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetOptions() (o *ActivityOptions) {
+	if v != nil && v.Options != nil {
+		return v.Options
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // UpdateDomainRequest is an internal type (TBD...)
 type UpdateDomainRequest struct {
 	Name                                   string                             `json:"name,omitempty"`
@@ -8270,6 +8760,113 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UnpauseActivityRequest is an internal type (TBD...)
+type UnpauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityId,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -8393,6 +8990,30 @@ func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
 	return
 }
 
+// WorkflowDeadline is the soft deadline of a StartWorkflowExecution request. It is kept in the execution info of
+// the workflow, once it is exceeded the workflow is requested to cancel, and it is terminated if it is still running
+// after the grace period. The deadline is not replicated to other clusters.
+type WorkflowDeadline struct {
+	DeadlineSeconds    int32 `json:"deadlineSeconds,omitempty"`
+	GracePeriodSeconds int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// GetDeadlineSeconds is an internal getter (TBD...)
+func (v *WorkflowDeadline) GetDeadlineSeconds() (o int32) {
+	if v != nil {
+		return v.DeadlineSeconds
+	}
+	return
+}
+
+// GetGracePeriodSeconds is an internal getter (TBD...)
+func (v *WorkflowDeadline) GetGracePeriodSeconds() (o int32) {
+	if v != nil {
+		return v.GracePeriodSeconds
+	}
+	return
+}
+
 // WorkflowExecution is an internal type (TBD...)
 type WorkflowExecution struct {
 	WorkflowID string `json:"workflowId,omitempty"`
@@ -9060,6 +9681,14 @@ func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *Sear
 	return
 }
 
+// GetHeader is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
 // GetPrevAutoResetPoints is an internal getter (TBD...)
 func (v *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() (o *ResetPoints) {
 	if v != nil && v.PrevAutoResetPoints != nil {
@@ -9279,6 +9908,43 @@ func (v *WorkflowTypeFilter) GetName() (o string) {
 	return
 }
 
+// A paused workflow execution doesn't get new decision tasks and its activities are not dispatched to
+// workers, events such as signals, fired timers and activity results are recorded in the history and
+// delivered to the workflow with the first decision task after it is unpaused. The pause state is kept in
+// the Paused field of the execution info and only applies to the current run: a run started by continue as
+// new, retry or cron is not paused. Until the public IDL has dedicated history events, pausing and unpausing
+// is recorded in the workflow history as a WorkflowExecutionSignaled event named WorkflowPauseSignalName or
+// WorkflowUnpauseSignalName whose input is the reason.
+const (
+	// WorkflowPauseSignalName is the name of the signal pausing the workflow execution
+	WorkflowPauseSignalName = "__cadence_workflow_pause"
+	// WorkflowUnpauseSignalName is the name of the signal unpausing the workflow execution
+	WorkflowUnpauseSignalName = "__cadence_workflow_unpause"
+)
+
+// CompletionCallbackPayload is the body of the HTTP POST notifying a completion callback of a StartWorkflowExecution
+// request once the workflow execution closes, a run continued as new is not closed and passes its callbacks on to
+// the new run. The callbacks are kept in the execution info rather than in the workflow history, as their headers
+// may hold credentials, so they are neither replicated to other clusters nor carried over by a reset.
+// Workflows of global domains can't register callbacks for that reason.
+type CompletionCallbackPayload struct {
+	Domain         string                        `json:"domain,omitempty"`
+	WorkflowID     string                        `json:"workflowId,omitempty"`
+	RunID          string                        `json:"runId,omitempty"`
+	WorkflowType   string                        `json:"workflowType,omitempty"`
+	CloseStatus    *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	CloseTimestamp int64                         `json:"closeTimestamp,omitempty"`
+}
+
+// IsReservedSignalName returns true if the signal is sent by the server and can't be sent by clients
+func IsReservedSignalName(signalName string) bool {
+	switch signalName {
+	case WorkflowPauseSignalName, WorkflowUnpauseSignalName:
+		return true
+	}
+	return false
+}
+
 // CrossClusterTaskType is an internal type (TBD...)
 type CrossClusterTaskType int32
 
@@ -83,6 +83,17 @@ var (
 	HistoryNotifyFailoverMarkersRequest   = types.NotifyFailoverMarkersRequest{
 		FailoverMarkerTokens: FailoverMarkerTokenArray,
 	}
+	HistoryPauseActivityRequest = types.HistoryPauseActivityRequest{
+		DomainUUID: DomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			ActivityID:        ActivityID,
+			Reason:            Reason,
+			Identity:          Identity,
+			RequestID:         RequestID,
+		},
+	}
 	HistoryPollMutableStateRequest = types.PollMutableStateRequest{
 		DomainUUID:          DomainID,
 		Execution:           &WorkflowExecution,
@@ -199,7 +210,18 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
-	HistoryResetQueueRequest          = AdminResetQueueRequest
+	HistoryResetQueueRequest    = AdminResetQueueRequest
+	HistoryResetActivityRequest = types.HistoryResetActivityRequest{
+		DomainUUID: DomainID,
+		ResetRequest: &types.ResetActivityRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			ActivityID:        ActivityID,
+			Reason:            Reason,
+			Identity:          Identity,
+			RequestID:         RequestID,
+		},
+	}
 	HistoryResetStickyTaskListRequest = types.HistoryResetStickyTaskListRequest{
 		DomainUUID: DomainID,
 		Execution:  &WorkflowExecution,
@@ -298,6 +320,36 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryUnpauseActivityRequest = types.HistoryUnpauseActivityRequest{
+		DomainUUID: DomainID,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			ActivityID:        ActivityID,
+			Reason:            Reason,
+			Identity:          Identity,
+			RequestID:         RequestID,
+		},
+	}
+	ActivityOptions = types.ActivityOptions{
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(Duration1),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(Duration2),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(Duration3),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(Duration4),
+		RetryPolicy:                   &RetryPolicy,
+	}
+	HistoryUpdateActivityOptionsRequest = types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: DomainID,
+		UpdateRequest: &types.UpdateActivityOptionsRequest{
+			Domain:            DomainName,
+			WorkflowExecution: &WorkflowExecution,
+			ActivityID:        ActivityID,
+			Options:           &ActivityOptions,
+			Reason:            Reason,
+			Identity:          Identity,
+			RequestID:         RequestID,
+		},
+	}
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
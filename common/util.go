@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -111,6 +113,8 @@ const (
 	FailureReasonDecisionAttemptsExceedsLimit = "DECISION_ATTEMPTS_EXCEEDS_LIMIT"
 	// FailureReasonPendingActivityExceedsLimit is reason to fail overflow when pending activity exceeds limit
 	FailureReasonPendingActivityExceedsLimit = "PENDING_ACTIVITY_EXCEEDS_LIMIT"
+	// FailureReasonWorkflowDeadlineExceeded is reason to cancel and then terminate workflow when its deadline is exceeded
+	FailureReasonWorkflowDeadlineExceeded = "WORKFLOW_DEADLINE_EXCEEDED"
 )
 
 var (
@@ -503,6 +507,144 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	return nil
 }
 
+// ValidateTaskPriority validates the task priority of a request
+func ValidateTaskPriority(priority *int32) error {
+	if priority == nil {
+		return nil
+	}
+	if *priority < 0 || *priority > constants.MaxTaskPriority {
+		return &types.BadRequestError{Message: fmt.Sprintf("Priority must be between 0 and %v.", constants.MaxTaskPriority)}
+	}
+	return nil
+}
+
+// ValidateTaskPartitionConfig validates the task priority and fairness key that the client headers of a request
+// set on its partition config
+func ValidateTaskPartitionConfig(partitionConfig map[string]string) error {
+	if value, ok := partitionConfig[constants.PartitionConfigKeyForTaskPriority]; ok {
+		priority, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Header %v must be an integer.", ClientTaskPriorityHeaderName)}
+		}
+		if err := ValidateTaskPriority(Int32Ptr(int32(priority))); err != nil {
+			return err
+		}
+	}
+	return ValidateTaskFairnessKey(partitionConfig[constants.PartitionConfigKeyForTaskFairnessKey])
+}
+
+// TaskPriorityFromPartitionConfig returns the task priority stored in the partition config,
+// 0 if it is missing or invalid
+func TaskPriorityFromPartitionConfig(partitionConfig map[string]string) int32 {
+	value, ok := partitionConfig[constants.PartitionConfigKeyForTaskPriority]
+	if !ok {
+		return 0
+	}
+	priority, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || priority < 0 {
+		return 0
+	}
+	return int32(min(priority, constants.MaxTaskPriority))
+}
+
+// PartitionConfigWithTaskPriority returns a copy of the partition config with the given task priority,
+// priority 0 removes it
+func PartitionConfigWithTaskPriority(partitionConfig map[string]string, priority int32) map[string]string {
+	value := ""
+	if priority > 0 {
+		value = strconv.Itoa(int(priority))
+	}
+	return partitionConfigWithValue(partitionConfig, constants.PartitionConfigKeyForTaskPriority, value)
+}
+
+// ValidateTaskFairnessKey validates the task fairness key of a request
+func ValidateTaskFairnessKey(fairnessKey string) error {
+	if len(fairnessKey) > constants.MaxTaskFairnessKeyLength {
+		return &types.BadRequestError{Message: fmt.Sprintf("FairnessKey exceeds length limit of %v.", constants.MaxTaskFairnessKeyLength)}
+	}
+	return nil
+}
+
+// PartitionConfigWithTaskFairnessKey returns a copy of the partition config with the given task fairness key,
+// an empty fairness key removes it
+func PartitionConfigWithTaskFairnessKey(partitionConfig map[string]string, fairnessKey string) map[string]string {
+	return partitionConfigWithValue(partitionConfig, constants.PartitionConfigKeyForTaskFairnessKey, fairnessKey)
+}
+
+func partitionConfigWithValue(partitionConfig map[string]string, key, value string) map[string]string {
+	if value == "" && len(partitionConfig) == 0 {
+		return partitionConfig
+	}
+	res := make(map[string]string, len(partitionConfig)+1)
+	for k, v := range partitionConfig {
+		res[k] = v
+	}
+	if value != "" {
+		res[key] = value
+	} else {
+		delete(res, key)
+	}
+	return res
+}
+
+// ValidateCompletionCallbacks validates the completion callbacks of a request, their URLs must be http or https
+// URLs of one of the allowed hosts
+func ValidateCompletionCallbacks(callbacks []*types.CompletionCallback, allowedHosts []interface{}) error {
+	if len(callbacks) > constants.MaxCompletionCallbacks {
+		return &types.BadRequestError{Message: fmt.Sprintf("CompletionCallbacks exceeds limit of %v.", constants.MaxCompletionCallbacks)}
+	}
+	for _, callback := range callbacks {
+		u, err := url.Parse(callback.GetURL())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &types.BadRequestError{Message: fmt.Sprintf("CompletionCallback URL %q is not a valid http or https URL.", callback.GetURL())}
+		}
+		if !IsCompletionCallbackHostAllowed(u.Hostname(), allowedHosts) {
+			return &types.BadRequestError{Message: fmt.Sprintf("CompletionCallback host %v is not allowed.", u.Hostname())}
+		}
+	}
+	return nil
+}
+
+// IsCompletionCallbackHostAllowed returns true if the host is one of the allowed hosts, an allowed host
+// starting with "*." also allows the subdomains of the host that follows
+func IsCompletionCallbackHostAllowed(host string, allowedHosts []interface{}) bool {
+	host = strings.ToLower(host)
+	for _, allowedHost := range allowedHosts {
+		allowed, ok := allowedHost.(string)
+		if !ok {
+			continue
+		}
+		allowed = strings.ToLower(allowed)
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok {
+			if strings.HasPrefix(suffix, ".") && strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWorkflowDeadline validates the deadline of a request against its execution timeout
+func ValidateWorkflowDeadline(deadline *types.WorkflowDeadline, executionTimeoutSeconds int32) error {
+	if deadline == nil {
+		return nil
+	}
+	if deadline.GetDeadlineSeconds() <= 0 {
+		return &types.BadRequestError{Message: "Invalid DeadlineSeconds."}
+	}
+	if deadline.GetGracePeriodSeconds() < 0 {
+		return &types.BadRequestError{Message: "Invalid GracePeriodSeconds."}
+	}
+	if deadline.GetDeadlineSeconds() >= executionTimeoutSeconds {
+		return &types.BadRequestError{Message: "DeadlineSeconds must be less than ExecutionStartToCloseTimeoutSeconds."}
+	}
+	return nil
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(
 	domainID string,
@@ -510,6 +652,12 @@ func CreateHistoryStartWorkflowRequest(
 	now time.Time,
 	partitionConfig map[string]string,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
+	if startRequest.Priority != nil {
+		partitionConfig = PartitionConfigWithTaskPriority(partitionConfig, startRequest.GetPriority())
+	}
+	if startRequest.FairnessKey != "" {
+		partitionConfig = PartitionConfigWithTaskFairnessKey(partitionConfig, startRequest.GetFairnessKey())
+	}
 	histRequest := &types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:      domainID,
 		StartRequest:    startRequest,
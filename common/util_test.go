@@ -32,6 +32,7 @@ import (
 	"runtime"
 	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -404,6 +405,287 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithoutCron(t *testing.
 	require.True(t, delta < 62*time.Second)
 }
 
+func TestCreateHistoryStartWorkflowRequest_Priority(t *testing.T) {
+	partitionConfig := map[string]string{"isolation-group": "zone-a"}
+	request := &types.StartWorkflowExecutionRequest{Priority: Int32Ptr(3)}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), partitionConfig)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskPriority: "3"}, startRequest.PartitionConfig)
+	assert.Equal(t, map[string]string{"isolation-group": "zone-a"}, partitionConfig, "input partition config must not be modified")
+}
+
+func TestValidateTaskPriority(t *testing.T) {
+	tests := map[string]struct {
+		priority *int32
+		wantErr  bool
+	}{
+		"not set":   {priority: nil},
+		"lowest":    {priority: Int32Ptr(0)},
+		"highest":   {priority: Int32Ptr(constants.MaxTaskPriority)},
+		"negative":  {priority: Int32Ptr(-1), wantErr: true},
+		"too large": {priority: Int32Ptr(constants.MaxTaskPriority + 1), wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTaskPriority(tc.priority)
+			if tc.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTaskPartitionConfig(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig map[string]string
+		wantErr         bool
+	}{
+		"nil config":         {partitionConfig: nil},
+		"no priority":        {partitionConfig: map[string]string{"isolation-group": "zone-a"}},
+		"valid priority":     {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "2"}},
+		"priority too large": {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "100"}, wantErr: true},
+		"negative priority":  {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "-1"}, wantErr: true},
+		"not a number":       {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "high"}, wantErr: true},
+		"valid fairness key": {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskFairnessKey: "tenant"}},
+		"fairness key too long": {
+			partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskFairnessKey: strings.Repeat("a", constants.MaxTaskFairnessKeyLength+1)},
+			wantErr:         true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTaskPartitionConfig(tc.partitionConfig)
+			if tc.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskPriorityFromPartitionConfig(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig map[string]string
+		want            int32
+	}{
+		"nil config":   {partitionConfig: nil, want: 0},
+		"missing key":  {partitionConfig: map[string]string{"isolation-group": "zone-a"}, want: 0},
+		"valid":        {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "2"}, want: 2},
+		"capped":       {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "100"}, want: constants.MaxTaskPriority},
+		"negative":     {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "-1"}, want: 0},
+		"not a number": {partitionConfig: map[string]string{constants.PartitionConfigKeyForTaskPriority: "high"}, want: 0},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, TaskPriorityFromPartitionConfig(tc.partitionConfig))
+		})
+	}
+}
+
+func TestPartitionConfigWithTaskPriority(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig map[string]string
+		priority        int32
+		want            map[string]string
+	}{
+		"nil config, no priority": {
+			partitionConfig: nil,
+			priority:        0,
+			want:            nil,
+		},
+		"nil config": {
+			partitionConfig: nil,
+			priority:        4,
+			want:            map[string]string{constants.PartitionConfigKeyForTaskPriority: "4"},
+		},
+		"overrides priority": {
+			partitionConfig: map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskPriority: "1"},
+			priority:        4,
+			want:            map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskPriority: "4"},
+		},
+		"removes priority": {
+			partitionConfig: map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskPriority: "1"},
+			priority:        0,
+			want:            map[string]string{"isolation-group": "zone-a"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, PartitionConfigWithTaskPriority(tc.partitionConfig, tc.priority))
+		})
+	}
+}
+
+func TestCreateHistoryStartWorkflowRequest_FairnessKey(t *testing.T) {
+	request := &types.StartWorkflowExecutionRequest{FairnessKey: "tenant-a"}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a"}, startRequest.PartitionConfig)
+}
+
+func TestCreateHistoryStartWorkflowRequest_CompletionCallbacks(t *testing.T) {
+	callbacks := []*types.CompletionCallback{{URL: "https://example.com/done", Header: map[string]string{"token": "secret"}}}
+	request := &types.StartWorkflowExecutionRequest{
+		Header:              &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+		CompletionCallbacks: callbacks,
+	}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, callbacks, startRequest.StartRequest.CompletionCallbacks)
+	// the callbacks are not recorded in the header of the started event
+	assert.Equal(t, request.Header, startRequest.StartRequest.Header)
+}
+
+func TestValidateCompletionCallbacks(t *testing.T) {
+	allowedHosts := []interface{}{"example.com", "*.callbacks.example.com"}
+	tests := map[string]struct {
+		callbacks []*types.CompletionCallback
+		wantErr   bool
+	}{
+		"no callbacks": {},
+		"valid callbacks": {
+			callbacks: []*types.CompletionCallback{{URL: "http://example.com:8080/done"}, {URL: "https://a.callbacks.example.com/done"}},
+		},
+		"too many callbacks": {
+			callbacks: make([]*types.CompletionCallback, constants.MaxCompletionCallbacks+1),
+			wantErr:   true,
+		},
+		"unsupported scheme": {
+			callbacks: []*types.CompletionCallback{{URL: "ftp://example.com/done"}},
+			wantErr:   true,
+		},
+		"missing host": {
+			callbacks: []*types.CompletionCallback{{URL: "http:///done"}},
+			wantErr:   true,
+		},
+		"host not allowed": {
+			callbacks: []*types.CompletionCallback{{URL: "http://169.254.169.254/latest/meta-data"}},
+			wantErr:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateCompletionCallbacks(tc.callbacks, allowedHosts)
+			if tc.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsCompletionCallbackHostAllowed(t *testing.T) {
+	allowedHosts := []interface{}{"Example.com", "*.callbacks.example.com", 1}
+	tests := map[string]bool{
+		"example.com":               true,
+		"EXAMPLE.COM":               true,
+		"a.callbacks.example.com":   true,
+		"a.b.callbacks.example.com": true,
+		"callbacks.example.com":     false,
+		"evilcallbacks.example.com": false,
+		"a.example.com":             false,
+		"localhost":                 false,
+		"example.com.attacker.net":  false,
+		".callbacks.example.com":    false,
+	}
+	for host, want := range tests {
+		t.Run(host, func(t *testing.T) {
+			assert.Equal(t, want, IsCompletionCallbackHostAllowed(host, allowedHosts))
+		})
+	}
+	assert.False(t, IsCompletionCallbackHostAllowed("example.com", nil))
+}
+
+func TestCreateHistoryStartWorkflowRequest_Deadline(t *testing.T) {
+	deadline := &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: 10}
+	request := &types.StartWorkflowExecutionRequest{
+		Header:   &types.Header{Fields: map[string][]byte{"key": []byte("value")}},
+		Deadline: deadline,
+	}
+
+	startRequest, err := CreateHistoryStartWorkflowRequest(uuid.New(), request, time.Now(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, deadline, startRequest.StartRequest.Deadline)
+	// the deadline is kept out of the header
+	assert.Equal(t, request.Header, startRequest.StartRequest.Header)
+}
+
+func TestValidateWorkflowDeadline(t *testing.T) {
+	tests := map[string]struct {
+		deadline *types.WorkflowDeadline
+		wantErr  bool
+	}{
+		"no deadline": {},
+		"valid deadline": {
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: 600},
+		},
+		"deadline not set": {
+			deadline: &types.WorkflowDeadline{GracePeriodSeconds: 10},
+			wantErr:  true,
+		},
+		"negative grace period": {
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: -1},
+			wantErr:  true,
+		},
+		"deadline after execution timeout": {
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 100},
+			wantErr:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateWorkflowDeadline(tc.deadline, 100)
+			if tc.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTaskFairnessKey(t *testing.T) {
+	assert.NoError(t, ValidateTaskFairnessKey(""))
+	assert.NoError(t, ValidateTaskFairnessKey(strings.Repeat("a", constants.MaxTaskFairnessKeyLength)))
+	assert.IsType(t, &types.BadRequestError{}, ValidateTaskFairnessKey(strings.Repeat("a", constants.MaxTaskFairnessKeyLength+1)))
+}
+
+func TestPartitionConfigWithTaskFairnessKey(t *testing.T) {
+	tests := map[string]struct {
+		partitionConfig map[string]string
+		fairnessKey     string
+		want            map[string]string
+	}{
+		"nil config, no fairness key": {
+			partitionConfig: nil,
+			fairnessKey:     "",
+			want:            nil,
+		},
+		"overrides fairness key": {
+			partitionConfig: map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a"},
+			fairnessKey:     "tenant-b",
+			want:            map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskFairnessKey: "tenant-b"},
+		},
+		"removes fairness key": {
+			partitionConfig: map[string]string{"isolation-group": "zone-a", constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a"},
+			fairnessKey:     "",
+			want:            map[string]string{"isolation-group": "zone-a"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, PartitionConfigWithTaskFairnessKey(tc.partitionConfig, tc.fairnessKey))
+		})
+	}
+}
+
 func TestConvertIndexedValueTypeToInternalType(t *testing.T) {
 	values := []types.IndexedValueType{types.IndexedValueTypeString, types.IndexedValueTypeKeyword, types.IndexedValueTypeInt, types.IndexedValueTypeDouble, types.IndexedValueTypeBool, types.IndexedValueTypeDatetime}
 	for _, expected := range values {
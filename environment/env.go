@@ -75,6 +75,13 @@ const (
 	// MongoDefaultPort is Mongo default port
 	MongoDefaultPort = "27017"
 
+	// DynamoDBSeeds env
+	DynamoDBSeeds = "DYNAMODB_SEEDS"
+	// DynamoDBPort env
+	DynamoDBPort = "DYNAMODB_PORT"
+	// DynamoDBDefaultPort is the default port of DynamoDB local
+	DynamoDBDefaultPort = "8000"
+
 	// KafkaSeeds env
 	KafkaSeeds = "KAFKA_SEEDS"
 	// KafkaPort env
@@ -100,6 +107,13 @@ const (
 	// PostgresDefaultPort Postgres default port
 	PostgresDefaultPort = "5432"
 
+	// CockroachDBSeeds env
+	CockroachDBSeeds = "COCKROACHDB_SEEDS"
+	// CockroachDBPort env
+	CockroachDBPort = "COCKROACHDB_PORT"
+	// CockroachDBDefaultPort CockroachDB default port
+	CockroachDBDefaultPort = "26257"
+
 	// CLITransportProtocol env
 	CLITransportProtocol = "CADENCE_CLI_TRANSPORT_PROTOCOL"
 	// DefaultCLITransportProtocol  CLI default channel
@@ -114,6 +128,8 @@ var envDefaults = map[string]string{
 	MySQLPort:             MySQLDefaultPort,
 	PostgresSeeds:         Localhost,
 	PostgresPort:          PostgresDefaultPort,
+	CockroachDBSeeds:      Localhost,
+	CockroachDBPort:       CockroachDBDefaultPort,
 	KafkaSeeds:            Localhost,
 	KafkaPort:             KafkaDefaultPort,
 	ESSeeds:               Localhost,
@@ -249,6 +265,25 @@ func GetPostgresPort() (int, error) {
 	return strconv.Atoi(port)
 }
 
+// GetCockroachDBAddress return the CockroachDB address
+func GetCockroachDBAddress() string {
+	addr := os.Getenv(CockroachDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetCockroachDBPort return the CockroachDB port
+func GetCockroachDBPort() (int, error) {
+	port := os.Getenv(CockroachDBPort)
+	if port == "" {
+		port = CockroachDBDefaultPort
+	}
+
+	return strconv.Atoi(port)
+}
+
 // GetESVersion return the ElasticSearch version
 func GetESVersion() string {
 	version := os.Getenv(ESVersion)
@@ -277,6 +312,25 @@ func GetMongoPort() (int, error) {
 	return strconv.Atoi(port)
 }
 
+// GetDynamoDBAddress return the DynamoDB address
+func GetDynamoDBAddress() string {
+	addr := os.Getenv(DynamoDBSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetDynamoDBPort return the DynamoDB port
+func GetDynamoDBPort() (int, error) {
+	port := os.Getenv(DynamoDBPort)
+	if port == "" {
+		port = DynamoDBDefaultPort
+	}
+
+	return strconv.Atoi(port)
+}
+
 func setEnv(key string, val string) error {
 	if err := os.Setenv(key, val); err != nil {
 		return fmt.Errorf("setting env %q: %w", key, err)
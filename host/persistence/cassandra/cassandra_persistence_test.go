@@ -120,6 +120,14 @@ func TestCassandraDomainAuditPersistence(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraAsyncWorkflowRequestStatusPersistence(t *testing.T) {
+	testflags.RequireCassandra(t)
+	s := new(persistencetests.AsyncWorkflowRequestStatusPersistenceSuite)
+	s.TestBase = public.NewTestBaseWithPublicCassandra(t, &persistencetests.TestBaseOptions{})
+	s.Setup()
+	suite.Run(t, s)
+}
+
 func TestCassandraHistoryTaskDLQPersistence(t *testing.T) {
 	testflags.RequireCassandra(t)
 	s := new(persistencetests.HistoryTaskDLQPersistenceSuite)
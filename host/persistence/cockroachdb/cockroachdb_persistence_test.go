@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/cockroachdb"
+	"github.com/uber/cadence/testflags"
+)
+
+func TestCockroachDBHistoryV2PersistenceSuite(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.HistoryV2PersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBMatchingPersistenceSuite(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.MatchingPersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBMetadataPersistenceSuiteV2(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.MetadataPersistenceSuiteV2)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBShardPersistenceSuite(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.ShardPersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+type ExecutionManagerSuite struct {
+	pt.ExecutionManagerSuite
+}
+
+func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionWithWorkflowRequestsDedup() {
+	s.T().Skip("skip the test until we store workflow_request in postgres sql, which the cockroachdb plugin reuses")
+}
+
+func (s *ExecutionManagerSuite) TestUpdateWorkflowExecutionWithWorkflowRequestsDedup() {
+	s.T().Skip("skip the test until we store workflow_request in postgres sql, which the cockroachdb plugin reuses")
+}
+
+func (s *ExecutionManagerSuite) TestGetActiveClusterSelectionPolicy() {
+	s.T().Skip("skip the test until we support ActiveClusterSelectionPolicy in postgres sql, which the cockroachdb plugin reuses")
+}
+
+func (s *ExecutionManagerSuite) TestDeleteActiveClusterSelectionPolicy() {
+	s.T().Skip("skip the test until we support ActiveClusterSelectionPolicy in postgres sql, which the cockroachdb plugin reuses")
+}
+
+func TestCockroachDBExecutionManagerSuite(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(ExecutionManagerSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBExecutionManagerWithEventsV2(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.ExecutionManagerSuiteForEventsV2)
+	option, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, option)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBVisibilityPersistenceSuite(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.DBVisibilityPersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBConfigPersistence(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.ConfigStorePersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBDomainAuditPersistence(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.DomainAuditPersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestCockroachDBAsyncWorkflowRequestStatusPersistence(t *testing.T) {
+	testflags.RequireCockroachDB(t)
+	s := new(pt.AsyncWorkflowRequestStatusPersistenceSuite)
+	options, err := cockroachdb.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
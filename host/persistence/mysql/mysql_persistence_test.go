@@ -142,3 +142,13 @@ func TestMySQLDomainAuditPersistence(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestMySQLAsyncWorkflowRequestStatusPersistence(t *testing.T) {
+	testflags.RequireMySQL(t)
+	s := new(pt.AsyncWorkflowRequestStatusPersistenceSuite)
+	option, err := mysql.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, option)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
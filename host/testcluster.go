@@ -53,6 +53,7 @@ import (
 	"github.com/uber/cadence/common/persistence/nosql"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/cockroachdb"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
@@ -339,6 +340,9 @@ func NewPersistenceTestCluster(t *testing.T, clusterConfig *TestClusterConfig) c
 			ops, err = postgres.GetTestClusterOption()
 		case sqlite.PluginName:
 			ops = sqlite.GetTestClusterOption()
+		case cockroachdb.PluginName:
+			testflags.RequireCockroachDB(t)
+			ops, err = cockroachdb.GetTestClusterOption()
 		default:
 			t.Fatal("not supported plugin " + TestFlags.SQLPluginName)
 		}
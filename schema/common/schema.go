@@ -2,6 +2,7 @@ package common
 
 import (
 	"bufio"
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
@@ -27,8 +28,12 @@ type (
 		Files               fs.FS
 		versionsPath        string
 		skipToLatestDDLPath string
+		parse               ddlParser
 	}
 
+	// ddlParser splits a schema file into the statements that are applied one by one
+	ddlParser func(file fs.File) ([]string, error)
+
 	// manifest is a value type that represents
 	// the deserialized manifest.json file within
 	// a schema version directory
@@ -47,6 +52,19 @@ func EmbeddedSchema(files fs.FS, version string, path string, skipToLatestDDLPat
 		versionsPath:        filepath.Join(path, VersionsPath),
 		Files:               files,
 		skipToLatestDDLPath: filepath.Join(path, skipToLatestDDLPath),
+		parse:               parseDDL,
+	}
+}
+
+// EmbeddedJSONSchema is the same as EmbeddedSchema, but for databases without a query language where
+// schema files are JSON arrays. Every element of the array is returned as a single compact JSON statement.
+func EmbeddedJSONSchema(files fs.FS, version string, path string, skipToLatestDDLPath string) persistence.Schema {
+	return &embeddedSchema{
+		latest:              mustParseVersion(version),
+		versionsPath:        filepath.Join(path, VersionsPath),
+		Files:               files,
+		skipToLatestDDLPath: filepath.Join(path, skipToLatestDDLPath),
+		parse:               parseJSONDDL,
 	}
 }
 
@@ -66,7 +84,7 @@ func (s *embeddedSchema) AllUpdates() ([]*persistence.SchemaUpdate, error) {
 		// They're optional and have never been used since
 		if dir.IsDir() && strings.HasPrefix(dir.Name(), "v") {
 			version := strings.TrimPrefix(dir.Name(), "v")
-			update, uErr := parseUpdate(s.Files, filepath.Join(s.versionsPath, dir.Name()), version)
+			update, uErr := parseUpdate(s.Files, filepath.Join(s.versionsPath, dir.Name()), version, s.parse)
 			if uErr != nil {
 				return nil, uErr
 			}
@@ -86,7 +104,7 @@ func (s *embeddedSchema) SkipToLatest() (*persistence.SchemaUpdate, error) {
 	if err != nil {
 		return nil, err
 	}
-	ddl, err := parseDDL(file)
+	ddl, err := s.parse(file)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +117,7 @@ func (s *embeddedSchema) SkipToLatest() (*persistence.SchemaUpdate, error) {
 	}, nil
 }
 
-func parseUpdate(root fs.FS, path, version string) (*persistence.SchemaUpdate, error) {
+func parseUpdate(root fs.FS, path, version string, parse ddlParser) (*persistence.SchemaUpdate, error) {
 	man, err := readManifest(root, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read manifest for version %s: %w", version, err)
@@ -123,7 +141,7 @@ func parseUpdate(root fs.FS, path, version string) (*persistence.SchemaUpdate, e
 	}
 	update.MinCompatibleVersion = minVer
 
-	ddl, err := readDDLStatements(root, path, man)
+	ddl, err := readDDLStatements(root, path, man, parse)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read ddl statements: %w", err)
 	}
@@ -161,7 +179,7 @@ func readManifest(fileSystem fs.FS, subdir string) (*manifest, error) {
 	return &m, nil
 }
 
-func readDDLStatements(root fs.FS, subdir string, man *manifest) ([]string, error) {
+func readDDLStatements(root fs.FS, subdir string, man *manifest, parse ddlParser) ([]string, error) {
 	var result []string
 
 	for _, file := range man.SchemaUpdateCqlFiles {
@@ -170,7 +188,7 @@ func readDDLStatements(root fs.FS, subdir string, man *manifest) ([]string, erro
 		if err != nil {
 			return nil, fmt.Errorf("error opening file %v, err=%v", path, err)
 		}
-		stmts, err := parseDDL(f)
+		stmts, err := parse(f)
 		if err != nil {
 			return nil, fmt.Errorf("error parsing file %v, err=%v", path, err)
 		}
@@ -221,6 +239,23 @@ func parseDDL(file fs.File) ([]string, error) {
 	return nil, err
 }
 
+func parseJSONDDL(file fs.File) ([]string, error) {
+	var elements []json.RawMessage
+	if err := json.NewDecoder(file).Decode(&elements); err != nil {
+		return nil, err
+	}
+
+	stmts := make([]string, 0, len(elements))
+	for _, element := range elements {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, element); err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, buf.String())
+	}
+	return stmts, nil
+}
+
 func mustParseVersion(v string) persistence.Version {
 	version, err := persistence.ParseVersion(v)
 	if err != nil {
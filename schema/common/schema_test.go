@@ -12,6 +12,8 @@ var TestDataFS embed.FS
 
 var TestSchema = EmbeddedSchema(TestDataFS, "0.2", "testdata", "latest.sql")
 
+var TestJSONSchema = EmbeddedJSONSchema(TestDataFS, "0.1", "testdata/json", "latest.json")
+
 func TestMetadata(t *testing.T) {
 	updates, err := TestSchema.AllUpdates()
 	require.NoError(t, err)
@@ -68,3 +70,21 @@ func TestLoadMultipleFiles(t *testing.T) {
 	require.Equal(t, "INSERT INTO domain_metadata (notification_version) VALUES (2);", statements[2])
 
 }
+
+func TestLoadJSON(t *testing.T) {
+	tables := []string{
+		`{"TableName":"shards","KeySchema":[{"AttributeName":"shard_id","KeyType":"HASH"}]}`,
+		`{"TableName":"domains","KeySchema":[{"AttributeName":"name","KeyType":"HASH"}]}`,
+	}
+
+	updates, err := TestJSONSchema.AllUpdates()
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, "0.1", updates[0].Version.String())
+	require.NotEmpty(t, updates[0].ManifestMD5)
+	require.Equal(t, tables, updates[0].DDLStatements)
+
+	latest, err := TestJSONSchema.SkipToLatest()
+	require.NoError(t, err)
+	require.Equal(t, tables, latest.DDLStatements)
+}
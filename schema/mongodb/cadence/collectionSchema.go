@@ -20,9 +20,25 @@
 
 package cadence
 
-// below are the names of all mongoDB collections
+// below are the names of all mongoDB collections.
+// Except cluster_config, every collection stores documents with a partition key "pk" and a sort key "sk" which are
+// unique together, the fields used in conditional updates, and all the other fields of a row encoded as JSON in "data".
+// Documents with a TTL have an "expiresat" date, after which MongoDB deletes them in the background.
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName              = "cluster_config"
+	ShardsCollectionName                     = "shards"
+	ExecutionsCollectionName                 = "executions"
+	HistoryTasksCollectionName               = "history_tasks"
+	HistoryTreeCollectionName                = "history_tree"
+	HistoryNodeCollectionName                = "history_node"
+	TasksCollectionName                      = "tasks"
+	QueueCollectionName                      = "queue"
+	DomainsCollectionName                    = "domains"
+	DomainAuditLogCollectionName             = "domain_audit_log"
+	AsyncWorkflowRequestStatusCollectionName = "async_workflow_request_status"
+	HistoryTaskDLQCollectionName             = "history_task_dlq"
+	HistoryTaskDLQAckLevelCollectionName     = "history_task_dlq_ack_level"
+	SchemaVersionCollectionName              = "schema_version"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
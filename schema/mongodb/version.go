@@ -20,7 +20,13 @@
 
 package mongodb
 
+import "github.com/uber/cadence/schema/common"
+
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the MongoDB database schema release version
-const Version = "0.1"
+const Version = "0.2"
+
+var (
+	DefaultSchema = common.EmbeddedJSONSchema(SchemaFS, Version, "cadence", "schema.json")
+)
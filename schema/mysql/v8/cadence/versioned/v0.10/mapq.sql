@@ -0,0 +1,16 @@
+CREATE TABLE mapq_items (
+  queue_id                VARCHAR(255) NOT NULL,
+  partition_path          VARCHAR(255) NOT NULL,
+  item_offset             BIGINT NOT NULL,
+  --
+  data                    MEDIUMBLOB NOT NULL,
+  PRIMARY KEY (queue_id, partition_path, item_offset)
+);
+
+CREATE TABLE mapq_offsets (
+  queue_id                VARCHAR(255) NOT NULL,
+  partition_path          VARCHAR(255) NOT NULL,
+  --
+  item_offset             BIGINT NOT NULL,
+  PRIMARY KEY (queue_id, partition_path)
+);
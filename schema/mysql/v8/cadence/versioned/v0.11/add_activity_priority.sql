@@ -0,0 +1 @@
+ALTER TABLE activity_info_maps ADD priority INT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE executions ADD paused TINYINT(1) NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE executions ADD completion_callbacks MEDIUMBLOB;
+ALTER TABLE executions ADD completion_callbacks_encoding VARCHAR(16) NOT NULL DEFAULT '';
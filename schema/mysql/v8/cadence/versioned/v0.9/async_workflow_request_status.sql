@@ -0,0 +1,14 @@
+CREATE TABLE async_workflow_request_status (
+  domain_id               VARCHAR(255) NOT NULL,
+  request_id              VARCHAR(255) NOT NULL,
+  --
+  workflow_id             VARCHAR(255) NOT NULL,
+  run_id                  VARCHAR(255) NOT NULL DEFAULT '',
+  state                   INT NOT NULL,
+  failure_reason          TEXT NOT NULL,
+  created_time            DATETIME(6) NOT NULL,
+  last_updated_time       DATETIME(6) NOT NULL,
+  expiry_time             DATETIME(6) NOT NULL,
+  PRIMARY KEY (domain_id, request_id),
+  INDEX (expiry_time)
+);
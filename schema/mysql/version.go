@@ -25,10 +25,10 @@ import "github.com/uber/cadence/schema/common"
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the MySQL database release version
-const Version = "0.8"
+const Version = "0.15"
 
 // VisibilityVersion is the MySQL visibility database release version
-const VisibilityVersion = "0.8"
+const VisibilityVersion = "0.9"
 
 var (
 	DefaultSchema    = common.EmbeddedSchema(SchemaFS, Version, "v8/cadence", "schema.sql")
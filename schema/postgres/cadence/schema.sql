@@ -53,6 +53,11 @@ CREATE TABLE executions(
   last_write_version BIGINT NOT NULL,
   data BYTEA NOT NULL,
   data_encoding VARCHAR(16) NOT NULL,
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  completion_callbacks BYTEA,
+  completion_callbacks_encoding VARCHAR(16) NOT NULL DEFAULT '',
+  workflow_deadline BYTEA,
+  workflow_deadline_encoding VARCHAR(16) NOT NULL DEFAULT '',
   PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
 
@@ -148,6 +153,8 @@ CREATE TABLE activity_info_maps (
   data_encoding VARCHAR(16),
   last_heartbeat_details BYTEA,
   last_heartbeat_updated_time TIMESTAMP NOT NULL,
+  priority INTEGER NOT NULL DEFAULT 0,
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
   PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
 );
 
@@ -301,3 +308,36 @@ CREATE TABLE active_cluster_selection_policy (
   data_encoding VARCHAR(16)  NOT NULL,
   PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
+
+CREATE TABLE async_workflow_request_status (
+  domain_id               TEXT NOT NULL,
+  request_id              TEXT NOT NULL,
+  --
+  workflow_id             TEXT NOT NULL,
+  run_id                  TEXT NOT NULL DEFAULT '',
+  state                   INTEGER NOT NULL,
+  failure_reason          TEXT NOT NULL DEFAULT '',
+  created_time            TIMESTAMP NOT NULL,
+  last_updated_time       TIMESTAMP NOT NULL,
+  expiry_time             TIMESTAMP NOT NULL,
+  PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE INDEX async_workflow_request_status_expiry_time_idx ON async_workflow_request_status (expiry_time);
+
+CREATE TABLE mapq_items (
+  queue_id                VARCHAR(255) NOT NULL,
+  partition_path          VARCHAR(255) NOT NULL,
+  item_offset             BIGINT NOT NULL,
+  --
+  data                    BYTEA NOT NULL,
+  PRIMARY KEY (queue_id, partition_path, item_offset)
+);
+
+CREATE TABLE mapq_offsets (
+  queue_id                VARCHAR(255) NOT NULL,
+  partition_path          VARCHAR(255) NOT NULL,
+  --
+  item_offset             BIGINT NOT NULL,
+  PRIMARY KEY (queue_id, partition_path)
+);
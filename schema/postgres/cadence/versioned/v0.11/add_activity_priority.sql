@@ -0,0 +1 @@
+ALTER TABLE activity_info_maps ADD priority INTEGER NOT NULL DEFAULT 0;
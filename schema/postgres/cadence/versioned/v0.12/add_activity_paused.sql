@@ -0,0 +1 @@
+ALTER TABLE activity_info_maps ADD paused BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,15 @@
+CREATE TABLE async_workflow_request_status (
+  domain_id               TEXT NOT NULL,
+  request_id              TEXT NOT NULL,
+  --
+  workflow_id             TEXT NOT NULL,
+  run_id                  TEXT NOT NULL DEFAULT '',
+  state                   INTEGER NOT NULL,
+  failure_reason          TEXT NOT NULL DEFAULT '',
+  created_time            TIMESTAMP NOT NULL,
+  last_updated_time       TIMESTAMP NOT NULL,
+  expiry_time             TIMESTAMP NOT NULL,
+  PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE INDEX async_workflow_request_status_expiry_time_idx ON async_workflow_request_status (expiry_time);
@@ -26,11 +26,11 @@ import "github.com/uber/cadence/schema/common"
 
 // Version is the Postgres database release version
 // Cadence supports both MySQL and Postgres officially, so upgrade should be perform for both MySQL and Postgres
-const Version = "0.8"
+const Version = "0.15"
 
 // VisibilityVersion is the Postgres visibility database release version
 // Cadence supports both MySQL and Postgres officially, so upgrade should be perform for both MySQL and Postgres
-const VisibilityVersion = "0.9"
+const VisibilityVersion = "0.10"
 
 var (
 	DefaultSchema    = common.EmbeddedSchema(SchemaFS, Version, "cadence", "schema.sql")
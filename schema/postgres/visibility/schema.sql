@@ -18,6 +18,7 @@ CREATE TABLE executions_visibility (
   cron_schedule            VARCHAR(255) NULL,
   execution_status         INTEGER NULL,
   scheduled_execution_time TIMESTAMP NULL,
+  search_attributes        JSONB NULL,
 
   PRIMARY KEY  (domain_id, run_id)
 );
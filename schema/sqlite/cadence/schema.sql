@@ -61,6 +61,11 @@ CREATE TABLE executions
     last_write_version BIGINT       NOT NULL,
     data               MEDIUMBLOB   NOT NULL,
     data_encoding      VARCHAR(16)  NOT NULL,
+    paused             TINYINT(1)   NOT NULL DEFAULT 0,
+    completion_callbacks MEDIUMBLOB,
+    completion_callbacks_encoding VARCHAR(16) NOT NULL DEFAULT '',
+    workflow_deadline MEDIUMBLOB,
+    workflow_deadline_encoding VARCHAR(16) NOT NULL DEFAULT '',
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
 
@@ -163,6 +168,8 @@ CREATE TABLE activity_info_maps
     data_encoding               VARCHAR(16),
     last_heartbeat_details      BLOB,
     last_heartbeat_updated_time DATETIME(6)  NOT NULL,
+    priority                    INT          NOT NULL DEFAULT 0,
+    paused                      TINYINT(1)   NOT NULL DEFAULT 0,
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
 );
 
@@ -329,3 +336,39 @@ CREATE TABLE active_cluster_selection_policy
     data_encoding VARCHAR(16)  NOT NULL,
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
+
+CREATE TABLE async_workflow_request_status
+(
+    domain_id         VARCHAR(255) NOT NULL,
+    request_id        VARCHAR(255) NOT NULL,
+    --
+    workflow_id       VARCHAR(255) NOT NULL,
+    run_id            VARCHAR(255) NOT NULL DEFAULT '',
+    state             INT          NOT NULL,
+    failure_reason    TEXT         NOT NULL DEFAULT '',
+    created_time      DATETIME(6)  NOT NULL,
+    last_updated_time DATETIME(6)  NOT NULL,
+    expiry_time       DATETIME(6)  NOT NULL,
+    PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE INDEX async_workflow_request_status_expiry_time_idx ON async_workflow_request_status (expiry_time);
+
+CREATE TABLE mapq_items
+(
+    queue_id       VARCHAR(255) NOT NULL,
+    partition_path VARCHAR(255) NOT NULL,
+    item_offset    BIGINT       NOT NULL,
+    --
+    data           MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_id, partition_path, item_offset)
+);
+
+CREATE TABLE mapq_offsets
+(
+    queue_id       VARCHAR(255) NOT NULL,
+    partition_path VARCHAR(255) NOT NULL,
+    --
+    item_offset    BIGINT       NOT NULL,
+    PRIMARY KEY (queue_id, partition_path)
+);
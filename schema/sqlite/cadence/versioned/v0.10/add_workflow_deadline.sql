@@ -0,0 +1,2 @@
+ALTER TABLE executions ADD workflow_deadline MEDIUMBLOB;
+ALTER TABLE executions ADD workflow_deadline_encoding VARCHAR(16) NOT NULL DEFAULT '';
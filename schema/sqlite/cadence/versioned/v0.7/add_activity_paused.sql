@@ -0,0 +1 @@
+ALTER TABLE activity_info_maps ADD paused TINYINT(1) NOT NULL DEFAULT 0;
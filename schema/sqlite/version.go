@@ -27,10 +27,10 @@ import (
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the SQLite database release version
-const Version = "0.3"
+const Version = "0.10"
 
 // VisibilityVersion is the SQLite visibility database release version
-const VisibilityVersion = "0.2"
+const VisibilityVersion = "0.3"
 
 var (
 	DefaultSchema    = common.EmbeddedSchema(SchemaFS, Version, "cadence", "schema.sql")
@@ -0,0 +1,2 @@
+-- Add search_attributes field to store custom search attributes as a JSON object keyed by attribute name
+ALTER TABLE executions_visibility ADD search_attributes TEXT;
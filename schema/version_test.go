@@ -28,6 +28,9 @@ import (
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/schema/cassandra"
+	"github.com/uber/cadence/schema/cockroachdb"
+	"github.com/uber/cadence/schema/dynamodb"
+	"github.com/uber/cadence/schema/mongodb"
 	"github.com/uber/cadence/schema/mysql"
 	"github.com/uber/cadence/schema/postgres"
 	"github.com/uber/cadence/schema/sqlite"
@@ -54,6 +57,29 @@ func TestSchema(t *testing.T) {
 			schema:          cassandra.VisibilitySchema,
 			declaredVersion: cassandra.VisibilityVersion,
 		},
+		// CockroachDB
+		{
+			name:            "cockroachdb_main",
+			schema:          cockroachdb.DefaultSchema,
+			declaredVersion: cockroachdb.Version,
+		},
+		{
+			name:            "cockroachdb_visibility",
+			schema:          cockroachdb.VisibilitySchema,
+			declaredVersion: cockroachdb.VisibilityVersion,
+		},
+		// DynamoDB
+		{
+			name:            "dynamodb_main",
+			schema:          dynamodb.DefaultSchema,
+			declaredVersion: dynamodb.Version,
+		},
+		// MongoDB
+		{
+			name:            "mongodb_main",
+			schema:          mongodb.DefaultSchema,
+			declaredVersion: mongodb.Version,
+		},
 		// MySQL
 		{
 			name:            "mysql_main",
@@ -145,6 +145,7 @@ func NewWorkflowHandler(
 		producerManager: NewProducerManager(
 			resource.GetDomainCache(),
 			resource.GetAsyncWorkflowQueueProvider(),
+			resource.GetPersistenceBean().GetAsyncWorkflowQueueManager(),
 			resource.GetLogger(),
 			resource.GetMetricsClient(),
 		),
@@ -1611,6 +1612,13 @@ func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
 	if err != nil {
 		return nil, err
 	}
+	// the queued request is encoded with the IDL, which doesn't carry completion callbacks and deadlines yet
+	if len(startRequest.StartWorkflowExecutionRequest.CompletionCallbacks) > 0 {
+		return nil, &types.BadRequestError{Message: "CompletionCallbacks are not supported by StartWorkflowExecutionAsync."}
+	}
+	if startRequest.StartWorkflowExecutionRequest.Deadline != nil {
+		return nil, &types.BadRequestError{Message: "Deadline is not supported by StartWorkflowExecutionAsync."}
+	}
 
 	producer, err := wh.producerManager.GetProducerByDomain(startRequest.GetDomain())
 	if err != nil {
@@ -1641,11 +1649,16 @@ func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
 		Encoding:     common.StringPtr(string(constants.EncodingTypeThriftRW)),
 		Payload:      payload,
 	}
+	requestID := startRequest.GetRequestID()
+	tracked := wh.recordAsyncWorkflowRequestQueued(ctx, startRequest.GetDomain(), requestID, startRequest.GetWorkflowID())
 	err = producer.Publish(ctx, message)
 	if err != nil {
+		if tracked {
+			wh.recordAsyncWorkflowRequestPublishFailed(ctx, startRequest.GetDomain(), requestID, err)
+		}
 		return nil, err
 	}
-	return &types.StartWorkflowExecutionAsyncResponse{}, nil
+	return &types.StartWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
 }
 
 // StartWorkflowExecution - Creates a new workflow execution
@@ -1736,6 +1749,31 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
 		return err
 	}
+	if err := common.ValidateTaskPriority(startRequest.Priority); err != nil {
+		return err
+	}
+	if err := common.ValidateTaskFairnessKey(startRequest.FairnessKey); err != nil {
+		return err
+	}
+	if err := common.ValidateTaskPartitionConfig(wh.getPartitionConfig(ctx, domainName)); err != nil {
+		return err
+	}
+	if err := common.ValidateCompletionCallbacks(startRequest.CompletionCallbacks, wh.config.CompletionCallbackAllowedHosts()); err != nil {
+		return err
+	}
+	if len(startRequest.CompletionCallbacks) > 0 {
+		if !wh.config.EnableCompletionCallbacks(domainName) {
+			return &types.BadRequestError{Message: fmt.Sprintf("Completion callbacks are not enabled for domain %v.", domainName)}
+		}
+		// callbacks are not replicated, they would be lost once the domain fails over
+		domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
+		if err != nil {
+			return err
+		}
+		if domainEntry.IsGlobalDomain() {
+			return &types.BadRequestError{Message: fmt.Sprintf("Completion callbacks are not supported for global domain %v.", domainName)}
+		}
+	}
 	wh.GetLogger().Debug(
 		"Received StartWorkflowExecution. WorkflowID",
 		tag.WorkflowID(startRequest.GetWorkflowID()))
@@ -1759,6 +1797,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
 		return validate.ErrInvalidTaskStartToCloseTimeoutSeconds
 	}
+	if err := common.ValidateWorkflowDeadline(startRequest.Deadline, startRequest.GetExecutionStartToCloseTimeoutSeconds()); err != nil {
+		return err
+	}
 	if startRequest.GetDelayStartSeconds() < 0 {
 		return validate.ErrInvalidDelayStartSeconds
 	}
@@ -2162,6 +2203,9 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	if signalRequest.GetSignalName() == "" {
 		return validate.ErrSignalNameNotSet
 	}
+	if types.IsReservedSignalName(signalRequest.GetSignalName()) {
+		return errReservedSignal
+	}
 
 	if !common.IsValidIDLength(
 		signalRequest.GetSignalName(),
@@ -2242,6 +2286,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
 	if err != nil {
 		return nil, err
 	}
+	// the request ID is optional for this API, but the consumer and DescribeAsyncWorkflowRequest need one
+	if signalWithStartRequest.RequestID == "" {
+		signalWithStartRequest.RequestID = uuid.New().String()
+	}
 
 	// Serialize the message to be sent to the queue.
 	// Avoid JSON because json encoding of requests excludes PII fields such as input. JSON encoded request are logged by acccess controlled api layer for audit purposes.
@@ -2267,11 +2315,16 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
 		Encoding:     common.StringPtr(string(constants.EncodingTypeThriftRW)),
 		Payload:      payload,
 	}
+	requestID := signalWithStartRequest.GetRequestID()
+	tracked := wh.recordAsyncWorkflowRequestQueued(ctx, signalWithStartRequest.GetDomain(), requestID, signalWithStartRequest.GetWorkflowID())
 	err = producer.Publish(ctx, message)
 	if err != nil {
+		if tracked {
+			wh.recordAsyncWorkflowRequestPublishFailed(ctx, signalWithStartRequest.GetDomain(), requestID, err)
+		}
 		return nil, err
 	}
-	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}, nil
+	return &types.SignalWithStartWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
 }
 
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
@@ -2351,6 +2404,9 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	if signalWithStartRequest.GetSignalName() == "" {
 		return validate.ErrSignalNameNotSet
 	}
+	if types.IsReservedSignalName(signalWithStartRequest.GetSignalName()) {
+		return errReservedSignal
+	}
 
 	if !common.IsValidIDLength(
 		signalWithStartRequest.GetSignalName(),
@@ -2407,6 +2463,15 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return err
 	}
+	if err := common.ValidateTaskPriority(signalWithStartRequest.Priority); err != nil {
+		return err
+	}
+	if err := common.ValidateTaskFairnessKey(signalWithStartRequest.FairnessKey); err != nil {
+		return err
+	}
+	if err := common.ValidateTaskPartitionConfig(wh.getPartitionConfig(ctx, domainName)); err != nil {
+		return err
+	}
 
 	if signalWithStartRequest.GetCronSchedule() != "" {
 		if _, err := backoff.ValidateSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
@@ -2523,6 +2588,11 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 	if err := validate.CheckExecution(wfExecution); err != nil {
 		return nil, err
 	}
+	// these options are dropped by the IDL mappers, including the hop to history,
+	// so the reset would silently ignore them
+	if resetRequest.GetDryRun() || resetRequest.GetReapplyCancelRequests() || len(resetRequest.GetSignalNamesToReapply()) > 0 {
+		return nil, &types.BadRequestError{Message: "DryRun, SignalNamesToReapply and ReapplyCancelRequests are not supported yet."}
+	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(resetRequest.GetDomain())
 	if err != nil {
@@ -2604,6 +2674,9 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 		return nil, err
 	}
 
+	if err := common.ValidateTaskPartitionConfig(wh.getPartitionConfig(ctx, domainName)); err != nil {
+		return nil, err
+	}
 	isolationGroup := wh.getIsolationGroup(ctx, domainName)
 	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup) {
 		return nil, &types.BadRequestError{
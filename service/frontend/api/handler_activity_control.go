@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// PauseActivity pauses the retries of a pending activity: the activity is not dispatched to workers until
+// it is unpaused, an attempt already running keeps running.
+func (wh *WorkflowHandler) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.PauseActivityRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	domainID, err := wh.validateActivityControlRequest(
+		getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		pauseRequest.GetDomain(),
+		pauseRequest.GetWorkflowExecution(),
+		pauseRequest.GetActivityID(),
+		pauseRequest.GetRequestID(),
+	)
+	if err != nil {
+		return err
+	}
+	err = wh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// UnpauseActivity unpauses a paused activity, it is dispatched right away if it is waiting for its next attempt.
+func (wh *WorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseActivityRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	domainID, err := wh.validateActivityControlRequest(
+		getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, unpauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		unpauseRequest.GetDomain(),
+		unpauseRequest.GetWorkflowExecution(),
+		unpauseRequest.GetActivityID(),
+		unpauseRequest.GetRequestID(),
+	)
+	if err != nil {
+		return err
+	}
+	err = wh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// ResetActivity resets the attempt count of a pending activity, an activity waiting for its next attempt
+// is dispatched right away unless it is paused.
+func (wh *WorkflowHandler) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.ResetActivityRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if resetRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	domainID, err := wh.validateActivityControlRequest(
+		getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, resetRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		resetRequest.GetDomain(),
+		resetRequest.GetWorkflowExecution(),
+		resetRequest.GetActivityID(),
+		resetRequest.GetRequestID(),
+	)
+	if err != nil {
+		return err
+	}
+	err = wh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+// UpdateActivityOptions updates the retry policy and timeouts of a pending activity in place, the options
+// which are not set are left unchanged.
+func (wh *WorkflowHandler) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.UpdateActivityOptionsRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if updateRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := validateActivityOptions(updateRequest.GetOptions()); err != nil {
+		return err
+	}
+	domainID, err := wh.validateActivityControlRequest(
+		getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, updateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		updateRequest.GetDomain(),
+		updateRequest.GetWorkflowExecution(),
+		updateRequest.GetActivityID(),
+		updateRequest.GetRequestID(),
+	)
+	if err != nil {
+		return err
+	}
+	err = wh.GetHistoryClient().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
+
+func validateActivityOptions(options *types.ActivityOptions) error {
+	if options == nil {
+		return &types.BadRequestError{Message: "Options is not set on request."}
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil && options.GetScheduleToCloseTimeoutSeconds() <= 0 {
+		return &types.BadRequestError{Message: "A valid ScheduleToCloseTimeoutSeconds is not set on request."}
+	}
+	if options.ScheduleToStartTimeoutSeconds != nil && options.GetScheduleToStartTimeoutSeconds() <= 0 {
+		return &types.BadRequestError{Message: "A valid ScheduleToStartTimeoutSeconds is not set on request."}
+	}
+	if options.StartToCloseTimeoutSeconds != nil && options.GetStartToCloseTimeoutSeconds() <= 0 {
+		return &types.BadRequestError{Message: "A valid StartToCloseTimeoutSeconds is not set on request."}
+	}
+	if options.HeartbeatTimeoutSeconds != nil && options.GetHeartbeatTimeoutSeconds() < 0 {
+		return &types.BadRequestError{Message: "A valid HeartbeatTimeoutSeconds is not set on request."}
+	}
+	return common.ValidateRetryPolicy(options.RetryPolicy)
+}
+
+// validateActivityControlRequest validates the fields shared by the activity control requests
+// and returns the ID of their domain
+func (wh *WorkflowHandler) validateActivityControlRequest(
+	scope metrics.Scope,
+	domainName string,
+	execution *types.WorkflowExecution,
+	activityID string,
+	requestID string,
+) (string, error) {
+	if domainName == "" {
+		return "", validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(execution); err != nil {
+		return "", err
+	}
+	if activityID == "" {
+		return "", validate.ErrActivityIDNotSet
+	}
+
+	if !common.IsValidIDLength(
+		requestID,
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return "", validate.ErrRequestIDTooLong
+	}
+
+	return wh.GetDomainCache().GetDomainID(domainName)
+}
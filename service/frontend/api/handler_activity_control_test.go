@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestActivityControl(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "test-workflow-id"}
+	pauseRequest := &types.PauseActivityRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: execution,
+		ActivityID:        "test-activity-id",
+		Reason:            "test-reason",
+		Identity:          "test-identity",
+		RequestID:         "test-request-id",
+	}
+	unpauseRequest := &types.UnpauseActivityRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: execution,
+		ActivityID:        "test-activity-id",
+		Reason:            "test-reason",
+		Identity:          "test-identity",
+		RequestID:         "test-request-id",
+	}
+	resetRequest := &types.ResetActivityRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: execution,
+		ActivityID:        "test-activity-id",
+		Reason:            "test-reason",
+		Identity:          "test-identity",
+		RequestID:         "test-request-id",
+	}
+	updateRequest := &types.UpdateActivityOptionsRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: execution,
+		ActivityID:        "test-activity-id",
+		Options: &types.ActivityOptions{
+			StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 1,
+				BackoffCoefficient:       2,
+				MaximumAttempts:          5,
+			},
+		},
+		Reason:    "test-reason",
+		Identity:  "test-identity",
+		RequestID: "test-request-id",
+	}
+	tests := map[string]struct {
+		call    func(wh *WorkflowHandler) error
+		mock    func(historyClient *history.MockClient)
+		wantErr error
+	}{
+		"pause": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseActivity(context.Background(), pauseRequest)
+			},
+			mock: func(historyClient *history.MockClient) {
+				historyClient.EXPECT().PauseActivity(gomock.Any(), &types.HistoryPauseActivityRequest{
+					DomainUUID:   "test-domain-id",
+					PauseRequest: pauseRequest,
+				}).Return(nil)
+			},
+		},
+		"unpause": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UnpauseActivity(context.Background(), unpauseRequest)
+			},
+			mock: func(historyClient *history.MockClient) {
+				historyClient.EXPECT().UnpauseActivity(gomock.Any(), &types.HistoryUnpauseActivityRequest{
+					DomainUUID:     "test-domain-id",
+					UnpauseRequest: unpauseRequest,
+				}).Return(nil)
+			},
+		},
+		"reset": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.ResetActivity(context.Background(), resetRequest)
+			},
+			mock: func(historyClient *history.MockClient) {
+				historyClient.EXPECT().ResetActivity(gomock.Any(), &types.HistoryResetActivityRequest{
+					DomainUUID:   "test-domain-id",
+					ResetRequest: resetRequest,
+				}).Return(nil)
+			},
+		},
+		"update options": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UpdateActivityOptions(context.Background(), updateRequest)
+			},
+			mock: func(historyClient *history.MockClient) {
+				historyClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.HistoryUpdateActivityOptionsRequest{
+					DomainUUID:    "test-domain-id",
+					UpdateRequest: updateRequest,
+				}).Return(nil)
+			},
+		},
+		"history error": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseActivity(context.Background(), pauseRequest)
+			},
+			mock: func(historyClient *history.MockClient) {
+				historyClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).
+					Return(&types.BadRequestError{Message: "Activity is already paused."})
+			},
+			wantErr: &types.BadRequestError{Message: "Activity is already paused."},
+		},
+		"request not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.ResetActivity(context.Background(), nil)
+			},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"domain not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseActivity(context.Background(), &types.PauseActivityRequest{
+					WorkflowExecution: execution,
+					ActivityID:        "test-activity-id",
+				})
+			},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"activity ID not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UnpauseActivity(context.Background(), &types.UnpauseActivityRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+				})
+			},
+			wantErr: validate.ErrActivityIDNotSet,
+		},
+		"options not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UpdateActivityOptions(context.Background(), &types.UpdateActivityOptionsRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+					ActivityID:        "test-activity-id",
+				})
+			},
+			wantErr: &types.BadRequestError{Message: "Options is not set on request."},
+		},
+		"invalid timeout": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UpdateActivityOptions(context.Background(), &types.UpdateActivityOptionsRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+					ActivityID:        "test-activity-id",
+					Options:           &types.ActivityOptions{ScheduleToCloseTimeoutSeconds: common.Int32Ptr(0)},
+				})
+			},
+			wantErr: &types.BadRequestError{Message: "A valid ScheduleToCloseTimeoutSeconds is not set on request."},
+		},
+		"invalid retry policy": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UpdateActivityOptions(context.Background(), &types.UpdateActivityOptionsRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+					ActivityID:        "test-activity-id",
+					Options:           &types.ActivityOptions{RetryPolicy: &types.RetryPolicy{}},
+				})
+			},
+			wantErr: &types.BadRequestError{Message: "InitialIntervalInSeconds must be greater than 0 on retry policy."},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			wh, mockResource := newControlTestHandler(t)
+			if tc.mock != nil {
+				tc.mock(mockResource.HistoryClient)
+			}
+			assert.Equal(t, tc.wantErr, tc.call(wh))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// DescribeAsyncWorkflowRequest returns the state of a request sent through one of the async workflow APIs.
+// Only requests accepted while frontend.enableAsyncWorkflowRequestTracking was on can be found,
+// and only until system.asyncWorkflowRequestStatusTTL has passed.
+func (wh *WorkflowHandler) DescribeAsyncWorkflowRequest(
+	ctx context.Context,
+	request *types.DescribeAsyncWorkflowRequestRequest,
+) (*types.DescribeAsyncWorkflowRequestResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetRequestID() == "" {
+		return nil, &types.BadRequestError{Message: "RequestID is not set on request."}
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.GetPersistenceBean().GetAsyncWorkflowRequestStatusManager().GetAsyncWorkflowRequestStatus(ctx, &persistence.GetAsyncWorkflowRequestStatusRequest{
+		DomainID:  domainID,
+		RequestID: request.GetRequestID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	status := resp.Status
+	return &types.DescribeAsyncWorkflowRequestResponse{
+		State:           toAsyncWorkflowRequestState(status.State),
+		WorkflowID:      status.WorkflowID,
+		RunID:           status.RunID,
+		FailureReason:   status.FailureReason,
+		CreatedTime:     status.CreatedTime,
+		LastUpdatedTime: status.LastUpdatedTime,
+	}, nil
+}
+
+// recordAsyncWorkflowRequestQueued records a request as queued if tracking is enabled for the domain.
+// It must be called before the request is published so that the consumer always finds the record to update.
+// Tracking is best-effort and never fails the request.
+func (wh *WorkflowHandler) recordAsyncWorkflowRequestQueued(ctx context.Context, domainName, requestID, workflowID string) bool {
+	if !wh.config.EnableAsyncWorkflowRequestTracking(domainName) {
+		return false
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err == nil {
+		err = wh.GetPersistenceBean().GetAsyncWorkflowRequestStatusManager().CreateAsyncWorkflowRequestStatus(ctx, &persistence.CreateAsyncWorkflowRequestStatusRequest{
+			DomainID:    domainID,
+			RequestID:   requestID,
+			WorkflowID:  workflowID,
+			CreatedTime: wh.GetTimeSource().Now(),
+		})
+	}
+	if err != nil {
+		wh.GetLogger().Warn("Failed to record async workflow request status",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRequestID(requestID),
+			tag.Error(err))
+		return false
+	}
+	return true
+}
+
+// recordAsyncWorkflowRequestPublishFailed marks a recorded request as failed when it could not be queued.
+func (wh *WorkflowHandler) recordAsyncWorkflowRequestPublishFailed(ctx context.Context, domainName, requestID string, publishErr error) {
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err == nil {
+		err = wh.GetPersistenceBean().GetAsyncWorkflowRequestStatusManager().UpdateAsyncWorkflowRequestStatus(ctx, &persistence.UpdateAsyncWorkflowRequestStatusRequest{
+			DomainID:        domainID,
+			RequestID:       requestID,
+			State:           persistence.AsyncWorkflowRequestStateFailed,
+			FailureReason:   "failed to enqueue request: " + publishErr.Error(),
+			LastUpdatedTime: wh.GetTimeSource().Now(),
+		})
+	}
+	if err != nil {
+		wh.GetLogger().Warn("Failed to record async workflow request status",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowRequestID(requestID),
+			tag.Error(err))
+	}
+}
+
+func toAsyncWorkflowRequestState(state persistence.AsyncWorkflowRequestState) types.AsyncWorkflowRequestState {
+	switch state {
+	case persistence.AsyncWorkflowRequestStateQueued:
+		return types.AsyncWorkflowRequestStateQueued
+	case persistence.AsyncWorkflowRequestStateStarted:
+		return types.AsyncWorkflowRequestStateStarted
+	case persistence.AsyncWorkflowRequestStateFailed:
+		return types.AsyncWorkflowRequestStateFailed
+	default:
+		return types.AsyncWorkflowRequestStateInvalid
+	}
+}
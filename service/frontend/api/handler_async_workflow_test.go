@@ -0,0 +1,239 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/client"
+	dc "github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	frontendcfg "github.com/uber/cadence/service/frontend/config"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func newAsyncWorkflowTestHandler(t *testing.T, trackingEnabled bool) (*WorkflowHandler, *resource.Test, *MockProducerManager) {
+	ctrl := gomock.NewController(t)
+	mockResource := resource.NewTest(t, ctrl, metrics.Frontend)
+	mockResource.DomainCache.EXPECT().GetDomainID(gomock.Any()).Return("test-domain-id", nil).AnyTimes()
+	mockProducerManager := NewMockProducerManager(ctrl)
+
+	cfg := frontendcfg.NewConfig(
+		dc.NewCollection(dc.NewInMemoryClient(), mockResource.GetLogger()),
+		numHistoryShards,
+		false,
+		"hostname",
+		mockResource.GetLogger(),
+	)
+	cfg.EnableAsyncWorkflowRequestTracking = dynamicproperties.GetBoolPropertyFnFilteredByDomain(trackingEnabled)
+	wh := NewWorkflowHandler(mockResource, cfg, client.NewMockVersionChecker(ctrl), nil)
+	wh.producerManager = mockProducerManager
+	return wh, mockResource, mockProducerManager
+}
+
+func TestStartWorkflowExecutionAsync_RequestTracking(t *testing.T) {
+	requestID := uuid.New().String()
+	request := &types.StartWorkflowExecutionAsyncRequest{
+		StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              "test-domain",
+			WorkflowID:                          "test-workflow-id",
+			WorkflowType:                        &types.WorkflowType{Name: "test-workflow-type"},
+			TaskList:                            &types.TaskList{Name: "test-task-list"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			RequestID:                           requestID,
+		},
+	}
+
+	tests := map[string]struct {
+		trackingEnabled bool
+		publishErr      error
+		mockFn          func(*persistence.MockAsyncWorkflowRequestStatusManager)
+		wantErr         bool
+	}{
+		"tracking disabled": {
+			trackingEnabled: false,
+			mockFn:          func(m *persistence.MockAsyncWorkflowRequestStatusManager) {},
+		},
+		"tracking enabled": {
+			trackingEnabled: true,
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().CreateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.CreateAsyncWorkflowRequestStatusRequest) error {
+						assert.Equal(t, "test-domain-id", req.DomainID)
+						assert.Equal(t, requestID, req.RequestID)
+						assert.Equal(t, "test-workflow-id", req.WorkflowID)
+						return nil
+					})
+			},
+		},
+		"tracking failure does not fail the request": {
+			trackingEnabled: true,
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().CreateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+		},
+		"publish failure is recorded": {
+			trackingEnabled: true,
+			publishErr:      errors.New("queue error"),
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().CreateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().UpdateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.UpdateAsyncWorkflowRequestStatusRequest) error {
+						assert.Equal(t, persistence.AsyncWorkflowRequestStateFailed, req.State)
+						assert.Contains(t, req.FailureReason, "queue error")
+						return nil
+					})
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			wh, mockResource, mockProducerManager := newAsyncWorkflowTestHandler(t, tc.trackingEnabled)
+			mockProducer := &mocks.KafkaProducer{}
+			mockProducerManager.EXPECT().GetProducerByDomain(gomock.Any()).Return(mockProducer, nil)
+			mockProducer.On("Publish", mock.Anything, mock.Anything).Return(tc.publishErr)
+			tc.mockFn(mockResource.AsyncWorkflowStatusMgr)
+
+			resp, err := wh.StartWorkflowExecutionAsync(context.Background(), request)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, requestID, resp.GetRequestID())
+		})
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionAsync_GeneratesRequestID(t *testing.T) {
+	wh, mockResource, mockProducerManager := newAsyncWorkflowTestHandler(t, true)
+	mockProducer := &mocks.KafkaProducer{}
+	mockProducerManager.EXPECT().GetProducerByDomain(gomock.Any()).Return(mockProducer, nil)
+	mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	var recorded string
+	mockResource.AsyncWorkflowStatusMgr.EXPECT().CreateAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *persistence.CreateAsyncWorkflowRequestStatusRequest) error {
+			recorded = req.RequestID
+			return nil
+		})
+
+	resp, err := wh.SignalWithStartWorkflowExecutionAsync(context.Background(), &types.SignalWithStartWorkflowExecutionAsyncRequest{
+		SignalWithStartWorkflowExecutionRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              "test-domain",
+			WorkflowID:                          "test-workflow-id",
+			WorkflowType:                        &types.WorkflowType{Name: "test-workflow-type"},
+			TaskList:                            &types.TaskList{Name: "test-task-list"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			SignalName:                          "test-signal",
+		},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetRequestID())
+	assert.Equal(t, recorded, resp.GetRequestID())
+}
+
+func TestDescribeAsyncWorkflowRequest(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := map[string]struct {
+		request *types.DescribeAsyncWorkflowRequestRequest
+		mockFn  func(*persistence.MockAsyncWorkflowRequestStatusManager)
+		want    *types.DescribeAsyncWorkflowRequestResponse
+		wantErr error
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(m *persistence.MockAsyncWorkflowRequestStatusManager) {},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"missing domain": {
+			request: &types.DescribeAsyncWorkflowRequestRequest{RequestID: "r1"},
+			mockFn:  func(m *persistence.MockAsyncWorkflowRequestStatusManager) {},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"missing request id": {
+			request: &types.DescribeAsyncWorkflowRequestRequest{Domain: "test-domain"},
+			mockFn:  func(m *persistence.MockAsyncWorkflowRequestStatusManager) {},
+			wantErr: &types.BadRequestError{Message: "RequestID is not set on request."},
+		},
+		"not found": {
+			request: &types.DescribeAsyncWorkflowRequestRequest{Domain: "test-domain", RequestID: "r1"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().GetAsyncWorkflowRequestStatus(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			wantErr: &types.EntityNotExistsError{Message: "not found"},
+		},
+		"success": {
+			request: &types.DescribeAsyncWorkflowRequestRequest{Domain: "test-domain", RequestID: "r1"},
+			mockFn: func(m *persistence.MockAsyncWorkflowRequestStatusManager) {
+				m.EXPECT().GetAsyncWorkflowRequestStatus(gomock.Any(), &persistence.GetAsyncWorkflowRequestStatusRequest{
+					DomainID:  "test-domain-id",
+					RequestID: "r1",
+				}).Return(&persistence.GetAsyncWorkflowRequestStatusResponse{
+					Status: &persistence.AsyncWorkflowRequestStatus{
+						DomainID:        "test-domain-id",
+						RequestID:       "r1",
+						WorkflowID:      "wid",
+						RunID:           "rid",
+						State:           persistence.AsyncWorkflowRequestStateStarted,
+						CreatedTime:     now,
+						LastUpdatedTime: now.Add(time.Second),
+					},
+				}, nil)
+			},
+			want: &types.DescribeAsyncWorkflowRequestResponse{
+				State:           types.AsyncWorkflowRequestStateStarted,
+				WorkflowID:      "wid",
+				RunID:           "rid",
+				CreatedTime:     now,
+				LastUpdatedTime: now.Add(time.Second),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			wh, mockResource, _ := newAsyncWorkflowTestHandler(t, true)
+			tc.mockFn(mockResource.AsyncWorkflowStatusMgr)
+
+			resp, err := wh.DescribeAsyncWorkflowRequest(context.Background(), tc.request)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, resp)
+		})
+	}
+}
@@ -41,7 +41,6 @@ import (
 )
 
 const (
-	scheduleWorkflowIDPrefix          = "cadence-scheduler:"
 	schedulerWorkflowExecutionTimeout = 10 * 365 * 24 * time.Hour // ~10 years
 	schedulerWorkflowDecisionTimeout  = 10 * time.Second
 	defaultListSchedulesPageSize      = 10
@@ -86,7 +85,7 @@ func isContinuedAsNew(closeStatus *types.WorkflowExecutionCloseStatus) bool {
 }
 
 func scheduleWorkflowID(scheduleID string) string {
-	return scheduleWorkflowIDPrefix + scheduleID
+	return scheduler.WorkflowID(scheduleID)
 }
 
 func validateSchedulePolicies(policies *types.SchedulePolicies) error {
@@ -103,6 +102,52 @@ func validateSchedulePolicies(policies *types.SchedulePolicies) error {
 	return nil
 }
 
+// validateScheduleAction checks that exactly one action variant is set and
+// that it carries what the scheduler worker needs to deliver it on each fire.
+func validateScheduleAction(action *types.ScheduleAction) error {
+	set := 0
+	for _, isSet := range []bool{
+		action.GetStartWorkflow() != nil,
+		action.GetSignalWorkflow() != nil,
+		action.GetSignalWithStartWorkflow() != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set == 0 {
+		return &types.BadRequestError{Message: "One of Action.StartWorkflow, Action.SignalWorkflow or Action.SignalWithStartWorkflow must be set on request."}
+	}
+	if set > 1 {
+		return &types.BadRequestError{Message: "Only one of Action.StartWorkflow, Action.SignalWorkflow or Action.SignalWithStartWorkflow may be set on request."}
+	}
+
+	if signal := action.GetSignalWorkflow(); signal != nil {
+		if signal.GetWorkflowID() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.WorkflowID is not set on request."}
+		}
+		if signal.GetSignalName() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.SignalName is not set on request."}
+		}
+		return nil
+	}
+
+	start := action.GetStartWorkflow()
+	if sws := action.GetSignalWithStartWorkflow(); sws != nil {
+		if sws.GetWorkflowID() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.WorkflowID is not set on request."}
+		}
+		if sws.GetSignalName() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.SignalName is not set on request."}
+		}
+		if sws.GetStartWorkflow().GetWorkflowType().GetName() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWithStartWorkflow.StartWorkflow.WorkflowType is not set on request."}
+		}
+		start = sws.GetStartWorkflow()
+	}
+	return common.ValidateRetryPolicy(start.GetRetryPolicy())
+}
+
 // validateScheduleSpecTimeRange rejects a spec whose EndTime is not after its
 // StartTime when both are set. A zero StartTime or EndTime means "unbounded" and
 // is left unchecked. Mirrors the range validation BackfillSchedule performs, and
@@ -122,6 +167,27 @@ func validateScheduleSpecTimeRange(spec *types.ScheduleSpec) error {
 	return nil
 }
 
+// validateScheduleSpec checks the fire-time sources of a spec. The cron
+// expression goes through backoff.ValidateSchedule so its error messages match
+// workflow cron validation; intervals, calendars and exclusions are parsed the
+// same way the scheduler workflow parses them. A spec without any source is
+// left for the workflow's update handling to ignore, matching the existing
+// behavior for partial specs.
+func (wh *WorkflowHandler) validateScheduleSpec(spec *types.ScheduleSpec) error {
+	if spec.GetCronExpression() != "" {
+		if _, err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+			return err
+		}
+	}
+	if len(spec.GetIntervals()) == 0 && len(spec.GetCalendars()) == 0 && len(spec.GetExcludeCalendars()) == 0 {
+		return nil
+	}
+	if err := scheduler.ValidateScheduleSpec(spec, wh.GetTimeSource().Now()); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid schedule Spec: %v", err)}
+	}
+	return nil
+}
+
 // warnIfBufferLimitExceedsSystemLimit logs a warning when buffer_limit exceeds
 // MaxBufferedFiresSystemLimit. The value is accepted (the policy still queues
 // up to the system limit), but drops at that cap will be tagged
@@ -175,6 +241,20 @@ func ongoingBackfillsForResponse(in []types.BackfillInfo) []*types.BackfillInfo
 	return out
 }
 
+// recentActionsForResponse converts the scheduler's RecentActions ring into
+// the pointer slice used by DescribeScheduleResponse, returning nil when empty.
+func recentActionsForResponse(in []types.ScheduleActionResult) []*types.ScheduleActionResult {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*types.ScheduleActionResult, 0, len(in))
+	for i := range in {
+		r := in[i]
+		out = append(out, &r)
+	}
+	return out
+}
+
 func (wh *WorkflowHandler) CreateSchedule(
 	ctx context.Context,
 	request *types.CreateScheduleRequest,
@@ -197,19 +277,20 @@ func (wh *WorkflowHandler) CreateSchedule(
 	if request.GetSpec() == nil {
 		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
 	}
-	if request.GetSpec().GetCronExpression() == "" {
-		return nil, &types.BadRequestError{Message: "CronExpression is not set on request."}
+	spec := request.GetSpec()
+	if spec.GetCronExpression() == "" && len(spec.GetIntervals()) == 0 && len(spec.GetCalendars()) == 0 {
+		return nil, &types.BadRequestError{Message: "One of CronExpression, Intervals or Calendars must be set on request."}
 	}
-	if _, err := backoff.ValidateSchedule(request.GetSpec().GetCronExpression()); err != nil {
+	if err := wh.validateScheduleSpec(spec); err != nil {
 		return nil, err
 	}
-	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
+	if err := validateScheduleSpecTimeRange(spec); err != nil {
 		return nil, err
 	}
-	if request.GetAction() == nil || request.GetAction().GetStartWorkflow() == nil {
-		return nil, &types.BadRequestError{Message: "Action.StartWorkflow is not set on request."}
+	if request.GetAction() == nil {
+		return nil, &types.BadRequestError{Message: "Action is not set on request."}
 	}
-	if err := common.ValidateRetryPolicy(request.GetAction().GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if err := validateScheduleAction(request.GetAction()); err != nil {
 		return nil, err
 	}
 	if err := validateSchedulePolicies(request.GetPolicies()); err != nil {
@@ -345,6 +426,8 @@ func (wh *WorkflowHandler) DescribeSchedule(
 			CreateTime:           desc.CreateTime,
 			LastUpdateTime:       desc.LastUpdateTime,
 			OngoingBackfills:     ongoingBackfillsForResponse(desc.OngoingBackfills),
+			RecentActions:        recentActionsForResponse(desc.RecentActions),
+			FutureActionTimes:    desc.FutureActionTimes,
 		},
 		Memo:             desc.Memo,
 		SearchAttributes: desc.SearchAttributes,
@@ -377,16 +460,16 @@ func (wh *WorkflowHandler) UpdateSchedule(
 		return nil, err
 	}
 	wh.warnIfBufferLimitExceedsSystemLimit(scheduleID, domainName, request.GetPolicies())
-	if spec := request.GetSpec(); spec != nil && spec.GetCronExpression() != "" {
-		if _, err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+	if spec := request.GetSpec(); spec != nil {
+		if err := wh.validateScheduleSpec(spec); err != nil {
 			return nil, err
 		}
 	}
 	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
 		return nil, err
 	}
-	if action := request.GetAction(); action != nil && action.GetStartWorkflow() != nil {
-		if err := common.ValidateRetryPolicy(action.GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if action := request.GetAction(); action != nil {
+		if err := validateScheduleAction(action); err != nil {
 			return nil, err
 		}
 	}
@@ -592,6 +675,39 @@ func (wh *WorkflowHandler) BackfillSchedule(
 	return &types.BackfillScheduleResponse{}, nil
 }
 
+// TriggerSchedule fires the schedule's action immediately, regardless of
+// the spec or the pause state. The fire is processed asynchronously by the
+// scheduler workflow and appears in DescribeSchedule's RecentActions.
+func (wh *WorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) (*types.TriggerScheduleResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	scheduleID := request.GetScheduleID()
+	if scheduleID == "" {
+		return nil, &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	}
+
+	signal := scheduler.TriggerSignal{
+		OverlapPolicy: request.GetOverlapPolicy(),
+	}
+
+	if err := wh.signalScheduleWorkflow(ctx, domainName, scheduleID, scheduler.SignalNameTrigger, signal); err != nil {
+		return nil, err
+	}
+	return &types.TriggerScheduleResponse{}, nil
+}
+
 func resolveBackfillID(clientID string) string {
 	if id := strings.TrimSpace(clientID); id != "" {
 		return id
@@ -681,14 +797,14 @@ func buildScheduleListEntriesFromExecutions(wh *WorkflowHandler, domainName stri
 	entries := make([]*types.ScheduleListEntry, 0, len(executions))
 	for _, exec := range executions {
 		wfID := exec.GetExecution().GetWorkflowID()
-		if !strings.HasPrefix(wfID, scheduleWorkflowIDPrefix) {
+		if !strings.HasPrefix(wfID, scheduler.WorkflowIDPrefix) {
 			logger.Warn("skipping visibility row without schedule workflow id prefix",
 				tag.WorkflowDomainName(domainName),
 				tag.WorkflowID(wfID),
 			)
 			continue
 		}
-		scheduleID := strings.TrimPrefix(wfID, scheduleWorkflowIDPrefix)
+		scheduleID := strings.TrimPrefix(wfID, scheduler.WorkflowIDPrefix)
 
 		entry := &types.ScheduleListEntry{
 			ScheduleID: scheduleID,
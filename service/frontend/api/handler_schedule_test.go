@@ -199,6 +199,29 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid calendar in spec": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					Calendars: []*types.ScheduleCalendarSpec{{Hour: "25"}},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"invalid interval in spec alongside a valid cron": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					CronExpression: "* * * * *",
+					Intervals:      []*types.ScheduleIntervalSpec{{Interval: time.Millisecond}},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"retry policy with no bounds rejected at create": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -220,6 +243,50 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"signal action without signal name": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+				Action: &types.ScheduleAction{
+					SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1"},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"signal-with-start action without workflow type": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+				Action: &types.ScheduleAction{
+					SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+						WorkflowID:    "entity-1",
+						SignalName:    "tick",
+						StartWorkflow: &types.StartWorkflowAction{TaskList: &types.TaskList{Name: "tl"}},
+					},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"more than one action variant": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+				Action: &types.ScheduleAction{
+					StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType: &types.WorkflowType{Name: "wf"},
+						TaskList:     &types.TaskList{Name: "tl"},
+					},
+					SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"invalid SKIP_NEW + CATCH_UP_ALL": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -329,6 +396,68 @@ func TestCreateSchedule(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"interval and calendar spec without cron": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "my-schedule",
+				Spec: &types.ScheduleSpec{
+					Intervals:        []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute}},
+					Calendars:        []*types.ScheduleCalendarSpec{{Hour: "18", DayOfMonth: "LW"}},
+					ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "12", DayOfMonth: "25"}},
+				},
+				Action: &types.ScheduleAction{
+					StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+						TaskList:     &types.TaskList{Name: "my-tasklist"},
+					},
+				},
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var input scheduler.SchedulerWorkflowInput
+						require.NoError(t, json.Unmarshal(req.StartRequest.Input, &input))
+						assert.Empty(t, input.Spec.CronExpression)
+						require.Len(t, input.Spec.Intervals, 1)
+						assert.Equal(t, 90*time.Minute, input.Spec.Intervals[0].Interval)
+						require.Len(t, input.Spec.Calendars, 1)
+						assert.Equal(t, "LW", input.Spec.Calendars[0].DayOfMonth)
+						require.Len(t, input.Spec.ExcludeCalendars, 1)
+						return &types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, nil
+					})
+			},
+			wantErr: false,
+		},
+		"signal action": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "my-schedule",
+				Spec:       &types.ScheduleSpec{CronExpression: "*/5 * * * *"},
+				Action: &types.ScheduleAction{
+					SignalWorkflow: &types.SignalWorkflowAction{
+						WorkflowID: "entity-1",
+						SignalName: "tick",
+						Input:      []byte(`"payload"`),
+					},
+				},
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var input scheduler.SchedulerWorkflowInput
+						require.NoError(t, json.Unmarshal(req.StartRequest.Input, &input))
+						assert.Nil(t, input.Action.StartWorkflow)
+						require.NotNil(t, input.Action.SignalWorkflow)
+						assert.Equal(t, "entity-1", input.Action.SignalWorkflow.WorkflowID)
+						assert.Equal(t, "tick", input.Action.SignalWorkflow.SignalName)
+						assert.Equal(t, []byte(`"payload"`), input.Action.SignalWorkflow.Input)
+						return &types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, nil
+					})
+			},
+			wantErr: false,
+		},
 		"search attributes forwarded into workflow input": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -1095,6 +1224,17 @@ func TestUpdateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"signal action without workflow ID rejected at update": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Action: &types.ScheduleAction{
+					SignalWorkflow: &types.SignalWorkflowAction{SignalName: "tick"},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"search attributes only update succeeds": {
 			request: &types.UpdateScheduleRequest{
 				Domain:     testDomain,
@@ -1429,6 +1569,88 @@ func TestBackfillSchedule(t *testing.T) {
 	}
 }
 
+func TestTriggerSchedule(t *testing.T) {
+	tests := map[string]struct {
+		request *types.TriggerScheduleRequest
+		mockFn  func(*scheduleTestFixture)
+		wantErr bool
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"missing domain": {
+			request: &types.TriggerScheduleRequest{ScheduleID: "s1"},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"missing schedule id": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"success with overlap override": {
+			request: &types.TriggerScheduleRequest{
+				Domain:        testDomain,
+				ScheduleID:    "s1",
+				OverlapPolicy: types.ScheduleOverlapPolicyTerminatePrevious,
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, scheduler.SignalNameTrigger, req.SignalRequest.SignalName)
+						var signal scheduler.TriggerSignal
+						require.NoError(t, json.Unmarshal(req.SignalRequest.Input, &signal))
+						assert.Equal(t, types.ScheduleOverlapPolicyTerminatePrevious, signal.OverlapPolicy)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		"success without overlap override inherits schedule policy": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain, ScheduleID: "s1"},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						var signal scheduler.TriggerSignal
+						require.NoError(t, json.Unmarshal(req.SignalRequest.Input, &signal))
+						assert.Equal(t, types.ScheduleOverlapPolicyInvalid, signal.OverlapPolicy)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		"schedule not found": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain, ScheduleID: "s1"},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "workflow cadence-scheduler:s1 not found"})
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.TriggerSchedule(context.Background(), tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
 func TestListSchedules(t *testing.T) {
 	t.Run("nil request", func(t *testing.T) {
 		f := newScheduleTestFixture(t)
@@ -1687,6 +1909,37 @@ func TestOngoingBackfillsForResponse(t *testing.T) {
 	})
 }
 
+func TestRecentActionsForResponse(t *testing.T) {
+	t.Run("nil input returns nil", func(t *testing.T) {
+		assert.Nil(t, recentActionsForResponse(nil))
+	})
+	t.Run("empty input returns nil", func(t *testing.T) {
+		assert.Nil(t, recentActionsForResponse([]types.ScheduleActionResult{}))
+	})
+	t.Run("non-empty input is mapped one-to-one and copies each entry", func(t *testing.T) {
+		in := []types.ScheduleActionResult{
+			{
+				ScheduledTime:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+				ActualTime:        time.Date(2026, 3, 1, 0, 0, 1, 0, time.UTC),
+				Outcome:           types.ScheduleActionOutcomeStarted,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf", RunID: "run"},
+			},
+			{
+				ScheduledTime: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+				Outcome:       types.ScheduleActionOutcomeSkipped,
+				Overlapped:    true,
+			},
+		}
+		out := recentActionsForResponse(in)
+		require.Len(t, out, 2)
+		assert.Equal(t, in[0], *out[0])
+		assert.Equal(t, in[1], *out[1])
+
+		out[1].Outcome = types.ScheduleActionOutcomeFailed
+		assert.Equal(t, types.ScheduleActionOutcomeSkipped, in[1].Outcome, "mutating out must not affect in")
+	})
+}
+
 // TestValidateUserSearchAttributes verifies that user-supplied search attribute
 // keys colliding with the scheduler-reserved "CadenceSchedule" prefix are
 // rejected, while other keys (including a lowercase near-miss) are allowed. The
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -701,6 +702,135 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	s.Equal(validate.ErrInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskPartitionConfig() {
+	tests := map[string]map[string]string{
+		"priority not a number": {constants.PartitionConfigKeyForTaskPriority: "high"},
+		"priority too large":    {constants.PartitionConfigKeyForTaskPriority: "100"},
+		"fairness key too long": {constants.PartitionConfigKeyForTaskFairnessKey: strings.Repeat("a", constants.MaxTaskFairnessKeyLength+1)},
+	}
+	for name, partitionConfig := range tests {
+		s.Run(name, func() {
+			config := s.newConfig(dc.NewInMemoryClient())
+			config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+			wh := s.getWorkflowHandler(config)
+
+			startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+				Domain:     s.testDomain,
+				WorkflowID: "workflow-id",
+				WorkflowType: &types.WorkflowType{
+					Name: "workflow-type",
+				},
+				TaskList: &types.TaskList{
+					Name: "task-list",
+				},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				RequestID:                           uuid.New(),
+			}
+			// the partition config is set from the client headers
+			ctx := isolationgroup.ContextWithConfig(context.Background(), partitionConfig)
+			_, err := wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+			s.IsType(&types.BadRequestError{}, err)
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidCompletionCallbacks() {
+	tests := map[string]struct {
+		enabled   bool
+		global    bool
+		callbacks []*types.CompletionCallback
+	}{
+		"callbacks not enabled": {
+			callbacks: []*types.CompletionCallback{{URL: "https://example.com/done"}},
+		},
+		"invalid callback URL": {
+			enabled:   true,
+			callbacks: []*types.CompletionCallback{{URL: "example.com/done"}},
+		},
+		"host not allowed": {
+			enabled:   true,
+			callbacks: []*types.CompletionCallback{{URL: "http://169.254.169.254/done"}},
+		},
+		"global domain": {
+			enabled:   true,
+			global:    true,
+			callbacks: []*types.CompletionCallback{{URL: "https://example.com/done"}},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			if tc.global {
+				s.mockDomainCache.EXPECT().GetDomain(s.testDomain).Return(cache.NewGlobalDomainCacheEntryForTest(
+					&persistence.DomainInfo{Name: s.testDomain},
+					&persistence.DomainConfig{},
+					&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+					0,
+				), nil)
+			}
+			config := s.newConfig(dc.NewInMemoryClient())
+			config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+			config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(tc.enabled)
+			config.CompletionCallbackAllowedHosts = dynamicproperties.GetListPropertyFn([]interface{}{"example.com"})
+			wh := s.getWorkflowHandler(config)
+
+			startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+				Domain:     s.testDomain,
+				WorkflowID: "workflow-id",
+				WorkflowType: &types.WorkflowType{
+					Name: "workflow-type",
+				},
+				TaskList: &types.TaskList{
+					Name: "task-list",
+				},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				RequestID:                           uuid.New(),
+				CompletionCallbacks:                 tc.callbacks,
+			}
+			_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+			s.IsType(&types.BadRequestError{}, err)
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidDeadline() {
+	tests := map[string]struct {
+		deadline *types.WorkflowDeadline
+	}{
+		"deadline after execution timeout": {
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 10},
+		},
+		"negative grace period": {
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 1, GracePeriodSeconds: -1},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			config := s.newConfig(dc.NewInMemoryClient())
+			config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+			wh := s.getWorkflowHandler(config)
+
+			startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+				Domain:     s.testDomain,
+				WorkflowID: "workflow-id",
+				WorkflowType: &types.WorkflowType{
+					Name: "workflow-type",
+				},
+				TaskList: &types.TaskList{
+					Name: "task-list",
+				},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				RequestID:                           uuid.New(),
+				Deadline:                            tc.deadline,
+			}
+			_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+			s.IsType(&types.BadRequestError{}, err)
+		})
+	}
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_IsolationGroupDrained() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
@@ -4611,6 +4741,33 @@ func (s *workflowHandlerSuite) TestResetWorkflowExecution() {
 			mockFn:      func() {},
 			expectError: true,
 		},
+		"signal names to reapply": {
+			request: &types.ResetWorkflowExecutionRequest{
+				Domain:               s.testDomain,
+				WorkflowExecution:    validRequest.WorkflowExecution,
+				SignalNamesToReapply: []string{"signal"},
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
+		"reapply cancel requests": {
+			request: &types.ResetWorkflowExecutionRequest{
+				Domain:                s.testDomain,
+				WorkflowExecution:     validRequest.WorkflowExecution,
+				ReapplyCancelRequests: true,
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
+		"dry run": {
+			request: &types.ResetWorkflowExecutionRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: validRequest.WorkflowExecution,
+				DryRun:            true,
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
 		"cannot get domain ID": {
 			request: validRequest,
 			mockFn: func() {
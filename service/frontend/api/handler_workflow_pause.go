@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+var errReservedSignal = &types.BadRequestError{Message: "SignalName is reserved for signals sent by the server."}
+
+// PauseWorkflowExecution pauses a running workflow execution: it doesn't get new decision tasks and its
+// activities are not dispatched until it is unpaused, while signals, fired timers and activity results are
+// still recorded in its history. See types.WorkflowPauseSignalName.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	return wh.signalWorkflowPause(
+		ctx,
+		getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		types.WorkflowPauseSignalName,
+		pauseRequest.GetDomain(),
+		pauseRequest.GetWorkflowExecution(),
+		pauseRequest.GetReason(),
+		pauseRequest.GetIdentity(),
+		pauseRequest.GetRequestID(),
+	)
+}
+
+// UnpauseWorkflowExecution unpauses a paused workflow execution, its next decision task delivers the
+// events recorded while it was paused.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+	return wh.signalWorkflowPause(
+		ctx,
+		getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...),
+		types.WorkflowUnpauseSignalName,
+		unpauseRequest.GetDomain(),
+		unpauseRequest.GetWorkflowExecution(),
+		unpauseRequest.GetReason(),
+		unpauseRequest.GetIdentity(),
+		unpauseRequest.GetRequestID(),
+	)
+}
+
+func (wh *WorkflowHandler) signalWorkflowPause(
+	ctx context.Context,
+	scope metrics.Scope,
+	signalName string,
+	domainName string,
+	execution *types.WorkflowExecution,
+	reason string,
+	identity string,
+	requestID string,
+) error {
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(execution); err != nil {
+		return err
+	}
+
+	if !common.IsValidIDLength(
+		requestID,
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return validate.ErrRequestIDTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            domainName,
+			WorkflowExecution: execution,
+			SignalName:        signalName,
+			Input:             []byte(reason),
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+	return nil
+}
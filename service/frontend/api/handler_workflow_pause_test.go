@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/client"
+	dc "github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	frontendcfg "github.com/uber/cadence/service/frontend/config"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func newControlTestHandler(t *testing.T) (*WorkflowHandler, *resource.Test) {
+	ctrl := gomock.NewController(t)
+	mockResource := resource.NewTest(t, ctrl, metrics.Frontend)
+	mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).AnyTimes()
+	cfg := frontendcfg.NewConfig(
+		dc.NewCollection(dc.NewInMemoryClient(), mockResource.GetLogger()),
+		numHistoryShards,
+		false,
+		"hostname",
+		mockResource.GetLogger(),
+	)
+	return NewWorkflowHandler(mockResource, cfg, client.NewMockVersionChecker(ctrl), nil), mockResource
+}
+
+func TestPauseAndUnpauseWorkflowExecution(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "8d8b9b3a-2d35-4c4b-9b6e-2f0c0c9f4d3e"}
+	tests := map[string]struct {
+		call           func(wh *WorkflowHandler) error
+		wantSignalName string
+		wantErr        error
+	}{
+		"pause": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseWorkflowExecution(context.Background(), &types.PauseWorkflowExecutionRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+					Reason:            "test-reason",
+					Identity:          "test-identity",
+					RequestID:         "test-request-id",
+				})
+			},
+			wantSignalName: types.WorkflowPauseSignalName,
+		},
+		"unpause": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UnpauseWorkflowExecution(context.Background(), &types.UnpauseWorkflowExecutionRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: execution,
+					Reason:            "test-reason",
+					Identity:          "test-identity",
+					RequestID:         "test-request-id",
+				})
+			},
+			wantSignalName: types.WorkflowUnpauseSignalName,
+		},
+		"request not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseWorkflowExecution(context.Background(), nil)
+			},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"domain not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.UnpauseWorkflowExecution(context.Background(), &types.UnpauseWorkflowExecutionRequest{
+					WorkflowExecution: execution,
+				})
+			},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"workflow ID not set": {
+			call: func(wh *WorkflowHandler) error {
+				return wh.PauseWorkflowExecution(context.Background(), &types.PauseWorkflowExecutionRequest{
+					Domain:            "test-domain",
+					WorkflowExecution: &types.WorkflowExecution{},
+				})
+			},
+			wantErr: validate.ErrWorkflowIDNotSet,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			wh, mockResource := newControlTestHandler(t)
+			if tc.wantErr == nil {
+				mockResource.HistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.HistorySignalWorkflowExecutionRequest{
+					DomainUUID: "test-domain-id",
+					SignalRequest: &types.SignalWorkflowExecutionRequest{
+						Domain:            "test-domain",
+						WorkflowExecution: execution,
+						SignalName:        tc.wantSignalName,
+						Input:             []byte("test-reason"),
+						Identity:          "test-identity",
+						RequestID:         "test-request-id",
+					},
+				}).Return(nil)
+			}
+			assert.Equal(t, tc.wantErr, tc.call(wh))
+		})
+	}
+}
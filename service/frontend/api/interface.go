@@ -94,6 +94,14 @@ type (
 		PauseSchedule(context.Context, *types.PauseScheduleRequest) (*types.PauseScheduleResponse, error)
 		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error)
 		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error)
+		TriggerSchedule(context.Context, *types.TriggerScheduleRequest) (*types.TriggerScheduleResponse, error)
 		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
+		DescribeAsyncWorkflowRequest(context.Context, *types.DescribeAsyncWorkflowRequestRequest) (*types.DescribeAsyncWorkflowRequestResponse, error)
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
+		PauseActivity(context.Context, *types.PauseActivityRequest) error
+		UnpauseActivity(context.Context, *types.UnpauseActivityRequest) error
+		ResetActivity(context.Context, *types.ResetActivityRequest) error
+		UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) error
 	}
 )
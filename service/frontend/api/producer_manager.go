@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -47,6 +48,7 @@ type (
 	producerManagerImpl struct {
 		domainCache   cache.DomainCache
 		provider      queue.Provider
+		queueManager  persistence.QueueManager
 		logger        log.Logger
 		metricsClient metrics.Client
 
@@ -57,12 +59,14 @@ type (
 func NewProducerManager(
 	domainCache cache.DomainCache,
 	provider queue.Provider,
+	queueManager persistence.QueueManager,
 	logger log.Logger,
 	metricsClient metrics.Client,
 ) ProducerManager {
 	return &producerManagerImpl{
 		domainCache:   domainCache,
 		provider:      provider,
+		queueManager:  queueManager,
 		logger:        logger,
 		metricsClient: metricsClient,
 		producerCache: cache.New(&cache.Options{
@@ -107,7 +111,7 @@ func (q *producerManagerImpl) GetProducerByDomain(
 		return val.(messaging.Producer), nil
 	}
 
-	producer, err := queue.CreateProducer(&provider.Params{Logger: q.logger, MetricsClient: q.metricsClient})
+	producer, err := queue.CreateProducer(&provider.Params{Logger: q.logger, MetricsClient: q.metricsClient, QueueManager: q.queueManager})
 	if err != nil {
 		return nil, err
 	}
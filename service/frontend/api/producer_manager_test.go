@@ -235,6 +235,7 @@ func TestGetProducerByDomain(t *testing.T) {
 			producerManager := NewProducerManager(
 				mockDomainCache,
 				mockProvider,
+				nil,
 				log.NewNoop(),
 				metrics.NewNoopMetricsClient(),
 			)
@@ -29,6 +29,7 @@ import (
 	"fmt"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -101,6 +102,13 @@ func checkRequiredDomainDataKVs(requiredDomainDataKeys map[string]interface{}, d
 	return nil
 }
 
+func checkAPIPolicies(domainData map[string]string) error {
+	if _, err := authorization.ParseAPIPolicies(domainData); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return nil
+}
+
 func checkFailOverPermission(config *config.Config, domainName string) error {
 	if config.Lockdown(domainName) {
 		return validate.ErrDomainInLockdown
@@ -308,6 +316,9 @@ func (v *requestValidatorImpl) ValidateRegisterDomainRequest(ctx context.Context
 	if err := checkRequiredDomainDataKVs(v.config.DomainConfig.RequiredDomainDataKeys(), registerRequest.GetData()); err != nil {
 		return err
 	}
+	if err := checkAPIPolicies(registerRequest.GetData()); err != nil {
+		return err
+	}
 	return validate.CheckPermission(v.config, registerRequest.SecurityToken)
 }
 
@@ -331,6 +342,9 @@ func (v *requestValidatorImpl) ValidateUpdateDomainRequest(ctx context.Context,
 	if updateRequest.WorkflowExecutionRetentionPeriodInDays != nil && *updateRequest.WorkflowExecutionRetentionPeriodInDays > int32(v.config.DomainConfig.MaxRetentionDays()) {
 		return validate.ErrInvalidRetention
 	}
+	if err := checkAPIPolicies(updateRequest.Data); err != nil {
+		return err
+	}
 	isFailover := isFailoverRequest(updateRequest)
 	// don't require permission for failover request
 	if isFailover {
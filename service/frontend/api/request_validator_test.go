@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
@@ -960,6 +961,30 @@ func TestValidateUpdateDomainRequest(t *testing.T) {
 			expectError:   true,
 			expectedError: "RetentionDays is invalid.",
 		},
+		{
+			name: "invalid api policies",
+			req: &types.UpdateDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data: map[string]string{
+					constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["@unknown"]}]`,
+				},
+			},
+			expectError:   true,
+			expectedError: `references unknown api set "@unknown"`,
+		},
+		{
+			name: "api policies granting a domain admin api",
+			req: &types.UpdateDomainRequest{
+				Name:          "domain",
+				SecurityToken: "token",
+				Data: map[string]string{
+					constants.DomainDataKeyForAPIPolicies: `[{"groups":["ops"],"apis":["UpdateDomain"]}]`,
+				},
+			},
+			expectError:   true,
+			expectedError: `references unknown api "UpdateDomain"`,
+		},
 		{
 			name: "wrong token",
 			req: &types.UpdateDomainRequest{
@@ -68,6 +68,8 @@ type Config struct {
 	EnableClientVersionCheck          dynamicproperties.BoolPropertyFn
 	EnableQueryAttributeValidation    dynamicproperties.BoolPropertyFn
 	DisallowQuery                     dynamicproperties.BoolPropertyFnWithDomainFilter
+	EnableCompletionCallbacks         dynamicproperties.BoolPropertyFnWithDomainFilter
+	CompletionCallbackAllowedHosts    dynamicproperties.ListPropertyFn
 	ShutdownDrainDuration             dynamicproperties.DurationPropertyFn
 	WarmupDuration                    dynamicproperties.DurationPropertyFn
 	Lockdown                          dynamicproperties.BoolPropertyFnWithDomainFilter
@@ -107,6 +109,7 @@ type Config struct {
 	DomainFailoverRefreshInterval                     dynamicproperties.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient       dynamicproperties.FloatPropertyFn
 	EnableActiveClusterSelectionPolicyInStartWorkflow dynamicproperties.BoolPropertyFnWithDomainFilter
+	EnableAsyncWorkflowRequestTracking                dynamicproperties.BoolPropertyFnWithDomainFilter
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicproperties.MapPropertyFn
@@ -191,6 +194,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DomainFailoverRefreshInterval:                     dc.GetDurationProperty(dynamicproperties.DomainFailoverRefreshInterval),
 		DomainFailoverRefreshTimerJitterCoefficient:       dc.GetFloat64Property(dynamicproperties.DomainFailoverRefreshTimerJitterCoefficient),
 		EnableActiveClusterSelectionPolicyInStartWorkflow: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableActiveClusterSelectionPolicyInStartWorkflow),
+		EnableAsyncWorkflowRequestTracking:                dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableAsyncWorkflowRequestTracking),
 		EnableClientVersionCheck:                          dc.GetBoolProperty(dynamicproperties.EnableClientVersionCheck),
 		EnableQueryAttributeValidation:                    dc.GetBoolProperty(dynamicproperties.EnableQueryAttributeValidation),
 		ValidSearchAttributes:                             dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
@@ -200,6 +204,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		PinotOptimizedQueryColumns:                        dc.GetMapProperty(dynamicproperties.PinotOptimizedQueryColumns),
 		VisibilityArchivalQueryMaxPageSize:                dc.GetIntProperty(dynamicproperties.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisallowQuery),
+		EnableCompletionCallbacks:                         dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		CompletionCallbackAllowedHosts:                    dc.GetListProperty(dynamicproperties.CompletionCallbackAllowedHosts),
 		SendRawWorkflowHistory:                            dc.GetBoolPropertyFilteredByDomain(dynamicproperties.SendRawWorkflowHistory),
 		DecisionResultCountLimit:                          dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendDecisionResultCountLimit),
 		EmitSignalNameMetricsTag:                          dc.GetBoolPropertyFilteredByDomain(dynamicproperties.FrontendEmitSignalNameMetricsTag),
@@ -92,6 +92,7 @@ func TestNewConfig(t *testing.T) {
 		"DomainFailoverRefreshInterval":                     {dynamicproperties.DomainFailoverRefreshInterval, time.Duration(33)},
 		"DomainFailoverRefreshTimerJitterCoefficient":       {dynamicproperties.DomainFailoverRefreshTimerJitterCoefficient, 34.0},
 		"EnableActiveClusterSelectionPolicyInStartWorkflow": {dynamicproperties.EnableActiveClusterSelectionPolicyInStartWorkflow, true},
+		"EnableAsyncWorkflowRequestTracking":                {dynamicproperties.EnableAsyncWorkflowRequestTracking, true},
 		"EnableClientVersionCheck":                          {dynamicproperties.EnableClientVersionCheck, true},
 		"EnableQueryAttributeValidation":                    {dynamicproperties.EnableQueryAttributeValidation, false},
 		"ValidSearchAttributes":                             {dynamicproperties.ValidSearchAttributes, map[string]interface{}{"foo": "bar"}},
@@ -100,6 +101,8 @@ func TestNewConfig(t *testing.T) {
 		"SearchAttributesTotalSizeLimit":                    {dynamicproperties.SearchAttributesTotalSizeLimit, 37},
 		"VisibilityArchivalQueryMaxPageSize":                {dynamicproperties.VisibilityArchivalQueryMaxPageSize, 38},
 		"DisallowQuery":                                     {dynamicproperties.DisallowQuery, true},
+		"EnableCompletionCallbacks":                         {dynamicproperties.EnableCompletionCallbacks, true},
+		"CompletionCallbackAllowedHosts":                    {dynamicproperties.CompletionCallbackAllowedHosts, []interface{}{"callback.example.com"}},
 		"SendRawWorkflowHistory":                            {dynamicproperties.SendRawWorkflowHistory, false},
 		"DecisionResultCountLimit":                          {dynamicproperties.FrontendDecisionResultCountLimit, 39},
 		"EmitSignalNameMetricsTag":                          {dynamicproperties.FrontendEmitSignalNameMetricsTag, true},
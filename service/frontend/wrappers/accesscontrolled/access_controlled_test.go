@@ -25,6 +25,7 @@ package accesscontrolled
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -93,6 +94,20 @@ func TestAuthorizationMetricsLabelConsistency(t *testing.T) {
 	assert.Empty(t, registrationErrors, "Prometheus registration errors must not be emitted")
 }
 
+func TestAPIPolicyNamesMatchHandler(t *testing.T) {
+	handlerType := reflect.TypeOf((*api.Handler)(nil)).Elem()
+	for name := range authorization.FrontendAPIs {
+		_, ok := handlerType.MethodByName(name)
+		assert.True(t, ok, "%s is not an API of the frontend handler", name)
+	}
+	for set, names := range authorization.APISets {
+		for _, name := range names {
+			_, ok := authorization.FrontendAPIs[name]
+			assert.True(t, ok, "%s of API set %s is not a frontend API", name, set)
+		}
+	}
+}
+
 func TestIsAuthorized(t *testing.T) {
 	testCases := []struct {
 		name         string
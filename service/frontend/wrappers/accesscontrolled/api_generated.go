@@ -145,6 +145,24 @@ func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	return a.handler.DeprecateDomain(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeAsyncWorkflowRequest(ctx context.Context, dp1 *types.DescribeAsyncWorkflowRequestRequest) (dp2 *types.DescribeAsyncWorkflowRequestResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeAsyncWorkflowRequestScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeAsyncWorkflowRequest",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeAsyncWorkflowRequest(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDescribeDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -403,6 +421,24 @@ func (a *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return a.handler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseActivity(ctx, pp1)
+}
+
 func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -421,6 +457,24 @@ func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseSchedule
 	return a.handler.PauseSchedule(ctx, pp1)
 }
 
+func (a *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (a *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPollForActivityTaskScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -538,6 +592,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivity(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	return a.handler.ResetStickyTaskList(ctx, rp1)
 }
@@ -748,6 +820,42 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (a *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendTriggerScheduleScope, tp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "TriggerSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(tp1),
+		DomainName:  tp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.TriggerSchedule(ctx, tp1)
+}
+
+func (a *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseActivity(ctx, up1)
+}
+
 func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, up1.GetDomain())
 	attr := &authorization.Attributes{
@@ -766,6 +874,42 @@ func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseSche
 	return a.handler.UnpauseSchedule(ctx, up1)
 }
 
+func (a *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseWorkflowExecution(ctx, up1)
+}
+
+func (a *apiHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateActivityOptions",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateActivityOptions(ctx, up1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -124,6 +124,12 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"StartWorkflowExecution":           {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
@@ -134,6 +140,9 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"PauseSchedule":    {},
 	"UnpauseSchedule":  {},
 	"BackfillSchedule": {},
+	"TriggerSchedule":  {},
+	// async request status is only recorded in the cluster which accepted the request
+	"DescribeAsyncWorkflowRequest": {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -143,6 +152,12 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"StartWorkflowExecution":           {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
@@ -160,6 +175,9 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"PauseSchedule":    {},
 	"UnpauseSchedule":  {},
 	"BackfillSchedule": {},
+	"TriggerSchedule":  {},
+	// async request status is only recorded in the cluster which accepted the request
+	"DescribeAsyncWorkflowRequest": {},
 }
 
 // allowedAPIsForDeprecatedDomains contains a list of APIs that are allowed to be called on deprecated domains
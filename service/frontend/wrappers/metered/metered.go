@@ -409,6 +409,70 @@ func toBackfillScheduleRequestTags(req *types.BackfillScheduleRequest) []tag.Tag
 	}
 }
 
+func toTriggerScheduleRequestTags(req *types.TriggerScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toPauseWorkflowExecutionRequestTags(req *types.PauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toUnpauseWorkflowExecutionRequestTags(req *types.UnpauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toPauseActivityRequestTags(req *types.PauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toUnpauseActivityRequestTags(req *types.UnpauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toResetActivityRequestTags(req *types.ResetActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toUpdateActivityOptionsRequestTags(req *types.UpdateActivityOptionsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toDescribeAsyncWorkflowRequestRequestTags(req *types.DescribeAsyncWorkflowRequestRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toListSchedulesRequestTags(req *types.ListSchedulesRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
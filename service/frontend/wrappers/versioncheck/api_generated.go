@@ -81,6 +81,14 @@ func (h *versionCheckHandler) DeprecateDomain(ctx context.Context, dp1 *types.De
 	return h.frontendHandler.DeprecateDomain(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DescribeAsyncWorkflowRequest(ctx context.Context, dp1 *types.DescribeAsyncWorkflowRequestRequest) (dp2 *types.DescribeAsyncWorkflowRequestResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DescribeAsyncWorkflowRequest(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -217,6 +225,14 @@ func (h *versionCheckHandler) ListWorkflowExecutions(ctx context.Context, lp1 *t
 	return h.frontendHandler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseActivity(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -225,6 +241,14 @@ func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.Paus
 	return h.frontendHandler.PauseSchedule(ctx, pp1)
 }
 
+func (h *versionCheckHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -285,6 +309,14 @@ func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context
 	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ResetActivity(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -437,6 +469,22 @@ func (h *versionCheckHandler) TerminateWorkflowExecution(ctx context.Context, tp
 	return h.frontendHandler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *versionCheckHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.TriggerSchedule(ctx, tp1)
+}
+
+func (h *versionCheckHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UnpauseActivity(ctx, up1)
+}
+
 func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -445,6 +493,22 @@ func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.Un
 	return h.frontendHandler.UnpauseSchedule(ctx, up1)
 }
 
+func (h *versionCheckHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UnpauseWorkflowExecution(ctx, up1)
+}
+
+func (h *versionCheckHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateActivityOptions(ctx, up1)
+}
+
 func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
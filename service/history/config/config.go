@@ -226,6 +226,14 @@ type Config struct {
 	ParentClosePolicyBatchSize dynamicproperties.IntPropertyFnWithDomainFilter
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
+	// whether workflows of a domain can register completion callbacks
+	EnableCompletionCallbacks dynamicproperties.BoolPropertyFnWithDomainFilter
+	// the number of attempts at delivering completion callbacks before giving up on them
+	CompletionCallbackMaxAttempts dynamicproperties.IntPropertyFnWithDomainFilter
+	// the timeout of a request delivering a completion callback
+	CompletionCallbackTimeout dynamicproperties.DurationPropertyFnWithDomainFilter
+	// the hosts completion callbacks can be delivered to
+	CompletionCallbackAllowedHosts dynamicproperties.ListPropertyFn
 
 	// Archival settings
 	NumArchiveSystemWorkflows        dynamicproperties.IntPropertyFn
@@ -530,6 +538,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicproperties.EnableParentClosePolicyWorker),
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyThreshold),
 		ParentClosePolicyBatchSize:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyBatchSize),
+		EnableCompletionCallbacks:           dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		CompletionCallbackMaxAttempts:       dc.GetIntPropertyFilteredByDomain(dynamicproperties.CompletionCallbackMaxAttempts),
+		CompletionCallbackTimeout:           dc.GetDurationPropertyFilteredByDomain(dynamicproperties.CompletionCallbackTimeout),
+		CompletionCallbackAllowedHosts:      dc.GetListProperty(dynamicproperties.CompletionCallbackAllowedHosts),
 
 		NumArchiveSystemWorkflows:        dc.GetIntProperty(dynamicproperties.NumArchiveSystemWorkflows),
 		ArchiveRequestRPS:                dc.GetIntProperty(dynamicproperties.ArchiveRequestRPS),
@@ -186,6 +186,10 @@ func TestNewConfig(t *testing.T) {
 		"EnableParentClosePolicyWorker":                        {dynamicproperties.EnableParentClosePolicyWorker, true},
 		"ParentClosePolicyThreshold":                           {dynamicproperties.ParentClosePolicyThreshold, 61},
 		"ParentClosePolicyBatchSize":                           {dynamicproperties.ParentClosePolicyBatchSize, 62},
+		"EnableCompletionCallbacks":                            {dynamicproperties.EnableCompletionCallbacks, true},
+		"CompletionCallbackMaxAttempts":                        {dynamicproperties.CompletionCallbackMaxAttempts, 1063},
+		"CompletionCallbackTimeout":                            {dynamicproperties.CompletionCallbackTimeout, time.Second},
+		"CompletionCallbackAllowedHosts":                       {dynamicproperties.CompletionCallbackAllowedHosts, []interface{}{"callback.example.com"}},
 		"NumParentClosePolicySystemWorkflows":                  {dynamicproperties.NumParentClosePolicySystemWorkflows, 63},
 		"NumArchiveSystemWorkflows":                            {dynamicproperties.NumArchiveSystemWorkflows, 64},
 		"ArchiveRequestRPS":                                    {dynamicproperties.ArchiveRequestRPS, 65},
@@ -366,6 +370,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.ListPropertyFn:
+			return fn()
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.DurationPropertyFnWithDomainIDFilter:
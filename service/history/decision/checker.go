@@ -246,6 +246,10 @@ func (v *attrValidator) validateActivityScheduleAttributes(
 		return err
 	}
 
+	if err := common.ValidateTaskPriority(attributes.Priority); err != nil {
+		return err
+	}
+
 	idLengthWarnLimit := v.config.MaxIDLengthWarnLimit()
 	if !common.IsValidIDLength(
 		attributes.GetActivityID(),
@@ -597,6 +601,9 @@ func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
 	if attributes.Execution.WorkflowID == "" {
 		return &types.BadRequestError{Message: "WorkflowId is not set on decision."}
 	}
+	if types.IsReservedSignalName(attributes.GetSignalName()) {
+		return &types.BadRequestError{Message: fmt.Sprintf("SignalName %v is reserved for signals sent by the server.", attributes.GetSignalName())}
+	}
 
 	idLengthWarnLimit := v.config.MaxIDLengthWarnLimit()
 	if !common.IsValidIDLength(
@@ -156,6 +156,10 @@ func (s *attrValidatorSuite) TestValidateSignalExternalWorkflowExecutionAttribut
 	s.EqualError(err, "Invalid RunId set on decision.")
 	attributes.Execution.RunID = constants.TestRunID
 
+	attributes.SignalName = types.WorkflowPauseSignalName
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.EqualError(err, "SignalName __cadence_workflow_pause is reserved for signals sent by the server.")
+
 	attributes.SignalName = "my signal name"
 	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.NoError(err)
@@ -495,6 +495,8 @@ Update_History_Loop:
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+		// paused workflows don't get new decision tasks, the next one is scheduled when the workflow is unpaused
+		createNewDecisionTask = createNewDecisionTask && !msBuilder.IsWorkflowPaused()
 		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v",
 			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled)
 		var newDecisionTaskScheduledID int64
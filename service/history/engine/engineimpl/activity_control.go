@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// PauseActivity holds back the attempts of a pending activity until it is unpaused
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+) error {
+	pauseRequest := request.GetPauseRequest()
+	return e.updateActivity(
+		ctx,
+		"Activity paused",
+		request.GetDomainUUID(),
+		pauseRequest.GetWorkflowExecution(),
+		pauseRequest.GetActivityID(),
+		pauseRequest.GetReason(),
+		pauseRequest.GetIdentity(),
+		pauseRequest.GetRequestID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			if ai.Paused {
+				return errActivityAlreadyPaused
+			}
+			return mutableState.PauseActivity(ai)
+		},
+	)
+}
+
+// UnpauseActivity dispatches again the attempts of a paused activity
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+) error {
+	unpauseRequest := request.GetUnpauseRequest()
+	return e.updateActivity(
+		ctx,
+		"Activity unpaused",
+		request.GetDomainUUID(),
+		unpauseRequest.GetWorkflowExecution(),
+		unpauseRequest.GetActivityID(),
+		unpauseRequest.GetReason(),
+		unpauseRequest.GetIdentity(),
+		unpauseRequest.GetRequestID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			if !ai.Paused {
+				return errActivityNotPaused
+			}
+			return mutableState.UnpauseActivity(ai)
+		},
+	)
+}
+
+// ResetActivity resets the attempt count of a pending activity
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+) error {
+	resetRequest := request.GetResetRequest()
+	return e.updateActivity(
+		ctx,
+		"Activity reset",
+		request.GetDomainUUID(),
+		resetRequest.GetWorkflowExecution(),
+		resetRequest.GetActivityID(),
+		resetRequest.GetReason(),
+		resetRequest.GetIdentity(),
+		resetRequest.GetRequestID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			return mutableState.ResetActivity(ai)
+		},
+	)
+}
+
+// UpdateActivityOptions updates the retry policy and timeouts of a pending activity
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+) error {
+	updateRequest := request.GetUpdateRequest()
+	if updateRequest.GetOptions() == nil {
+		return errActivityOptionsNotSet
+	}
+	return e.updateActivity(
+		ctx,
+		"Activity options updated",
+		request.GetDomainUUID(),
+		updateRequest.GetWorkflowExecution(),
+		updateRequest.GetActivityID(),
+		updateRequest.GetReason(),
+		updateRequest.GetIdentity(),
+		updateRequest.GetRequestID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			return mutableState.UpdateActivityOptions(ai, updateRequest.GetOptions())
+		},
+	)
+}
+
+// updateActivity applies an activity control operation to the activity info of a pending activity of the
+// current run. The operation is not recorded in the workflow history and doesn't wake up the workflow,
+// so it is logged with its reason and identity.
+func (e *historyEngineImpl) updateActivity(
+	ctx context.Context,
+	operation string,
+	domainUUID string,
+	workflowExecution *types.WorkflowExecution,
+	activityID string,
+	reason string,
+	identity string,
+	requestID string,
+	action func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error,
+) error {
+	if workflowExecution == nil {
+		return &types.BadRequestError{Message: "Execution is not set on request."}
+	}
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, domainUUID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.logger,
+		e.executionCache,
+		e.executionManager,
+		e.shard.GetShardID(),
+		domainID,
+		e.shard.GetDomainCache(),
+		*workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			// retried requests are deduplicated with the request IDs of the signals
+			if requestID != "" && mutableState.IsSignalRequested(requestID) {
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			ai, ok := mutableState.GetActivityByActivityID(activityID)
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+			if err := action(mutableState, ai); err != nil {
+				return nil, err
+			}
+			if requestID != "" {
+				mutableState.AddSignalRequested(requestID)
+			}
+
+			executionInfo := mutableState.GetExecutionInfo()
+			e.logger.Info(operation,
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(executionInfo.WorkflowID),
+				tag.WorkflowRunID(executionInfo.RunID),
+				tag.WorkflowActivityID(activityID),
+				tag.Reason(reason),
+				tag.ActorID(identity),
+			)
+			return &workflow.UpdateAction{Noop: false, CreateDecision: false}, nil
+		},
+	)
+}
@@ -73,7 +73,12 @@ const (
 )
 
 var (
-	errDomainDeprecated = &types.BadRequestError{Message: "Domain is deprecated."}
+	errDomainDeprecated      = &types.BadRequestError{Message: "Domain is deprecated."}
+	errWorkflowAlreadyPaused = &types.BadRequestError{Message: "Workflow execution is already paused."}
+	errWorkflowNotPaused     = &types.BadRequestError{Message: "Workflow execution is not paused."}
+	errActivityAlreadyPaused = &types.BadRequestError{Message: "Activity is already paused."}
+	errActivityNotPaused     = &types.BadRequestError{Message: "Activity is not paused."}
+	errActivityOptionsNotSet = &types.BadRequestError{Message: "Activity options are not set."}
 )
 
 type historyEngineImpl struct {
@@ -5841,6 +5841,284 @@ func (s *engineSuite) TestSignalWorkflowExecution_AfterFirstDecision_DecisionSch
 	s.NotEqual(commonconstants.EmptyEventID, updateReq.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_PauseAndUnpause() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	tests := map[string]struct {
+		signalName             string
+		paused                 bool
+		wantErr                error
+		wantPaused             bool
+		wantDecisionScheduled  bool
+		wantActivityDispatched bool
+	}{
+		"pause": {
+			signalName: types.WorkflowPauseSignalName,
+			wantPaused: true,
+		},
+		"pause paused workflow": {
+			signalName: types.WorkflowPauseSignalName,
+			paused:     true,
+			wantErr:    errWorkflowAlreadyPaused,
+		},
+		"unpause": {
+			signalName:             types.WorkflowUnpauseSignalName,
+			paused:                 true,
+			wantDecisionScheduled:  true,
+			wantActivityDispatched: true,
+		},
+		"unpause workflow not paused": {
+			signalName: types.WorkflowUnpauseSignalName,
+			wantErr:    errWorkflowNotPaused,
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			we := types.WorkflowExecution{
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      uuid.New(),
+			}
+			tasklist := "testTaskList"
+			identity := "testIdentity"
+
+			// the workflow completed its first decision, which scheduled an activity not yet started
+			msBuilder := execution.NewMutableStateBuilderWithEventV2(
+				s.mockHistoryEngine.shard,
+				testlogger.New(s.Suite.T()),
+				we.GetRunID(),
+				constants.TestLocalDomainEntry,
+			)
+			test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+			di := test.AddDecisionTaskScheduledEvent(msBuilder)
+			startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+			completedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.ID, nil, identity)
+			test.AddActivityTaskScheduledEvent(msBuilder, completedEvent.ID, "activity-id", "activity-type", tasklist, nil, 100, 10, 10, 10)
+			if tc.paused {
+				_, err := msBuilder.AddWorkflowExecutionSignaled(types.WorkflowPauseSignalName, []byte("reason"), identity, "")
+				s.NoError(err)
+			}
+
+			ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+			ms.ExecutionInfo.DomainID = constants.TestDomainID
+			gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+			var updateReq *persistence.UpdateWorkflowExecutionRequest
+
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution.RunID == we.RunID
+			})).Return(gwmsResponse, nil).Once()
+			if tc.wantErr == nil {
+				s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+				s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						updateReq = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+					}).
+					Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).
+					Once()
+			}
+
+			err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), &types.HistorySignalWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				SignalRequest: &types.SignalWorkflowExecutionRequest{
+					Domain:            constants.TestDomainID,
+					WorkflowExecution: &we,
+					Identity:          identity,
+					SignalName:        tc.signalName,
+				},
+			})
+			if tc.wantErr != nil {
+				s.Equal(tc.wantErr, err)
+				return
+			}
+			s.NoError(err)
+			s.NotNil(updateReq)
+
+			mutation := updateReq.UpdateWorkflowMutation
+			s.Equal(tc.wantPaused, mutation.ExecutionInfo.Paused)
+			s.Equal(tc.wantDecisionScheduled, mutation.ExecutionInfo.DecisionScheduleID != commonconstants.EmptyEventID)
+			activityDispatched := false
+			for _, task := range mutation.TasksByCategory[persistence.HistoryTaskCategoryTimer] {
+				if _, ok := task.(*persistence.ActivityRetryTimerTask); ok {
+					activityDispatched = true
+				}
+			}
+			s.Equal(tc.wantActivityDispatched, activityDispatched)
+		})
+	}
+}
+
+func (s *engineSuite) TestActivityControl() {
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: constants.TestLocalDomainEntry.GetReplicationConfig().ActiveClusterName,
+		FailoverVersion:   constants.TestLocalDomainEntry.GetFailoverVersion(),
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).AnyTimes()
+
+	pause := func(activityID string) func(*historyEngineImpl, *types.WorkflowExecution) error {
+		return func(e *historyEngineImpl, we *types.WorkflowExecution) error {
+			return e.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+				DomainUUID: constants.TestDomainID,
+				PauseRequest: &types.PauseActivityRequest{
+					Domain:            constants.TestDomainName,
+					WorkflowExecution: we,
+					ActivityID:        activityID,
+				},
+			})
+		}
+	}
+	unpause := func(e *historyEngineImpl, we *types.WorkflowExecution) error {
+		return e.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			UnpauseRequest: &types.UnpauseActivityRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: we,
+				ActivityID:        "activity-id",
+			},
+		})
+	}
+	reset := func(e *historyEngineImpl, we *types.WorkflowExecution) error {
+		return e.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			ResetRequest: &types.ResetActivityRequest{
+				Domain:            constants.TestDomainName,
+				WorkflowExecution: we,
+				ActivityID:        "activity-id",
+			},
+		})
+	}
+	updateOptions := func(options *types.ActivityOptions) func(*historyEngineImpl, *types.WorkflowExecution) error {
+		return func(e *historyEngineImpl, we *types.WorkflowExecution) error {
+			return e.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+				DomainUUID: constants.TestDomainID,
+				UpdateRequest: &types.UpdateActivityOptionsRequest{
+					Domain:            constants.TestDomainName,
+					WorkflowExecution: we,
+					ActivityID:        "activity-id",
+					Options:           options,
+				},
+			})
+		}
+	}
+	tests := map[string]struct {
+		control                func(*historyEngineImpl, *types.WorkflowExecution) error
+		paused                 bool
+		wantErr                error
+		wantPaused             bool
+		wantActivityDispatched bool
+	}{
+		"pause": {
+			control:    pause("activity-id"),
+			wantPaused: true,
+		},
+		"pause paused activity": {
+			control: pause("activity-id"),
+			paused:  true,
+			wantErr: errActivityAlreadyPaused,
+		},
+		"unpause": {
+			control:                unpause,
+			paused:                 true,
+			wantActivityDispatched: true,
+		},
+		"unpause activity not paused": {
+			control: unpause,
+			wantErr: errActivityNotPaused,
+		},
+		"reset": {
+			control:                reset,
+			wantActivityDispatched: true,
+		},
+		"reset paused activity": {
+			control:    reset,
+			paused:     true,
+			wantPaused: true,
+		},
+		"update options": {
+			control: updateOptions(&types.ActivityOptions{StartToCloseTimeoutSeconds: common.Int32Ptr(30)}),
+		},
+		"update options not set": {
+			control: updateOptions(nil),
+			wantErr: errActivityOptionsNotSet,
+		},
+		"unknown activity": {
+			control: pause("unknown-activity-id"),
+			wantErr: workflow.ErrActivityTaskNotFound,
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			we := types.WorkflowExecution{
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      uuid.New(),
+			}
+			tasklist := "testTaskList"
+			identity := "testIdentity"
+
+			// the workflow completed its first decision, which scheduled an activity not yet started
+			msBuilder := execution.NewMutableStateBuilderWithEventV2(
+				s.mockHistoryEngine.shard,
+				testlogger.New(s.Suite.T()),
+				we.GetRunID(),
+				constants.TestLocalDomainEntry,
+			)
+			test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity, nil)
+			di := test.AddDecisionTaskScheduledEvent(msBuilder)
+			startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+			completedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.ID, nil, identity)
+			test.AddActivityTaskScheduledEvent(msBuilder, completedEvent.ID, "activity-id", "activity-type", tasklist, nil, 100, 10, 10, 10)
+			if tc.paused {
+				ai, ok := msBuilder.GetActivityByActivityID("activity-id")
+				s.True(ok)
+				s.NoError(msBuilder.PauseActivity(ai))
+			}
+
+			ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+			ms.ExecutionInfo.DomainID = constants.TestDomainID
+			gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+			var updateReq *persistence.UpdateWorkflowExecutionRequest
+
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution.RunID == we.RunID
+			})).Return(gwmsResponse, nil).Maybe()
+			if tc.wantErr == nil {
+				s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					return req.UpdateWorkflowMutation.ExecutionInfo.RunID == we.RunID
+				})).
+					Run(func(args mock.Arguments) {
+						updateReq = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+					}).
+					Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).
+					Once()
+			}
+
+			err := tc.control(s.mockHistoryEngine, &we)
+			if tc.wantErr != nil {
+				s.Equal(tc.wantErr, err)
+				return
+			}
+			s.NoError(err)
+			s.NotNil(updateReq)
+
+			// activity control mutates ActivityInfo only and writes no history events
+			mutation := updateReq.UpdateWorkflowMutation
+			s.Empty(mutation.NewBufferedEvents)
+			s.Equal(commonconstants.EmptyEventID, mutation.ExecutionInfo.DecisionScheduleID)
+			s.Len(mutation.UpsertActivityInfos, 1)
+			s.Equal(tc.wantPaused, mutation.UpsertActivityInfos[0].Paused)
+			activityDispatched := false
+			for _, task := range mutation.TasksByCategory[persistence.HistoryTaskCategoryTimer] {
+				if _, ok := task.(*persistence.ActivityRetryTimerTask); ok {
+					activityDispatched = true
+				}
+			}
+			s.Equal(tc.wantActivityDispatched, activityDispatched)
+		})
+	}
+}
+
 func (s *engineSuite) TestSignalWithStartWorkflowExecution_DelayStart_NoDecisionScheduled() {
 	// Test SignalWithStart on existing workflow waiting for DelayStart - should NOT schedule decision
 	testActiveClusterInfo := &types.ActiveClusterInfo{
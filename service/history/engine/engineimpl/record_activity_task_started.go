@@ -148,6 +148,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if ai.Paused {
+				// the activity task is dispatched again when the activity is unpaused
+				return workflow.ErrActivityTaskNotFound
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -35,6 +35,7 @@ import (
 	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/reset"
 )
 
 const (
@@ -121,8 +122,8 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}
 	}
 
-	// dedup by requestID
-	if currentMutableState.GetExecutionInfo().CreateRequestID == request.GetRequestID() {
+	// dedup by requestID, a dry run is never committed so it has nothing to dedup against
+	if !request.GetDryRun() && currentMutableState.GetExecutionInfo().CreateRequestID == request.GetRequestID() {
 		e.logger.Info("Duplicated reset request",
 			tag.WorkflowID(workflowID),
 			tag.WorkflowRunID(currentRunID),
@@ -161,6 +162,44 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		return nil, err
 	}
 
+	currentWorkflow := execution.NewWorkflow(
+		ctx,
+		e.shard.GetClusterMetadata(),
+		currentContext,
+		currentMutableState,
+		currentReleaseFn,
+		e.logger,
+	)
+	reapplyPolicy := reset.ReapplyPolicy{
+		SkipSignals:    request.GetSkipSignalReapply(),
+		SignalNames:    request.GetSignalNamesToReapply(),
+		CancelRequests: request.GetReapplyCancelRequests(),
+	}
+
+	if request.GetDryRun() {
+		dryRunResult, err := e.workflowResetter.DryRunResetWorkflow(
+			ctx,
+			domainID,
+			workflowID,
+			baseRunID,
+			baseCurrentBranchToken,
+			baseRebuildLastEventID,
+			baseRebuildLastEventVersion,
+			baseNextEventID,
+			resetRunID,
+			request.GetRequestID(),
+			currentWorkflow,
+			request.GetReason(),
+			reapplyPolicy,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ResetWorkflowExecutionResponse{
+			DryRunResult: dryRunResult,
+		}, nil
+	}
+
 	if err := e.workflowResetter.ResetWorkflow(
 		ctx,
 		domainID,
@@ -172,17 +211,10 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseNextEventID,
 		resetRunID,
 		request.GetRequestID(),
-		execution.NewWorkflow(
-			ctx,
-			e.shard.GetClusterMetadata(),
-			currentContext,
-			currentMutableState,
-			currentReleaseFn,
-			e.logger,
-		),
+		currentWorkflow,
 		request.GetReason(),
 		nil,
-		request.GetSkipSignalReapply(),
+		reapplyPolicy,
 	); err != nil {
 		if t, ok := persistence.AsDuplicateRequestError(err); ok {
 			if t.RequestType == persistence.WorkflowRequestTypeReset {
@@ -429,12 +429,70 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(nil).Times(1)
 				},
 			},
 			// Can't assert on the result because the runID is random
 		},
+		{
+			name: "Dry run",
+			request: func() *types.HistoryResetWorkflowExecutionRequest {
+				request := resetExecutionRequest(latestExecution, 24)
+				request.ResetRequest.SkipSignalReapply = false
+				request.ResetRequest.SignalNamesToReapply = []string{"address"}
+				request.ResetRequest.ReapplyCancelRequests = true
+				request.ResetRequest.DryRun = true
+				return request
+			}(),
+			init: []InitFn{
+				withCurrentExecution(latestExecution),
+				withState(latestExecution, &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:        constants.TestDomainID,
+						WorkflowID:      constants.TestWorkflowID,
+						RunID:           latestRunID,
+						NextEventID:     26,
+						BranchToken:     branchToken,
+						CreateRequestID: testRequestID,
+					},
+					ReplicationState: &persistence.ReplicationState{
+						CurrentVersion: version,
+					},
+					ExecutionStats: &persistence.ExecutionStats{HistorySize: 1},
+				}),
+				withActiveClusterInfo(constants.TestDomainID, latestExecution, &types.ActiveClusterInfo{ActiveClusterName: "test-active-cluster"}),
+				withHistoryPagination(branchToken, 24),
+				func(t *testing.T, engine *testdata.EngineForTest) {
+					ctrl := gomock.NewController(t)
+					mockResetter := reset.NewMockWorkflowResetter(ctrl)
+					engine.Engine.(*historyEngineImpl).workflowResetter = mockResetter
+
+					mockResetter.EXPECT().DryRunResetWorkflow(
+						gomock.Any(), // Context
+						gomock.Eq(constants.TestDomainID),
+						gomock.Eq(constants.TestWorkflowID),
+						gomock.Eq(latestExecution.RunID),
+						gomock.Eq(branchToken),
+						gomock.Eq(int64(24)-1), // Request.DecisionFinishEventID - 1
+						gomock.Eq(version),     // CurrentVersion
+						gomock.Eq(int64(26)),   // NextEventID
+						gomock.Any(),           // random uuid
+						gomock.Eq(testRequestID),
+						&workflowMatcher{latestExecution},
+						gomock.Eq(testRequestReason),
+						gomock.Eq(reset.ReapplyPolicy{SignalNames: []string{"address"}, CancelRequests: true}),
+					).Return(&types.ResetWorkflowExecutionDryRunResult{
+						NewEvents: []*types.HistoryEvent{{ID: 24}},
+					}, nil).Times(1)
+				},
+			},
+			expected: &types.ResetWorkflowExecutionResponse{
+				DryRunResult: &types.ResetWorkflowExecutionDryRunResult{
+					NewEvents: []*types.HistoryEvent{{ID: 24}},
+				},
+			},
+		},
 		{
 			name: "Success using version histories started in current cluster",
 			// This corresponds to VersionHistories.Histories.Items.EventID
@@ -497,7 +555,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(nil).Times(1)
 				},
 			},
@@ -554,7 +612,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(nil).Times(1)
 				},
 			},
@@ -599,7 +657,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(&persistence.DuplicateRequestError{
 						RequestType: persistence.WorkflowRequestTypeReset,
 						RunID:       "errorID",
@@ -649,7 +707,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(&persistence.DuplicateRequestError{
 						RequestType: persistence.WorkflowRequestTypeStart,
 						RunID:       "errorID",
@@ -700,7 +758,7 @@ func TestResetWorkflowExecution(t *testing.T) {
 						&workflowMatcher{latestExecution},
 						gomock.Eq(testRequestReason),
 						gomock.Nil(),
-						gomock.Eq(testRequestSkipSignalReapply),
+						gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 					).Return(&types.BadRequestError{
 						Message: "didn't work",
 					}).Times(1)
@@ -723,7 +781,9 @@ func TestResetWorkflowExecution(t *testing.T) {
 			eft.Engine.Stop()
 
 			if testCase.expectedErr == nil {
-				if assert.NotNil(t, result) {
+				if testCase.expected != nil {
+					assert.Equal(t, testCase.expected, result)
+				} else if assert.NotNil(t, result) {
 					assert.NotEmpty(t, result.RunID)
 				}
 				assert.NoError(t, err)
@@ -833,7 +893,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					&workflowMatcher{latestExecution},
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
-					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 23,
@@ -856,7 +916,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					&workflowMatcher{latestExecution},
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
-					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 9,
@@ -879,7 +939,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					&workflowMatcher{latestExecution},
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
-					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 12,
@@ -902,7 +962,7 @@ func TestResetWorkflowExecution_ResetPointsValidation(t *testing.T) {
 					&workflowMatcher{latestExecution},
 					gomock.Eq(testRequestReason),
 					gomock.Nil(),
-					gomock.Eq(testRequestSkipSignalReapply),
+					gomock.Eq(reset.ReapplyPolicy{SkipSignals: testRequestSkipSignalReapply}),
 				).Return(nil).Times(1)
 			},
 			resetEventID: 24,
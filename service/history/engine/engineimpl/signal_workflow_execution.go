@@ -24,6 +24,7 @@ package engineimpl
 import (
 	"context"
 
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -109,6 +110,17 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				}
 			}
 
+			switch request.GetSignalName() {
+			case types.WorkflowPauseSignalName:
+				if mutableState.IsWorkflowPaused() {
+					return nil, errWorkflowAlreadyPaused
+				}
+			case types.WorkflowUnpauseSignalName:
+				if !mutableState.IsWorkflowPaused() {
+					return nil, errWorkflowNotPaused
+				}
+			}
+
 			if requestID := request.GetRequestID(); requestID != "" {
 				mutableState.AddSignalRequested(requestID)
 			}
@@ -121,6 +133,11 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
+			if request.GetSignalName() == types.WorkflowUnpauseSignalName {
+				if err := e.generateUnpausedWorkflowTasks(ctx, mutableState); err != nil {
+					return nil, err
+				}
+			}
 
 			return &workflow.UpdateAction{
 				Noop:           false,
@@ -128,3 +145,39 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}, nil
 		})
 }
+
+// generateUnpausedWorkflowTasks generates the tasks held back while the workflow was paused:
+// the dispatch of the activities not yet started and the first decision task if the workflow
+// was paused before it was scheduled, the other decision tasks are scheduled by the update action
+func (e *historyEngineImpl) generateUnpausedWorkflowTasks(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) error {
+	taskGenerator := execution.NewMutableStateTaskGenerator(
+		e.logger,
+		e.shard.GetClusterMetadata(),
+		e.shard.GetDomainCache(),
+		mutableState,
+	)
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.StartedID != constants.EmptyEventID {
+			continue
+		}
+		// the retry timer task dispatches the activity at its scheduled time, or right away if it is in the past
+		if err := taskGenerator.GenerateActivityRetryTasks(ai.ScheduleID); err != nil {
+			return err
+		}
+	}
+
+	if mutableState.HasProcessedOrPendingDecision() {
+		return nil
+	}
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return err
+	}
+	if startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds() == 0 {
+		return execution.ScheduleDecision(mutableState)
+	}
+	return taskGenerator.GenerateDelayedDecisionTasks(startEvent)
+}
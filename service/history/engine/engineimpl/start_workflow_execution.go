@@ -570,8 +570,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 			// Create a transfer task to schedule a decision task
 			// Do not schedule if the workflow hasn't processed its first decision yet
-			// (e.g. waiting for DelayStart or Cron timer)
-			if !mutableState.HasPendingDecision() && mutableState.HasProcessedOrPendingDecision() {
+			// (e.g. waiting for DelayStart or Cron timer) or if the workflow is paused
+			if !mutableState.HasPendingDecision() && mutableState.HasProcessedOrPendingDecision() && !mutableState.IsWorkflowPaused() {
 				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -666,6 +666,8 @@ func getStartRequest(
 		JitterStartSeconds:                  request.JitterStartSeconds,
 		FirstRunAtTimeStamp:                 request.FirstRunAtTimestamp,
 		ActiveClusterSelectionPolicy:        request.ActiveClusterSelectionPolicy,
+		Priority:                            request.Priority,
+		FairnessKey:                         request.FairnessKey,
 	}
 
 	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig)
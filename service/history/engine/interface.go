@@ -50,6 +50,10 @@ type (
 		RespondActivityTaskFailed(ctx context.Context, request *types.HistoryRespondActivityTaskFailedRequest) error
 		RespondActivityTaskCanceled(ctx context.Context, request *types.HistoryRespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(ctx context.Context, request *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error
+		ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest) error
+		UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) error
 		RequestCancelWorkflowExecution(ctx context.Context, request *types.HistoryRequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest) error
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
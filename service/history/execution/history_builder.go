@@ -234,6 +234,7 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 		DecisionTaskCompletedEventID:  decisionCompletedEventID,
 		RetryPolicy:                   attributes.RetryPolicy,
 		Domain:                        domain,
+		Priority:                      attributes.Priority,
 	}
 
 	return b.addEventToHistory(event)
@@ -1193,7 +1193,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(
 	e.ClearStickyness()
 	e.writeEventToCache(event)
 
-	return e.taskGenerator.GenerateWorkflowCloseTasks(event, e.config.WorkflowDeletionJitterRange(e.domainEntry.GetInfo().Name))
+	return e.generateWorkflowCloseTasks(event)
 }
 
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
@@ -1277,6 +1277,8 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	); err != nil {
 		return nil, nil, &types.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
+	// the completion callbacks are notified once the last run closes
+	newStateBuilder.executionInfo.CompletionCallbacks = e.executionInfo.CompletionCallbacks
 
 	if err = e.ReplicateWorkflowExecutionContinuedAsNewEvent(
 		firstEventID,
@@ -1328,7 +1330,21 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(
 	e.ClearStickyness()
 	e.writeEventToCache(continueAsNewEvent)
 
-	return e.taskGenerator.GenerateWorkflowCloseTasks(continueAsNewEvent, e.config.WorkflowDeletionJitterRange(e.domainEntry.GetInfo().Name))
+	return e.generateWorkflowCloseTasks(continueAsNewEvent)
+}
+
+func (e *mutableStateBuilder) generateWorkflowCloseTasks(
+	closeEvent *types.HistoryEvent,
+) error {
+
+	domainName := e.domainEntry.GetInfo().Name
+	if err := e.taskGenerator.GenerateWorkflowCloseTasks(closeEvent, e.config.WorkflowDeletionJitterRange(domainName)); err != nil {
+		return err
+	}
+	if !e.config.EnableCompletionCallbacks(domainName) {
+		return nil
+	}
+	return e.taskGenerator.GenerateCompletionCallbackTasks(closeEvent)
 }
 
 // TODO mutable state should generate corresponding transfer / timer tasks according to
@@ -312,6 +312,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TaskList:                 attributes.TaskList.GetName(),
 		TaskListKind:             attributes.TaskList.GetKind(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Priority:                 attributes.GetPriority(),
 	}
 
 	if ai.HasRetryPolicy {
@@ -741,3 +742,111 @@ func (e *mutableStateBuilder) RetryActivity(
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 	return true, nil
 }
+
+// PauseActivity holds back the attempts of a pending activity until it is unpaused: an activity not started
+// is not dispatched to workers and an attempt already running keeps running. The pause is not recorded in the
+// workflow history, it is kept in the activity info only.
+func (e *mutableStateBuilder) PauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	ai.Paused = true
+	return e.updateControlledActivity(ai, false)
+}
+
+// UnpauseActivity dispatches right away the attempt of a paused activity held back while it was paused
+func (e *mutableStateBuilder) UnpauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	ai.Paused = false
+	return e.updateControlledActivity(ai, true)
+}
+
+// ResetActivity resets the attempt count of a pending activity, an activity waiting for its next attempt
+// skips the backoff of the pending retry unless it is paused
+func (e *mutableStateBuilder) ResetActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	ai.Attempt = 0
+	return e.updateControlledActivity(ai, true)
+}
+
+// UpdateActivityOptions updates the retry policy and timeouts of a pending activity in place,
+// the options which are not set are left unchanged
+func (e *mutableStateBuilder) UpdateActivityOptions(
+	ai *persistence.ActivityInfo,
+	options *types.ActivityOptions,
+) error {
+
+	updateActivityOptions(ai, options, e.timeSource.Now())
+	return e.updateControlledActivity(ai, false)
+}
+
+// updateControlledActivity persists the activity info updated by an activity control operation.
+// The activity info is replicated by a sync activity task which doesn't carry the pause state,
+// the retry policy nor the timeouts, so they are not carried over to the standby clusters.
+func (e *mutableStateBuilder) updateControlledActivity(
+	ai *persistence.ActivityInfo,
+	dispatch bool,
+) error {
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity ID: %v in mutable state", ai.ActivityID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	if dispatch && !ai.Paused && ai.StartedID == constants.EmptyEventID {
+		// the activity waiting for its next attempt is dispatched right away
+		ai.ScheduledTime = e.timeSource.Now()
+		if err := e.taskGenerator.GenerateActivityRetryTasks(ai.ScheduleID); err != nil {
+			return err
+		}
+	}
+	// timeout timers are created again from the updated activity info when the transaction is closed
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+func updateActivityOptions(
+	ai *persistence.ActivityInfo,
+	options *types.ActivityOptions,
+	now time.Time,
+) {
+
+	if options == nil {
+		return
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil {
+		ai.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeoutSeconds()
+	}
+	if options.ScheduleToStartTimeoutSeconds != nil {
+		ai.ScheduleToStartTimeout = options.GetScheduleToStartTimeoutSeconds()
+	}
+	if options.StartToCloseTimeoutSeconds != nil {
+		ai.StartToCloseTimeout = options.GetStartToCloseTimeoutSeconds()
+	}
+	if options.HeartbeatTimeoutSeconds != nil {
+		ai.HeartbeatTimeout = options.GetHeartbeatTimeoutSeconds()
+	}
+	if retryPolicy := options.RetryPolicy; retryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = retryPolicy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.MaximumInterval = retryPolicy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.NonRetriableErrors = retryPolicy.NonRetriableErrorReasons
+		// the expiration interval of the new retry policy starts when it is applied
+		ai.ExpirationTime = time.Time{}
+		if retryPolicy.GetExpirationIntervalInSeconds() != 0 {
+			ai.ExpirationTime = now.Add(time.Duration(retryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+}
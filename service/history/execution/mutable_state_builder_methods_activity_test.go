@@ -654,3 +654,150 @@ func Test__AddActivityTaskFailedEvent(t *testing.T) {
 		assert.Equal(t, int32(200), event.ActivityTaskFailedEventAttributes.FailureOptions.GetNextRetryIntervalSeconds())
 	})
 }
+
+func Test__ActivityControl(t *testing.T) {
+	scheduledTime := time.Unix(1000, 0)
+	newActivityInfo := func(startedID int64) *persistence.ActivityInfo {
+		return &persistence.ActivityInfo{
+			Version:                1,
+			ScheduleID:             5,
+			ActivityID:             "activity",
+			ScheduledTime:          scheduledTime,
+			StartedID:              startedID,
+			ScheduleToStartTimeout: 10,
+			ScheduleToCloseTimeout: 20,
+			StartToCloseTimeout:    10,
+			HeartbeatTimeout:       5,
+			TimerTaskStatus:        TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose,
+			Attempt:                3,
+			HasRetryPolicy:         true,
+			InitialInterval:        1,
+			BackoffCoefficient:     2,
+			MaximumAttempts:        10,
+		}
+	}
+	tests := map[string]struct {
+		paused       bool
+		startedID    int64
+		control      func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error
+		want         func(*persistence.ActivityInfo)
+		wantDispatch bool
+	}{
+		"pause": {
+			startedID: commonconstants.EmptyEventID,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.PauseActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.Paused = true
+			},
+		},
+		"unpause waiting for retry": {
+			paused:    true,
+			startedID: commonconstants.EmptyEventID,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.UnpauseActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.Paused = false
+				ai.ScheduledTime = currentTime
+			},
+			wantDispatch: true,
+		},
+		"unpause started": {
+			paused:    true,
+			startedID: 6,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.UnpauseActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.Paused = false
+			},
+		},
+		"reset waiting for retry": {
+			startedID: commonconstants.EmptyEventID,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.ResetActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.ScheduledTime = currentTime
+				ai.Attempt = 0
+			},
+			wantDispatch: true,
+		},
+		"reset paused": {
+			paused:    true,
+			startedID: commonconstants.EmptyEventID,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.ResetActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.Attempt = 0
+			},
+		},
+		"reset started": {
+			startedID: 6,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.ResetActivity(ai)
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.Attempt = 0
+			},
+		},
+		"update options": {
+			startedID: commonconstants.EmptyEventID,
+			control: func(mb *mutableStateBuilder, ai *persistence.ActivityInfo) error {
+				return mb.UpdateActivityOptions(ai, &types.ActivityOptions{
+					StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+					HeartbeatTimeoutSeconds:    common.Int32Ptr(0),
+					RetryPolicy: &types.RetryPolicy{
+						InitialIntervalInSeconds:    5,
+						BackoffCoefficient:          1.5,
+						MaximumAttempts:             20,
+						ExpirationIntervalInSeconds: 100,
+					},
+				})
+			},
+			want: func(ai *persistence.ActivityInfo) {
+				ai.StartToCloseTimeout = 30
+				ai.HeartbeatTimeout = 0
+				ai.InitialInterval = 5
+				ai.BackoffCoefficient = 1.5
+				ai.MaximumAttempts = 20
+				ai.ExpirationTime = currentTime.Add(100 * time.Second)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mb := testMutableStateBuilder(t)
+			ai := newActivityInfo(test.startedID)
+			ai.Paused = test.paused
+			mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+			mb.pendingActivityIDToEventID[ai.ActivityID] = ai.ScheduleID
+
+			assert.NoError(t, test.control(mb, ai))
+
+			expected := newActivityInfo(test.startedID)
+			expected.Paused = test.paused
+			test.want(expected)
+			expected.Version = mb.GetCurrentVersion()
+			expected.TimerTaskStatus = TimerTaskStatusNone
+			assert.Equal(t, expected, ai)
+			assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+			assert.Contains(t, mb.syncActivityTasks, ai.ScheduleID)
+			if test.wantDispatch {
+				assert.Len(t, mb.insertTimerTasks, 1)
+			} else {
+				assert.Empty(t, mb.insertTimerTasks)
+			}
+		})
+	}
+
+	t.Run("unknown activity", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := newActivityInfo(commonconstants.EmptyEventID)
+		assert.Equal(t, ErrMissingActivityInfo, mb.PauseActivity(ai))
+		assert.Empty(t, mb.updateActivityInfos)
+	})
+}
@@ -67,7 +67,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionCompletedEvent(
 	e.ClearStickyness()
 	e.writeEventToCache(event)
 
-	return e.taskGenerator.GenerateWorkflowCloseTasks(event, e.config.WorkflowDeletionJitterRange(e.domainEntry.GetInfo().Name))
+	return e.generateWorkflowCloseTasks(event)
 }
 
 func (e *mutableStateBuilder) AddFailWorkflowEvent(
@@ -102,7 +102,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionFailedEvent(
 	e.ClearStickyness()
 	e.writeEventToCache(event)
 
-	return e.taskGenerator.GenerateWorkflowCloseTasks(event, e.config.WorkflowDeletionJitterRange(e.domainEntry.GetInfo().Name))
+	return e.generateWorkflowCloseTasks(event)
 }
 
 // GetCompletionEvent retrieves the workflow completion event from mutable state
@@ -40,6 +40,11 @@ func (e *mutableStateBuilder) IsSignalRequested(
 	return false
 }
 
+// IsWorkflowPaused returns true if the workflow execution is paused, see types.WorkflowPauseSignalName
+func (e *mutableStateBuilder) IsWorkflowPaused() bool {
+	return e.executionInfo.Paused
+}
+
 // GetSignalInfo get details about a signal request that is currently in progress.
 func (e *mutableStateBuilder) GetSignalInfo(
 	initiatedEventID int64,
@@ -79,6 +84,12 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	switch attributes := event.WorkflowExecutionSignaledEventAttributes; attributes.SignalName {
+	case types.WorkflowPauseSignalName:
+		e.executionInfo.Paused = true
+	case types.WorkflowUnpauseSignalName:
+		e.executionInfo.Paused = false
+	}
 	e.insertWorkflowRequest(persistence.WorkflowRequest{
 		RequestID:   event.WorkflowExecutionSignaledEventAttributes.RequestID,
 		Version:     event.Version,
@@ -67,6 +67,35 @@ func Test__GetSignalInfo(t *testing.T) {
 	})
 }
 
+func Test__ReplicateWorkflowExecutionSignaled_Pause(t *testing.T) {
+	newSignaledEvent := func(signalName, input string) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: signalName,
+				Input:      []byte(input),
+			},
+		}
+	}
+	mb := testMutableStateBuilder(t)
+	partitionConfig := map[string]string{"isolation-group": "zone-1"}
+	mb.executionInfo.PartitionConfig = partitionConfig
+	assert.False(t, mb.IsWorkflowPaused())
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(newSignaledEvent("signal", "")))
+	assert.False(t, mb.IsWorkflowPaused())
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(newSignaledEvent(types.WorkflowPauseSignalName, "dependency down")))
+	assert.True(t, mb.IsWorkflowPaused())
+	assert.True(t, mb.executionInfo.Paused)
+	assert.Equal(t, partitionConfig, mb.executionInfo.PartitionConfig, "the pause state must not leak into the partition config")
+
+	assert.NoError(t, mb.ReplicateWorkflowExecutionSignaled(newSignaledEvent(types.WorkflowUnpauseSignalName, "")))
+	assert.False(t, mb.IsWorkflowPaused())
+	assert.False(t, mb.executionInfo.Paused)
+	assert.Equal(t, partitionConfig, mb.executionInfo.PartitionConfig)
+	assert.Equal(t, int32(3), mb.executionInfo.SignalCount)
+}
+
 func Test__ReplicateExternalWorkflowExecutionSignaled(t *testing.T) {
 	mb := testMutableStateBuilder(t)
 	event := &types.HistoryEvent{
@@ -169,6 +169,8 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 	if startRequest.ParentExecutionInfo != nil {
 		parentDomainID = &startRequest.ParentExecutionInfo.DomainUUID
 	}
+	// the deadline is kept out of the history, it is set before the start tasks are generated from it
+	e.executionInfo.WorkflowDeadline = request.Deadline
 	if err := e.ReplicateWorkflowExecutionStartedEvent(
 		parentDomainID,
 		execution,
@@ -177,6 +179,8 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		false); err != nil {
 		return nil, err
 	}
+	// the callbacks are kept out of the history, see types.CompletionCallbackPayload
+	e.executionInfo.CompletionCallbacks = request.CompletionCallbacks
 
 	return event, nil
 }
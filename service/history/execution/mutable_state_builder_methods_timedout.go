@@ -59,5 +59,5 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTimedoutEvent(
 	e.ClearStickyness()
 	e.writeEventToCache(event)
 
-	return e.taskGenerator.GenerateWorkflowCloseTasks(event, e.config.WorkflowDeletionJitterRange(e.domainEntry.GetInfo().Name))
+	return e.generateWorkflowCloseTasks(event)
 }
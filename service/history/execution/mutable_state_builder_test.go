@@ -4490,3 +4490,46 @@ func TestStartTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateWorkflowCloseTasks_CompletionCallbacks(t *testing.T) {
+	closeEvent := &types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+		Version:   1,
+	}
+
+	tests := map[string]struct {
+		enabled         bool
+		expectCallbacks bool
+	}{
+		"callbacks enabled": {
+			enabled:         true,
+			expectCallbacks: true,
+		},
+		"callbacks disabled": {
+			enabled:         false,
+			expectCallbacks: false,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			taskGenerator := NewMockMutableStateTaskGenerator(ctrl)
+			cfg := config.NewForTest()
+			cfg.EnableCompletionCallbacks = func(string) bool { return td.enabled }
+
+			msb := &mutableStateBuilder{
+				config:        cfg,
+				domainEntry:   constants.TestLocalDomainEntry,
+				taskGenerator: taskGenerator,
+			}
+
+			taskGenerator.EXPECT().GenerateWorkflowCloseTasks(closeEvent, gomock.Any()).Return(nil).Times(1)
+			if td.expectCallbacks {
+				taskGenerator.EXPECT().GenerateCompletionCallbackTasks(closeEvent).Return(nil).Times(1)
+			}
+
+			assert.NoError(t, msb.generateWorkflowCloseTasks(closeEvent))
+		})
+	}
+}
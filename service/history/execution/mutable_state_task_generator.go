@@ -50,6 +50,9 @@ type (
 			closeEvent *types.HistoryEvent,
 			workflowDeletionTaskJitterRange int,
 		) error
+		GenerateCompletionCallbackTasks(
+			closeEvent *types.HistoryEvent,
+		) error
 		GenerateRecordWorkflowStartedTasks(
 			startEvent *types.HistoryEvent,
 		) error
@@ -148,6 +151,57 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowStartTasks(
 		TaskList: executionInfo.TaskList,
 	})
 
+	return r.generateWorkflowDeadlineTasks(startTime, startEvent, workflowTimeoutTimestamp)
+}
+
+// generateWorkflowDeadlineTasks schedules the soft deadline timer, which requests
+// cancellation, and the timer which terminates the workflow once the grace period
+// has passed. Timers that would fire at or after the hard timeout are skipped.
+func (r *mutableStateTaskGeneratorImpl) generateWorkflowDeadlineTasks(
+	startTime time.Time,
+	startEvent *types.HistoryEvent,
+	workflowTimeoutTimestamp time.Time,
+) error {
+	executionInfo := r.mutableState.GetExecutionInfo()
+	deadline := executionInfo.WorkflowDeadline
+	if deadline == nil {
+		return nil
+	}
+
+	attr := startEvent.WorkflowExecutionStartedEventAttributes
+	firstDecisionDelayDuration := time.Duration(attr.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+	deadlineTimestamp := startTime.Add(time.Duration(deadline.GetDeadlineSeconds())*time.Second + firstDecisionDelayDuration)
+	if !deadlineTimestamp.Before(workflowTimeoutTimestamp) {
+		return nil
+	}
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   executionInfo.DomainID,
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}
+	r.mutableState.AddTimerTasks(&persistence.WorkflowDeadlineTask{
+		WorkflowIdentifier: workflowIdentifier,
+		TaskData: persistence.TaskData{
+			// TaskID is set by shard
+			VisibilityTimestamp: deadlineTimestamp,
+			Version:             startEvent.Version,
+		},
+		TaskList: executionInfo.TaskList,
+	})
+
+	terminateTimestamp := deadlineTimestamp.Add(time.Duration(deadline.GetGracePeriodSeconds()) * time.Second)
+	if !terminateTimestamp.Before(workflowTimeoutTimestamp) {
+		return nil
+	}
+	r.mutableState.AddTimerTasks(&persistence.WorkflowDeadlineTerminateTask{
+		WorkflowIdentifier: workflowIdentifier,
+		TaskData: persistence.TaskData{
+			// TaskID is set by shard
+			VisibilityTimestamp: terminateTimestamp,
+			Version:             startEvent.Version,
+		},
+		TaskList: executionInfo.TaskList,
+	})
 	return nil
 }
 
@@ -211,6 +265,35 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	return nil
 }
 
+func (r *mutableStateTaskGeneratorImpl) GenerateCompletionCallbackTasks(
+	closeEvent *types.HistoryEvent,
+) error {
+
+	// a continued as new run hands its callbacks over to the new run
+	if closeEvent.GetEventType() == types.EventTypeWorkflowExecutionContinuedAsNew {
+		return nil
+	}
+
+	// each callback gets its own task, so that it is retried and dead lettered independently of the others
+	executionInfo := r.mutableState.GetExecutionInfo()
+	for index := range executionInfo.CompletionCallbacks {
+		r.mutableState.AddTransferTasks(&persistence.CompletionCallbackTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID and VisibilityTimestamp are set by shard context
+				Version: closeEvent.Version,
+			},
+			TaskList:      executionInfo.TaskList,
+			CallbackIndex: int64(index),
+		})
+	}
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) GenerateDelayedDecisionTasks(
 	startEvent *types.HistoryEvent,
 ) error {
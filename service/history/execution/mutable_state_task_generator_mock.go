@@ -98,6 +98,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateChildWorkflowTasks(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateChildWorkflowTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateChildWorkflowTasks), event)
 }
 
+// GenerateCompletionCallbackTasks mocks base method.
+func (m *MockMutableStateTaskGenerator) GenerateCompletionCallbackTasks(closeEvent *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCompletionCallbackTasks", closeEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateCompletionCallbackTasks indicates an expected call of GenerateCompletionCallbackTasks.
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateCompletionCallbackTasks(closeEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCompletionCallbackTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateCompletionCallbackTasks), closeEvent)
+}
+
 // GenerateDecisionScheduleTasks mocks base method.
 func (m *MockMutableStateTaskGenerator) GenerateDecisionScheduleTasks(decisionScheduleID int64) error {
 	m.ctrl.T.Helper()
@@ -366,7 +366,7 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowStartTasks() {
 
 	for _, tc := range testCases {
 		s.T().Run(tc.name, func(t *testing.T) {
-			s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{WorkflowTimeout: tc.workflowTimeout, ExpirationTime: expirationTime, TaskList: "task-list"}).Times(1)
+			s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{WorkflowTimeout: tc.workflowTimeout, ExpirationTime: expirationTime, TaskList: "task-list"}).Times(2)
 			s.mockMutableState.EXPECT().AddTimerTasks(&persistence.WorkflowTimeoutTask{
 				TaskData: persistence.TaskData{
 					VisibilityTimestamp: tc.visibilityTimestamp,
@@ -382,6 +382,67 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowStartTasks() {
 	}
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowStartTasks_Deadline() {
+	startTime := time.Now()
+	startEvent := &types.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+		Version:                                 constants.TestVersion,
+	}
+
+	testCases := []struct {
+		name          string
+		deadline      *types.WorkflowDeadline
+		expectedTasks []persistence.Task
+	}{
+		{
+			name:     "deadline and terminate timers",
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: 30},
+			expectedTasks: []persistence.Task{
+				&persistence.WorkflowDeadlineTask{
+					TaskData: persistence.TaskData{VisibilityTimestamp: startTime.Add(60 * time.Second), Version: constants.TestVersion},
+					TaskList: "task-list",
+				},
+				&persistence.WorkflowDeadlineTerminateTask{
+					TaskData: persistence.TaskData{VisibilityTimestamp: startTime.Add(90 * time.Second), Version: constants.TestVersion},
+					TaskList: "task-list",
+				},
+			},
+		},
+		{
+			name:     "grace period reaching the timeout",
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 60, GracePeriodSeconds: 40},
+			expectedTasks: []persistence.Task{
+				&persistence.WorkflowDeadlineTask{
+					TaskData: persistence.TaskData{VisibilityTimestamp: startTime.Add(60 * time.Second), Version: constants.TestVersion},
+					TaskList: "task-list",
+				},
+			},
+		},
+		{
+			name:     "deadline reaching the timeout",
+			deadline: &types.WorkflowDeadline{DeadlineSeconds: 100},
+		},
+		{
+			name: "no deadline",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			executionInfo := &persistence.WorkflowExecutionInfo{WorkflowTimeout: 100, TaskList: "task-list", WorkflowDeadline: tc.deadline}
+			s.mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).Times(2)
+			s.mockMutableState.EXPECT().AddTimerTasks(gomock.AssignableToTypeOf(&persistence.WorkflowTimeoutTask{})).Times(1)
+			for _, task := range tc.expectedTasks {
+				s.mockMutableState.EXPECT().AddTimerTasks(task).Times(1)
+			}
+
+			err := s.taskGenerator.GenerateWorkflowStartTasks(startTime, startEvent)
+
+			s.NoError(err)
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateDelayedDecisionTasks() {
 	timestamp := common.Int64Ptr(time.Now().UnixNano())
 	firstDecisionTaskBackoffSeconds := common.Int32Ptr(1)
@@ -1350,6 +1411,71 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowResetTasks() {
 	s.NoError(err)
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateCompletionCallbackTasks() {
+	version := int64(123)
+	testCases := []struct {
+		name      string
+		eventType types.EventType
+		setupMock func()
+	}{
+		{
+			name:      "workflow completed",
+			eventType: types.EventTypeWorkflowExecutionCompleted,
+			setupMock: func() {
+				s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   "domain-id",
+					WorkflowID: "wf-id",
+					RunID:      "run-id",
+					TaskList:   "task-list",
+					CompletionCallbacks: []*types.CompletionCallback{
+						{URL: "https://example.com/first"},
+						{URL: "https://example.com/second"},
+					},
+				}).Times(1)
+				for index := int64(0); index < 2; index++ {
+					s.mockMutableState.EXPECT().AddTransferTasks(&persistence.CompletionCallbackTask{
+						WorkflowIdentifier: persistence.WorkflowIdentifier{
+							DomainID:   "domain-id",
+							WorkflowID: "wf-id",
+							RunID:      "run-id",
+						},
+						TaskData: persistence.TaskData{
+							Version: version,
+						},
+						TaskList:      "task-list",
+						CallbackIndex: index,
+					}).Times(1)
+				}
+			},
+		},
+		{
+			name:      "workflow without callbacks",
+			eventType: types.EventTypeWorkflowExecutionCompleted,
+			setupMock: func() {
+				s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).Times(1)
+			},
+		},
+		{
+			name:      "workflow continued as new",
+			eventType: types.EventTypeWorkflowExecutionContinuedAsNew,
+			setupMock: func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setupMock()
+
+			err := s.taskGenerator.GenerateCompletionCallbackTasks(&types.HistoryEvent{
+				EventType: tc.eventType.Ptr(),
+				Version:   version,
+			})
+
+			s.NoError(err)
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateActivityTimerTasks() {
 	s.mockMutableState.EXPECT().GetPendingActivityInfos().Return(nil).Times(1)
 
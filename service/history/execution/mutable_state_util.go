@@ -106,12 +106,12 @@ func FailDecision(
 	return mutableState.FlushBufferedEvents()
 }
 
-// ScheduleDecision schedules a new decision task
+// ScheduleDecision schedules a new decision task, unless there is a pending one or the workflow is paused
 func ScheduleDecision(
 	mutableState MutableState,
 ) error {
 
-	if mutableState.HasPendingDecision() {
+	if mutableState.HasPendingDecision() || mutableState.IsWorkflowPaused() {
 		return nil
 	}
 
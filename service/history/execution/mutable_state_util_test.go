@@ -484,9 +484,18 @@ func TestScheduleDecision(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("workflow is paused", func(t *testing.T) {
+		mockMutableState := NewMockMutableState(gomock.NewController(t))
+		mockMutableState.EXPECT().HasPendingDecision().Return(false)
+		mockMutableState.EXPECT().IsWorkflowPaused().Return(true)
+		err := ScheduleDecision(mockMutableState)
+		require.NoError(t, err)
+	})
+
 	t.Run("internal service error", func(t *testing.T) {
 		mockMutableState := NewMockMutableState(gomock.NewController(t))
 		mockMutableState.EXPECT().HasPendingDecision().Return(false)
+		mockMutableState.EXPECT().IsWorkflowPaused().Return(false)
 		mockMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(nil, errors.New("some error"))
 		err := ScheduleDecision(mockMutableState)
 		assert.NotNil(t, err)
@@ -496,6 +505,7 @@ func TestScheduleDecision(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockMutableState := NewMockMutableState(gomock.NewController(t))
 		mockMutableState.EXPECT().HasPendingDecision().Return(false)
+		mockMutableState.EXPECT().IsWorkflowPaused().Return(false)
 		mockMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(nil, nil)
 		err := ScheduleDecision(mockMutableState)
 		require.NoError(t, err)
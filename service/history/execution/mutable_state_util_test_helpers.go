@@ -136,6 +136,9 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		Memo:                               sourceInfo.Memo,
 		SearchAttributes:                   sourceInfo.SearchAttributes,
 		PartitionConfig:                    sourceInfo.PartitionConfig,
+		Paused:                             sourceInfo.Paused,
+		CompletionCallbacks:                sourceInfo.CompletionCallbacks,
+		WorkflowDeadline:                   sourceInfo.WorkflowDeadline,
 		ExecutionStatus:                    sourceInfo.ExecutionStatus,
 		ScheduledExecutionTimestamp:        sourceInfo.ScheduledExecutionTimestamp,
 		Attempt:                            sourceInfo.Attempt,
@@ -194,6 +197,8 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
 		LastFailureCategory:      sourceInfo.LastFailureCategory,
 		LastRetryIntervalSeconds: sourceInfo.LastRetryIntervalSeconds,
+		Priority:                 sourceInfo.Priority,
+		Paused:                   sourceInfo.Paused,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
@@ -298,6 +298,11 @@ func (t *timerSequenceImpl) getActivityScheduleToStartTimeout(
 		return nil
 	}
 
+	// activity is paused, the timeout starts when it is unpaused
+	if activityInfo.Paused {
+		return nil
+	}
+
 	startTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToStartTimeout) * time.Second,
 	)
@@ -320,6 +325,11 @@ func (t *timerSequenceImpl) getActivityScheduleToCloseTimeout(
 		return nil
 	}
 
+	// activity is paused before it is started, the timeout starts when it is unpaused
+	if activityInfo.Paused && activityInfo.StartedID == constants.EmptyEventID {
+		return nil
+	}
+
 	closeTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToCloseTimeout) * time.Second,
 	)
@@ -800,6 +800,27 @@ func (s *timerSequenceSuite) TestGetActivityScheduleToStartTimeout_Scheduled_Sta
 	s.Empty(timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityScheduleToStartTimeout_Scheduled_Paused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                123,
+		ScheduleID:             234,
+		ScheduledTime:          now,
+		StartedID:              constants.EmptyEventID,
+		StartedTime:            time.Time{},
+		ActivityID:             "some random activity ID",
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 1000,
+		StartToCloseTimeout:    100,
+		TimerTaskStatus:        TimerTaskStatusNone,
+		Attempt:                12,
+		Paused:                 true,
+	}
+
+	timerSequence := s.timerSequence.getActivityScheduleToStartTimeout(activityInfo)
+	s.Empty(timerSequence)
+}
+
 func (s *timerSequenceSuite) TestGetActivityScheduleToCloseTimeout_NotScheduled() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
@@ -859,6 +880,41 @@ func (s *timerSequenceSuite) TestGetActivityScheduleToCloseTimeout_Scheduled() {
 	s.Equal(expectedTimerSequence, timerSequence)
 }
 
+func (s *timerSequenceSuite) TestGetActivityScheduleToCloseTimeout_Scheduled_Paused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                123,
+		ScheduleID:             234,
+		ScheduledTime:          now,
+		StartedID:              constants.EmptyEventID,
+		StartedTime:            time.Time{},
+		ActivityID:             "some random activity ID",
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 1000,
+		StartToCloseTimeout:    100,
+		TimerTaskStatus:        TimerTaskStatusNone,
+		Attempt:                12,
+		Paused:                 true,
+	}
+
+	timerSequence := s.timerSequence.getActivityScheduleToCloseTimeout(activityInfo)
+	s.Empty(timerSequence)
+
+	// the timeout of an activity paused while it is running still applies
+	activityInfo.StartedID = 345
+	activityInfo.StartedTime = now.Add(200 * time.Second)
+	timerSequence = s.timerSequence.getActivityScheduleToCloseTimeout(activityInfo)
+	s.Equal(&TimerSequenceID{
+		EventID: activityInfo.ScheduleID,
+		Timestamp: activityInfo.ScheduledTime.Add(
+			time.Duration(activityInfo.ScheduleToCloseTimeout) * time.Second,
+		),
+		TimerType:    TimerTypeScheduleToClose,
+		TimerCreated: false,
+		Attempt:      12,
+	}, timerSequence)
+}
+
 func (s *timerSequenceSuite) TestGetActivityStartToCloseTimeout_NotStarted() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
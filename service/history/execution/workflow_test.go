@@ -372,6 +372,7 @@ func (s *workflowSuite) TestFlushBufferedEvents_Success() {
 	s.mockMutableState.EXPECT().AddDecisionTaskFailedEvent(decision.ScheduleID, decision.StartedID, types.DecisionTaskFailedCauseFailoverCloseDecision, nil, IdentityHistoryService, "", "", "", "", int64(0), "").Return(&types.HistoryEvent{}, nil)
 	s.mockMutableState.EXPECT().FlushBufferedEvents().Return(nil)
 	s.mockMutableState.EXPECT().HasPendingDecision().Return(false)
+	s.mockMutableState.EXPECT().IsWorkflowPaused().Return(false)
 	s.mockMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(&DecisionInfo{}, nil)
 
 	nDCWorkflow := NewWorkflow(
@@ -204,6 +204,7 @@ func (s *branchManagerSuite) TestFlushBufferedEvents() {
 	).Return(&types.HistoryEvent{}, nil).Times(1)
 	s.mockMutableState.EXPECT().FlushBufferedEvents().Return(nil).Times(1)
 	s.mockMutableState.EXPECT().HasPendingDecision().Return(false).Times(1)
+	s.mockMutableState.EXPECT().IsWorkflowPaused().Return(false).Times(1)
 	s.mockMutableState.EXPECT().AddDecisionTaskScheduledEvent(false).Return(&execution.DecisionInfo{}, nil).Times(1)
 
 	s.mockContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), gomock.Any()).Return(nil).Times(1)
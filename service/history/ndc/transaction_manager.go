@@ -368,7 +368,7 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 			targetWorkflow,
 			EventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
-			false,
+			reset.ReapplyPolicy{},
 		); err != nil {
 			return 0, execution.TransactionPolicyActive, err
 		}
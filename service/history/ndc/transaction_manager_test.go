@@ -234,7 +234,7 @@ func (s *transactionManagerSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Cl
 		workflow,
 		EventsReapplicationResetWorkflowReason,
 		workflowEvents.Events,
-		false,
+		reset.ReapplyPolicy{},
 	).Return(nil).Times(1)
 
 	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(domainID).Return(domainName, nil).AnyTimes()
@@ -24,6 +24,7 @@ package reset
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -58,8 +59,35 @@ type (
 			currentWorkflow execution.Workflow,
 			resetReason string,
 			additionalReapplyEvents []*types.HistoryEvent,
-			skipSignalReapply bool,
+			reapplyPolicy ReapplyPolicy,
 		) error
+		// DryRunResetWorkflow replays the reset of the workflow without committing it
+		DryRunResetWorkflow(
+			ctx context.Context,
+			domainID string,
+			workflowID string,
+			baseRunID string,
+			baseBranchToken []byte,
+			baseRebuildLastEventID int64,
+			baseRebuildLastEventVersion int64,
+			baseNextEventID int64,
+			resetRunID string,
+			resetRequestID string,
+			currentWorkflow execution.Workflow,
+			resetReason string,
+			reapplyPolicy ReapplyPolicy,
+		) (*types.ResetWorkflowExecutionDryRunResult, error)
+	}
+
+	// ReapplyPolicy selects the events after the reset point which are reapplied to the reset workflow,
+	// the zero value reapplies all the signals
+	ReapplyPolicy struct {
+		// SkipSignals skips reapplying the signals
+		SkipSignals bool
+		// SignalNames restricts the signals reapplied to these signal names when not empty
+		SignalNames []string
+		// CancelRequests reapplies the requests to cancel the workflow
+		CancelRequests bool
 	}
 
 	workflowResetterImpl struct {
@@ -112,7 +140,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	currentWorkflow execution.Workflow,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
+	reapplyPolicy ReapplyPolicy,
 ) (retError error) {
 	activeClusterSelectionPolicy := currentWorkflow.GetMutableState().GetExecutionInfo().ActiveClusterSelectionPolicy
 	activeClusterInfo, err := r.activeClusterManager.GetActiveClusterInfoByClusterAttribute(ctx, domainID, activeClusterSelectionPolicy.GetClusterAttribute())
@@ -160,10 +188,11 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		resetWorkflowVersion,
 		resetReason,
 		additionalReapplyEvents,
-		skipSignalReapply,
+		reapplyPolicy,
 		currentRunID,
 		currentNextEventID,
 		currentBranchToken,
+		nil,
 	)
 	if err != nil {
 		return err
@@ -178,6 +207,77 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	)
 }
 
+func (r *workflowResetterImpl) DryRunResetWorkflow(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	baseNextEventID int64,
+	resetRunID string,
+	resetRequestID string,
+	currentWorkflow execution.Workflow,
+	resetReason string,
+	reapplyPolicy ReapplyPolicy,
+) (_ *types.ResetWorkflowExecutionDryRunResult, retError error) {
+	activeClusterSelectionPolicy := currentWorkflow.GetMutableState().GetExecutionInfo().ActiveClusterSelectionPolicy
+	activeClusterInfo, err := r.activeClusterManager.GetActiveClusterInfoByClusterAttribute(ctx, domainID, activeClusterSelectionPolicy.GetClusterAttribute())
+	if err != nil {
+		return nil, err
+	}
+	resetWorkflowVersion := activeClusterInfo.FailoverVersion
+
+	// the current run is left untouched, it is only terminated when the reset is committed
+	currentMutableState := currentWorkflow.GetMutableState()
+	if currentMutableState.IsWorkflowExecutionRunning() {
+		resetWorkflowVersion = currentMutableState.GetCurrentVersion()
+	}
+	currentBranchToken, err := currentMutableState.GetCurrentBranchToken()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.ResetWorkflowExecutionDryRunResult{}
+	resetWorkflow, err := r.prepareResetWorkflow(
+		ctx,
+		domainID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseNextEventID,
+		resetRunID,
+		resetRequestID,
+		resetWorkflowVersion,
+		resetReason,
+		nil,
+		reapplyPolicy,
+		currentMutableState.GetExecutionInfo().RunID,
+		currentMutableState.GetNextEventID(),
+		currentBranchToken,
+		result,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resetWorkflow.GetReleaseFn()(retError)
+
+	_, resetWorkflowEventsSeq, err := resetWorkflow.GetMutableState().CloseTransactionAsSnapshot(
+		r.shard.GetTimeSource().Now(),
+		execution.TransactionPolicyActive,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, workflowEvents := range resetWorkflowEventsSeq {
+		result.NewEvents = append(result.NewEvents, workflowEvents.Events...)
+	}
+	return result, nil
+}
+
 func (r *workflowResetterImpl) prepareResetWorkflow(
 	ctx context.Context,
 	domainID string,
@@ -192,10 +292,11 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	resetWorkflowVersion int64,
 	resetReason string,
 	additionalReapplyEvents []*types.HistoryEvent,
-	skipSignalReapply bool,
+	reapplyPolicy ReapplyPolicy,
 	currentRunID string,
 	currentNextEventID int64,
 	currentBranchToken []byte,
+	dryRunResult *types.ResetWorkflowExecutionDryRunResult,
 ) (execution.Workflow, error) {
 
 	resetWorkflow, err := r.replayResetWorkflow(
@@ -208,6 +309,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		baseRebuildLastEventVersion,
 		resetRunID,
 		resetRequestID,
+		dryRunResult != nil,
 	)
 	if err != nil {
 		return nil, err
@@ -244,10 +346,11 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
-	// TODO right now only signals are eligible for reapply, so we can directly skip the whole reapply process
+	// TODO right now only signals and cancel requests are eligible for reapply, so we can directly skip the whole reapply process
 	// for the sake of performance. In the future, if there are other events that need to be reapplied, remove this check
 	// For example, we may want to re-apply activity/timer results for https://github.com/uber/cadence/issues/2934
-	if !skipSignalReapply {
+	// A dry run still reads the events to report the ones which are dropped.
+	if !reapplyPolicy.reappliesNothing() || dryRunResult != nil {
 		if err := r.reapplyResetAndContinueAsNewWorkflowEvents(
 			ctx,
 			resetMutableState,
@@ -260,6 +363,8 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 			currentRunID,
 			currentNextEventID,
 			currentBranchToken,
+			reapplyPolicy,
+			dryRunResult,
 		); err != nil {
 			return nil, err
 		}
@@ -267,7 +372,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	}
 
 	// NOTE: this is reapplying events that are passing into the API that we shouldn't skip
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, ReapplyPolicy{}, nil); err != nil {
 		return nil, err
 	}
 
@@ -343,6 +448,7 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 	baseRebuildLastEventVersion int64,
 	resetRunID string,
 	resetRequestID string,
+	dryRun bool,
 ) (execution.Workflow, error) {
 
 	resetContext := execution.NewContext(
@@ -357,6 +463,10 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 	)
 
 	resetBranchTokenFn := func() ([]byte, error) {
+		if dryRun {
+			// the reset workflow is never persisted, there is no need to fork the history branch
+			return baseBranchToken, nil
+		}
 		resetBranchToken, err := r.forkAndGenerateBranchToken(
 			ctx,
 			domainID,
@@ -496,6 +606,8 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 	currentRunID string,
 	currentNextEventID int64,
 	currentBranchToken []byte,
+	reapplyPolicy ReapplyPolicy,
+	dryRunResult *types.ResetWorkflowExecutionDryRunResult,
 ) error {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -511,6 +623,8 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		reapplyPolicy,
+		dryRunResult,
 	); err != nil {
 		return err
 	}
@@ -564,6 +678,8 @@ func (r *workflowResetterImpl) reapplyResetAndContinueAsNewWorkflowEvents(
 			constants.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			reapplyPolicy,
+			dryRunResult,
 		); err != nil {
 			return err
 		}
@@ -577,6 +693,8 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
+	reapplyPolicy ReapplyPolicy,
+	dryRunResult *types.ResetWorkflowExecutionDryRunResult,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -606,7 +724,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			return "", err
 		}
 		lastEvents = batch.(*types.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
+		if err := r.reapplyEvents(mutableState, lastEvents, reapplyPolicy, dryRunResult); err != nil {
 			return "", err
 		}
 	}
@@ -623,27 +741,78 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState execution.MutableState,
 	events []*types.HistoryEvent,
+	reapplyPolicy ReapplyPolicy,
+	dryRunResult *types.ResetWorkflowExecutionDryRunResult,
 ) error {
 
 	for _, event := range events {
-		switch event.GetEventType() {
-		case types.EventTypeWorkflowExecutionSignaled:
-			attr := event.GetWorkflowExecutionSignaledEventAttributes()
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				attr.GetSignalName(),
-				attr.GetInput(),
-				attr.GetIdentity(),
-				"", // Do not set requestID for requests reapplied, because they have already been applied previously
-			); err != nil {
-				return err
+		reapplied := false
+		if reapplyPolicy.shouldReapply(event) {
+			switch event.GetEventType() {
+			case types.EventTypeWorkflowExecutionSignaled:
+				attr := event.GetWorkflowExecutionSignaledEventAttributes()
+				if _, err := mutableState.AddWorkflowExecutionSignaled(
+					attr.GetSignalName(),
+					attr.GetInput(),
+					attr.GetIdentity(),
+					"", // Do not set requestID for requests reapplied, because they have already been applied previously
+				); err != nil {
+					return err
+				}
+				reapplied = true
+			case types.EventTypeWorkflowExecutionCancelRequested:
+				if cancelRequested, _ := mutableState.IsCancelRequested(); cancelRequested {
+					break
+				}
+				attr := event.GetWorkflowExecutionCancelRequestedEventAttributes()
+				if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+					attr.Cause,
+					&types.HistoryRequestCancelWorkflowExecutionRequest{
+						CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+							Identity: attr.Identity,
+							// Do not set requestID for requests reapplied, because they have already been applied previously
+						},
+						ExternalInitiatedEventID:  attr.ExternalInitiatedEventID,
+						ExternalWorkflowExecution: attr.ExternalWorkflowExecution,
+					},
+				); err != nil {
+					return err
+				}
+				reapplied = true
 			}
-		default:
-			// events other than signal will be ignored
+		}
+		if dryRunResult == nil {
+			continue
+		}
+		if reapplied {
+			dryRunResult.ReappliedEvents = append(dryRunResult.ReappliedEvents, event)
+		} else {
+			dryRunResult.DroppedEvents = append(dryRunResult.DroppedEvents, event)
 		}
 	}
 	return nil
 }
 
+func (p ReapplyPolicy) shouldReapply(event *types.HistoryEvent) bool {
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionSignaled:
+		if p.SkipSignals {
+			return false
+		}
+		return len(p.SignalNames) == 0 ||
+			slices.Contains(p.SignalNames, event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+	case types.EventTypeWorkflowExecutionCancelRequested:
+		return p.CancelRequests
+	default:
+		// events other than signal and cancel request will be ignored
+		return false
+	}
+}
+
+func (p ReapplyPolicy) reappliesNothing() bool {
+	return p.SkipSignals && !p.CancelRequests
+}
+
 func (r *workflowResetterImpl) getPaginationFn(
 	ctx context.Context,
 	firstEventID int64,
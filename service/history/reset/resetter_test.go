@@ -272,12 +272,67 @@ func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 		baseRebuildLastEventVersion,
 		s.resetRunID,
 		resetRequestID,
+		false,
 	)
 	s.NoError(err)
 	s.Equal(resetHistorySize, resetWorkflow.GetContext().GetHistorySize())
 	s.Equal(resetMutableState, resetWorkflow.GetMutableState())
 }
 
+func (s *workflowResetterSuite) TestReplayResetWorkflow_DryRun() {
+	ctx := context.Background()
+	baseBranchToken := []byte("some random base branch token")
+	baseRebuildLastEventID := int64(1233)
+	baseRebuildLastEventVersion := int64(12)
+
+	resetRequestID := uuid.New()
+	resetHistorySize := int64(4411)
+	resetMutableState := execution.NewMockMutableState(s.controller)
+
+	// the history branch is not forked, the reset workflow keeps the base branch token
+	s.mockStateRebuilder.EXPECT().Rebuild(
+		ctx,
+		gomock.Any(),
+		definition.NewWorkflowIdentifier(
+			s.domainID,
+			s.workflowID,
+			s.baseRunID,
+		),
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		definition.NewWorkflowIdentifier(
+			s.domainID,
+			s.workflowID,
+			s.resetRunID,
+		),
+		gomock.Any(),
+		resetRequestID,
+	).DoAndReturn(func(ctx context.Context, now time.Time, baseWorkflowIdentifier definition.WorkflowIdentifier, baseBranchToken []byte, baseRebuildLastEventID int64, baseRebuildLastEventVersion int64, targetWorkflowIdentifier definition.WorkflowIdentifier, targetBranchFn func() ([]byte, error), requestID string) (execution.MutableState, int64, error) {
+		targetBranchToken, err := targetBranchFn()
+		s.NoError(err)
+		s.Equal(baseBranchToken, targetBranchToken)
+
+		return resetMutableState, resetHistorySize, nil
+	}).Times(1)
+
+	resetWorkflow, err := s.workflowResetter.replayResetWorkflow(
+		ctx,
+		s.domainID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		s.resetRunID,
+		resetRequestID,
+		true,
+	)
+	s.NoError(err)
+	s.Equal(resetMutableState, resetWorkflow.GetMutableState())
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "ForkHistoryBranch", mock.Anything, mock.Anything)
+}
+
 func (s *workflowResetterSuite) TestFailInflightActivity() {
 	now := time.Now().UTC()
 	terminateReason := "some random termination reason"
@@ -506,6 +561,8 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		currentRunID,
 		currentNextEventID,
 		currentBranchToken,
+		ReapplyPolicy{},
+		nil,
 	)
 	s.NoError(err)
 }
@@ -624,6 +681,8 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_CurrentRu
 		currentRunID,
 		currentNextEventID,
 		currentBranchToken,
+		ReapplyPolicy{},
+		nil,
 	)
 	s.NoError(err)
 }
@@ -684,6 +743,8 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		firstEventID,
 		nextEventID,
 		branchToken,
+		ReapplyPolicy{},
+		nil,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -795,10 +856,97 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, ReapplyPolicy{}, nil)
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestReapplyEvents_ReapplyPolicy() {
+	paymentSignal := &types.HistoryEvent{
+		ID:        101,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "payment",
+			Input:      []byte("some random signal input"),
+			Identity:   "some random signal identity",
+		},
+	}
+	decisionScheduled := &types.HistoryEvent{
+		ID:                                   102,
+		EventType:                            types.EventTypeDecisionTaskScheduled.Ptr(),
+		DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{},
+	}
+	addressSignal := &types.HistoryEvent{
+		ID:        103,
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "address",
+			Input:      []byte("another random signal input"),
+			Identity:   "another random signal identity",
+		},
+	}
+	cancelRequested := &types.HistoryEvent{
+		ID:        104,
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			Cause:     "some random cause",
+			Identity:  "some random cancel identity",
+			RequestID: "9c4f4ac3-5fe2-4c4d-8d1e-20d1a9fc1c9f",
+		},
+	}
+	events := []*types.HistoryEvent{paymentSignal, decisionScheduled, addressSignal, cancelRequested}
+
+	tests := map[string]struct {
+		policy        ReapplyPolicy
+		mockFn        func(mutableState *execution.MockMutableState)
+		wantReapplied []*types.HistoryEvent
+		wantDropped   []*types.HistoryEvent
+	}{
+		"selected signal names": {
+			policy: ReapplyPolicy{SignalNames: []string{"address"}},
+			mockFn: func(mutableState *execution.MockMutableState) {
+				mutableState.EXPECT().AddWorkflowExecutionSignaled("address", gomock.Any(), gomock.Any(), "").Return(&types.HistoryEvent{}, nil).Times(1)
+			},
+			wantReapplied: []*types.HistoryEvent{addressSignal},
+			wantDropped:   []*types.HistoryEvent{paymentSignal, decisionScheduled, cancelRequested},
+		},
+		"cancel requests without signals": {
+			policy: ReapplyPolicy{SkipSignals: true, CancelRequests: true},
+			mockFn: func(mutableState *execution.MockMutableState) {
+				mutableState.EXPECT().IsCancelRequested().Return(false, "").Times(1)
+				mutableState.EXPECT().AddWorkflowExecutionCancelRequestedEvent(
+					"some random cause",
+					&types.HistoryRequestCancelWorkflowExecutionRequest{
+						CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+							Identity: "some random cancel identity",
+						},
+					},
+				).Return(&types.HistoryEvent{}, nil).Times(1)
+			},
+			wantReapplied: []*types.HistoryEvent{cancelRequested},
+			wantDropped:   []*types.HistoryEvent{paymentSignal, decisionScheduled, addressSignal},
+		},
+		"cancel already requested": {
+			policy: ReapplyPolicy{SkipSignals: true, CancelRequests: true},
+			mockFn: func(mutableState *execution.MockMutableState) {
+				mutableState.EXPECT().IsCancelRequested().Return(true, "").Times(1)
+			},
+			wantDropped: []*types.HistoryEvent{paymentSignal, decisionScheduled, addressSignal, cancelRequested},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			mutableState := execution.NewMockMutableState(s.controller)
+			tc.mockFn(mutableState)
+
+			dryRunResult := &types.ResetWorkflowExecutionDryRunResult{}
+			err := s.workflowResetter.reapplyEvents(mutableState, events, tc.policy, dryRunResult)
+			s.NoError(err)
+			s.Equal(tc.wantReapplied, dryRunResult.ReappliedEvents)
+			s.Equal(tc.wantDropped, dryRunResult.DroppedEvents)
+		})
+	}
+}
+
 func (s *workflowResetterSuite) TestPagination() {
 	firstEventID := commonconstants.FirstEventID
 	nextEventID := int64(101)
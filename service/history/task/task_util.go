@@ -127,6 +127,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeCompletionCallback:
+		if isActive {
+			return metrics.TransferActiveTaskCompletionCallbackScope
+		}
+		return metrics.TransferStandbyTaskCompletionCallbackScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -176,6 +181,16 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case persistence.TaskTypeWorkflowDeadline:
+		if isActive {
+			return metrics.TimerActiveTaskWorkflowDeadlineScope
+		}
+		return metrics.TimerStandbyTaskWorkflowDeadlineScope
+	case persistence.TaskTypeWorkflowDeadlineTerminate:
+		if isActive {
+			return metrics.TimerActiveTaskWorkflowDeadlineTerminateScope
+		}
+		return metrics.TimerStandbyTaskWorkflowDeadlineTerminateScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -228,6 +228,18 @@ func TestGetTransferTaskMetricsScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TransferStandbyTaskApplyParentClosePolicyScope,
 		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - active",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      true,
+			expectedScope: metrics.TransferActiveTaskCompletionCallbackScope,
+		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - standby",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      false,
+			expectedScope: metrics.TransferStandbyTaskCompletionCallbackScope,
+		},
 		{
 			name:          "TransferTaskType not caught - active",
 			taskType:      -100,
@@ -328,6 +340,30 @@ func TestGetTimerTaskMetricScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TimerStandbyTaskWorkflowBackoffTimerScope,
 		},
+		{
+			name:          "TimerTaskTypeWorkflowDeadline - active",
+			taskType:      persistence.TaskTypeWorkflowDeadline,
+			isActive:      true,
+			expectedScope: metrics.TimerActiveTaskWorkflowDeadlineScope,
+		},
+		{
+			name:          "TimerTaskTypeWorkflowDeadline - standby",
+			taskType:      persistence.TaskTypeWorkflowDeadline,
+			isActive:      false,
+			expectedScope: metrics.TimerStandbyTaskWorkflowDeadlineScope,
+		},
+		{
+			name:          "TimerTaskTypeWorkflowDeadlineTerminate - active",
+			taskType:      persistence.TaskTypeWorkflowDeadlineTerminate,
+			isActive:      true,
+			expectedScope: metrics.TimerActiveTaskWorkflowDeadlineTerminateScope,
+		},
+		{
+			name:          "TimerTaskTypeWorkflowDeadlineTerminate - standby",
+			taskType:      persistence.TaskTypeWorkflowDeadlineTerminate,
+			isActive:      false,
+			expectedScope: metrics.TimerStandbyTaskWorkflowDeadlineTerminateScope,
+		},
 		{
 			name:          "TimerTaskTypeDeleteHistoryEvent - active",
 			taskType:      persistence.TaskTypeDeleteHistoryEvent,
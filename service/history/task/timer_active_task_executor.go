@@ -113,6 +113,14 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowTimeoutTask(ctx, timerTask)
+	case *persistence.WorkflowDeadlineTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeWorkflowDeadlineTask(ctx, timerTask)
+	case *persistence.WorkflowDeadlineTerminateTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeWorkflowDeadlineTerminateTask(ctx, timerTask)
 	case *persistence.ActivityRetryTimerTask:
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
@@ -707,6 +715,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowPaused() || activityInfo.Paused {
+		// the activity is dispatched when the workflow or the activity is unpaused
+		return nil
+	}
 
 	domainID := task.DomainID
 	targetDomainID := domainID
@@ -874,6 +886,122 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	)
 }
 
+// executeWorkflowDeadlineTask requests cancellation of a workflow which passed its soft deadline,
+// giving it the grace period to run compensation logic before it is terminated.
+func (t *timerActiveTaskExecutor) executeWorkflowDeadlineTask(
+	ctx context.Context,
+	task *persistence.WorkflowDeadlineTask,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TimerQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	startVersion, err := mutableState.GetStartVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, startVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	// a cancellation requested earlier is already being handled by the workflow
+	if isCanceled, _ := mutableState.IsCancelRequested(); isCanceled {
+		return nil
+	}
+
+	if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+		common.FailureReasonWorkflowDeadlineExceeded,
+		&types.HistoryRequestCancelWorkflowExecutionRequest{
+			DomainUUID: task.DomainID,
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+				Identity: execution.IdentityHistoryService,
+				Cause:    common.FailureReasonWorkflowDeadlineExceeded,
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := t.updateWorkflowExecution(ctx, wfContext, mutableState, true); err != nil {
+		return err
+	}
+	t.metricsClient.Scope(metrics.TimerActiveTaskWorkflowDeadlineScope, metrics.DomainTag(mutableState.GetDomainEntry().GetInfo().Name)).IncCounter(metrics.WorkflowDeadlineCancelRequested)
+	return nil
+}
+
+// executeWorkflowDeadlineTerminateTask terminates a workflow which is still running
+// once the grace period after its soft deadline has passed.
+func (t *timerActiveTaskExecutor) executeWorkflowDeadlineTerminateTask(
+	ctx context.Context,
+	task *persistence.WorkflowDeadlineTerminateTask,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TimerQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	startVersion, err := mutableState.GetStartVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, startVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := execution.TerminateWorkflow(
+		mutableState,
+		mutableState.GetNextEventID(),
+		common.FailureReasonWorkflowDeadlineExceeded,
+		nil,
+		execution.IdentityHistoryService,
+	); err != nil {
+		return err
+	}
+
+	if err := t.updateWorkflowExecution(ctx, wfContext, mutableState, false); err != nil {
+		return err
+	}
+	t.metricsClient.Scope(metrics.TimerActiveTaskWorkflowDeadlineTerminateScope, metrics.DomainTag(mutableState.GetDomainEntry().GetInfo().Name)).IncCounter(metrics.WorkflowDeadlineTerminated)
+	return nil
+}
+
 func (t *timerActiveTaskExecutor) updateWorkflowExecution(
 	ctx context.Context,
 	wfContext execution.Context,
@@ -124,6 +124,14 @@ func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowTimeoutTask(ctx, timerTask)
+	case *persistence.WorkflowDeadlineTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeWorkflowDeadlineTask(ctx, timerTask)
+	case *persistence.WorkflowDeadlineTerminateTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.executeWorkflowDeadlineTerminateTask(ctx, timerTask)
 	case *persistence.ActivityRetryTimerTask:
 		// retry backoff timer should not get created on passive cluster
 		// TODO: add error logs
@@ -431,6 +439,83 @@ func (t *timerStandbyTaskExecutor) executeWorkflowTimeoutTask(
 	)
 }
 
+func (t *timerStandbyTaskExecutor) executeWorkflowDeadlineTask(
+	ctx context.Context,
+	timerTask *persistence.WorkflowDeadlineTask,
+) error {
+
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		startVersion, err := mutableState.GetStartVersion()
+		if err != nil {
+			return nil, err
+		}
+		ok, err := verifyTaskVersion(t.shard, t.logger, timerTask.DomainID, startVersion, timerTask.Version, timerTask)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		// the deadline is handled once the cancellation request is replicated
+		if isCanceled, _ := mutableState.IsCancelRequested(); isCanceled {
+			return nil, nil
+		}
+
+		return getHistoryResendInfo(mutableState)
+	}
+
+	return t.processTimer(
+		ctx,
+		timerTask,
+		0,
+		actionFn,
+		getStandbyPostActionFn(
+			t.logger,
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			t.fetchHistoryFromRemote,
+			standbyTaskPostActionWriteToDLQ(t.dlqWriter, t.shard, t.config.HistoryTaskDLQMode),
+		),
+	)
+}
+
+func (t *timerStandbyTaskExecutor) executeWorkflowDeadlineTerminateTask(
+	ctx context.Context,
+	timerTask *persistence.WorkflowDeadlineTerminateTask,
+) error {
+
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		startVersion, err := mutableState.GetStartVersion()
+		if err != nil {
+			return nil, err
+		}
+		ok, err := verifyTaskVersion(t.shard, t.logger, timerTask.DomainID, startVersion, timerTask.Version, timerTask)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		return getHistoryResendInfo(mutableState)
+	}
+
+	return t.processTimer(
+		ctx,
+		timerTask,
+		0,
+		actionFn,
+		getStandbyPostActionFn(
+			t.logger,
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			t.fetchHistoryFromRemote,
+			standbyTaskPostActionWriteToDLQ(t.dlqWriter, t.shard, t.config.HistoryTaskDLQMode),
+		),
+	)
+}
+
 func (t *timerStandbyTaskExecutor) getStandbyClusterTime() time.Time {
 	// time of remote cluster in the shard is delayed by "StandbyClusterDelay"
 	// so to get the current accurate remote cluster time, need to add it back
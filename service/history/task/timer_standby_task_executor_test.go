@@ -1062,6 +1062,71 @@ func (s *timerStandbyTaskExecutorSuite) TestProcessWorkflowTimeout_Success() {
 	s.Nil(err)
 }
 
+func (s *timerStandbyTaskExecutorSuite) TestProcessWorkflowDeadline_Pending() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.WorkflowDeadlineTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	s.mockShard.SetCurrentTime(s.clusterName, s.timeSource.Now())
+	_, err = s.timerStandbyTaskExecutor.Execute(timerTask)
+	s.True(isRedispatchErr(err))
+}
+
+func (s *timerStandbyTaskExecutorSuite) TestProcessWorkflowDeadline_CancelRequested() {
+
+	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+		common.FailureReasonWorkflowDeadlineExceeded,
+		&types.HistoryRequestCancelWorkflowExecutionRequest{
+			DomainUUID:    s.domainID,
+			CancelRequest: &types.RequestCancelWorkflowExecutionRequest{},
+		},
+	)
+	s.NoError(err)
+	mutableState.FlushBufferedEvents()
+
+	timerTask := s.newTimerTaskFromInfo(&persistence.WorkflowDeadlineTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	s.mockShard.SetCurrentTime(s.clusterName, s.timeSource.Now())
+	_, err = s.timerStandbyTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+}
+
 func (s *timerStandbyTaskExecutorSuite) TestProcessRetryTimeout() {
 
 	workflowExecution, _, err := test.StartWorkflow(s.T(), s.mockShard, s.domainID)
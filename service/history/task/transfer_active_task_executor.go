@@ -22,9 +22,13 @@
 package task
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -42,10 +46,12 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
+	historyConstants "github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/simulation"
+	"github.com/uber/cadence/service/history/taskdlq"
 	"github.com/uber/cadence/service/history/workflowcache"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
@@ -76,6 +82,7 @@ type (
 		parentClosePolicyClient parentclosepolicy.Client
 		workflowResetter        reset.WorkflowResetter
 		wfIDCache               workflowcache.WFCache
+		callbackClient          *http.Client
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -110,6 +117,12 @@ func NewTransferActiveTaskExecutor(
 		),
 		workflowResetter: workflowResetter,
 		wfIDCache:        wfIDCache,
+		callbackClient: &http.Client{
+			// redirects are not followed, so that a callback can't reach a host outside of the allow-list
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 }
 
@@ -158,6 +171,8 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		return executeResponse, t.processResetWorkflow(ctx, transferTask)
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		return executeResponse, t.processCompletionCallback(ctx, transferTask, task.GetAttempt())
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
@@ -206,6 +221,10 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowPaused() || ai.Paused {
+		// the activity is dispatched when the workflow or the activity is unpaused
+		return nil
+	}
 
 	timeout := min(ai.ScheduleToStartTimeout, constants.MaxTaskTimeout)
 
@@ -223,7 +242,7 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	pushActivityInfo := &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: timeout,
 		tasklist:                       taskList,
-		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		partitionConfig:                getActivityPartitionConfig(mutableState.GetExecutionInfo().PartitionConfig, ai),
 	}
 	err = t.pushActivity(ctx, task, pushActivityInfo)
 	if err == nil {
@@ -365,6 +384,212 @@ func (t *transferActiveTaskExecutor) processRecordChildExecutionCompleted(
 	return t.processCloseExecutionTaskHelper(ctx, task, false, true, false)
 }
 
+// processCompletionCallback notifies one completion callback of a closed workflow. Each callback has its
+// own task, so a callback is delivered at least once independently of the others. A failed delivery fails
+// the task so that it is retried with backoff until the max attempts are exhausted, after which the task is
+// written to the history task DLQ.
+func (t *transferActiveTaskExecutor) processCompletionCallback(
+	ctx context.Context,
+	task *persistence.CompletionCallbackTask,
+	attempt int,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TransferQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.GetDomainID(), lastWriteVersion, task.GetVersion(), task)
+	if err != nil || !ok {
+		return err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	if task.CallbackIndex < 0 || task.CallbackIndex >= int64(len(executionInfo.CompletionCallbacks)) {
+		return nil
+	}
+	callback := executionInfo.CompletionCallbacks[task.CallbackIndex]
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return err
+	}
+
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	payload, err := json.Marshal(&types.CompletionCallbackPayload{
+		Domain:         domainName,
+		WorkflowID:     executionInfo.WorkflowID,
+		RunID:          executionInfo.RunID,
+		WorkflowType:   executionInfo.WorkflowTypeName,
+		CloseStatus:    persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+		CloseTimestamp: completionEvent.GetTimestamp(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// we've gathered all necessary information from mutable state.
+	// release the context lock since the rest of logic is making HTTP calls, which takes time.
+	release(nil)
+
+	logTags := []tag.Tag{
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(task.GetWorkflowID()),
+		tag.WorkflowRunID(task.GetRunID()),
+		tag.Attempt(int32(attempt)),
+	}
+	// the allow-list is checked again on delivery, since it may have changed since the workflow started
+	if !t.isCompletionCallbackAllowed(callback) {
+		t.logger.Warn("Completion callback host is not allowed, skipping it.", logTags...)
+		return nil
+	}
+
+	scope := t.metricsClient.Scope(metrics.TransferActiveTaskCompletionCallbackScope, metrics.DomainTag(domainName))
+	err = t.deliverCompletionCallback(ctx, callback, payload, t.config.CompletionCallbackTimeout(domainName))
+	if err == nil {
+		return nil
+	}
+
+	scope.IncCounter(metrics.CompletionCallbackDeliveryFailures)
+	t.logger.Warn("Failed to deliver completion callback.", append(logTags, tag.Error(err))...)
+	if attempt < t.config.CompletionCallbackMaxAttempts(domainName) {
+		return err
+	}
+	if err := t.writeCompletionCallbackToDLQ(ctx, task, domainName); err != nil {
+		return err
+	}
+	scope.IncCounter(metrics.CompletionCallbackDeadLettered)
+	return nil
+}
+
+func (t *transferActiveTaskExecutor) isCompletionCallbackAllowed(
+	callback *types.CompletionCallback,
+) bool {
+
+	callbackURL, err := url.Parse(callback.GetURL())
+	if err != nil {
+		return false
+	}
+	return common.IsCompletionCallbackHostAllowed(callbackURL.Hostname(), t.config.CompletionCallbackAllowedHosts())
+}
+
+func (t *transferActiveTaskExecutor) deliverCompletionCallback(
+	ctx context.Context,
+	callback *types.CompletionCallback,
+	payload []byte,
+	timeout time.Duration,
+) error {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.GetURL(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	for key, value := range callback.GetHeader() {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := t.callbackClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("completion callback %v responded with status %v", callback.GetURL(), response.StatusCode)
+	}
+	return nil
+}
+
+// writeCompletionCallbackToDLQ writes a completion callback task whose attempts are exhausted to the
+// history task DLQ, the task is discarded when the DLQ is not enabled for the domain.
+func (t *transferActiveTaskExecutor) writeCompletionCallbackToDLQ(
+	ctx context.Context,
+	task *persistence.CompletionCallbackTask,
+	domainName string,
+) error {
+
+	taskTags := []tag.Tag{
+		tag.WorkflowID(task.GetWorkflowID()),
+		tag.WorkflowRunID(task.GetRunID()),
+		tag.WorkflowDomainID(task.GetDomainID()),
+		tag.WorkflowDomainName(domainName),
+		tag.TaskID(task.GetTaskID()),
+		tag.TaskType(task.GetTaskType()),
+	}
+
+	mode := t.config.HistoryTaskDLQMode(domainName)
+	if mode != historyConstants.HistoryTaskDLQModeEnabled && mode != historyConstants.HistoryTaskDLQModeShadow {
+		t.logger.Warn("DLQ not enabled for domain; discarding completion callback task.", taskTags...)
+		return nil
+	}
+
+	clusterAttribute, err := getClusterAttributesForTask(ctx, t.shard, task)
+	if err != nil {
+		if !errors.Is(err, errActiveClusterSelectionPolicyNotFound) {
+			return err
+		}
+		t.logger.Warn("Active cluster selection policy not found. Defaulting to default scope and name.", taskTags...)
+	}
+	if clusterAttribute == nil {
+		clusterAttribute = &types.ClusterAttribute{
+			Scope: taskdlq.DefaultClusterAttributeScope,
+			Name:  taskdlq.DefaultClusterAttributeName,
+		}
+	}
+
+	writer := t.shard.GetHistoryTaskDLQWriter()
+	err = writer.CreateHistoryDLQTask(ctx, persistence.CreateHistoryDLQTaskRequest{
+		ShardID:               t.shard.GetShardID(),
+		DomainID:              task.GetDomainID(),
+		DomainName:            domainName,
+		ClusterAttributeScope: clusterAttribute.Scope,
+		ClusterAttributeName:  clusterAttribute.Name,
+		Task:                  task,
+	})
+	if err == nil {
+		err = writer.CreateHistoryDLQAckLevelIfNotExists(ctx, persistence.CreateHistoryDLQAckLevelRequest{
+			ShardID:               t.shard.GetShardID(),
+			DomainID:              task.GetDomainID(),
+			ClusterAttributeScope: clusterAttribute.Scope,
+			ClusterAttributeName:  clusterAttribute.Name,
+			TaskCategory:          task.GetTaskCategory(),
+		})
+	}
+	if mode == historyConstants.HistoryTaskDLQModeShadow {
+		if err != nil {
+			t.logger.Warn("Failed to write completion callback task to DLQ in shadow mode. Will discard the task.", append(taskTags, tag.Error(err))...)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	t.logger.Warn("Wrote completion callback task to DLQ after exhausting its attempts.", taskTags...)
+	return nil
+}
+
 // TODO: this helper function performs three operations:
 // 1. publish workflow closed visibility record
 // 2. if has parent workflow, reply to the parent workflow
@@ -1799,7 +2024,7 @@ func (t *transferActiveTaskExecutor) resetWorkflow(
 		),
 		reason,
 		nil,
-		false,
+		reset.ReapplyPolicy{},
 	)
 
 	switch err.(type) {
@@ -24,7 +24,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -1095,6 +1098,134 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_Has
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback() {
+	testCases := []struct {
+		name          string
+		statusCode    int
+		maxAttempts   int
+		allowedHosts  []interface{}
+		callbackIndex int64
+		setupMock     func()
+		expectErr     bool
+		expectCall    bool
+	}{
+		{
+			name:          "callback delivered",
+			statusCode:    http.StatusOK,
+			maxAttempts:   10,
+			allowedHosts:  []interface{}{"127.0.0.1"},
+			callbackIndex: 1,
+			setupMock:     func() {},
+			expectCall:    true,
+		},
+		{
+			name:          "delivery failed, retried",
+			statusCode:    http.StatusInternalServerError,
+			maxAttempts:   10,
+			allowedHosts:  []interface{}{"127.0.0.1"},
+			callbackIndex: 1,
+			setupMock:     func() {},
+			expectErr:     true,
+			expectCall:    true,
+		},
+		{
+			name:          "delivery failed, attempts exhausted",
+			statusCode:    http.StatusInternalServerError,
+			maxAttempts:   0,
+			allowedHosts:  []interface{}{"127.0.0.1"},
+			callbackIndex: 1,
+			setupMock: func() {
+				s.mockShard.Resource.HistoryTaskDLQMgr.EXPECT().CreateHistoryDLQTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectCall: true,
+		},
+		{
+			name:          "host not allowed",
+			statusCode:    http.StatusOK,
+			maxAttempts:   10,
+			callbackIndex: 1,
+			setupMock:     func() {},
+		},
+		{
+			name:          "callback index out of range",
+			statusCode:    http.StatusOK,
+			maxAttempts:   10,
+			allowedHosts:  []interface{}{"127.0.0.1"},
+			callbackIndex: 2,
+			setupMock:     func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Need set up the suite manually, since we are in a subtest
+			s.SetupTest()
+			var payload types.CompletionCallbackPayload
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				s.Equal(http.MethodPost, r.Method)
+				s.Equal("token", r.Header.Get("Authorization"))
+				body, err := io.ReadAll(r.Body)
+				s.NoError(err)
+				s.NoError(json.Unmarshal(body, &payload))
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+			s.NoError(err)
+			// only the callback of the task is delivered, the other one is never reached
+			mutableState.GetExecutionInfo().CompletionCallbacks = []*types.CompletionCallback{
+				{URL: "http://127.0.0.1:0/unreachable"},
+				{URL: server.URL, Header: map[string]string{"Authorization": "token"}},
+			}
+			event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+			transferTask := s.newTransferTaskFromInfo(&persistence.CompletionCallbackTask{
+				WorkflowIdentifier: persistence.WorkflowIdentifier{
+					DomainID:   s.domainID,
+					WorkflowID: workflowExecution.GetWorkflowID(),
+					RunID:      workflowExecution.GetRunID(),
+				},
+				TaskData: persistence.TaskData{
+					Version: s.version,
+					TaskID:  int64(59),
+				},
+				CallbackIndex: tc.callbackIndex,
+			})
+
+			persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+			s.NoError(err)
+			s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+			s.mockShard.GetConfig().CompletionCallbackMaxAttempts = func(string) int { return tc.maxAttempts }
+			s.mockShard.GetConfig().CompletionCallbackAllowedHosts = dynamicproperties.GetListPropertyFn(tc.allowedHosts)
+			s.mockShard.GetConfig().CompletionCallbackTimeout = dynamicproperties.GetDurationPropertyFnFilteredByDomain(time.Second)
+			s.mockShard.GetConfig().HistoryTaskDLQMode = func(string) string { return constants.HistoryTaskDLQModeEnabled }
+			tc.setupMock()
+
+			_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+			if tc.expectErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.Equal(tc.expectCall, called)
+			if !tc.expectCall {
+				return
+			}
+			s.Equal(types.CompletionCallbackPayload{
+				Domain:         s.domainName,
+				WorkflowID:     workflowExecution.GetWorkflowID(),
+				RunID:          workflowExecution.GetRunID(),
+				WorkflowType:   mutableState.GetExecutionInfo().WorkflowTypeName,
+				CloseStatus:    types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+				CloseTimestamp: event.GetTimestamp(),
+			}, payload)
+		})
+	}
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessCancelExecution_Success() {
 	s.testProcessCancelExecution(
 		constants.TestDomainID,
@@ -2116,7 +2247,7 @@ func (s *transferActiveTaskExecutorSuite) testProcessResetWorkflowWithError(same
 		gomock.Any(),
 		"test-reason",
 		nil,
-		false).Return(resetError).Times(1)
+		reset.ReapplyPolicy{}).Return(resetError).Times(1)
 
 	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
 
@@ -133,6 +133,9 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		// no reset needed for standby
 		// TODO: add error logs
 		return executeResponse, nil
+	case *persistence.CompletionCallbackTask:
+		// completion callbacks are only delivered by the active cluster
+		return executeResponse, nil
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
 	default:
@@ -173,7 +176,7 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
 				taskList,
-				mutableState.GetExecutionInfo().PartitionConfig,
+				getActivityPartitionConfig(mutableState.GetExecutionInfo().PartitionConfig, activityInfo),
 			), nil
 		}
 
@@ -498,6 +498,18 @@ func getWorkflowExecutionTimestamp(
 	return executionTimestamp
 }
 
+// getActivityPartitionConfig returns the partition config of the activity tasks,
+// the priority of the activity overrides the priority of the workflow
+func getActivityPartitionConfig(
+	executionPartitionConfig map[string]string,
+	activityInfo *persistence.ActivityInfo,
+) map[string]string {
+	if activityInfo.Priority <= 0 {
+		return executionPartitionConfig
+	}
+	return common.PartitionConfigWithTaskPriority(executionPartitionConfig, activityInfo.Priority)
+}
+
 func getWorkflowMemo(
 	memo map[string][]byte,
 ) *types.Memo {
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
@@ -89,6 +90,35 @@ func TestShouldRedactContextHeader(t *testing.T) {
 	}
 }
 
+func TestGetActivityPartitionConfig(t *testing.T) {
+	executionPartitionConfig := map[string]string{
+		"isolation-group": "zone-a",
+		commonconstants.PartitionConfigKeyForTaskPriority: "1",
+	}
+	tests := map[string]struct {
+		activityInfo *persistence.ActivityInfo
+		want         map[string]string
+	}{
+		"activity without priority uses the workflow priority": {
+			activityInfo: &persistence.ActivityInfo{},
+			want:         executionPartitionConfig,
+		},
+		"activity priority overrides the workflow priority": {
+			activityInfo: &persistence.ActivityInfo{Priority: 4},
+			want: map[string]string{
+				"isolation-group": "zone-a",
+				commonconstants.PartitionConfigKeyForTaskPriority: "4",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getActivityPartitionConfig(executionPartitionConfig, tc.activityInfo))
+		})
+	}
+	assert.Equal(t, "1", executionPartitionConfig[commonconstants.PartitionConfigKeyForTaskPriority], "execution partition config must not be modified")
+}
+
 // TestPushDecision covers the standby branch of pushDecision. For an active-active domain whose
 // workflow is active in another cluster, shouldPushToMatching returns false. Once the standby
 // task has been pending past StandbyTaskMissingEventsDiscardDelay, the task is now written to the
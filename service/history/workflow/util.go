@@ -263,7 +263,7 @@ UpdateHistoryLoop:
 
 		if postActions.CreateDecision {
 			// Create a transfer task to schedule a decision task
-			if !mutableState.HasPendingDecision() {
+			if !mutableState.HasPendingDecision() && !mutableState.IsWorkflowPaused() {
 				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -64,6 +64,7 @@ func TestUpdateHelper(t *testing.T) {
 			msg: "schedule new decision",
 			mockSetupFn: func(mockContext *execution.MockContext, mockMutableState *execution.MockMutableState) {
 				mockMutableState.EXPECT().HasPendingDecision().Return(false).Times(1)
+				mockMutableState.EXPECT().IsWorkflowPaused().Return(false).Times(1)
 				mockMutableState.EXPECT().AddDecisionTaskScheduledEvent(gomock.Any()).Return(&execution.DecisionInfo{}, nil).Times(1)
 				mockContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
@@ -71,6 +72,17 @@ func TestUpdateHelper(t *testing.T) {
 				return UpdateWithNewDecision, nil
 			},
 		},
+		{
+			msg: "no new decision for paused workflow",
+			mockSetupFn: func(mockContext *execution.MockContext, mockMutableState *execution.MockMutableState) {
+				mockMutableState.EXPECT().HasPendingDecision().Return(false).Times(1)
+				mockMutableState.EXPECT().IsWorkflowPaused().Return(true).Times(1)
+				mockContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			actionFn: func(context execution.Context, mutableState execution.MutableState) (*UpdateAction, error) {
+				return UpdateWithNewDecision, nil
+			},
+		},
 		{
 			msg: "update workflow conflict",
 			mockSetupFn: func(mockContext *execution.MockContext, mockMutableState *execution.MockMutableState) {
@@ -121,6 +121,10 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest) (err error) {
+	return h.wrapped.PauseActivity(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -185,6 +189,10 @@ func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest) (err error) {
+	return h.wrapped.ResetActivity(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -327,3 +335,11 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest) (err error) {
+	return h.wrapped.UnpauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest) (err error) {
+	return h.wrapped.UpdateActivityOptions(ctx, hp1)
+}
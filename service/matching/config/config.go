@@ -79,6 +79,10 @@ type (
 		IsolationGroupHasPollersSustainedDuration dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupNoPollersSustainedDuration  dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupsPerPartition               dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		PriorityDispatchFairnessInterval          dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		EnableFairnessKeyDispatch                 dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		EnableBacklogSubqueues                    dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		FairnessKeyWeights                        dynamicproperties.MapPropertyFnWithDomainFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
@@ -153,6 +157,13 @@ type (
 		IsolationGroupHasPollersSustainedDuration func() time.Duration
 		IsolationGroupNoPollersSustainedDuration  func() time.Duration
 		IsolationGroupsPerPartition               func() int
+		// task priority configuration
+		PriorityDispatchFairnessInterval func() int
+		// task fairness configuration
+		EnableFairnessKeyDispatch func() bool
+		FairnessKeyWeights        func() map[string]interface{}
+		// backlog sub-queues configuration
+		EnableBacklogSubqueues func() bool
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold      func() int
 		MaxTaskBatchSize                     func() int
@@ -233,6 +244,10 @@ func NewConfig(dc *dynamicconfig.Collection, operationalDC *dynamicconfig.Collec
 		IsolationGroupHasPollersSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration),
 		IsolationGroupNoPollersSustainedDuration:   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration),
 		IsolationGroupsPerPartition:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupsPerPartition),
+		PriorityDispatchFairnessInterval:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPriorityDispatchFairnessInterval),
+		EnableFairnessKeyDispatch:                  dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableFairnessKeyDispatch),
+		EnableBacklogSubqueues:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableBacklogSubqueues),
+		FairnessKeyWeights:                         dc.GetMapPropertyFilteredByDomain(dynamicproperties.MatchingFairnessKeyWeights),
 		TaskIsolationDuration:                      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationDuration),
 		TaskIsolationPollerWindow:                  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationPollerWindow),
 		HostName:                                   hostName,
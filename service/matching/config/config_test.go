@@ -108,6 +108,10 @@ func TestNewConfig(t *testing.T) {
 		"AppendTaskTimeout":                         {dynamicproperties.AppendTaskTimeout, time.Duration(42)},
 		"RecordTaskStartedTimeout":                  {dynamicproperties.MatchingRecordTaskStartedTimeout, time.Duration(43)},
 		"MinTaskListWritePartitions":                {dynamicproperties.MatchingTaskListMinimumWritePartitions, 1},
+		"PriorityDispatchFairnessInterval":          {dynamicproperties.MatchingPriorityDispatchFairnessInterval, 44},
+		"EnableFairnessKeyDispatch":                 {dynamicproperties.MatchingEnableFairnessKeyDispatch, false},
+		"EnableBacklogSubqueues":                    {dynamicproperties.MatchingEnableBacklogSubqueues, true},
+		"FairnessKeyWeights":                        {dynamicproperties.MatchingFairnessKeyWeights, map[string]interface{}{"tenant": 3}},
 	}
 	operationalConfigFields := map[string]configTestCase{
 		"ExcludeShortLivedTaskListsFromShardManager": {dynamicproperties.MatchingExcludeShortLivedTaskListsFromShardManager, false},
@@ -188,6 +192,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
@@ -25,9 +25,11 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/ctxutils"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -50,6 +52,14 @@ type taskMatcherImpl struct {
 	// synchronos task channels to match producer/consumer for a certain isolation group
 	// the key is the name of the isolation group
 	isolatedTaskC map[string]chan *InternalTask
+	// synchronous task channels to match producer/consumer for tasks having a priority, indexed by priority
+	// the key is the name of the isolation group, tasks having no isolation requirement use the empty key.
+	// Tasks having a priority are offered on both their priority channel and their task channel,
+	// pollers check the priority channels first, highest priority first
+	priorityTaskC map[string][]chan *InternalTask
+	// number of polls, every PriorityDispatchFairnessInterval-th poll doesn't check the priority channels first
+	// so that tasks without a priority are not starved
+	pollCount atomic.Int64
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when domain is
@@ -89,8 +99,10 @@ func newTaskMatcher(
 	limiter quotas.Limiter,
 ) TaskMatcher {
 	isolatedTaskC := make(map[string]chan *InternalTask)
+	priorityTaskC := map[string][]chan *InternalTask{"": newPriorityTaskC()}
 	for _, g := range isolationGroups {
 		isolatedTaskC[g] = make(chan *InternalTask)
+		priorityTaskC[g] = newPriorityTaskC()
 	}
 
 	cancelCtx, cancelFunc := context.WithCancel(context.Background())
@@ -101,6 +113,7 @@ func newTaskMatcher(
 		fwdr:          fwdr,
 		taskC:         make(chan *InternalTask),
 		isolatedTaskC: isolatedTaskC,
+		priorityTaskC: priorityTaskC,
 		queryTaskC:    make(chan *InternalTask),
 		config:        config,
 		tasklist:      tasklist,
@@ -161,11 +174,13 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 		TaskListType: tm.tasklist.GetType(),
 		TaskListKind: tm.tasklistKind.Ptr(),
 	}
+	// tasks with a priority are offered on both their task channel and their priority channel,
+	// pollers check the priority channels first
+	taskC, priorityTaskC := tm.getTaskC(task), tm.getPriorityTaskC(task)
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	if localWaitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
-		select {
-		case tm.getTaskC(task) <- task: // poller picked up the task
+		matched := func() (bool, error) {
 			cancel()
 			if task.ResponseC != nil {
 				// if there is a response channel, block until resp is received
@@ -189,12 +204,17 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 				}
 			}
 			return false, nil
+		}
+		select {
+		case taskC <- task: // poller picked up the task
+			return matched()
+		case priorityTaskC <- task: // poller picked up the task
+			return matched()
 		case <-childCtx.Done():
 			cancel()
 		}
 	}
-	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
+	matched := func() (bool, error) {
 		if task.ResponseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -212,6 +232,12 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 			}
 		}
 		return false, nil
+	}
+	select {
+	case taskC <- task: // poller picked up the task
+		return matched()
+	case priorityTaskC <- task: // poller picked up the task
+		return matched()
 	default:
 		// no poller waiting for tasks, try forwarding this task to the
 		// root partition if possible
@@ -257,8 +283,7 @@ func (tm *taskMatcherImpl) OfferOrTimeout(ctx context.Context, startT time.Time,
 			return false, err
 		}
 	}
-	select {
-	case tm.getTaskC(task) <- task: // poller picked up the task
+	matched := func() (bool, error) {
 		if task.ResponseC != nil {
 			select {
 			case err := <-task.ResponseC:
@@ -270,6 +295,12 @@ func (tm *taskMatcherImpl) OfferOrTimeout(ctx context.Context, startT time.Time,
 			}
 		}
 		return false, nil
+	}
+	select {
+	case tm.getTaskC(task) <- task: // poller picked up the task
+		return matched()
+	case tm.getPriorityTaskC(task) <- task: // poller picked up the task
+		return matched()
 	case <-ctx.Done():
 		return false, nil
 	}
@@ -330,11 +361,12 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 	startT := time.Now()
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
-	taskC := tm.getTaskC(task)
+	// tasks with a priority are offered on both their task channel and their priority channel,
+	// pollers check the priority channels first
+	taskC, priorityTaskC := tm.getTaskC(task), tm.getPriorityTaskC(task)
 	localWaitTime := tm.config.LocalTaskWaitTime()
 	childCtx, cancel := context.WithTimeout(ctx, localWaitTime)
-	select {
-	case taskC <- task: // poller picked up the task
+	dispatched := func() error {
 		cancel()
 		tm.scope.IncCounter(metrics.AsyncMatchLocalPollCounterPerTaskList)
 		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
@@ -342,6 +374,12 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		e.EventName = "Dispatched to Local Poller"
 		event.Log(e)
 		return nil
+	}
+	select {
+	case taskC <- task: // poller picked up the task
+		return dispatched()
+	case priorityTaskC <- task: // poller picked up the task
+		return dispatched()
 	case <-ctx.Done():
 		cancel()
 		e.EventName = "Context Done While Dispatching to Local Poller"
@@ -352,6 +390,26 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 	}
 
 	attempt := 0
+	dispatchedAfterAttempts := func() error {
+		e.EventName = "Dispatched to Local Poller"
+		event.Log(e)
+		tm.scope.IncCounter(metrics.AsyncMatchLocalPollCounterPerTaskList)
+		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAttemptPerTaskList, time.Duration(attempt))
+		tm.scope.IntExponentialHistogram(metrics.AsyncMatchLocalPollAttemptPerTaskListHistogram, attempt)
+		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
+		tm.scope.ExponentialHistogram(metrics.AsyncMatchLocalPollLatencyPerTaskListHistogram, time.Since(startT))
+		return nil
+	}
+	dispatchedAfterForwardFailed := func() error {
+		e.EventName = "Dispatched to Local Poller (after failed forward)"
+		event.Log(e)
+		tm.scope.IncCounter(metrics.AsyncMatchLocalPollAfterForwardFailedCounterPerTaskList)
+		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedAttemptPerTaskList, time.Duration(attempt))
+		tm.scope.IntExponentialHistogram(metrics.AsyncMatchLocalPollAfterForwardFailedAttemptPerTaskListHistogram, attempt)
+		tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedLatencyPerTaskList, time.Since(startT))
+		tm.scope.ExponentialHistogram(metrics.AsyncMatchLocalPollAfterForwardFailedLatencyPerTaskListHistogram, time.Since(startT))
+		return nil
+	}
 forLoop:
 	for {
 		if err := ctx.Err(); err != nil {
@@ -361,14 +419,9 @@ forLoop:
 		}
 		select {
 		case taskC <- task: // poller picked up the task
-			e.EventName = "Dispatched to Local Poller"
-			event.Log(e)
-			tm.scope.IncCounter(metrics.AsyncMatchLocalPollCounterPerTaskList)
-			tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAttemptPerTaskList, time.Duration(attempt))
-			tm.scope.IntExponentialHistogram(metrics.AsyncMatchLocalPollAttemptPerTaskListHistogram, attempt)
-			tm.scope.RecordTimer(metrics.AsyncMatchLocalPollLatencyPerTaskList, time.Since(startT))
-			tm.scope.ExponentialHistogram(metrics.AsyncMatchLocalPollLatencyPerTaskListHistogram, time.Since(startT))
-			return nil
+			return dispatchedAfterAttempts()
+		case priorityTaskC <- task: // poller picked up the task
+			return dispatchedAfterAttempts()
 		case token := <-tm.fwdrAddReqTokenC():
 			e.EventName = "Attempting to Forward Task"
 			event.Log(e)
@@ -393,15 +446,11 @@ forLoop:
 				// hoping for a local poller match
 				select {
 				case taskC <- task: // poller picked up the task
-					e.EventName = "Dispatched to Local Poller (after failed forward)"
-					event.Log(e)
 					cancel()
-					tm.scope.IncCounter(metrics.AsyncMatchLocalPollAfterForwardFailedCounterPerTaskList)
-					tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedAttemptPerTaskList, time.Duration(attempt))
-					tm.scope.IntExponentialHistogram(metrics.AsyncMatchLocalPollAfterForwardFailedAttemptPerTaskListHistogram, attempt)
-					tm.scope.RecordTimer(metrics.AsyncMatchLocalPollAfterForwardFailedLatencyPerTaskList, time.Since(startT))
-					tm.scope.ExponentialHistogram(metrics.AsyncMatchLocalPollAfterForwardFailedLatencyPerTaskListHistogram, time.Since(startT))
-					return nil
+					return dispatchedAfterForwardFailed()
+				case priorityTaskC <- task: // poller picked up the task
+					cancel()
+					return dispatchedAfterForwardFailed()
 				case <-childCtx.Done():
 					attempt++
 					cancel()
@@ -460,6 +509,12 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		}
 	}()
 
+	// try tasks having a priority first
+	if task = tm.pollPriorityTask(isolationGroup); task != nil {
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		tm.scope.ExponentialHistogram(metrics.PollLocalMatchLatencyPerTaskListHistogram, time.Since(startT))
+		return task, nil
+	}
 	// try local match first without blocking until context timeout
 	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
@@ -794,6 +849,57 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	}
 }
 
+// pollPriorityTask returns a task having a priority if a producer is waiting to match one, highest
+// priority first and tasks of the poller's isolation group first. It doesn't block.
+func (tm *taskMatcherImpl) pollPriorityTask(isolationGroup string) *InternalTask {
+	interval := tm.config.PriorityDispatchFairnessInterval()
+	if interval <= 1 || tm.pollCount.Add(1)%int64(interval) == 0 {
+		return nil
+	}
+	var isolatedPriorityTaskC []chan *InternalTask
+	if isolationGroup != "" {
+		isolatedPriorityTaskC = tm.priorityTaskC[isolationGroup]
+	}
+	for priority := constants.MaxTaskPriority; priority > 0; priority-- {
+		if isolatedPriorityTaskC != nil {
+			select {
+			case task := <-isolatedPriorityTaskC[priority]:
+				tm.onPriorityTaskMatched(task, true)
+				return task
+			default:
+			}
+		}
+		select {
+		case task := <-tm.priorityTaskC[""][priority]:
+			tm.onPriorityTaskMatched(task, false)
+			return task
+		default:
+		}
+	}
+	return nil
+}
+
+func (tm *taskMatcherImpl) onPriorityTaskMatched(task *InternalTask, fromIsolatedTaskC bool) {
+	if task.ResponseC != nil {
+		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+	}
+	tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+	event.Log(event.E{
+		TaskListName: tm.tasklist.GetName(),
+		TaskListType: tm.tasklist.GetType(),
+		TaskListKind: tm.tasklistKind.Ptr(),
+		TaskInfo:     task.Info(),
+		EventName:    "Matched Priority Task",
+		Payload: map[string]any{
+			"TaskIsForwarded":   task.IsForwarded(),
+			"SyncMatched":       task.ResponseC != nil,
+			"FromIsolatedTaskC": fromIsolatedTaskC,
+			"IsolationGroup":    task.isolationGroup,
+			"Priority":          task.priority,
+		},
+	})
+}
+
 func (tm *taskMatcherImpl) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return noopForwarderTokenC
@@ -832,3 +938,25 @@ func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
 	}
 	return taskC
 }
+
+// getPriorityTaskC returns the priority channel of the task, nil if the task has no priority
+// or priority dispatch is disabled
+func (tm *taskMatcherImpl) getPriorityTaskC(task *InternalTask) chan<- *InternalTask {
+	if task.priority <= 0 || tm.config.PriorityDispatchFairnessInterval() <= 1 {
+		return nil
+	}
+	priorityTaskC := tm.priorityTaskC[""]
+	if isolatedPriorityTaskC, ok := tm.priorityTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
+		priorityTaskC = isolatedPriorityTaskC
+	}
+	return priorityTaskC[task.priority]
+}
+
+func newPriorityTaskC() []chan *InternalTask {
+	// priority 0 has no priority channel
+	priorityTaskC := make([]chan *InternalTask, constants.MaxTaskPriority+1)
+	for priority := 1; priority <= constants.MaxTaskPriority; priority++ {
+		priorityTaskC[priority] = make(chan *InternalTask)
+	}
+	return priorityTaskC
+}
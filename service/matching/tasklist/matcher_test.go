@@ -24,6 +24,7 @@ import (
 	"context"
 	"math"
 	"math/rand"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -415,6 +416,36 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestMustOfferLocalMatchPriority() {
+	t.disableRemoteForwarding()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	offer := func(priority int) *persistence.TaskInfo {
+		info := t.newTaskInfo()
+		info.PartitionConfig = map[string]string{constants.PartitionConfigKeyForTaskPriority: strconv.Itoa(priority)}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t.NoError(t.matcher.MustOffer(ctx, newInternalTask(info, nil, types.TaskSourceDbBacklog, "", false, "")))
+		}()
+		return info
+	}
+	low := offer(0)
+	time.Sleep(50 * time.Millisecond)
+	high := offer(3)
+	time.Sleep(50 * time.Millisecond)
+
+	for _, expected := range []*persistence.TaskInfo{high, low} {
+		task, err := t.matcher.Poll(ctx, "")
+		t.NoError(err)
+		t.Equal(expected.TaskID, task.Event.TaskID)
+		task.Finish(nil)
+	}
+	wg.Wait()
+}
+
 func (t *MatcherTestSuite) TestIsolationMustOfferLocalMatch() {
 	// force disable remote forwarding
 	t.disableRemoteForwarding()
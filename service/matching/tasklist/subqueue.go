@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// numFairnessSubqueues is the number of sub-queues the fairness keys of a priority are hashed to.
+// It must not change, as tasks already persisted in a sub-queue are only read from it.
+const numFairnessSubqueues = 4
+
+type (
+	// subqueueKey identifies a partition of the persisted backlog of a task list
+	subqueueKey struct {
+		priority       int32
+		fairnessBucket int
+	}
+
+	// subqueue is a partition of the persisted backlog of a task list. It is stored as a separate persisted
+	// task list, with its own lease, ack level and reader, so that a large backlog in one sub-queue doesn't
+	// delay the tasks of the other ones: readers of all sub-queues dispatch concurrently, and the matcher
+	// offers the tasks having a priority to pollers first.
+	subqueue struct {
+		db             *taskListDB
+		taskAckManager messaging.AckManager
+		taskWriter     *taskWriter
+		taskReader     *taskReader
+	}
+)
+
+// rootSubqueueKey is the key of the tasks without priority and fairness key, which are persisted in the task list itself
+var rootSubqueueKey = subqueueKey{}
+
+// subqueueName returns the name of the persisted task list holding a sub-queue of the given task list
+func subqueueName(taskListName string, key subqueueKey) string {
+	return fmt.Sprintf("%v%v/subqueue-%d-%d", constants.ReservedTaskListPrefix, taskListName, key.priority, key.fairnessBucket)
+}
+
+func (c *taskListManagerImpl) backlogSubqueuesEnabled() bool {
+	return c.taskListKind == types.TaskListKindNormal && c.config.EnableBacklogSubqueues()
+}
+
+// subqueueKeyForTask returns the sub-queue the task is persisted in
+func (c *taskListManagerImpl) subqueueKeyForTask(task *persistence.TaskInfo) subqueueKey {
+	var key subqueueKey
+	if c.config.PriorityDispatchFairnessInterval() > 1 {
+		key.priority = common.TaskPriorityFromPartitionConfig(task.PartitionConfig)
+	}
+	if fairnessKey := task.PartitionConfig[constants.PartitionConfigKeyForTaskFairnessKey]; fairnessKey != "" && c.config.EnableFairnessKeyDispatch() {
+		h := fnv.New32a()
+		h.Write([]byte(fairnessKey))
+		key.fairnessBucket = int(h.Sum32() % numFairnessSubqueues)
+	}
+	return key
+}
+
+// appendTask persists the task in the backlog of the task list, or in its sub-queue when backlog sub-queues
+// are enabled, and signals the reader of the backlog
+func (c *taskListManagerImpl) appendTask(ctx context.Context, task *persistence.TaskInfo) error {
+	writer, reader := c.taskWriter, c.taskReader
+	if c.backlogSubqueuesEnabled() {
+		if key := c.subqueueKeyForTask(task); key != rootSubqueueKey {
+			sq, err := c.getOrCreateSubqueue(key)
+			if err != nil {
+				return err
+			}
+			writer, reader = sq.taskWriter, sq.taskReader
+		}
+	}
+	if _, err := writer.appendTask(ctx, task); err != nil {
+		return err
+	}
+	reader.Signal()
+	return nil
+}
+
+// getOrCreateSubqueue returns the sub-queue, it takes the lease of the sub-queue and starts reading it if it isn't loaded yet
+func (c *taskListManagerImpl) getOrCreateSubqueue(key subqueueKey) (*subqueue, error) {
+	c.subqueuesLock.RLock()
+	sq, ok := c.subqueues[key]
+	c.subqueuesLock.RUnlock()
+	if ok {
+		return sq, nil
+	}
+
+	// creations are serialized so that a sub-queue is leased once, the lookups above don't wait for it
+	c.subqueueCreateLock.Lock()
+	defer c.subqueueCreateLock.Unlock()
+	c.subqueuesLock.RLock()
+	sq, ok = c.subqueues[key]
+	c.subqueuesLock.RUnlock()
+	if ok {
+		return sq, nil
+	}
+	if atomic.LoadInt32(&c.stopped) == 1 {
+		return nil, errShutdown
+	}
+
+	db := newTaskListDB(c.db.store, c.taskListID.GetDomainID(), c.domainName, subqueueName(c.taskListID.GetName(), key), c.taskListID.GetType(), int(c.taskListKind), c.logger)
+	ackManager := messaging.NewAckManager(c.logger)
+	writer := newTaskWriter(c, db, ackManager)
+	reader := newTaskReader(c, db, writer, newTaskGC(db, c.config), ackManager, c.isolationGroups)
+	reader.subqueue = true
+	if err := writer.Start(); err != nil {
+		return nil, err
+	}
+	go c.watchForStop(reader, writer)
+	reader.Start()
+
+	sq = &subqueue{
+		db:             db,
+		taskAckManager: ackManager,
+		taskWriter:     writer,
+		taskReader:     reader,
+	}
+	c.subqueuesLock.Lock()
+	c.subqueues[key] = sq
+	c.subqueuesLock.Unlock()
+	return sq, nil
+}
+
+// loadSubqueues starts reading the sub-queues persisted by the previous owners of the task list
+func (c *taskListManagerImpl) loadSubqueues() error {
+	var g errgroup.Group
+	for priority := int32(0); priority <= constants.MaxTaskPriority; priority++ {
+		for bucket := 0; bucket < numFairnessSubqueues; bucket++ {
+			key := subqueueKey{priority: priority, fairnessBucket: bucket}
+			if key == rootSubqueueKey {
+				continue
+			}
+			g.Go(func() error {
+				err := c.throttleRetry.Do(context.Background(), func(ctx context.Context) error {
+					_, err := c.db.GetTaskListInfo(subqueueName(c.taskListID.GetName(), key))
+					return err
+				})
+				var notExists *types.EntityNotExistsError
+				if errors.As(err, &notExists) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				_, err = c.getOrCreateSubqueue(key)
+				return err
+			})
+		}
+	}
+	return g.Wait()
+}
+
+// stopSubqueues stops the sub-queues, no sub-queue can be created once the manager is stopped
+func (c *taskListManagerImpl) stopSubqueues() {
+	c.subqueueCreateLock.Lock()
+	defer c.subqueueCreateLock.Unlock()
+	c.subqueuesLock.RLock()
+	defer c.subqueuesLock.RUnlock()
+	for _, sq := range c.subqueues {
+		sq.taskWriter.Stop()
+		sq.taskReader.Stop()
+	}
+}
+
+// backlogCount returns the number of backlog tasks read and not yet dispatched, across all sub-queues
+func (c *taskListManagerImpl) backlogCount() int64 {
+	count := c.taskAckManager.GetBacklogCount()
+	c.subqueuesLock.RLock()
+	defer c.subqueuesLock.RUnlock()
+	for _, sq := range c.subqueues {
+		count += sq.taskAckManager.GetBacklogCount()
+	}
+	return count
+}
+
+// subqueuesSize returns the number of tasks persisted in the sub-queues, as of the last time their readers checked it
+func (c *taskListManagerImpl) subqueuesSize() int64 {
+	c.subqueuesLock.RLock()
+	defer c.subqueuesLock.RUnlock()
+	var size int64
+	for _, sq := range c.subqueues {
+		size += sq.db.BacklogCount()
+	}
+	return size
+}
+
+// isBacklogEmpty returns true if all the tasks persisted in the backlog and its sub-queues have been dispatched
+func (c *taskListManagerImpl) isBacklogEmpty() bool {
+	if c.taskAckManager.GetAckLevel() != c.taskWriter.GetMaxReadLevel() {
+		return false
+	}
+	c.subqueuesLock.RLock()
+	defer c.subqueuesLock.RUnlock()
+	for _, sq := range c.subqueues {
+		if sq.taskAckManager.GetAckLevel() != sq.taskWriter.GetMaxReadLevel() {
+			return false
+		}
+	}
+	return true
+}
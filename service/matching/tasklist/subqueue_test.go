@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func TestSubqueueKeyForTask(t *testing.T) {
+	tests := []struct {
+		name             string
+		partitionConfig  map[string]string
+		fairnessInterval int
+		enableFairness   bool
+		want             subqueueKey
+	}{
+		{
+			name:             "no priority nor fairness key",
+			fairnessInterval: 5,
+			enableFairness:   true,
+			want:             rootSubqueueKey,
+		},
+		{
+			name:             "priority",
+			partitionConfig:  map[string]string{constants.PartitionConfigKeyForTaskPriority: "3"},
+			fairnessInterval: 5,
+			enableFairness:   true,
+			want:             subqueueKey{priority: 3},
+		},
+		{
+			name:             "priority dispatch disabled",
+			partitionConfig:  map[string]string{constants.PartitionConfigKeyForTaskPriority: "3"},
+			fairnessInterval: 1,
+			enableFairness:   true,
+			want:             rootSubqueueKey,
+		},
+		{
+			name: "priority and fairness key",
+			partitionConfig: map[string]string{
+				constants.PartitionConfigKeyForTaskPriority:    "2",
+				constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a",
+			},
+			fairnessInterval: 5,
+			enableFairness:   true,
+			want:             subqueueKey{priority: 2, fairnessBucket: 3},
+		},
+		{
+			name:             "fairness key dispatch disabled",
+			partitionConfig:  map[string]string{constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a"},
+			fairnessInterval: 5,
+			enableFairness:   false,
+			want:             rootSubqueueKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlm := &taskListManagerImpl{config: &config.TaskListConfig{
+				PriorityDispatchFairnessInterval: func() int { return tt.fairnessInterval },
+				EnableFairnessKeyDispatch:        func() bool { return tt.enableFairness },
+			}}
+			assert.Equal(t, tt.want, tlm.subqueueKeyForTask(&persistence.TaskInfo{PartitionConfig: tt.partitionConfig}))
+		})
+	}
+}
+
+func TestSubqueueName(t *testing.T) {
+	assert.Equal(t, "/__cadence_sys/tl/subqueue-3-1", subqueueName("tl", subqueueKey{priority: 3, fairnessBucket: 1}))
+}
+
+func TestBacklogSubqueues(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+	timeSource := clock.NewRealTimeSource()
+	tm := NewTestTaskManager(t, logger, timeSource)
+	cfg := defaultTestConfig()
+	cfg.EnableBacklogSubqueues = dynamicproperties.GetBoolPropertyFilteredByTaskListInfo(true)
+
+	tlm := createTestTaskListManagerWithTaskManager(t, logger, controller, cfg, timeSource, tm)
+	require.NoError(t, tlm.Start(context.Background()))
+	// stop reading the backlog, so that the tasks stay persisted until the task list is loaded again
+	tlm.taskReader.cancelFunc()
+
+	for scheduleID, priority := range []string{"0", "3"} {
+		_, err := tlm.AddTask(context.Background(), AddTaskParams{
+			TaskInfo: &persistence.TaskInfo{
+				DomainID:                      "domainId",
+				RunID:                         "run1",
+				WorkflowID:                    "workflow1",
+				ScheduleID:                    int64(scheduleID),
+				ScheduleToStartTimeoutSeconds: 100,
+				PartitionConfig:               map[string]string{constants.PartitionConfigKeyForTaskPriority: priority},
+			},
+		})
+		require.NoError(t, err)
+		if priority != "0" {
+			tlm.subqueues[subqueueKey{priority: 3}].taskReader.cancelFunc()
+		}
+	}
+	subqueueTasks := func() int {
+		return tm.getTaskListManager(newTestTaskListKey(tlm.taskListID.GetDomainID(), subqueueName("tl", subqueueKey{priority: 3}), tlm.taskListID.GetType())).tasks.Size()
+	}
+	assert.Equal(t, 1, tm.GetTaskCount(tlm.taskListID))
+	assert.Equal(t, 1, subqueueTasks())
+	tlm.Stop()
+
+	// the sub-queue is read again by the next owner of the task list
+	tlm = createTestTaskListManagerWithTaskManager(t, logger, controller, cfg, timeSource, tm)
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+	assert.Len(t, tlm.subqueues, 1)
+
+	var scheduleIDs []int64
+	for i := 0; i < 2; i++ {
+		task, err := tlm.GetTask(context.Background(), nil)
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.Event.ScheduleID)
+		task.Finish(nil)
+	}
+	assert.ElementsMatch(t, []int64{0, 1}, scheduleIDs)
+	assert.True(t, awaitCondition(func() bool {
+		return tm.GetTaskCount(tlm.taskListID) == 0 && subqueueTasks() == 0
+	}, time.Second))
+}
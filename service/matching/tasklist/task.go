@@ -21,6 +21,8 @@
 package tasklist
 
 import (
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -55,6 +57,7 @@ type (
 		source           types.TaskSource
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		isolationGroup   string     // isolation group of this task (empty if it can be polled by workers from any isolation group)
+		priority         int32      // priority of this task, higher priority tasks are dispatched first
 		ResponseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		BacklogCountHint int64
 		AutoConfigHint   *types.AutoConfigHint // worker auto-scaler hint, which includes enable auto config flag and poller wait time on the matching engine
@@ -77,6 +80,7 @@ func newInternalTask(
 		source:         source,
 		forwardedFrom:  forwardedFrom,
 		isolationGroup: isolationGroup,
+		priority:       common.TaskPriorityFromPartitionConfig(info.PartitionConfig),
 	}
 	if forSyncMatch {
 		task.ResponseC = make(chan error, 1)
@@ -86,7 +90,7 @@ func newInternalTask(
 	// OriginalIsolationGroup is populated here and isn't written to the DB. If it's already
 	// present then it's a forwarded task and we should respect it.
 	if configIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.GroupKey]; ok {
-		partitionConfig := make(map[string]string, 3)
+		partitionConfig := make(map[string]string, 5)
 		if originalIsolationGroup, ok := task.Event.PartitionConfig[isolationgroup.OriginalGroupKey]; ok {
 			partitionConfig[isolationgroup.OriginalGroupKey] = originalIsolationGroup
 		} else {
@@ -94,6 +98,11 @@ func newInternalTask(
 		}
 		partitionConfig[isolationgroup.GroupKey] = isolationGroup
 		partitionConfig[isolationgroup.WorkflowIDKey] = task.Event.PartitionConfig[isolationgroup.WorkflowIDKey]
+		for _, key := range []string{constants.PartitionConfigKeyForTaskPriority, constants.PartitionConfigKeyForTaskFairnessKey} {
+			if value, ok := task.Event.PartitionConfig[key]; ok {
+				partitionConfig[key] = value
+			}
+		}
 		task.Event.PartitionConfig = partitionConfig
 	}
 	return task
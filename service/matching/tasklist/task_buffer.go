@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tasklist
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+type (
+	// taskBuffer is the in-memory queue of backlog tasks waiting to be dispatched to pollers.
+	// Tasks are returned highest priority first. Every PriorityDispatchFairnessInterval-th task
+	// is taken from the priority having the oldest buffered task, so that a steady flow of high
+	// priority tasks doesn't starve the low priority ones.
+	//
+	// Within a priority, tasks are dispatched weighted round robin across their fairness keys,
+	// so that a tenant enqueuing many tasks doesn't block the other tenants sharing the task list.
+	//
+	// The buffer only reorders the tasks already read from the database. Unless backlog sub-queues
+	// are enabled, a high priority task at the end of a large backlog is dispatched once the reader
+	// has buffered it; with sub-queues, each priority and fairness bucket is persisted and read
+	// separately, so it is not stuck behind the backlog of the others.
+	taskBuffer struct {
+		sync.Mutex
+		queues     [constants.MaxTaskPriority + 1]fairQueue
+		size       int
+		capacity   int
+		dispatched int
+		config     *config.TaskListConfig
+		putC       chan struct{} // signaled when a task is added
+		getC       chan struct{} // signaled when a task is removed
+	}
+
+	// fairQueue is a weighted round robin queue of tasks across their fairness keys,
+	// tasks of a fairness key are dispatched in task ID order.
+	fairQueue struct {
+		tasks  map[string][]*persistence.TaskInfo
+		keys   []string // fairness keys having buffered tasks, in round robin order
+		next   int      // index in keys of the fairness key whose turn it is
+		served int      // number of tasks dispatched in the current turn
+	}
+)
+
+func newTaskBuffer(capacity int, config *config.TaskListConfig) *taskBuffer {
+	return &taskBuffer{
+		capacity: max(capacity, 1),
+		config:   config,
+		putC:     make(chan struct{}, 1),
+		getC:     make(chan struct{}, 1),
+	}
+}
+
+// Put adds a task to the buffer, it blocks while the buffer is full.
+// Returns false if the context is done before the task could be added.
+func (b *taskBuffer) Put(ctx context.Context, task *persistence.TaskInfo) bool {
+	priority := common.TaskPriorityFromPartitionConfig(task.PartitionConfig)
+	fairnessKey := ""
+	if b.config.EnableFairnessKeyDispatch() {
+		fairnessKey = task.PartitionConfig[constants.PartitionConfigKeyForTaskFairnessKey]
+	}
+	for {
+		b.Lock()
+		if b.size < b.capacity {
+			b.queues[priority].push(fairnessKey, task)
+			b.size++
+			b.Unlock()
+			signal(b.putC)
+			return true
+		}
+		b.Unlock()
+		select {
+		case <-b.getC:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Get removes the next task to dispatch from the buffer, it blocks while the buffer is empty.
+// Returns false if the context is done before a task is available.
+func (b *taskBuffer) Get(ctx context.Context) (*persistence.TaskInfo, bool) {
+	for {
+		if task := b.tryGet(); task != nil {
+			signal(b.getC)
+			return task, true
+		}
+		select {
+		case <-b.putC:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// Len returns the number of buffered tasks
+func (b *taskBuffer) Len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+// Cap returns the maximum number of buffered tasks
+func (b *taskBuffer) Cap() int {
+	return b.capacity
+}
+
+func (b *taskBuffer) tryGet() *persistence.TaskInfo {
+	b.Lock()
+	defer b.Unlock()
+	if b.size == 0 {
+		return nil
+	}
+	b.dispatched++
+	priority := b.highestPriority()
+	if interval := b.config.PriorityDispatchFairnessInterval(); interval <= 1 || b.dispatched%interval == 0 {
+		priority = b.oldestTaskPriority()
+	}
+	weights := b.config.FairnessKeyWeights()
+	task := b.queues[priority].pop(func(fairnessKey string) int {
+		return fairnessKeyWeight(weights, fairnessKey)
+	})
+	b.size--
+	return task
+}
+
+func (b *taskBuffer) highestPriority() int {
+	for priority := len(b.queues) - 1; priority > 0; priority-- {
+		if !b.queues[priority].isEmpty() {
+			return priority
+		}
+	}
+	return 0
+}
+
+func (b *taskBuffer) oldestTaskPriority() int {
+	oldest, oldestTaskID := -1, int64(0)
+	for priority := range b.queues {
+		if b.queues[priority].isEmpty() {
+			continue
+		}
+		if taskID := b.queues[priority].oldestTaskID(); oldest == -1 || taskID < oldestTaskID {
+			oldest, oldestTaskID = priority, taskID
+		}
+	}
+	return oldest
+}
+
+func (q *fairQueue) isEmpty() bool {
+	return len(q.keys) == 0
+}
+
+func (q *fairQueue) push(fairnessKey string, task *persistence.TaskInfo) {
+	if q.tasks == nil {
+		q.tasks = make(map[string][]*persistence.TaskInfo)
+	}
+	if len(q.tasks[fairnessKey]) == 0 {
+		q.keys = append(q.keys, fairnessKey)
+	}
+	q.tasks[fairnessKey] = append(q.tasks[fairnessKey], task)
+}
+
+// pop returns the next task of the fairness key whose turn it is, a fairness key keeps
+// its turn for weight consecutive tasks. The queue must not be empty.
+func (q *fairQueue) pop(weight func(fairnessKey string) int) *persistence.TaskInfo {
+	fairnessKey := q.keys[q.next]
+	tasks := q.tasks[fairnessKey]
+	task := tasks[0]
+	tasks[0] = nil
+	q.served++
+	if len(tasks) == 1 {
+		delete(q.tasks, fairnessKey)
+		q.keys = slices.Delete(q.keys, q.next, q.next+1)
+		q.served = 0
+	} else {
+		q.tasks[fairnessKey] = tasks[1:]
+		if q.served >= weight(fairnessKey) {
+			q.next++
+			q.served = 0
+		}
+	}
+	if q.next >= len(q.keys) {
+		q.next = 0
+	}
+	return task
+}
+
+// oldestTaskID returns the smallest buffered task ID. The queue must not be empty.
+func (q *fairQueue) oldestTaskID() int64 {
+	oldest := q.tasks[q.keys[0]][0].TaskID
+	for _, fairnessKey := range q.keys[1:] {
+		oldest = min(oldest, q.tasks[fairnessKey][0].TaskID)
+	}
+	return oldest
+}
+
+// fairnessKeyWeight returns the weight of the fairness key in the dynamic config weights, 1 by default
+func fairnessKeyWeight(weights map[string]interface{}, fairnessKey string) int {
+	switch weight := weights[fairnessKey].(type) {
+	case int:
+		return max(weight, 1)
+	case float64:
+		return max(int(weight), 1)
+	default:
+		return 1
+	}
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default: // channel already has a signal, don't block
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func TestTaskBuffer_Order(t *testing.T) {
+	cases := []struct {
+		name             string
+		fairnessInterval int
+		priorities       []int // priority of the buffered tasks, in task ID order
+		expected         []int64
+	}{
+		{
+			name:             "priority order",
+			fairnessInterval: 100,
+			priorities:       []int{0, 2, 1, 2, 5},
+			expected:         []int64{4, 1, 3, 2, 0},
+		},
+		{
+			name:             "fairness",
+			fairnessInterval: 3,
+			priorities:       []int{0, 1, 5, 5, 5, 5},
+			expected:         []int64{2, 3, 0, 4, 5, 1},
+		},
+		{
+			name:             "fifo when priority dispatch is disabled",
+			fairnessInterval: 1,
+			priorities:       []int{0, 2, 1, 5},
+			expected:         []int64{0, 1, 2, 3},
+		},
+		{
+			name:             "out of range priority is clamped",
+			fairnessInterval: 100,
+			priorities:       []int{0, 100, -1, 1},
+			expected:         []int64{1, 3, 0, 2},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buffer := newTaskBuffer(len(tc.priorities), newTaskBufferConfig(tc.fairnessInterval, true, nil))
+			for i, priority := range tc.priorities {
+				require.True(t, buffer.Put(context.Background(), newPriorityTaskInfo(int64(i), priority, "")))
+			}
+			assert.Equal(t, len(tc.priorities), buffer.Len())
+
+			var taskIDs []int64
+			for range tc.priorities {
+				task, ok := buffer.Get(context.Background())
+				require.True(t, ok)
+				taskIDs = append(taskIDs, task.TaskID)
+			}
+			assert.Equal(t, tc.expected, taskIDs)
+			assert.Equal(t, 0, buffer.Len())
+		})
+	}
+}
+
+func TestTaskBuffer_Blocking(t *testing.T) {
+	buffer := newTaskBuffer(1, newTaskBufferConfig(5, true, nil))
+	assert.Equal(t, 1, buffer.Cap())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	_, ok := buffer.Get(ctx)
+	cancel()
+	assert.False(t, ok, "get should block while the buffer is empty")
+
+	require.True(t, buffer.Put(context.Background(), newPriorityTaskInfo(1, 0, "")))
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	ok = buffer.Put(ctx, newPriorityTaskInfo(2, 0, ""))
+	cancel()
+	assert.False(t, ok, "put should block while the buffer is full")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.True(t, buffer.Put(context.Background(), newPriorityTaskInfo(3, 0, "")))
+	}()
+	task, ok := buffer.Get(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, int64(1), task.TaskID)
+	<-done
+	task, ok = buffer.Get(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, int64(3), task.TaskID)
+}
+
+func TestTaskBuffer_FairnessKeys(t *testing.T) {
+	type task struct {
+		priority    int
+		fairnessKey string
+	}
+	cases := []struct {
+		name           string
+		enableFairness bool
+		weights        map[string]interface{}
+		tasks          []task // buffered tasks, in task ID order
+		expected       []int64
+	}{
+		{
+			name:           "round robin",
+			enableFairness: true,
+			tasks:          []task{{0, "a"}, {0, "a"}, {0, "a"}, {0, "b"}, {0, ""}, {0, "b"}},
+			expected:       []int64{0, 3, 4, 1, 5, 2},
+		},
+		{
+			name:           "weighted round robin",
+			enableFairness: true,
+			weights:        map[string]interface{}{"a": 2, "b": float64(3)},
+			tasks:          []task{{0, "a"}, {0, "a"}, {0, "a"}, {0, "b"}, {0, "b"}, {0, "b"}, {0, "b"}, {0, "c"}},
+			expected:       []int64{0, 1, 3, 4, 5, 7, 2, 6},
+		},
+		{
+			name:           "priority first",
+			enableFairness: true,
+			tasks:          []task{{0, "a"}, {0, "b"}, {1, "a"}, {1, "a"}, {1, "b"}},
+			expected:       []int64{2, 4, 3, 0, 1},
+		},
+		{
+			name:           "disabled",
+			enableFairness: false,
+			tasks:          []task{{0, "a"}, {0, "a"}, {0, "b"}, {0, "b"}},
+			expected:       []int64{0, 1, 2, 3},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buffer := newTaskBuffer(len(tc.tasks), newTaskBufferConfig(100, tc.enableFairness, tc.weights))
+			for i, task := range tc.tasks {
+				require.True(t, buffer.Put(context.Background(), newPriorityTaskInfo(int64(i), task.priority, task.fairnessKey)))
+			}
+
+			var taskIDs []int64
+			for range tc.tasks {
+				task, ok := buffer.Get(context.Background())
+				require.True(t, ok)
+				taskIDs = append(taskIDs, task.TaskID)
+			}
+			assert.Equal(t, tc.expected, taskIDs)
+		})
+	}
+}
+
+func newTaskBufferConfig(fairnessInterval int, enableFairness bool, weights map[string]interface{}) *config.TaskListConfig {
+	return &config.TaskListConfig{
+		PriorityDispatchFairnessInterval: func() int { return fairnessInterval },
+		EnableFairnessKeyDispatch:        func() bool { return enableFairness },
+		FairnessKeyWeights:               func() map[string]interface{} { return weights },
+	}
+}
+
+func newPriorityTaskInfo(taskID int64, priority int, fairnessKey string) *persistence.TaskInfo {
+	partitionConfig := map[string]string{constants.PartitionConfigKeyForTaskPriority: strconv.Itoa(priority)}
+	if fairnessKey != "" {
+		partitionConfig[constants.PartitionConfigKeyForTaskFairnessKey] = fairnessKey
+	}
+	return &persistence.TaskInfo{
+		TaskID:          taskID,
+		PartitionConfig: partitionConfig,
+	}
+}
@@ -147,6 +147,11 @@ type (
 		qpsTracker     stats.QPSTrackerGroup
 		adaptiveScaler AdaptiveScaler
 
+		// backlog sub-queues of the task list, see subqueue
+		subqueues          map[subqueueKey]*subqueue
+		subqueuesLock      sync.RWMutex
+		subqueueCreateLock sync.Mutex
+
 		partitionConfigLock sync.RWMutex
 		partitionConfig     *types.TaskListPartitionConfig
 		historyService      history.Client
@@ -194,6 +199,7 @@ func NewManager(p ManagerParams) (Manager, error) {
 		logger:          p.Logger.WithTags(tag.WorkflowDomainName(domainName), tag.WorkflowTaskListName(p.TaskList.GetName()), tag.WorkflowTaskListType(p.TaskList.GetType())),
 		db:              db,
 		taskAckManager:  messaging.NewAckManager(p.Logger),
+		subqueues:       make(map[subqueueKey]*subqueue),
 		taskGC:          newTaskGC(db, taskListConfig),
 		config:          taskListConfig,
 		matchingClient:  p.MatchingClient,
@@ -250,8 +256,8 @@ func NewManager(p ManagerParams) (Manager, error) {
 	}
 	tlMgr.limiter = newTaskListLimiter(p.TimeSource, tlMgr.scope, taskListConfig, numReadPartitionsFn)
 	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, p.TaskList, p.TaskListKind, tlMgr.limiter).(*taskMatcherImpl)
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
-	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
+	tlMgr.taskWriter = newTaskWriter(tlMgr, db, tlMgr.taskAckManager)
+	tlMgr.taskReader = newTaskReader(tlMgr, db, tlMgr.taskWriter, tlMgr.taskGC, tlMgr.taskAckManager, isolationGroups)
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
@@ -303,7 +309,13 @@ func (c *taskListManagerImpl) Start(ctx context.Context) error {
 			}()
 		}
 	}
-	go c.watchForStop()
+	if c.backlogSubqueuesEnabled() {
+		if err := c.loadSubqueues(); err != nil {
+			c.Stop()
+			return err
+		}
+	}
+	go c.watchForStop(c.taskReader, c.taskWriter)
 	c.liveness.Start()
 	c.taskReader.Start()
 	c.qpsTracker.Start()
@@ -334,17 +346,18 @@ func (c *taskListManagerImpl) Stop() {
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.stopSubqueues()
 	c.matcher.DisconnectBlockedPollers()
 	c.stopWG.Wait()
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
 
-// watchForStop unloads the task list when the reader or the writer reports a fatal error,
-// usually a lease lost to another host.
-func (c *taskListManagerImpl) watchForStop() {
+// watchForStop unloads the task list when the reader or the writer of its backlog or of one of its
+// sub-queues reports a fatal error, usually a lease lost to another host.
+func (c *taskListManagerImpl) watchForStop(reader *taskReader, writer *taskWriter) {
 	select {
-	case <-c.taskReader.Fatal():
-	case <-c.taskWriter.Fatal():
+	case <-reader.Fatal():
+	case <-writer.Fatal():
 	case <-c.shutdownCh:
 		// the task list is already being stopped by its owner, nothing to do
 		return
@@ -408,7 +421,7 @@ func (c *taskListManagerImpl) LoadBalancerHints() *types.LoadBalancerHints {
 		return nil
 	}
 	return &types.LoadBalancerHints{
-		BacklogCount:  c.taskAckManager.GetBacklogCount(),
+		BacklogCount:  c.backlogCount(),
 		RatePerSecond: c.qpsTracker.QPS(),
 	}
 }
@@ -593,12 +606,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 		// Persist the standby task, but the sync match still fails.
 		// Return the false syncMatch flag along with any error
-		_, err = c.taskWriter.appendTask(ctx, params.TaskInfo)
-		if err == nil {
-			// Signal the task reader only if appendTask succeeded
-			c.taskReader.Signal()
-		}
-		return syncMatch, err
+		return syncMatch, c.appendTask(ctx, params.TaskInfo)
 	}
 
 	isolationGroup, _ := c.getIsolationGroupForTask(ctx, params.TaskInfo)
@@ -620,10 +628,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 
 	e.EventName = "Task Sent to Writer"
 	event.Log(e)
-	if _, err := c.taskWriter.appendTask(ctx, params.TaskInfo); err != nil {
+	if err := c.appendTask(ctx, params.TaskInfo); err != nil {
 		return syncMatch, err
 	}
-	c.taskReader.Signal()
 	return syncMatch, nil
 }
 
@@ -695,7 +702,7 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, fmt.Errorf("couldn't get task: %w", err)
 	}
 	task.domainName = c.domainName
-	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	task.BacklogCountHint = c.backlogCount()
 	return task, nil
 }
 
@@ -790,7 +797,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
-		BacklogCountHint: c.taskAckManager.GetBacklogCount(),
+		BacklogCountHint: c.backlogCount(),
 		RatePerSecond:    float64(c.limiter.Limit()),
 		TaskIDBlock: &types.TaskIDBlock{
 			StartID: idBlock.start,
@@ -798,7 +805,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		},
 		IsolationGroupMetrics: isolationGroupMetrics,
 		NewTasksPerSecond:     c.qpsTracker.QPS(),
-		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		Empty:                 c.isBacklogEmpty(),
 	}
 
 	return response
@@ -1125,6 +1132,18 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		IsolationGroupsPerPartition: func() int {
 			return cfg.IsolationGroupsPerPartition(domainName, taskListName, taskType)
 		},
+		PriorityDispatchFairnessInterval: func() int {
+			return cfg.PriorityDispatchFairnessInterval(domainName, taskListName, taskType)
+		},
+		EnableFairnessKeyDispatch: func() bool {
+			return cfg.EnableFairnessKeyDispatch(domainName, taskListName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return cfg.FairnessKeyWeights(domainName)
+		},
+		EnableBacklogSubqueues: func() bool {
+			return cfg.EnableBacklogSubqueues(domainName, taskListName, taskType)
+		},
 		QPSTrackerInterval: func() time.Duration {
 			return cfg.QPSTrackerInterval(domainName, taskListName, taskType)
 		},
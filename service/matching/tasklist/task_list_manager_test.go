@@ -132,7 +132,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) { tlm.taskReader.cancelFunc() },
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{})
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -166,7 +166,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Put(context.Background(), &persistence.TaskInfo{})
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -229,7 +229,10 @@ func createTestTaskListManager(t *testing.T, logger log.Logger, controller *gomo
 }
 
 func createTestTaskListManagerWithConfig(t *testing.T, logger log.Logger, controller *gomock.Controller, cfg *config.Config, timeSource clock.TimeSource) *taskListManagerImpl {
-	tm := NewTestTaskManager(t, logger, timeSource)
+	return createTestTaskListManagerWithTaskManager(t, logger, controller, cfg, timeSource, NewTestTaskManager(t, logger, timeSource))
+}
+
+func createTestTaskListManagerWithTaskManager(t *testing.T, logger log.Logger, controller *gomock.Controller, cfg *config.Config, timeSource clock.TimeSource, tm *TestTaskManager) *taskListManagerImpl {
 	mockIsolationState := isolationgroup.NewMockState(controller)
 	mockIsolationState.EXPECT().IsDrained(gomock.Any(), "domainName", gomock.Any()).Return(false, nil).AnyTimes()
 	mockDomainCache := cache.NewMockDomainCache(controller)
@@ -1031,9 +1034,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := min(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Cap(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -1318,7 +1321,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].Len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
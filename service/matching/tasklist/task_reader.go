@@ -64,7 +64,8 @@ type (
 		// that are enqueued for pollers to pickup. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
+		// Buffered tasks are dispatched by priority, see taskBuffer.
+		taskBuffers     map[string]*taskBuffer
 		notifyC         chan struct{} // Used as signal to notify pump of new tasks
 		tlMgr           *taskListManagerImpl
 		taskListID      *Identifier
@@ -98,12 +99,23 @@ type (
 		// unusable. The reader only reports it, the manager owns the teardown.
 		fatalCh   chan struct{}
 		fatalOnce sync.Once
+
+		// subqueue is true if the reader reads a backlog sub-queue, the backlog metrics of the
+		// task list are emitted by the reader of the task list itself
+		subqueue bool
 	}
 )
 
-func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
+func newTaskReader(
+	tlMgr *taskListManagerImpl,
+	db *taskListDB,
+	taskWriter *taskWriter,
+	taskGC *taskGC,
+	taskAckManager messaging.AckManager,
+	isolationGroups []string,
+) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
+	taskBuffers := make(map[string]*taskBuffer)
 
 	// Validate batch size to prevent system failures
 	batchSize := tlMgr.config.GetTasksBatchSize()
@@ -115,24 +127,24 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		batchSize = fallback
 	}
 
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, batchSize-1)
+	// we always dequeue the head of the buffer and try to dispatch it to a poller
+	// so allocate one less than desired target buffer size
+	taskBuffers[defaultTaskBufferIsolationGroup] = newTaskBuffer(batchSize-1, tlMgr.config)
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, batchSize-1)
+		taskBuffers[g] = newTaskBuffer(batchSize-1, tlMgr.config)
 	}
 	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
-		fatalCh:        make(chan struct{}),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
+		tlMgr:                    tlMgr,
+		taskListID:               tlMgr.taskListID,
+		config:                   tlMgr.config,
+		db:                       db,
+		taskWriter:               taskWriter,
+		taskGC:                   taskGC,
+		taskAckManager:           taskAckManager,
+		cancelCtx:                ctx,
+		cancelFunc:               cancel,
+		notifyC:                  make(chan struct{}, 1),
+		fatalCh:                  make(chan struct{}),
 		taskBuffers:              taskBuffers,
 		domainCache:              tlMgr.domainCache,
 		clusterMetadata:          tlMgr.clusterMetadata,
@@ -198,27 +210,22 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
-dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
-				break dispatchLoop
-			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+		taskInfo, ok := tr.taskBuffers[isolationGroup].Get(tr.cancelCtx)
+		if !ok { // Task list is shutting down
+			return
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
+		if breakDispatchLoop {
+			// shutting down
+			return
 		}
 	}
 }
@@ -228,7 +235,9 @@ func (tr *taskReader) getTasksPump() {
 	defer updateAckTimer.Stop()
 getTasksPumpLoop:
 	for {
-		tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.taskAckManager.GetBacklogCount()))
+		if !tr.subqueue {
+			tr.scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.tlMgr.backlogCount()))
+		}
 		select {
 		case <-tr.cancelCtx.Done():
 			break getTasksPumpLoop
@@ -269,8 +278,8 @@ getTasksPumpLoop:
 		case <-updateAckTimer.Chan():
 			{
 				ackLevel := tr.taskAckManager.GetAckLevel()
-				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil {
-					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size))
+				if size, err := tr.db.GetTaskListSize(ackLevel); err == nil && !tr.subqueue {
+					tr.scope.UpdateGauge(metrics.TaskCountPerTaskListGauge, float64(size+tr.tlMgr.subqueuesSize()))
 				}
 				if err := tr.persistAckLevel(); err != nil {
 					var condErr *persistence.ConditionFailedError
@@ -378,12 +387,7 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	if !ok {
 		buffer = tr.taskBuffers[defaultTaskBufferIsolationGroup]
 	}
-	select {
-	case buffer <- task:
-		return true
-	case <-tr.cancelCtx.Done():
-		return false
-	}
+	return buffer.Put(tr.cancelCtx, task)
 }
 
 func (tr *taskReader) persistAckLevel() error {
@@ -392,7 +396,9 @@ func (tr *taskReader) persistAckLevel() error {
 		maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 		// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 		// especially when task list ownership changes.
-		tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+		if !tr.subqueue {
+			tr.scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+		}
 
 		return tr.db.UpdateState(ackLevel)
 	}
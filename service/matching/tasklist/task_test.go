@@ -28,6 +28,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -128,6 +129,54 @@ func TestNewInternalTask(t *testing.T) {
 				isolationgroup.WorkflowIDKey:    "workflowID",
 			},
 		},
+		{
+			name:   "priority",
+			source: types.TaskSourceHistory,
+			partitionConfig: map[string]string{
+				constants.PartitionConfigKeyForTaskPriority: "3",
+			},
+			expectedPartitionConfig: map[string]string{
+				constants.PartitionConfigKeyForTaskPriority: "3",
+			},
+			additionalAssertions: func(t *testing.T, task *InternalTask) {
+				assert.Equal(t, int32(3), task.priority)
+			},
+		},
+		{
+			name:           "priority - tasklist isolation",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "a",
+			partitionConfig: map[string]string{
+				isolationgroup.GroupKey:                     "a",
+				isolationgroup.WorkflowIDKey:                "workflowID",
+				constants.PartitionConfigKeyForTaskPriority: "2",
+			},
+			expectedPartitionConfig: map[string]string{
+				isolationgroup.OriginalGroupKey:             "a",
+				isolationgroup.GroupKey:                     "a",
+				isolationgroup.WorkflowIDKey:                "workflowID",
+				constants.PartitionConfigKeyForTaskPriority: "2",
+			},
+			additionalAssertions: func(t *testing.T, task *InternalTask) {
+				assert.Equal(t, int32(2), task.priority)
+			},
+		},
+		{
+			name:           "fairness key - tasklist isolation",
+			source:         types.TaskSourceDbBacklog,
+			isolationGroup: "a",
+			partitionConfig: map[string]string{
+				isolationgroup.GroupKey:                        "a",
+				isolationgroup.WorkflowIDKey:                   "workflowID",
+				constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a",
+			},
+			expectedPartitionConfig: map[string]string{
+				isolationgroup.OriginalGroupKey:                "a",
+				isolationgroup.GroupKey:                        "a",
+				isolationgroup.WorkflowIDKey:                   "workflowID",
+				constants.PartitionConfigKeyForTaskFairnessKey: "tenant-a",
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 var _ persistence.TaskManager = (*TestTaskManager)(nil) // Asserts that interface is indeed implemented
@@ -43,11 +44,19 @@ type (
 	TestTaskManager struct {
 		sync.Mutex
 		t          *testing.T
-		taskLists  map[Identifier]*testTaskListManager
+		taskLists  map[testTaskListKey]*testTaskListManager
 		logger     log.Logger
 		timeSource clock.TimeSource
 	}
 
+	// testTaskListKey identifies a persisted task list by its raw name, which can be the name
+	// of a backlog sub-queue and not a valid task list identifier
+	testTaskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
 	testTaskListManager struct {
 		sync.RWMutex
 		rangeID                 int64
@@ -66,7 +75,7 @@ func newTestTaskListManager() *testTaskListManager {
 func NewTestTaskManager(t *testing.T, logger log.Logger, timeSource clock.TimeSource) *TestTaskManager {
 	return &TestTaskManager{
 		t:          t,
-		taskLists:  make(map[Identifier]*testTaskListManager),
+		taskLists:  make(map[testTaskListKey]*testTaskListManager),
 		logger:     logger,
 		timeSource: timeSource,
 	}
@@ -84,7 +93,7 @@ func (m *TestTaskManager) LeaseTaskList(
 	_ context.Context,
 	request *persistence.LeaseTaskListRequest,
 ) (*persistence.LeaseTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	if request.RangeID > 0 && request.RangeID != tlm.rangeID {
@@ -114,7 +123,12 @@ func (m *TestTaskManager) GetTaskList(
 	_ context.Context,
 	request *persistence.GetTaskListRequest,
 ) (*persistence.GetTaskListResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	m.Lock()
+	tlm, ok := m.taskLists[newTestTaskListKey(request.DomainID, request.TaskList, request.TaskType)]
+	m.Unlock()
+	if !ok {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("task list %v does not exist", request.TaskList)}
+	}
 	tlm.RLock()
 	defer tlm.RUnlock()
 	return &persistence.GetTaskListResponse{
@@ -138,7 +152,7 @@ func (m *TestTaskManager) UpdateTaskList(
 	m.logger.Debug(fmt.Sprintf("testTaskManager.UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -162,7 +176,7 @@ func (m *TestTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskList
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, tli.DomainID, tli.Name, tli.TaskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(tli.DomainID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -177,7 +191,7 @@ func (m *TestTaskManager) CompleteTasksLessThan(
 	request *persistence.CompleteTasksLessThanRequest,
 ) (*persistence.CompleteTasksLessThanResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.CompleteTasksLessThan taskID=%v", request.TaskID))
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(request.DomainID, request.TaskListName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	rowsDeleted := 0
@@ -207,8 +221,7 @@ func (m *TestTaskManager) DeleteTaskList(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType)
-	delete(m.taskLists, *key)
+	delete(m.taskLists, newTestTaskListKey(request.DomainID, request.TaskListName, request.TaskListType))
 	return nil
 }
 
@@ -222,7 +235,7 @@ func (m *TestTaskManager) CreateTasks(
 	taskType := request.TaskListInfo.TaskType
 	rangeID := request.TaskListInfo.RangeID
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, domainID, taskList, taskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(domainID, taskList, taskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -276,7 +289,7 @@ func (m *TestTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, *request.MaxReadLevel))
 
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskList, request.TaskType))
+	tlm := m.getTaskListManager(newTestTaskListKey(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistence.TaskInfo
@@ -298,7 +311,7 @@ func (m *TestTaskManager) GetTasks(
 }
 
 func (m *TestTaskManager) GetTaskListSize(_ context.Context, request *persistence.GetTaskListSizeRequest) (*persistence.GetTaskListSizeResponse, error) {
-	tlm := m.getTaskListManager(NewTestTaskListID(m.t, request.DomainID, request.TaskListName, request.TaskListType))
+	tlm := m.getTaskListManager(newTestTaskListKey(request.DomainID, request.TaskListName, request.TaskListType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	count := int64(0)
@@ -319,7 +332,7 @@ func (m *TestTaskManager) GetOrphanTasks(_ context.Context, request *persistence
 
 // getTaskCount returns number of tasks in a task list
 func (m *TestTaskManager) GetTaskCount(taskList *Identifier) int {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(newTestTaskListKey(taskList.GetDomainID(), taskList.GetName(), taskList.GetType()))
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.tasks.Size()
@@ -327,35 +340,35 @@ func (m *TestTaskManager) GetTaskCount(taskList *Identifier) int {
 
 // getCreateTaskCount returns how many times CreateTask was called
 func (m *TestTaskManager) GetCreateTaskCount(taskList *Identifier) int {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(newTestTaskListKey(taskList.GetDomainID(), taskList.GetName(), taskList.GetType()))
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.createTaskCount
 }
 
 func (m *TestTaskManager) SetRangeID(taskList *Identifier, rangeID int64) {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(newTestTaskListKey(taskList.GetDomainID(), taskList.GetName(), taskList.GetType()))
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID = rangeID
 }
 
 func (m *TestTaskManager) GetRangeID(taskList *Identifier) int64 {
-	tlm := m.getTaskListManager(taskList)
+	tlm := m.getTaskListManager(newTestTaskListKey(taskList.GetDomainID(), taskList.GetName(), taskList.GetType()))
 	tlm.Lock()
 	defer tlm.Unlock()
 	return tlm.rangeID
 }
 
-func (m *TestTaskManager) getTaskListManager(id *Identifier) *testTaskListManager {
+func (m *TestTaskManager) getTaskListManager(key testTaskListKey) *testTaskListManager {
 	m.Lock()
 	defer m.Unlock()
-	result, ok := m.taskLists[*id]
+	result, ok := m.taskLists[key]
 	if ok {
 		return result
 	}
 	result = newTestTaskListManager()
-	m.taskLists[*id] = result
+	m.taskLists[key] = result
 	return result
 }
 
@@ -386,6 +399,10 @@ func (m *TestTaskManager) String() string {
 	return result
 }
 
+func newTestTaskListKey(domainID string, taskListName string, taskType int) testTaskListKey {
+	return testTaskListKey{domainID: domainID, name: taskListName, taskType: taskType}
+}
+
 func NewTestTaskListID(t *testing.T, domainID string, taskListName string, taskType int) *Identifier {
 	id, err := NewIdentifier(domainID, taskListName, taskType)
 	if err != nil {
@@ -36,7 +36,9 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -71,6 +73,27 @@ func WithEmitConsumerCountMetrifFn(fn func(int)) ConsumerManagerOptions {
 	}
 }
 
+// WithQueueManager sets the persistence queue handed to queues backed by the Cadence database
+func WithQueueManager(queueManager persistence.QueueManager) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.queueManager = queueManager
+	}
+}
+
+// WithMembershipResolver sets the resolver consumers use to agree on a single owner across worker hosts
+func WithMembershipResolver(resolver membership.Resolver) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.membershipResolver = resolver
+	}
+}
+
+// WithRequestStatusManager sets the manager consumers use to record the outcome of tracked requests
+func WithRequestStatusManager(statusManager persistence.AsyncWorkflowRequestStatusManager) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.statusManager = statusManager
+	}
+}
+
 func withAfterIterFn(fn func()) ConsumerManagerOptions {
 	return func(c *ConsumerManager) { c.afterIterFn = fn }
 }
@@ -116,6 +139,9 @@ type ConsumerManager struct {
 	domainCache               cache.DomainCache
 	queueProvider             queue.Provider
 	frontendClient            frontend.Client
+	queueManager              persistence.QueueManager
+	membershipResolver        membership.Resolver
+	statusManager             persistence.AsyncWorkflowRequestStatusManager
 	refreshInterval           time.Duration
 	shutdownTimeout           time.Duration
 	ctx                       context.Context
@@ -228,9 +254,14 @@ func (c *ConsumerManager) refreshConsumers() {
 
 		c.logger.Info("Starting consumer", tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
 		consumer, err := queue.CreateConsumer(&provider.Params{
-			Logger:         c.logger,
-			MetricsClient:  c.metricsClient,
-			FrontendClient: c.frontendClient,
+			Logger:               c.logger,
+			MetricsClient:        c.metricsClient,
+			FrontendClient:       c.frontendClient,
+			QueueManager:         c.queueManager,
+			MembershipResolver:   c.membershipResolver,
+			RequestStatusManager: c.statusManager,
+			DomainCache:          c.domainCache,
+			TimeSource:           c.timeSrc,
 		})
 		if err != nil {
 			c.logger.Error("Failed to create consumer", tag.Error(err), tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
@@ -0,0 +1,1225 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+const (
+	defaultPageSize = 100
+
+	// history nodes are read one at a time, as this is the only way to learn the transaction ID of every node
+	historyNodePageSize = 1
+)
+
+// taskCategories are the history task categories copied along with a shard
+var taskCategories = []persistence.HistoryTaskCategory{
+	persistence.HistoryTaskCategoryTransfer,
+	persistence.HistoryTaskCategoryTimer,
+	persistence.HistoryTaskCategoryReplication,
+}
+
+type (
+	// Stores are the persistence managers of one side of a migration
+	Stores struct {
+		Shard     persistence.ShardManager
+		Execution persistence.ExecutionManager
+		Domain    persistence.DomainManager
+		Task      persistence.TaskManager
+		// ConfigStore records the verified shards, only the one of the source is used
+		ConfigStore persistence.ConfigStoreManager
+		// History is the history store rather than the manager, so that nodes are copied with
+		// their original transaction IDs and interleave correctly with mirrored appends
+		History persistence.HistoryStore
+		// Visibility is nil when db visibility records are not migrated
+		Visibility persistence.VisibilityManager
+	}
+
+	// Copier copies domains, shards and workflow executions from the source to the target stores.
+	// Every copy is idempotent: it converges the target to the state the source had when it was read.
+	Copier struct {
+		source   *Stores
+		target   *Stores
+		encoder  *codec.ThriftRWEncoder
+		pageSize int
+		logger   log.Logger
+		timeSrc  func() time.Time
+	}
+
+	historyNode struct {
+		nodeID int64
+		txnID  int64
+		events *persistence.DataBlob
+	}
+)
+
+// NewCopier creates a new Copier
+func NewCopier(source, target *Stores, logger log.Logger) *Copier {
+	return &Copier{
+		source:   source,
+		target:   target,
+		encoder:  codec.NewThriftRWEncoder(),
+		pageSize: defaultPageSize,
+		logger:   logger,
+		timeSrc:  time.Now,
+	}
+}
+
+// CopyDomains creates or updates every source domain in the target and returns the number of domains written
+func (c *Copier) CopyDomains(ctx context.Context) (int, error) {
+	copied := 0
+	var token []byte
+	for {
+		resp, err := c.source.Domain.ListDomains(ctx, &persistence.ListDomainsRequest{
+			PageSize:      c.pageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return copied, fmt.Errorf("list source domains: %w", err)
+		}
+		for _, domain := range resp.Domains {
+			written, err := c.copyDomain(ctx, domain)
+			if err != nil {
+				return copied, err
+			}
+			if written {
+				copied++
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return copied, nil
+		}
+		token = resp.NextPageToken
+	}
+}
+
+func (c *Copier) copyDomain(ctx context.Context, source *persistence.GetDomainResponse) (bool, error) {
+	target, err := c.target.Domain.GetDomain(ctx, &persistence.GetDomainRequest{ID: source.Info.ID})
+	if isNotExists(err) {
+		_, err := c.target.Domain.CreateDomain(ctx, &persistence.CreateDomainRequest{
+			Info:              source.Info,
+			Config:            source.Config,
+			ReplicationConfig: source.ReplicationConfig,
+			IsGlobalDomain:    source.IsGlobalDomain,
+			ConfigVersion:     source.ConfigVersion,
+			FailoverVersion:   source.FailoverVersion,
+			LastUpdatedTime:   source.LastUpdatedTime,
+			CurrentTimeStamp:  c.timeSrc(),
+		})
+		if err != nil {
+			return false, fmt.Errorf("create domain %v in target: %w", source.Info.Name, err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get domain %v from target: %w", source.Info.Name, err)
+	}
+	if target.ConfigVersion == source.ConfigVersion &&
+		target.FailoverVersion == source.FailoverVersion &&
+		target.LastUpdatedTime == source.LastUpdatedTime {
+		return false, nil
+	}
+
+	// notification versions are tracked per store, so the update has to use the target's
+	metadata, err := c.target.Domain.GetMetadata(ctx)
+	if err != nil {
+		return false, fmt.Errorf("get target domain metadata: %w", err)
+	}
+	err = c.target.Domain.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        source.Info,
+		Config:                      source.Config,
+		ReplicationConfig:           source.ReplicationConfig,
+		ConfigVersion:               source.ConfigVersion,
+		FailoverVersion:             source.FailoverVersion,
+		FailoverNotificationVersion: source.FailoverNotificationVersion,
+		PreviousFailoverVersion:     source.PreviousFailoverVersion,
+		FailoverEndTime:             source.FailoverEndTime,
+		LastUpdatedTime:             source.LastUpdatedTime,
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+	if err != nil {
+		return false, fmt.Errorf("update domain %v in target: %w", source.Info.Name, err)
+	}
+	return true, nil
+}
+
+// CopyShard copies the shard record and its pending history tasks.
+// The target shard is moved to the source range ID unless it is already ahead of it.
+func (c *Copier) CopyShard(ctx context.Context, shardID int) error {
+	source, err := c.source.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return fmt.Errorf("get source shard %v: %w", shardID, err)
+	}
+	rangeID, err := c.copyShardInfo(ctx, source.ShardInfo)
+	if err != nil {
+		return err
+	}
+	for _, category := range taskCategories {
+		if err := c.copyHistoryTasks(ctx, shardID, rangeID, category); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Copier) copyShardInfo(ctx context.Context, info *persistence.ShardInfo) (int64, error) {
+	target, err := c.target.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: info.ShardID})
+	if isNotExists(err) {
+		if err := c.target.Shard.CreateShard(ctx, &persistence.CreateShardRequest{ShardInfo: info}); err != nil {
+			return 0, fmt.Errorf("create shard %v in target: %w", info.ShardID, err)
+		}
+		return info.RangeID, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get target shard %v: %w", info.ShardID, err)
+	}
+	if target.ShardInfo.RangeID >= info.RangeID {
+		return target.ShardInfo.RangeID, nil
+	}
+	err = c.target.Shard.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       info,
+		PreviousRangeID: target.ShardInfo.RangeID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("update shard %v in target: %w", info.ShardID, err)
+	}
+	return info.RangeID, nil
+}
+
+func (c *Copier) copyHistoryTasks(
+	ctx context.Context,
+	shardID int,
+	rangeID int64,
+	category persistence.HistoryTaskCategory,
+) error {
+	missing, err := c.missingHistoryTasks(ctx, shardID, category)
+	if err != nil {
+		return err
+	}
+	for len(missing) > 0 {
+		batch := missing[:min(len(missing), c.pageSize)]
+		missing = missing[len(batch):]
+		err := c.target.Execution.CreateHistoryTasks(ctx, &persistence.CreateHistoryTasksRequest{
+			ShardID:         common.IntPtr(shardID),
+			RangeID:         rangeID,
+			TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{category: batch},
+		})
+		if err != nil {
+			return fmt.Errorf("create %v tasks of shard %v in target: %w", category.Name(), shardID, err)
+		}
+	}
+	return nil
+}
+
+// missingHistoryTasks returns the tasks of the source shard that are missing in the target
+func (c *Copier) missingHistoryTasks(
+	ctx context.Context,
+	shardID int,
+	category persistence.HistoryTaskCategory,
+) ([]persistence.Task, error) {
+	// task IDs are unique within a shard, and unlike scheduled times they do not lose precision between stores
+	existing, err := c.historyTaskIDs(ctx, c.target.Execution, shardID, category)
+	if err != nil {
+		return nil, fmt.Errorf("read %v tasks of shard %v from target: %w", category.Name(), shardID, err)
+	}
+	var missing []persistence.Task
+	err = c.forEachHistoryTask(ctx, c.source.Execution, shardID, category, func(task persistence.Task) {
+		if _, ok := existing[task.GetTaskID()]; !ok {
+			missing = append(missing, task)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read %v tasks of shard %v from source: %w", category.Name(), shardID, err)
+	}
+	return missing, nil
+}
+
+func (c *Copier) historyTaskIDs(
+	ctx context.Context,
+	manager persistence.ExecutionManager,
+	shardID int,
+	category persistence.HistoryTaskCategory,
+) (map[int64]struct{}, error) {
+	taskIDs := make(map[int64]struct{})
+	err := c.forEachHistoryTask(ctx, manager, shardID, category, func(task persistence.Task) {
+		taskIDs[task.GetTaskID()] = struct{}{}
+	})
+	return taskIDs, err
+}
+
+func (c *Copier) forEachHistoryTask(
+	ctx context.Context,
+	manager persistence.ExecutionManager,
+	shardID int,
+	category persistence.HistoryTaskCategory,
+	fn func(persistence.Task),
+) error {
+	minKey, maxKey := persistence.MinimumHistoryTaskKey, persistence.MaximumHistoryTaskKey
+	if category.Type() == persistence.HistoryTaskCategoryTypeImmediate {
+		minKey, maxKey = persistence.NewImmediateTaskKey(0), persistence.NewImmediateTaskKey(math.MaxInt64)
+	}
+	request := &persistence.GetHistoryTasksRequest{
+		ShardID:             common.IntPtr(shardID),
+		TaskCategory:        category,
+		InclusiveMinTaskKey: minKey,
+		ExclusiveMaxTaskKey: maxKey,
+		PageSize:            c.pageSize,
+	}
+	for {
+		resp, err := manager.GetHistoryTasks(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, task := range resp.Tasks {
+			fn(task)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// CopyShardExecutions copies every execution of the source shard that is not in sync with the target
+// and returns the number of executions copied
+func (c *Copier) CopyShardExecutions(ctx context.Context, shardID int) (int, error) {
+	copied := 0
+	request := &persistence.ListConcreteExecutionsRequest{
+		ShardID:  common.IntPtr(shardID),
+		PageSize: c.pageSize,
+	}
+	for {
+		resp, err := c.source.Execution.ListConcreteExecutions(ctx, request)
+		if err != nil {
+			return copied, fmt.Errorf("list executions of shard %v from source: %w", shardID, err)
+		}
+		for _, e := range resp.Executions {
+			execution := &entity.Execution{
+				ShardID:    shardID,
+				DomainID:   e.ExecutionInfo.DomainID,
+				WorkflowID: e.ExecutionInfo.WorkflowID,
+				RunID:      e.ExecutionInfo.RunID,
+				State:      e.ExecutionInfo.State,
+			}
+			diff, err := c.VerifyExecution(ctx, execution)
+			if err != nil {
+				return copied, err
+			}
+			if diff == "" {
+				continue
+			}
+			if err := c.CopyExecution(ctx, execution); err != nil {
+				return copied, err
+			}
+			logExecution(c.logger, execution).Info("copied execution to persistence migration target", tag.Value(diff))
+			copied++
+		}
+		if len(resp.PageToken) == 0 {
+			return copied, nil
+		}
+		request.PageToken = resp.PageToken
+	}
+}
+
+// VerifyShard compares the shard record, the pending history tasks and every execution of the shard between
+// the source and the target, and returns a description of the first difference found, or an empty string
+// when the target is in sync. A shard is only switched to the target once it is verified.
+func (c *Copier) VerifyShard(ctx context.Context, shardID int) (string, error) {
+	source, err := c.source.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return "", fmt.Errorf("get source shard %v: %w", shardID, err)
+	}
+	target, err := c.target.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if isNotExists(err) {
+		return "shard is missing in target", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get target shard %v: %w", shardID, err)
+	}
+	if target.ShardInfo.RangeID < source.ShardInfo.RangeID {
+		return fmt.Sprintf("range ID is %v in source but %v in target",
+			source.ShardInfo.RangeID, target.ShardInfo.RangeID), nil
+	}
+
+	for _, category := range taskCategories {
+		missing, err := c.missingHistoryTasks(ctx, shardID, category)
+		if err != nil || len(missing) == 0 {
+			return "", err
+		}
+		// tasks created while the stores were read are mirrored right after, so they are read again
+		existing, err := c.historyTaskIDs(ctx, c.target.Execution, shardID, category)
+		if err != nil {
+			return "", fmt.Errorf("read %v tasks of shard %v from target: %w", category.Name(), shardID, err)
+		}
+		for _, task := range missing {
+			if _, ok := existing[task.GetTaskID()]; !ok {
+				return fmt.Sprintf("%v task %v is missing in target", category.Name(), task.GetTaskID()), nil
+			}
+		}
+	}
+
+	request := &persistence.ListConcreteExecutionsRequest{
+		ShardID:  common.IntPtr(shardID),
+		PageSize: c.pageSize,
+	}
+	for {
+		resp, err := c.source.Execution.ListConcreteExecutions(ctx, request)
+		if err != nil {
+			return "", fmt.Errorf("list executions of shard %v from source: %w", shardID, err)
+		}
+		for _, e := range resp.Executions {
+			execution := &entity.Execution{
+				ShardID:    shardID,
+				DomainID:   e.ExecutionInfo.DomainID,
+				WorkflowID: e.ExecutionInfo.WorkflowID,
+				RunID:      e.ExecutionInfo.RunID,
+				State:      e.ExecutionInfo.State,
+			}
+			diff, err := c.VerifyExecution(ctx, execution)
+			if err != nil {
+				return "", err
+			}
+			if diff != "" {
+				// an update can land between the reads of the source and the target, it's mirrored right after
+				if diff, err = c.VerifyExecution(ctx, execution); err != nil {
+					return "", err
+				}
+			}
+			if diff != "" {
+				return fmt.Sprintf("execution %v/%v: %v", execution.WorkflowID, execution.RunID, diff), nil
+			}
+		}
+		if len(resp.PageToken) == 0 {
+			return "", nil
+		}
+		request.PageToken = resp.PageToken
+	}
+}
+
+// RecordVerifiedShards records the shards as verified, which lets the shard routers of the servers
+// switch them to the target datastore once their migration mode is target
+func (c *Copier) RecordVerifiedShards(ctx context.Context, shardIDs []int) error {
+	if err := migration.AddVerifiedShards(ctx, c.source.ConfigStore, shardIDs); err != nil {
+		return fmt.Errorf("record verified shards: %w", err)
+	}
+	return nil
+}
+
+// VerifyExecution compares the execution in the source and the target and returns a description of
+// the first difference found, or an empty string when the target is in sync.
+// Only the fields every workflow update touches are compared, which is enough to detect a missed or failed mirrored write.
+func (c *Copier) VerifyExecution(ctx context.Context, execution *entity.Execution) (string, error) {
+	domain, err := c.sourceDomain(ctx, execution.DomainID)
+	if err != nil {
+		return "", err
+	}
+	source, err := getExecution(ctx, c.source.Execution, execution, domain.Info.Name)
+	if err != nil {
+		return "", fmt.Errorf("get execution from source: %w", err)
+	}
+	target, err := getExecution(ctx, c.target.Execution, execution, domain.Info.Name)
+	if err != nil {
+		return "", fmt.Errorf("get execution from target: %w", err)
+	}
+	if diff := compareMutableStates(source, target); diff != "" || source == nil {
+		return diff, nil
+	}
+	return c.compareLastHistoryNode(ctx, execution.ShardID, source)
+}
+
+// CopyExecution copies the domain, history, mutable state and visibility record of the execution.
+// A target execution that differs from the source is overwritten on the condition that its next event ID
+// didn't change since it was read, and a target execution that no longer exists in the source is deleted.
+func (c *Copier) CopyExecution(ctx context.Context, execution *entity.Execution) error {
+	domain, err := c.sourceDomain(ctx, execution.DomainID)
+	if err != nil {
+		return err
+	}
+	if _, err := c.copyDomain(ctx, domain); err != nil {
+		return err
+	}
+	domainName := domain.Info.Name
+	// the target is read first: a write mirrored after that read changes the next event ID the
+	// overwrite is conditioned on, while a write mirrored before it is also in the source read after
+	target, err := getExecution(ctx, c.target.Execution, execution, domainName)
+	if err != nil {
+		return fmt.Errorf("get execution from target: %w", err)
+	}
+	source, err := getExecution(ctx, c.source.Execution, execution, domainName)
+	if err != nil {
+		return fmt.Errorf("get execution from source: %w", err)
+	}
+	if source == nil {
+		if target == nil {
+			return nil
+		}
+		return c.deleteTargetExecution(ctx, execution, domainName)
+	}
+
+	if err := c.copyHistory(ctx, execution.ShardID, source); err != nil {
+		return err
+	}
+	switch {
+	case target == nil:
+		err = c.createExecution(ctx, execution.ShardID, domainName, source)
+	case compareMutableStates(source, target) != "":
+		err = c.resetExecution(ctx, execution.ShardID, domainName, source, target)
+	}
+	if err != nil {
+		return err
+	}
+	return c.copyVisibility(ctx, execution.ShardID, domain, source)
+}
+
+func (c *Copier) deleteTargetExecution(ctx context.Context, execution *entity.Execution, domainName string) error {
+	err := c.target.Execution.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:    common.IntPtr(execution.ShardID),
+		DomainID:   execution.DomainID,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return fmt.Errorf("delete execution from target: %w", err)
+	}
+	current, err := c.target.Execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:    common.IntPtr(execution.ShardID),
+		DomainID:   execution.DomainID,
+		WorkflowID: execution.WorkflowID,
+		DomainName: domainName,
+	})
+	if isNotExists(err) || (err == nil && current.RunID != execution.RunID) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get current execution from target: %w", err)
+	}
+	err = c.target.Execution.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:    common.IntPtr(execution.ShardID),
+		DomainID:   execution.DomainID,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return fmt.Errorf("delete current execution from target: %w", err)
+	}
+	return nil
+}
+
+// createExecution writes the mutable state to the target. The persistence API only creates open
+// workflows or zombies, so closed runs are first created as a placeholder and then conflict resolved
+// to their actual state.
+func (c *Copier) createExecution(
+	ctx context.Context,
+	shardID int,
+	domainName string,
+	state *persistence.WorkflowMutableState,
+) error {
+	info := state.ExecutionInfo
+	target, err := c.target.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return fmt.Errorf("get target shard %v: %w", shardID, err)
+	}
+	rangeID := target.ShardInfo.RangeID
+
+	isCurrent, err := c.isCurrentExecution(ctx, shardID, domainName, info)
+	if err != nil {
+		return err
+	}
+	snapshot := snapshotFromMutableState(state)
+	create := &persistence.CreateWorkflowExecutionRequest{
+		ShardID:             common.IntPtr(shardID),
+		RangeID:             rangeID,
+		Mode:                persistence.CreateWorkflowModeZombie,
+		NewWorkflowSnapshot: *snapshot,
+		DomainName:          domainName,
+	}
+	resolveMode := persistence.ConflictResolveWorkflowModeBypassCurrent
+	if isCurrent && info.State != persistence.WorkflowStateZombie {
+		resolveMode = persistence.ConflictResolveWorkflowModeUpdateCurrent
+		create.Mode = persistence.CreateWorkflowModeBrandNew
+		current, err := c.target.Execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:    common.IntPtr(shardID),
+			DomainID:   info.DomainID,
+			WorkflowID: info.WorkflowID,
+			DomainName: domainName,
+		})
+		switch {
+		case err == nil:
+			create.Mode = persistence.CreateWorkflowModeWorkflowIDReuse
+			create.PreviousRunID = current.RunID
+			create.PreviousLastWriteVersion = current.LastWriteVersion
+		case !isNotExists(err):
+			return fmt.Errorf("get current execution from target: %w", err)
+		}
+	}
+
+	closed := info.State == persistence.WorkflowStateCompleted
+	if closed {
+		placeholderState := persistence.WorkflowStateZombie
+		if resolveMode == persistence.ConflictResolveWorkflowModeUpdateCurrent {
+			placeholderState = persistence.WorkflowStateRunning
+		}
+		create.NewWorkflowSnapshot = *placeholderSnapshot(snapshot, placeholderState)
+	}
+	if _, err := c.target.Execution.CreateWorkflowExecution(ctx, create); err != nil {
+		return fmt.Errorf("create execution in target: %w", err)
+	}
+	if closed {
+		snapshot.Condition = info.NextEventID
+		_, err := c.target.Execution.ConflictResolveWorkflowExecution(ctx, &persistence.ConflictResolveWorkflowExecutionRequest{
+			ShardID:               common.IntPtr(shardID),
+			RangeID:               rangeID,
+			Mode:                  resolveMode,
+			ResetWorkflowSnapshot: *snapshot,
+			Encoding:              constants.EncodingTypeThriftRW,
+			DomainName:            domainName,
+		})
+		if err != nil {
+			return fmt.Errorf("resolve closed execution in target: %w", err)
+		}
+	}
+	return c.copyBufferedEvents(ctx, shardID, rangeID, domainName, state, snapshot.ExecutionStats)
+}
+
+// resetExecution overwrites a target execution with the source mutable state. The write is conditioned on
+// the next event ID read from the target, so it fails rather than overwriting a mirrored write that
+// landed in the meantime, and the execution is copied again by the next scan.
+func (c *Copier) resetExecution(
+	ctx context.Context,
+	shardID int,
+	domainName string,
+	source *persistence.WorkflowMutableState,
+	target *persistence.WorkflowMutableState,
+) error {
+	info := source.ExecutionInfo
+	if target.ExecutionInfo.NextEventID > info.NextEventID {
+		return fmt.Errorf("execution is ahead in target, next event ID is %v in source but %v in target",
+			info.NextEventID, target.ExecutionInfo.NextEventID)
+	}
+	shard, err := c.target.Shard.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return fmt.Errorf("get target shard %v: %w", shardID, err)
+	}
+	rangeID := shard.ShardInfo.RangeID
+
+	// the current record is kept as it is in the target, it's moved by copying the run that is current in the source
+	mode := persistence.ConflictResolveWorkflowModeBypassCurrent
+	current, err := c.target.Execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:    common.IntPtr(shardID),
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		DomainName: domainName,
+	})
+	switch {
+	case err == nil && current.RunID == info.RunID:
+		mode = persistence.ConflictResolveWorkflowModeUpdateCurrent
+	case err != nil && !isNotExists(err):
+		return fmt.Errorf("get current execution from target: %w", err)
+	}
+	if mode == persistence.ConflictResolveWorkflowModeBypassCurrent && info.State != persistence.WorkflowStateCompleted &&
+		info.State != persistence.WorkflowStateZombie {
+		return fmt.Errorf("execution is open in source but is not the current execution in target")
+	}
+
+	snapshot := snapshotFromMutableState(source)
+	snapshot.Condition = target.ExecutionInfo.NextEventID
+	_, err = c.target.Execution.ConflictResolveWorkflowExecution(ctx, &persistence.ConflictResolveWorkflowExecutionRequest{
+		ShardID:               common.IntPtr(shardID),
+		RangeID:               rangeID,
+		Mode:                  mode,
+		ResetWorkflowSnapshot: *snapshot,
+		Encoding:              constants.EncodingTypeThriftRW,
+		DomainName:            domainName,
+	})
+	if err != nil {
+		return fmt.Errorf("reset execution in target: %w", err)
+	}
+	return c.copyBufferedEvents(ctx, shardID, rangeID, domainName, source, snapshot.ExecutionStats)
+}
+
+// copyBufferedEvents appends the buffered events of the source to the target execution, as they cannot be
+// part of a snapshot
+func (c *Copier) copyBufferedEvents(
+	ctx context.Context,
+	shardID int,
+	rangeID int64,
+	domainName string,
+	state *persistence.WorkflowMutableState,
+	stats *persistence.ExecutionStats,
+) error {
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	info := state.ExecutionInfo
+	_, err := c.target.Execution.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID: common.IntPtr(shardID),
+		RangeID: rangeID,
+		Mode:    persistence.UpdateWorkflowModeIgnoreCurrent,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     info,
+			ExecutionStats:    stats,
+			VersionHistories:  state.VersionHistories,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         info.NextEventID,
+			Checksum:          state.Checksum,
+		},
+		Encoding:   constants.EncodingTypeThriftRW,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return fmt.Errorf("copy buffered events to target: %w", err)
+	}
+	return nil
+}
+
+func (c *Copier) isCurrentExecution(
+	ctx context.Context,
+	shardID int,
+	domainName string,
+	info *persistence.WorkflowExecutionInfo,
+) (bool, error) {
+	current, err := c.source.Execution.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:    common.IntPtr(shardID),
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		DomainName: domainName,
+	})
+	if isNotExists(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get current execution from source: %w", err)
+	}
+	return current.RunID == info.RunID, nil
+}
+
+// copyHistory copies every branch of the execution's version histories
+func (c *Copier) copyHistory(ctx context.Context, shardID int, state *persistence.WorkflowMutableState) error {
+	info := state.ExecutionInfo
+	cleanupInfo := persistence.BuildHistoryGarbageCleanupInfo(info.DomainID, info.WorkflowID, info.RunID)
+	if state.VersionHistories == nil {
+		return c.copyHistoryBranch(ctx, shardID, cleanupInfo, info.BranchToken, info.NextEventID)
+	}
+	for _, history := range state.VersionHistories.Histories {
+		lastItem, err := history.GetLastItem()
+		if err != nil {
+			return err
+		}
+		if err := c.copyHistoryBranch(ctx, shardID, cleanupInfo, history.GetBranchToken(), lastItem.EventID+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyHistoryBranch copies the nodes of the branch below nextNodeID. Like in the source, the nodes of
+// every ancestor range are written under the ancestor's branch ID.
+func (c *Copier) copyHistoryBranch(
+	ctx context.Context,
+	shardID int,
+	cleanupInfo string,
+	branchToken []byte,
+	nextNodeID int64,
+) error {
+	branch, err := c.decodeBranch(branchToken)
+	if err != nil {
+		return err
+	}
+	tree, err := c.target.History.GetHistoryTree(ctx, &persistence.InternalGetHistoryTreeRequest{
+		TreeID:  branch.TreeID,
+		ShardID: common.IntPtr(shardID),
+	})
+	if err != nil {
+		return fmt.Errorf("get history tree from target: %w", err)
+	}
+	existingBranches := make(map[string]struct{}, len(tree.Branches))
+	for _, b := range tree.Branches {
+		existingBranches[b.BranchID] = struct{}{}
+	}
+
+	beginNodeID := constants.FirstEventID
+	for i := 0; i <= len(branch.Ancestors); i++ {
+		rangeBranch := types.HistoryBranch{
+			TreeID:    branch.TreeID,
+			BranchID:  branch.BranchID,
+			Ancestors: branch.Ancestors[:i],
+		}
+		endNodeID := nextNodeID
+		if i < len(branch.Ancestors) {
+			rangeBranch.BranchID = branch.Ancestors[i].BranchID
+			endNodeID = branch.Ancestors[i].EndNodeID
+		}
+		_, hasTreeRow := existingBranches[rangeBranch.BranchID]
+		if err := c.copyHistoryRange(ctx, shardID, cleanupInfo, rangeBranch, beginNodeID, endNodeID, hasTreeRow); err != nil {
+			return err
+		}
+		beginNodeID = endNodeID
+	}
+	return nil
+}
+
+func (c *Copier) copyHistoryRange(
+	ctx context.Context,
+	shardID int,
+	cleanupInfo string,
+	branch types.HistoryBranch,
+	minNodeID int64,
+	maxNodeID int64,
+	hasTreeRow bool,
+) error {
+	if !hasTreeRow && len(branch.Ancestors) > 0 {
+		// forks are not mirrored as they generate a new branch ID, recreate the one of the source
+		parent := branch.Ancestors[len(branch.Ancestors)-1]
+		_, err := c.target.History.ForkHistoryBranch(ctx, &persistence.InternalForkHistoryBranchRequest{
+			ForkBranchInfo: types.HistoryBranch{
+				TreeID:    branch.TreeID,
+				BranchID:  parent.BranchID,
+				Ancestors: branch.Ancestors[:len(branch.Ancestors)-1],
+			},
+			ForkNodeID:       parent.EndNodeID,
+			NewBranchID:      branch.BranchID,
+			Info:             cleanupInfo,
+			ShardID:          shardID,
+			CurrentTimeStamp: c.timeSrc(),
+		})
+		if err != nil {
+			return fmt.Errorf("fork history branch in target: %w", err)
+		}
+		hasTreeRow = true
+	}
+	if minNodeID >= maxNodeID {
+		return nil
+	}
+
+	sourceNodes, err := readHistoryNodes(ctx, c.source.History, shardID, branch, minNodeID, maxNodeID)
+	if err != nil {
+		return fmt.Errorf("read history from source: %w", err)
+	}
+	targetNodes, err := readHistoryNodes(ctx, c.target.History, shardID, branch, minNodeID, maxNodeID)
+	if err != nil {
+		return fmt.Errorf("read history from target: %w", err)
+	}
+	existing := make(map[int64]int64, len(targetNodes))
+	for _, node := range targetNodes {
+		existing[node.nodeID] = node.txnID
+	}
+
+	isNewBranch := !hasTreeRow && len(targetNodes) == 0
+	for _, node := range sourceNodes {
+		if txnID, ok := existing[node.nodeID]; ok && txnID >= node.txnID {
+			continue
+		}
+		err := c.target.History.AppendHistoryNodes(ctx, &persistence.InternalAppendHistoryNodesRequest{
+			IsNewBranch:      isNewBranch,
+			Info:             cleanupInfo,
+			BranchInfo:       branch,
+			NodeID:           node.nodeID,
+			Events:           node.events,
+			TransactionID:    node.txnID,
+			ShardID:          shardID,
+			CurrentTimeStamp: c.timeSrc(),
+		})
+		if err != nil {
+			return fmt.Errorf("append history node %v to target: %w", node.nodeID, err)
+		}
+		isNewBranch = false
+	}
+	return nil
+}
+
+// compareLastHistoryNode checks that the node holding the last event batch has the same transaction in both stores
+func (c *Copier) compareLastHistoryNode(
+	ctx context.Context,
+	shardID int,
+	state *persistence.WorkflowMutableState,
+) (string, error) {
+	info := state.ExecutionInfo
+	branchToken := info.BranchToken
+	if state.VersionHistories != nil {
+		current, err := state.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return "", err
+		}
+		branchToken = current.GetBranchToken()
+	}
+	branch, err := c.decodeBranch(branchToken)
+	if err != nil {
+		return "", err
+	}
+	nodeID := info.LastFirstEventID
+	rangeBranch := types.HistoryBranch{TreeID: branch.TreeID, BranchID: branch.BranchID}
+	for i, ancestor := range branch.Ancestors {
+		if nodeID < ancestor.EndNodeID {
+			rangeBranch.BranchID = ancestor.BranchID
+			rangeBranch.Ancestors = branch.Ancestors[:i]
+			break
+		}
+	}
+
+	sourceNodes, err := readHistoryNodes(ctx, c.source.History, shardID, rangeBranch, nodeID, nodeID+1)
+	if err != nil {
+		return "", fmt.Errorf("read history from source: %w", err)
+	}
+	targetNodes, err := readHistoryNodes(ctx, c.target.History, shardID, rangeBranch, nodeID, nodeID+1)
+	if err != nil {
+		return "", fmt.Errorf("read history from target: %w", err)
+	}
+	switch {
+	case len(sourceNodes) == 0:
+		return "", nil
+	case len(targetNodes) == 0:
+		return fmt.Sprintf("history node %v is missing in target", nodeID), nil
+	case sourceNodes[0].txnID != targetNodes[0].txnID:
+		return fmt.Sprintf("history node %v has transaction %v in source but %v in target",
+			nodeID, sourceNodes[0].txnID, targetNodes[0].txnID), nil
+	}
+	return "", nil
+}
+
+// copyVisibility copies the db visibility record of the execution, when visibility is migrated
+func (c *Copier) copyVisibility(
+	ctx context.Context,
+	shardID int,
+	domain *persistence.GetDomainResponse,
+	state *persistence.WorkflowMutableState,
+) error {
+	if c.source.Visibility == nil || c.target.Visibility == nil {
+		return nil
+	}
+	info := state.ExecutionInfo
+	execution := types.WorkflowExecution{WorkflowID: info.WorkflowID, RunID: info.RunID}
+
+	var record *types.WorkflowExecutionInfo
+	if info.State == persistence.WorkflowStateCompleted {
+		resp, err := c.source.Visibility.GetClosedWorkflowExecution(ctx, &persistence.GetClosedWorkflowExecutionRequest{
+			DomainUUID: info.DomainID,
+			Domain:     domain.Info.Name,
+			Execution:  execution,
+		})
+		if err != nil && !isNotExists(err) {
+			return fmt.Errorf("get closed visibility record from source: %w", err)
+		}
+		if resp != nil {
+			record = resp.Execution
+		}
+	} else {
+		var token []byte
+		for record == nil {
+			resp, err := c.source.Visibility.ListOpenWorkflowExecutionsByWorkflowID(ctx, &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+				ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+					DomainUUID:    info.DomainID,
+					Domain:        domain.Info.Name,
+					EarliestTime:  info.StartTimestamp.UnixNano(),
+					LatestTime:    c.timeSrc().UnixNano(),
+					PageSize:      c.pageSize,
+					NextPageToken: token,
+				},
+				WorkflowID: info.WorkflowID,
+			})
+			if err != nil {
+				return fmt.Errorf("list open visibility records from source: %w", err)
+			}
+			for _, r := range resp.Executions {
+				if r.GetExecution().GetRunID() == info.RunID {
+					record = r
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			token = resp.NextPageToken
+		}
+	}
+	if record == nil {
+		// the record is written by a transfer task, which the target shard still has if it did not run yet
+		return nil
+	}
+
+	numClusters := int16(0)
+	if domain.ReplicationConfig != nil {
+		numClusters = int16(len(domain.ReplicationConfig.Clusters))
+	}
+	clusterAttribute := record.ActiveClusterSelectionPolicy.GetClusterAttribute()
+	if record.CloseStatus == nil {
+		err := c.target.Visibility.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:                  info.DomainID,
+			Domain:                      domain.Info.Name,
+			Execution:                   execution,
+			WorkflowTypeName:            record.GetType().GetName(),
+			StartTimestamp:              record.GetStartTime(),
+			ExecutionTimestamp:          record.GetExecutionTime(),
+			WorkflowTimeout:             int64(info.WorkflowTimeout),
+			TaskID:                      info.LastEventTaskID,
+			Memo:                        record.Memo,
+			TaskList:                    record.TaskList.GetName(),
+			IsCron:                      record.IsCron,
+			NumClusters:                 numClusters,
+			ClusterAttributeScope:       clusterAttribute.GetScope(),
+			ClusterAttributeName:        clusterAttribute.GetName(),
+			UpdateTimestamp:             record.GetUpdateTime(),
+			SearchAttributes:            record.GetSearchAttributes().GetIndexedFields(),
+			ShardID:                     int16(shardID),
+			ExecutionStatus:             record.GetExecutionStatus(),
+			CronSchedule:                record.GetCronSchedule(),
+			ScheduledExecutionTimestamp: record.GetScheduledExecutionTime(),
+		})
+		if err != nil {
+			return fmt.Errorf("record open visibility record in target: %w", err)
+		}
+		return nil
+	}
+	err := c.target.Visibility.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:                  info.DomainID,
+		Domain:                      domain.Info.Name,
+		Execution:                   execution,
+		WorkflowTypeName:            record.GetType().GetName(),
+		StartTimestamp:              record.GetStartTime(),
+		ExecutionTimestamp:          record.GetExecutionTime(),
+		CloseTimestamp:              record.GetCloseTime(),
+		Status:                      record.GetCloseStatus(),
+		HistoryLength:               record.HistoryLength,
+		RetentionSeconds:            int64(domain.Config.Retention) * int64(24*time.Hour/time.Second),
+		TaskID:                      info.LastEventTaskID,
+		Memo:                        record.Memo,
+		TaskList:                    record.TaskList.GetName(),
+		IsCron:                      record.IsCron,
+		CronSchedule:                record.GetCronSchedule(),
+		NumClusters:                 numClusters,
+		ClusterAttributeScope:       clusterAttribute.GetScope(),
+		ClusterAttributeName:        clusterAttribute.GetName(),
+		UpdateTimestamp:             record.GetUpdateTime(),
+		SearchAttributes:            record.GetSearchAttributes().GetIndexedFields(),
+		ShardID:                     int16(shardID),
+		ExecutionStatus:             record.GetExecutionStatus(),
+		ScheduledExecutionTimestamp: record.GetScheduledExecutionTime(),
+	})
+	if err != nil {
+		return fmt.Errorf("record closed visibility record in target: %w", err)
+	}
+	return nil
+}
+
+func (c *Copier) sourceDomain(ctx context.Context, domainID string) (*persistence.GetDomainResponse, error) {
+	domain, err := c.source.Domain.GetDomain(ctx, &persistence.GetDomainRequest{ID: domainID})
+	if err != nil {
+		return nil, fmt.Errorf("get domain %v from source: %w", domainID, err)
+	}
+	return domain, nil
+}
+
+func (c *Copier) decodeBranch(branchToken []byte) (types.HistoryBranch, error) {
+	var branch shared.HistoryBranch
+	if err := c.encoder.Decode(branchToken, &branch); err != nil {
+		return types.HistoryBranch{}, fmt.Errorf("decode branch token: %w", err)
+	}
+	return *thrift.ToHistoryBranch(&branch), nil
+}
+
+// readHistoryNodes returns the visible nodes of the branch range, i.e. the ones a reader of the
+// branch would get, with their transaction IDs
+func readHistoryNodes(
+	ctx context.Context,
+	store persistence.HistoryStore,
+	shardID int,
+	branch types.HistoryBranch,
+	minNodeID int64,
+	maxNodeID int64,
+) ([]historyNode, error) {
+	request := &persistence.InternalReadHistoryBranchRequest{
+		TreeID:     branch.TreeID,
+		BranchID:   branch.BranchID,
+		MinNodeID:  minNodeID,
+		MaxNodeID:  maxNodeID,
+		PageSize:   historyNodePageSize,
+		LastNodeID: constants.FirstEventID - 1,
+		ShardID:    shardID,
+	}
+	var nodes []historyNode
+	for {
+		resp, err := store.ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.History) > 0 {
+			nodes = append(nodes, historyNode{
+				nodeID: resp.LastNodeID,
+				txnID:  resp.LastTransactionID,
+				events: resp.History[len(resp.History)-1],
+			})
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nodes, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+		request.LastNodeID = resp.LastNodeID
+		request.LastTransactionID = resp.LastTransactionID
+	}
+}
+
+func getExecution(
+	ctx context.Context,
+	manager persistence.ExecutionManager,
+	execution *entity.Execution,
+	domainName string,
+) (*persistence.WorkflowMutableState, error) {
+	resp, err := manager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:  common.IntPtr(execution.ShardID),
+		DomainID: execution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+		DomainName: domainName,
+	})
+	if isNotExists(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.State, nil
+}
+
+func compareMutableStates(source, target *persistence.WorkflowMutableState) string {
+	switch {
+	case source == nil && target == nil:
+		return ""
+	case source == nil:
+		return "execution was deleted in source"
+	case target == nil:
+		return "execution is missing in target"
+	}
+	s, t := source.ExecutionInfo, target.ExecutionInfo
+	fields := []struct {
+		name           string
+		source, target int64
+	}{
+		{"state", int64(s.State), int64(t.State)},
+		{"close status", int64(s.CloseStatus), int64(t.CloseStatus)},
+		{"next event ID", s.NextEventID, t.NextEventID},
+		{"last first event ID", s.LastFirstEventID, t.LastFirstEventID},
+		{"decision schedule ID", s.DecisionScheduleID, t.DecisionScheduleID},
+		{"decision started ID", s.DecisionStartedID, t.DecisionStartedID},
+		{"decision attempt", s.DecisionAttempt, t.DecisionAttempt},
+		{"signal count", int64(s.SignalCount), int64(t.SignalCount)},
+		{"activities", int64(len(source.ActivityInfos)), int64(len(target.ActivityInfos))},
+		{"timers", int64(len(source.TimerInfos)), int64(len(target.TimerInfos))},
+		{"child executions", int64(len(source.ChildExecutionInfos)), int64(len(target.ChildExecutionInfos))},
+		{"request cancels", int64(len(source.RequestCancelInfos)), int64(len(target.RequestCancelInfos))},
+		{"signals", int64(len(source.SignalInfos)), int64(len(target.SignalInfos))},
+		{"signal requested IDs", int64(len(source.SignalRequestedIDs)), int64(len(target.SignalRequestedIDs))},
+		{"buffered events", int64(len(source.BufferedEvents)), int64(len(target.BufferedEvents))},
+	}
+	for _, f := range fields {
+		if f.source != f.target {
+			return fmt.Sprintf("%v is %v in source but %v in target", f.name, f.source, f.target)
+		}
+	}
+	// activity heartbeats and retries do not generate events
+	for scheduleID, activity := range source.ActivityInfos {
+		other, ok := target.ActivityInfos[scheduleID]
+		if !ok {
+			return fmt.Sprintf("activity %v is missing in target", scheduleID)
+		}
+		if activity.StartedID != other.StartedID || activity.Attempt != other.Attempt {
+			return fmt.Sprintf("activity %v differs between source and target", scheduleID)
+		}
+	}
+	return ""
+}
+
+func snapshotFromMutableState(state *persistence.WorkflowMutableState) *persistence.WorkflowSnapshot {
+	snapshot := &persistence.WorkflowSnapshot{
+		ExecutionInfo:    state.ExecutionInfo,
+		ExecutionStats:   state.ExecutionStats,
+		VersionHistories: state.VersionHistories,
+		Condition:        state.ExecutionInfo.NextEventID,
+		Checksum:         state.Checksum,
+	}
+	if snapshot.ExecutionStats == nil {
+		snapshot.ExecutionStats = &persistence.ExecutionStats{}
+	}
+	for _, info := range state.ActivityInfos {
+		snapshot.ActivityInfos = append(snapshot.ActivityInfos, info)
+	}
+	for _, info := range state.TimerInfos {
+		snapshot.TimerInfos = append(snapshot.TimerInfos, info)
+	}
+	for _, info := range state.ChildExecutionInfos {
+		snapshot.ChildExecutionInfos = append(snapshot.ChildExecutionInfos, info)
+	}
+	for _, info := range state.RequestCancelInfos {
+		snapshot.RequestCancelInfos = append(snapshot.RequestCancelInfos, info)
+	}
+	for _, info := range state.SignalInfos {
+		snapshot.SignalInfos = append(snapshot.SignalInfos, info)
+	}
+	for id := range state.SignalRequestedIDs {
+		snapshot.SignalRequestedIDs = append(snapshot.SignalRequestedIDs, id)
+	}
+	return snapshot
+}
+
+// placeholderSnapshot returns an empty open or zombie copy of the snapshot that CreateWorkflowExecution accepts
+func placeholderSnapshot(snapshot *persistence.WorkflowSnapshot, state int) *persistence.WorkflowSnapshot {
+	info := *snapshot.ExecutionInfo
+	info.State = state
+	info.CloseStatus = persistence.WorkflowCloseStatusNone
+	info.CompletionEvent = nil
+	return &persistence.WorkflowSnapshot{
+		ExecutionInfo:    &info,
+		ExecutionStats:   snapshot.ExecutionStats,
+		VersionHistories: snapshot.VersionHistories,
+	}
+}
+
+func isNotExists(err error) bool {
+	var notExists *types.EntityNotExistsError
+	return errors.As(err, &notExists)
+}
+
+func logExecution(logger log.Logger, execution *entity.Execution) log.Logger {
+	return logger.WithTags(
+		tag.ShardID(execution.ShardID),
+		tag.WorkflowDomainID(execution.DomainID),
+		tag.WorkflowID(execution.WorkflowID),
+		tag.WorkflowRunID(execution.RunID),
+	)
+}
@@ -0,0 +1,459 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+type testStores struct {
+	shard      *persistence.MockShardManager
+	execution  *persistence.MockExecutionManager
+	domain     *persistence.MockDomainManager
+	task       *persistence.MockTaskManager
+	history    *persistence.MockHistoryStore
+	visibility *persistence.MockVisibilityManager
+}
+
+func newTestStores(ctrl *gomock.Controller) (*testStores, *Stores) {
+	m := &testStores{
+		shard:      persistence.NewMockShardManager(ctrl),
+		execution:  persistence.NewMockExecutionManager(ctrl),
+		domain:     persistence.NewMockDomainManager(ctrl),
+		task:       persistence.NewMockTaskManager(ctrl),
+		history:    persistence.NewMockHistoryStore(ctrl),
+		visibility: persistence.NewMockVisibilityManager(ctrl),
+	}
+	return m, &Stores{
+		Shard:      m.shard,
+		Execution:  m.execution,
+		Domain:     m.domain,
+		Task:       m.task,
+		History:    m.history,
+		Visibility: m.visibility,
+	}
+}
+
+func newTestCopier(t *testing.T) (*Copier, *testStores, *testStores) {
+	ctrl := gomock.NewController(t)
+	source, sourceStores := newTestStores(ctrl)
+	target, targetStores := newTestStores(ctrl)
+	copier := NewCopier(sourceStores, targetStores, log.NewNoop())
+	copier.timeSrc = func() time.Time { return time.Unix(1000, 0) }
+	return copier, source, target
+}
+
+func TestCopyDomains(t *testing.T) {
+	sourceDomain := &persistence.GetDomainResponse{
+		Info:            &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		Config:          &persistence.DomainConfig{Retention: 1},
+		ConfigVersion:   2,
+		FailoverVersion: 3,
+		LastUpdatedTime: 4,
+	}
+	tests := map[string]struct {
+		target      *persistence.GetDomainResponse
+		targetErr   error
+		expectWrite func(target *testStores)
+		wantCopied  int
+		wantErr     bool
+	}{
+		"missing in target": {
+			targetErr: &types.EntityNotExistsError{},
+			expectWrite: func(target *testStores) {
+				target.domain.EXPECT().CreateDomain(gomock.Any(), &persistence.CreateDomainRequest{
+					Info:             sourceDomain.Info,
+					Config:           sourceDomain.Config,
+					ConfigVersion:    2,
+					FailoverVersion:  3,
+					LastUpdatedTime:  4,
+					CurrentTimeStamp: time.Unix(1000, 0),
+				}).Return(&persistence.CreateDomainResponse{ID: "domain-id"}, nil)
+			},
+			wantCopied: 1,
+		},
+		"outdated in target": {
+			target: &persistence.GetDomainResponse{ConfigVersion: 1, FailoverVersion: 3, LastUpdatedTime: 4},
+			expectWrite: func(target *testStores) {
+				target.domain.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+				target.domain.EXPECT().UpdateDomain(gomock.Any(), &persistence.UpdateDomainRequest{
+					Info:                sourceDomain.Info,
+					Config:              sourceDomain.Config,
+					ConfigVersion:       2,
+					FailoverVersion:     3,
+					LastUpdatedTime:     4,
+					NotificationVersion: 7,
+				}).Return(nil)
+			},
+			wantCopied: 1,
+		},
+		"in sync": {
+			target:      &persistence.GetDomainResponse{ConfigVersion: 2, FailoverVersion: 3, LastUpdatedTime: 4},
+			expectWrite: func(target *testStores) {},
+		},
+		"target unavailable": {
+			targetErr:   errors.New("unavailable"),
+			expectWrite: func(target *testStores) {},
+			wantErr:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			copier, source, target := newTestCopier(t)
+			source.domain.EXPECT().ListDomains(gomock.Any(), &persistence.ListDomainsRequest{PageSize: defaultPageSize}).
+				Return(&persistence.ListDomainsResponse{Domains: []*persistence.GetDomainResponse{sourceDomain}}, nil)
+			target.domain.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "domain-id"}).Return(tc.target, tc.targetErr)
+			tc.expectWrite(target)
+
+			copied, err := copier.CopyDomains(context.Background())
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCopied, copied)
+		})
+	}
+}
+
+func TestCopyShard(t *testing.T) {
+	sourceShard := &persistence.ShardInfo{ShardID: 1, RangeID: 10}
+	tests := map[string]struct {
+		target      *persistence.GetShardResponse
+		targetErr   error
+		expectWrite func(target *testStores)
+		wantRangeID int64
+	}{
+		"missing in target": {
+			targetErr: &types.EntityNotExistsError{},
+			expectWrite: func(target *testStores) {
+				target.shard.EXPECT().CreateShard(gomock.Any(), &persistence.CreateShardRequest{ShardInfo: sourceShard}).Return(nil)
+			},
+			wantRangeID: 10,
+		},
+		"behind in target": {
+			target: &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 8}},
+			expectWrite: func(target *testStores) {
+				target.shard.EXPECT().UpdateShard(gomock.Any(), &persistence.UpdateShardRequest{ShardInfo: sourceShard, PreviousRangeID: 8}).Return(nil)
+			},
+			wantRangeID: 10,
+		},
+		"ahead in target": {
+			target:      &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 12}},
+			expectWrite: func(target *testStores) {},
+			wantRangeID: 12,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			copier, source, target := newTestCopier(t)
+			source.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).
+				Return(&persistence.GetShardResponse{ShardInfo: sourceShard}, nil)
+			target.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(tc.target, tc.targetErr)
+			tc.expectWrite(target)
+
+			copiedTask := &persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 2}}
+			for _, category := range taskCategories {
+				sourceTasks, targetTasks := []persistence.Task(nil), []persistence.Task(nil)
+				if category == persistence.HistoryTaskCategoryTransfer {
+					sourceTasks = []persistence.Task{
+						&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 1}},
+						copiedTask,
+					}
+					targetTasks = sourceTasks[:1]
+					target.execution.EXPECT().CreateHistoryTasks(gomock.Any(), &persistence.CreateHistoryTasksRequest{
+						ShardID:         common.IntPtr(1),
+						RangeID:         tc.wantRangeID,
+						TasksByCategory: map[persistence.HistoryTaskCategory][]persistence.Task{category: {copiedTask}},
+					}).Return(nil)
+				}
+				source.execution.EXPECT().GetHistoryTasks(gomock.Any(), historyTasksRequest(category)).
+					Return(&persistence.GetHistoryTasksResponse{Tasks: sourceTasks}, nil)
+				target.execution.EXPECT().GetHistoryTasks(gomock.Any(), historyTasksRequest(category)).
+					Return(&persistence.GetHistoryTasksResponse{Tasks: targetTasks}, nil)
+			}
+
+			require.NoError(t, copier.CopyShard(context.Background(), 1))
+		})
+	}
+}
+
+func historyTasksRequest(category persistence.HistoryTaskCategory) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		request, ok := x.(*persistence.GetHistoryTasksRequest)
+		return ok && request.TaskCategory == category && *request.ShardID == 1
+	})
+}
+
+func TestCompareMutableStates(t *testing.T) {
+	newState := func(nextEventID int64, activityAttempt int32) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: nextEventID},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{5: {ScheduleID: 5, Attempt: activityAttempt}},
+		}
+	}
+	tests := map[string]struct {
+		source, target *persistence.WorkflowMutableState
+		want           string
+	}{
+		"both missing": {},
+		"deleted in source": {
+			target: newState(10, 0),
+			want:   "execution was deleted in source",
+		},
+		"missing in target": {
+			source: newState(10, 0),
+			want:   "execution is missing in target",
+		},
+		"event missing in target": {
+			source: newState(10, 0),
+			target: newState(8, 0),
+			want:   "next event ID is 10 in source but 8 in target",
+		},
+		"activity retry missing in target": {
+			source: newState(10, 1),
+			target: newState(10, 0),
+			want:   "activity 5 differs between source and target",
+		},
+		"in sync": {
+			source: newState(10, 1),
+			target: newState(10, 1),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, compareMutableStates(tc.source, tc.target))
+		})
+	}
+}
+
+func TestCopyExecutionDeletedInSource(t *testing.T) {
+	copier, source, target := newTestCopier(t)
+	execution := &entity.Execution{ShardID: 1, DomainID: "domain-id", WorkflowID: "wid", RunID: "rid"}
+	source.domain.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "domain-id"}).Return(&persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+	}, nil)
+	target.domain.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "domain-id"}).Return(&persistence.GetDomainResponse{}, nil)
+	source.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	target.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{ExecutionInfo: &persistence.WorkflowExecutionInfo{RunID: "rid"}},
+	}, nil)
+	target.execution.EXPECT().DeleteWorkflowExecution(gomock.Any(), &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:    common.IntPtr(1),
+		DomainID:   "domain-id",
+		WorkflowID: "wid",
+		RunID:      "rid",
+		DomainName: "domain",
+	}).Return(nil)
+	target.execution.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{RunID: "rid"}, nil)
+	target.execution.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:    common.IntPtr(1),
+		DomainID:   "domain-id",
+		WorkflowID: "wid",
+		RunID:      "rid",
+		DomainName: "domain",
+	}).Return(nil)
+
+	require.NoError(t, copier.CopyExecution(context.Background(), execution))
+}
+
+func TestResetExecution(t *testing.T) {
+	newState := func(state int, nextEventID int64) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:    "domain-id",
+				WorkflowID:  "wid",
+				RunID:       "rid",
+				State:       state,
+				NextEventID: nextEventID,
+			},
+		}
+	}
+	tests := map[string]struct {
+		source     *persistence.WorkflowMutableState
+		target     *persistence.WorkflowMutableState
+		currentRun string
+		wantMode   persistence.ConflictResolveWorkflowMode
+		wantErr    bool
+	}{
+		"current execution": {
+			source:     newState(persistence.WorkflowStateRunning, 10),
+			target:     newState(persistence.WorkflowStateRunning, 8),
+			currentRun: "rid",
+			wantMode:   persistence.ConflictResolveWorkflowModeUpdateCurrent,
+		},
+		"closed execution that is not current": {
+			source:     newState(persistence.WorkflowStateCompleted, 10),
+			target:     newState(persistence.WorkflowStateRunning, 8),
+			currentRun: "other-rid",
+			wantMode:   persistence.ConflictResolveWorkflowModeBypassCurrent,
+		},
+		"open execution that is not current": {
+			source:     newState(persistence.WorkflowStateRunning, 10),
+			target:     newState(persistence.WorkflowStateRunning, 8),
+			currentRun: "other-rid",
+			wantErr:    true,
+		},
+		"ahead in target": {
+			source:  newState(persistence.WorkflowStateRunning, 8),
+			target:  newState(persistence.WorkflowStateRunning, 10),
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			copier, _, target := newTestCopier(t)
+			target.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).
+				Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 5}}, nil).AnyTimes()
+			target.execution.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).
+				Return(&persistence.GetCurrentExecutionResponse{RunID: tc.currentRun}, nil).AnyTimes()
+			if !tc.wantErr {
+				target.execution.EXPECT().ConflictResolveWorkflowExecution(gomock.Any(), gomock.Cond(func(x any) bool {
+					request, ok := x.(*persistence.ConflictResolveWorkflowExecutionRequest)
+					// the write is conditioned on the next event ID of the target
+					return ok && request.Mode == tc.wantMode && request.RangeID == 5 &&
+						request.ResetWorkflowSnapshot.Condition == tc.target.ExecutionInfo.NextEventID &&
+						request.ResetWorkflowSnapshot.ExecutionInfo == tc.source.ExecutionInfo
+				})).Return(&persistence.ConflictResolveWorkflowExecutionResponse{}, nil)
+			}
+
+			err := copier.resetExecution(context.Background(), 1, "domain", tc.source, tc.target)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyShard(t *testing.T) {
+	tests := map[string]struct {
+		target    *persistence.GetShardResponse
+		targetErr error
+		want      string
+	}{
+		"missing in target": {
+			targetErr: &types.EntityNotExistsError{},
+			want:      "shard is missing in target",
+		},
+		"behind in target": {
+			target: &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 8}},
+			want:   "range ID is 10 in source but 8 in target",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			copier, source, target := newTestCopier(t)
+			source.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).
+				Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 10}}, nil)
+			target.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(tc.target, tc.targetErr)
+
+			diff, err := copier.VerifyShard(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, diff)
+		})
+	}
+}
+
+func TestVerifyShardMissingTask(t *testing.T) {
+	copier, source, target := newTestCopier(t)
+	shard := &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 10}}
+	source.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(shard, nil)
+	target.shard.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(shard, nil)
+	category := persistence.HistoryTaskCategoryTransfer
+	source.execution.EXPECT().GetHistoryTasks(gomock.Any(), historyTasksRequest(category)).
+		Return(&persistence.GetHistoryTasksResponse{Tasks: []persistence.Task{
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 1}},
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 2}},
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 3}},
+		}}, nil)
+	// task 2 is mirrored between the reads
+	target.execution.EXPECT().GetHistoryTasks(gomock.Any(), historyTasksRequest(category)).
+		Return(&persistence.GetHistoryTasksResponse{Tasks: []persistence.Task{
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 1}},
+		}}, nil)
+	target.execution.EXPECT().GetHistoryTasks(gomock.Any(), historyTasksRequest(category)).
+		Return(&persistence.GetHistoryTasksResponse{Tasks: []persistence.Task{
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 1}},
+			&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 2}},
+		}}, nil)
+
+	diff, err := copier.VerifyShard(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer task 3 is missing in target", diff)
+}
+
+func TestReadHistoryNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockHistoryStore(ctrl)
+	branch := types.HistoryBranch{TreeID: "tree", BranchID: "branch"}
+	first := &persistence.DataBlob{Data: []byte("1")}
+	second := &persistence.DataBlob{Data: []byte("3")}
+	store.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.InternalReadHistoryBranchRequest{
+		TreeID:    "tree",
+		BranchID:  "branch",
+		MinNodeID: 1,
+		MaxNodeID: 5,
+		PageSize:  historyNodePageSize,
+		ShardID:   1,
+	}).Return(&persistence.InternalReadHistoryBranchResponse{
+		History:           []*persistence.DataBlob{first},
+		NextPageToken:     []byte("token"),
+		LastNodeID:        1,
+		LastTransactionID: 11,
+	}, nil)
+	store.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.InternalReadHistoryBranchRequest{
+		TreeID:            "tree",
+		BranchID:          "branch",
+		MinNodeID:         1,
+		MaxNodeID:         5,
+		PageSize:          historyNodePageSize,
+		NextPageToken:     []byte("token"),
+		LastNodeID:        1,
+		LastTransactionID: 11,
+		ShardID:           1,
+	}).Return(&persistence.InternalReadHistoryBranchResponse{
+		History:           []*persistence.DataBlob{second},
+		LastNodeID:        3,
+		LastTransactionID: 15,
+	}, nil)
+
+	nodes, err := readHistoryNodes(context.Background(), store, 1, branch, 1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []historyNode{
+		{nodeID: 1, txnID: 11, events: first},
+		{nodeID: 3, txnID: 15, events: second},
+	}, nodes)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+)
+
+type migrated struct {
+	copier    *Copier
+	shardMode dynamicproperties.StringPropertyFnWithShardIDFilter
+	logger    log.Logger
+
+	// copiedShards holds the IDs of the shards whose record and tasks were copied by this invariant
+	copiedShards sync.Map
+}
+
+// NewInvariant returns an invariant that checks that a concrete execution is in sync between the
+// source and the target store of a persistence migration, and copies it when it is not
+func NewInvariant(
+	copier *Copier,
+	shardMode dynamicproperties.StringPropertyFnWithShardIDFilter,
+	logger log.Logger,
+) invariant.Invariant {
+	return &migrated{
+		copier:    copier,
+		shardMode: shardMode,
+		logger:    logger,
+	}
+}
+
+// Check compares the execution between the source and the target store
+func (m *migrated) Check(
+	ctx context.Context,
+	e interface{},
+) invariant.CheckResult {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return invariant.CheckResult{
+			CheckResultType: invariant.CheckResultTypeFailed,
+			InvariantName:   m.Name(),
+			Info:            "failed to check: context expired or cancelled",
+			InfoDetails:     ctxErr.Error(),
+		}
+	}
+	concreteExecution, ok := e.(*entity.ConcreteExecution)
+	if !ok {
+		return invariant.CheckResult{
+			CheckResultType: invariant.CheckResultTypeFailed,
+			InvariantName:   m.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+	execution := &concreteExecution.Execution
+
+	// once a shard is served by the target the source is no longer updated, so it cannot be compared
+	if mode, _ := migration.ParseMode(m.shardMode(execution.ShardID)); mode == migration.ModeTarget {
+		return invariant.CheckResult{
+			CheckResultType: invariant.CheckResultTypeHealthy,
+			InvariantName:   m.Name(),
+		}
+	}
+
+	diff, err := m.copier.VerifyExecution(ctx, execution)
+	if err != nil {
+		return invariant.CheckResult{
+			CheckResultType: invariant.CheckResultTypeFailed,
+			InvariantName:   m.Name(),
+			Info:            "failed to compare execution between source and target",
+			InfoDetails:     err.Error(),
+		}
+	}
+	if diff != "" {
+		return invariant.CheckResult{
+			CheckResultType: invariant.CheckResultTypeCorrupted,
+			InvariantName:   m.Name(),
+			Info:            "execution is not in sync with the target store",
+			InfoDetails:     diff,
+		}
+	}
+	return invariant.CheckResult{
+		CheckResultType: invariant.CheckResultTypeHealthy,
+		InvariantName:   m.Name(),
+	}
+}
+
+// Fix copies the shard of the execution, once per shard, and then the execution to the target store
+func (m *migrated) Fix(
+	ctx context.Context,
+	e interface{},
+) invariant.FixResult {
+	checkResult := m.Check(ctx, e)
+	switch checkResult.CheckResultType {
+	case invariant.CheckResultTypeHealthy:
+		return invariant.FixResult{
+			FixResultType: invariant.FixResultTypeSkipped,
+			InvariantName: m.Name(),
+			CheckResult:   checkResult,
+			Info:          "skipped fix because execution was healthy",
+		}
+	case invariant.CheckResultTypeFailed:
+		return invariant.FixResult{
+			FixResultType: invariant.FixResultTypeFailed,
+			InvariantName: m.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed fix because check failed",
+		}
+	}
+
+	execution := &e.(*entity.ConcreteExecution).Execution
+	if _, copied := m.copiedShards.Load(execution.ShardID); !copied {
+		if err := m.copier.CopyShard(ctx, execution.ShardID); err != nil {
+			return invariant.FixResult{
+				FixResultType: invariant.FixResultTypeFailed,
+				InvariantName: m.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to copy shard to target",
+				InfoDetails:   err.Error(),
+			}
+		}
+		m.copiedShards.Store(execution.ShardID, struct{}{})
+	}
+	if err := m.copier.CopyExecution(ctx, execution); err != nil {
+		return invariant.FixResult{
+			FixResultType: invariant.FixResultTypeFailed,
+			InvariantName: m.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed to copy execution to target",
+			InfoDetails:   err.Error(),
+		}
+	}
+	logExecution(m.logger, execution).Info("copied execution to persistence migration target")
+	return invariant.FixResult{
+		FixResultType: invariant.FixResultTypeFixed,
+		InvariantName: m.Name(),
+		CheckResult:   checkResult,
+	}
+}
+
+func (m *migrated) Name() invariant.Name {
+	return invariant.PersistenceMigration
+}
+
+type unavailable struct {
+	err error
+}
+
+// newUnavailableInvariant returns an invariant that fails every check and fix,
+// used when the migration stores cannot be connected to
+func newUnavailableInvariant(err error) invariant.Invariant {
+	return &unavailable{err: err}
+}
+
+func (u *unavailable) Check(context.Context, interface{}) invariant.CheckResult {
+	return invariant.CheckResult{
+		CheckResultType: invariant.CheckResultTypeFailed,
+		InvariantName:   u.Name(),
+		Info:            "failed to connect to persistence migration stores",
+		InfoDetails:     u.err.Error(),
+	}
+}
+
+func (u *unavailable) Fix(ctx context.Context, e interface{}) invariant.FixResult {
+	checkResult := u.Check(ctx, e)
+	return invariant.FixResult{
+		FixResultType: invariant.FixResultTypeFailed,
+		InvariantName: u.Name(),
+		CheckResult:   checkResult,
+		Info:          "failed fix because check failed",
+	}
+}
+
+func (u *unavailable) Name() invariant.Name {
+	return invariant.PersistenceMigration
+}
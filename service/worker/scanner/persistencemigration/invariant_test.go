@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestInvariantCheck(t *testing.T) {
+	execution := &entity.ConcreteExecution{
+		Execution: entity.Execution{ShardID: 1, DomainID: "domain-id", WorkflowID: "wid", RunID: "rid"},
+	}
+	tests := map[string]struct {
+		entity    interface{}
+		shardMode string
+		expect    func(source, target *testStores)
+		want      invariant.CheckResultType
+	}{
+		"not a concrete execution": {
+			entity:    &entity.Timer{},
+			shardMode: "dual-write",
+			expect:    func(source, target *testStores) {},
+			want:      invariant.CheckResultTypeFailed,
+		},
+		"shard served by target": {
+			entity:    execution,
+			shardMode: "target",
+			expect:    func(source, target *testStores) {},
+			want:      invariant.CheckResultTypeHealthy,
+		},
+		"source unavailable": {
+			entity:    execution,
+			shardMode: "dual-write",
+			expect: func(source, target *testStores) {
+				source.domain.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+			},
+			want: invariant.CheckResultTypeFailed,
+		},
+		"missing in target": {
+			entity:    execution,
+			shardMode: "dual-write",
+			expect: func(source, target *testStores) {
+				source.domain.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+				}, nil)
+				source.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+					State: &persistence.WorkflowMutableState{ExecutionInfo: &persistence.WorkflowExecutionInfo{RunID: "rid"}},
+				}, nil)
+				target.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			want: invariant.CheckResultTypeCorrupted,
+		},
+		"deleted in both": {
+			entity:    execution,
+			shardMode: "source",
+			expect: func(source, target *testStores) {
+				source.domain.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{
+					Info: &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+				}, nil)
+				source.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+				target.execution.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			want: invariant.CheckResultTypeHealthy,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			copier, source, target := newTestCopier(t)
+			tc.expect(source, target)
+			inv := NewInvariant(copier, func(int) string { return tc.shardMode }, log.NewNoop())
+
+			result := inv.Check(context.Background(), tc.entity)
+			assert.Equal(t, tc.want, result.CheckResultType)
+			assert.Equal(t, invariant.PersistenceMigration, result.InvariantName)
+		})
+	}
+}
+
+func TestInvariantFix(t *testing.T) {
+	execution := &entity.ConcreteExecution{
+		Execution: entity.Execution{ShardID: 1, DomainID: "domain-id", WorkflowID: "wid", RunID: "rid"},
+	}
+
+	copier, _, _ := newTestCopier(t)
+	inv := NewInvariant(copier, func(int) string { return "target" }, log.NewNoop())
+	assert.Equal(t, invariant.FixResultTypeSkipped, inv.Fix(context.Background(), execution).FixResultType)
+
+	inv = newUnavailableInvariant(errors.New("unavailable"))
+	result := inv.Fix(context.Background(), execution)
+	assert.Equal(t, invariant.FixResultTypeFailed, result.FixResultType)
+	assert.Equal(t, invariant.CheckResultTypeFailed, result.CheckResult.CheckResultType)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+)
+
+const (
+	// ScannerWFTypeName defines workflow type name for persistence migration scanner
+	ScannerWFTypeName   = "cadence-sys-persistence-migration-scanner-workflow"
+	wfid                = "cadence-sys-persistence-migration-scanner"
+	scannerTaskListName = "cadence-sys-persistence-migration-scanner-tasklist-0"
+
+	// FixerWFTypeName defines workflow type name for persistence migration fixer
+	FixerWFTypeName   = "cadence-sys-persistence-migration-fixer-workflow"
+	fixerTaskListName = "cadence-sys-persistence-migration-fixer-tasklist-0"
+	fixerwfid         = "cadence-sys-persistence-migration-fixer"
+)
+
+// ScannerWorkflow starts persistence migration scanner.
+func ScannerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.ScannerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewScannerWorkflow(ctx, ScannerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// FixerWorkflow starts persistence migration fixer.
+func FixerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.FixerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewFixerWorkflow(ctx, FixerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// hooks builds the scanner and fixer hooks, connecting to the migration stores on first use
+type hooks struct {
+	params    Params
+	shardMode dynamicproperties.StringPropertyFnWithShardIDFilter
+
+	once   sync.Once
+	copier *Copier
+	err    error
+}
+
+// ScannerHooks provides hooks for persistence migration scanner.
+func (h *hooks) ScannerHooks() *shardscanner.ScannerHooks {
+	s, err := shardscanner.NewScannerHooks(h.Manager, Iterator, Config)
+	if err != nil {
+		return nil
+	}
+
+	return s
+}
+
+// FixerHooks provides hooks needed for persistence migration fixer.
+func (h *hooks) FixerHooks() *shardscanner.FixerHooks {
+	f, err := shardscanner.NewFixerHooks(h.FixerManager, FixerIterator, migrationCustomConfig)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// Manager provides invariant manager for persistence migration scanner.
+func (h *hooks) Manager(
+	_ context.Context,
+	_ persistence.Retryer,
+	_ shardscanner.ScanShardActivityParams,
+	_ cache.DomainCache,
+) invariant.Manager {
+	return invariant.NewInvariantManager([]invariant.Invariant{h.invariant()})
+}
+
+// FixerManager provides invariant manager for persistence migration fixer.
+func (h *hooks) FixerManager(
+	_ context.Context,
+	_ persistence.Retryer,
+	_ shardscanner.FixShardActivityParams,
+	_ cache.DomainCache,
+) invariant.Manager {
+	return invariant.NewInvariantManager([]invariant.Invariant{h.invariant()})
+}
+
+func (h *hooks) invariant() invariant.Invariant {
+	h.once.Do(func() {
+		h.copier, h.err = NewCopierFromParams(h.params)
+		if h.err != nil {
+			h.params.Logger.Error("failed to connect to persistence migration stores", tag.Error(h.err))
+		}
+	})
+	if h.err != nil {
+		return newUnavailableInvariant(h.err)
+	}
+	return NewInvariant(h.copier, h.shardMode, h.params.Logger)
+}
+
+func migrationCustomConfig(_ shardscanner.FixerContext) shardscanner.CustomScannerConfig {
+	// must be non-empty to pass backwards-compat check
+	return map[string]string{
+		string(invariant.PersistenceMigration): "true",
+	}
+}
+
+// Iterator provides iterator for persistence migration scanner.
+// Executions are read from the source store through the regular, migration aware, persistence client.
+func Iterator(
+	ctx context.Context,
+	pr persistence.Retryer,
+	params shardscanner.ScanShardActivityParams,
+) pagination.Iterator {
+	return fetcher.ConcreteExecutionIterator(ctx, pr, params.PageSize)
+}
+
+// FixerIterator provides iterator for persistence migration fixer.
+func FixerIterator(
+	ctx context.Context,
+	client blobstore.Client,
+	keys store.Keys,
+	_ shardscanner.FixShardActivityParams,
+) store.ScanOutputIterator {
+	return store.NewBlobstoreIterator(ctx, client, keys, &entity.ConcreteExecution{})
+}
+
+// Config resolves dynamic config for persistence migration scanner.
+func Config(_ shardscanner.ScannerContext) shardscanner.CustomScannerConfig {
+	return migrationCustomConfig(shardscanner.FixerContext{})
+}
+
+// ScannerConfig configures persistence migration scanner
+func ScannerConfig(dc *dynamicconfig.Collection, params Params) *shardscanner.ScannerConfig {
+	h := &hooks{
+		params:    params,
+		shardMode: dc.GetStringPropertyFilteredByShardID(dynamicproperties.PersistenceMigrationShardMode),
+	}
+	return &shardscanner.ScannerConfig{
+		ScannerWFTypeName: ScannerWFTypeName,
+		FixerWFTypeName:   FixerWFTypeName,
+		DynamicParams: shardscanner.DynamicParams{
+			ScannerEnabled:          dc.GetBoolProperty(dynamicproperties.PersistenceMigrationScannerEnabled),
+			FixerEnabled:            dc.GetBoolProperty(dynamicproperties.PersistenceMigrationFixerEnabled),
+			Concurrency:             dc.GetIntProperty(dynamicproperties.PersistenceMigrationScannerConcurrency),
+			PageSize:                dc.GetIntProperty(dynamicproperties.PersistenceMigrationScannerPersistencePageSize),
+			BlobstoreFlushThreshold: dc.GetIntProperty(dynamicproperties.PersistenceMigrationScannerBlobstoreFlushThreshold),
+			ActivityBatchSize:       dc.GetIntProperty(dynamicproperties.PersistenceMigrationScannerActivityBatchSize),
+			AllowDomain:             dc.GetBoolPropertyFilteredByDomain(dynamicproperties.PersistenceMigrationFixerDomainAllow),
+		},
+		DynamicCollection: dc,
+		ScannerHooks:      h.ScannerHooks,
+		FixerHooks:        h.FixerHooks,
+
+		StartWorkflowOptions: client.StartWorkflowOptions{
+			ID:                           wfid,
+			TaskList:                     scannerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "*/5 * * * *",
+		},
+		StartFixerOptions: client.StartWorkflowOptions{
+			ID:                           fixerwfid,
+			TaskList:                     fixerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "*/5 * * * *",
+		},
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+)
+
+// Params are the dependencies needed to connect to both sides of a persistence migration
+type Params struct {
+	// Persistence is the server persistence config, its Migration section must be set
+	Persistence    *config.Persistence
+	ClusterName    string
+	PersistenceQPS quotas.RPSFunc
+	DynamicConfig  *persistence.DynamicConfiguration
+	MetricsClient  metrics.Client
+	Logger         log.Logger
+}
+
+// NewCopierFromParams connects to the source and the target stores configured in the migration
+// section of the persistence config and returns a copier between them
+func NewCopierFromParams(params Params) (*Copier, error) {
+	if params.Persistence.Migration == nil {
+		return nil, fmt.Errorf("persistence migration is not configured")
+	}
+	sourceCfg := params.Persistence.MigrationSource()
+	source, err := newStores(params, &sourceCfg, params.Persistence.Migration.TargetVisibilityStore != "")
+	if err != nil {
+		return nil, fmt.Errorf("connect to source stores: %w", err)
+	}
+	targetCfg := params.Persistence.MigrationTarget()
+	target, err := newStores(params, &targetCfg, params.Persistence.Migration.TargetVisibilityStore != "")
+	if err != nil {
+		return nil, fmt.Errorf("connect to target stores: %w", err)
+	}
+	return NewCopier(source, target, params.Logger), nil
+}
+
+func newStores(params Params, cfg *config.Persistence, withVisibility bool) (*Stores, error) {
+	factory := client.NewFactory(cfg, params.PersistenceQPS, params.ClusterName, params.MetricsClient, params.Logger, params.DynamicConfig)
+	var stores Stores
+	var err error
+	if stores.Shard, err = factory.NewShardManager(); err != nil {
+		return nil, err
+	}
+	if stores.Execution, err = factory.NewExecutionManager(); err != nil {
+		return nil, err
+	}
+	if stores.History, err = factory.NewHistoryStore(); err != nil {
+		return nil, err
+	}
+	if stores.Domain, err = factory.NewDomainManager(); err != nil {
+		return nil, err
+	}
+	if stores.Task, err = factory.NewTaskManager(); err != nil {
+		return nil, err
+	}
+	if stores.ConfigStore, err = factory.NewConfigStoreManager(); err != nil {
+		return nil, err
+	}
+	if !withVisibility {
+		return &stores, nil
+	}
+
+	// only db visibility records are migrated, advanced visibility is migrated by its own means
+	visibilityCfg := *cfg
+	visibilityCfg.AdvancedVisibilityStore = ""
+	stores.Visibility, err = factory.NewVisibilityManager(
+		&client.Params{PersistenceConfig: visibilityCfg, MetricsClient: params.MetricsClient},
+		&service.Config{
+			ReadVisibilityStoreName:                     dynamicproperties.GetStringPropertyFnFilteredByDomain(constants.VisibilityModeDB),
+			WriteVisibilityStoreName:                    dynamicproperties.GetStringPropertyFn(constants.VisibilityModeDB),
+			EnableReadDBVisibilityFromClosedExecutionV2: dynamicproperties.GetBoolPropertyFn(false),
+			EnableLogCustomerQueryParameter:             dynamicproperties.GetBoolPropertyFnFilteredByDomain(false),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &stores, nil
+}
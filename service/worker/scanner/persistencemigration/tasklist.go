@@ -0,0 +1,334 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+)
+
+// taskIDRangeSize is the number of task IDs a task list lease holds, it must match the range size of matching
+const taskIDRangeSize = 100000
+
+type (
+	// TaskList identifies a task list of a domain
+	TaskList struct {
+		DomainID string
+		Name     string
+		TaskType int
+	}
+
+	// taskKey identifies a task independently of its task ID, which differs between the stores
+	taskKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+)
+
+// ShardTaskLists returns the task lists the open executions of the source shard can have pending tasks in.
+// Task lists cannot be listed in every store, so they are found from the executions that scheduled the tasks.
+func (c *Copier) ShardTaskLists(ctx context.Context, shardID int) ([]TaskList, error) {
+	found := make(map[TaskList]struct{})
+	domainNames := make(map[string]string)
+	request := &persistence.ListConcreteExecutionsRequest{
+		ShardID:  common.IntPtr(shardID),
+		PageSize: c.pageSize,
+	}
+	for {
+		resp, err := c.source.Execution.ListConcreteExecutions(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("list executions of shard %v from source: %w", shardID, err)
+		}
+		for _, e := range resp.Executions {
+			info := e.ExecutionInfo
+			if info.State == persistence.WorkflowStateCompleted || info.State == persistence.WorkflowStateZombie {
+				continue
+			}
+			if info.DecisionScheduleID != constants.EmptyEventID && info.DecisionStartedID == constants.EmptyEventID {
+				found[TaskList{DomainID: info.DomainID, Name: info.TaskList, TaskType: persistence.TaskListTypeDecision}] = struct{}{}
+				if info.StickyTaskList != "" {
+					found[TaskList{DomainID: info.DomainID, Name: info.StickyTaskList, TaskType: persistence.TaskListTypeDecision}] = struct{}{}
+				}
+			}
+
+			// the listing doesn't include the activities, they are read from the mutable state
+			domainName, ok := domainNames[info.DomainID]
+			if !ok {
+				domain, err := c.sourceDomain(ctx, info.DomainID)
+				if err != nil {
+					return nil, err
+				}
+				domainName = domain.Info.Name
+				domainNames[info.DomainID] = domainName
+			}
+			state, err := getExecution(ctx, c.source.Execution, &entity.Execution{
+				ShardID:    shardID,
+				DomainID:   info.DomainID,
+				WorkflowID: info.WorkflowID,
+				RunID:      info.RunID,
+			}, domainName)
+			if err != nil {
+				return nil, fmt.Errorf("get execution from source: %w", err)
+			}
+			if state == nil {
+				continue
+			}
+			for _, activity := range state.ActivityInfos {
+				if activity.StartedID == constants.EmptyEventID {
+					found[TaskList{DomainID: info.DomainID, Name: activity.TaskList, TaskType: persistence.TaskListTypeActivity}] = struct{}{}
+				}
+			}
+		}
+		if len(resp.PageToken) == 0 {
+			break
+		}
+		request.PageToken = resp.PageToken
+	}
+
+	taskLists := make([]TaskList, 0, len(found))
+	for taskList := range found {
+		taskLists = append(taskLists, taskList)
+	}
+	sort.Slice(taskLists, func(i, j int) bool {
+		a, b := taskLists[i], taskLists[j]
+		if a.DomainID != b.DomainID {
+			return a.DomainID < b.DomainID
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.TaskType < b.TaskType
+	})
+	return taskLists, nil
+}
+
+// CopyTaskList copies the pending tasks of every partition of the task list that are missing in the target
+// and returns the number of tasks copied. Copied tasks get new task IDs from a lease taken on the target
+// task list, which makes the matching host that owns the task list reload it and read them.
+func (c *Copier) CopyTaskList(ctx context.Context, taskList TaskList) (int, error) {
+	domain, err := c.sourceDomain(ctx, taskList.DomainID)
+	if err != nil {
+		return 0, err
+	}
+	domainName := domain.Info.Name
+	copied := 0
+	numPartitions := 1
+	for partition := 0; ; partition++ {
+		name := taskList.Name
+		if partition > 0 {
+			name = fmt.Sprintf("%v%v/%v", constants.ReservedTaskListPrefix, taskList.Name, partition)
+		}
+		source, err := c.source.Task.GetTaskList(ctx, &persistence.GetTaskListRequest{
+			DomainID:   taskList.DomainID,
+			DomainName: domainName,
+			TaskList:   name,
+			TaskType:   taskList.TaskType,
+		})
+		if isNotExists(err) {
+			// partitions are created when they are first used, so there can be gaps below the configured count
+			if partition < numPartitions {
+				continue
+			}
+			return copied, nil
+		}
+		if err != nil {
+			return copied, fmt.Errorf("get task list %v from source: %w", name, err)
+		}
+		if partition == 0 {
+			numPartitions = partitionCount(source.TaskListInfo.AdaptivePartitionConfig)
+		}
+		n, err := c.copyTaskListPartition(ctx, domainName, source.TaskListInfo)
+		copied += n
+		if err != nil {
+			return copied, err
+		}
+	}
+}
+
+func (c *Copier) copyTaskListPartition(ctx context.Context, domainName string, source *persistence.TaskListInfo) (int, error) {
+	// tasks are compared by the activity or decision they dispatch, as their IDs are allocated per store
+	var target *persistence.TaskListInfo
+	existing := make(map[taskKey]struct{})
+	resp, err := c.target.Task.GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID:   source.DomainID,
+		DomainName: domainName,
+		TaskList:   source.Name,
+		TaskType:   source.TaskType,
+	})
+	switch {
+	case err == nil:
+		target = resp.TaskListInfo
+		tasks, err := c.readTasks(ctx, c.target.Task, domainName, target)
+		if err != nil {
+			return 0, fmt.Errorf("read tasks of task list %v from target: %w", source.Name, err)
+		}
+		for _, task := range tasks {
+			existing[taskKeyOf(task)] = struct{}{}
+		}
+	case !isNotExists(err):
+		return 0, fmt.Errorf("get task list %v from target: %w", source.Name, err)
+	}
+	tasks, err := c.readTasks(ctx, c.source.Task, domainName, source)
+	if err != nil {
+		return 0, fmt.Errorf("read tasks of task list %v from source: %w", source.Name, err)
+	}
+	now := c.timeSrc()
+	var missing []*persistence.TaskInfo
+	for _, task := range tasks {
+		if _, ok := existing[taskKeyOf(task)]; ok {
+			continue
+		}
+		if !task.Expiry.IsZero() && task.Expiry.Before(now) {
+			continue
+		}
+		missing = append(missing, task)
+	}
+	copyPartitionConfig := source.AdaptivePartitionConfig != nil && (target == nil || target.AdaptivePartitionConfig == nil)
+	if len(missing) == 0 && !copyPartitionConfig {
+		return 0, nil
+	}
+
+	lease, err := c.leaseTaskList(ctx, domainName, source, 0)
+	if err != nil {
+		return 0, err
+	}
+	if copyPartitionConfig {
+		info := *lease
+		info.AdaptivePartitionConfig = source.AdaptivePartitionConfig
+		_, err := c.target.Task.UpdateTaskList(ctx, &persistence.UpdateTaskListRequest{
+			TaskListInfo:     &info,
+			DomainName:       domainName,
+			CurrentTimeStamp: now,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("update task list %v in target: %w", source.Name, err)
+		}
+		lease = &info
+	}
+
+	copied := 0
+	nextTaskID := (lease.RangeID-1)*taskIDRangeSize + 1
+	for len(missing) > 0 {
+		if nextTaskID > lease.RangeID*taskIDRangeSize {
+			if lease, err = c.leaseTaskList(ctx, domainName, source, lease.RangeID); err != nil {
+				return copied, err
+			}
+			nextTaskID = (lease.RangeID-1)*taskIDRangeSize + 1
+		}
+		batch := missing[:min(len(missing), c.pageSize, int(lease.RangeID*taskIDRangeSize-nextTaskID+1))]
+		missing = missing[len(batch):]
+		request := &persistence.CreateTasksRequest{
+			TaskListInfo:     lease,
+			DomainName:       domainName,
+			CurrentTimeStamp: now,
+		}
+		for _, task := range batch {
+			data := *task
+			data.TaskID = nextTaskID
+			request.Tasks = append(request.Tasks, &persistence.CreateTaskInfo{Data: &data, TaskID: nextTaskID})
+			nextTaskID++
+		}
+		if _, err := c.target.Task.CreateTasks(ctx, request); err != nil {
+			return copied, fmt.Errorf("create tasks of task list %v in target: %w", source.Name, err)
+		}
+		copied += len(batch)
+	}
+	return copied, nil
+}
+
+// leaseTaskList takes a lease on the target task list, or renews the given one when rangeID is not 0
+func (c *Copier) leaseTaskList(
+	ctx context.Context,
+	domainName string,
+	source *persistence.TaskListInfo,
+	rangeID int64,
+) (*persistence.TaskListInfo, error) {
+	resp, err := c.target.Task.LeaseTaskList(ctx, &persistence.LeaseTaskListRequest{
+		DomainID:         source.DomainID,
+		DomainName:       domainName,
+		TaskList:         source.Name,
+		TaskType:         source.TaskType,
+		TaskListKind:     source.Kind,
+		RangeID:          rangeID,
+		CurrentTimeStamp: c.timeSrc(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lease task list %v in target: %w", source.Name, err)
+	}
+	return resp.TaskListInfo, nil
+}
+
+// readTasks returns the tasks of the task list above its ack level
+func (c *Copier) readTasks(
+	ctx context.Context,
+	manager persistence.TaskManager,
+	domainName string,
+	info *persistence.TaskListInfo,
+) ([]*persistence.TaskInfo, error) {
+	maxReadLevel := int64(math.MaxInt64)
+	request := &persistence.GetTasksRequest{
+		DomainID:     info.DomainID,
+		TaskList:     info.Name,
+		TaskType:     info.TaskType,
+		ReadLevel:    info.AckLevel,
+		MaxReadLevel: &maxReadLevel,
+		BatchSize:    c.pageSize,
+		DomainName:   domainName,
+	}
+	var tasks []*persistence.TaskInfo
+	for {
+		resp, err := manager.GetTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, resp.Tasks...)
+		if len(resp.Tasks) < c.pageSize {
+			return tasks, nil
+		}
+		request.ReadLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+	}
+}
+
+func taskKeyOf(task *persistence.TaskInfo) taskKey {
+	return taskKey{workflowID: task.WorkflowID, runID: task.RunID, scheduleID: task.ScheduleID}
+}
+
+// partitionCount returns the number of partitions of the partition config, or 1 when there is none
+func partitionCount(config *persistence.TaskListPartitionConfig) int {
+	count := 1
+	if config == nil {
+		return count
+	}
+	for _, partitions := range []map[int]*persistence.TaskListPartition{config.ReadPartitions, config.WritePartitions} {
+		for partition := range partitions {
+			count = max(count, partition+1)
+		}
+	}
+	return count
+}
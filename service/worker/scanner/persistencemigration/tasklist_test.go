@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCopyTaskList(t *testing.T) {
+	copier, source, target := newTestCopier(t)
+	source.domain.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "domain-id"}).Return(&persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+	}, nil)
+	sourceInfo := &persistence.TaskListInfo{
+		DomainID: "domain-id",
+		Name:     "tl",
+		TaskType: persistence.TaskListTypeActivity,
+		RangeID:  7,
+		AckLevel: 600000,
+		Kind:     persistence.TaskListKindNormal,
+	}
+	source.task.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID: "domain-id", DomainName: "domain", TaskList: "tl", TaskType: persistence.TaskListTypeActivity,
+	}).Return(&persistence.GetTaskListResponse{TaskListInfo: sourceInfo}, nil)
+	source.task.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+		DomainID: "domain-id", DomainName: "domain", TaskList: "/__cadence_sys/tl/1", TaskType: persistence.TaskListTypeActivity,
+	}).Return(nil, &types.EntityNotExistsError{})
+
+	copiedTask := &persistence.TaskInfo{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 600002, ScheduleID: 5}
+	source.task.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 600001, ScheduleID: 3},
+		copiedTask,
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 600003, ScheduleID: 7, Expiry: time.Unix(999, 0)},
+	}}, nil)
+	targetInfo := &persistence.TaskListInfo{DomainID: "domain-id", Name: "tl", TaskType: persistence.TaskListTypeActivity, RangeID: 2, AckLevel: 100000}
+	target.task.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListResponse{TaskListInfo: targetInfo}, nil)
+	target.task.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 100001, ScheduleID: 3},
+	}}, nil)
+
+	lease := &persistence.TaskListInfo{DomainID: "domain-id", Name: "tl", TaskType: persistence.TaskListTypeActivity, RangeID: 3, AckLevel: 100000}
+	target.task.EXPECT().LeaseTaskList(gomock.Any(), &persistence.LeaseTaskListRequest{
+		DomainID:         "domain-id",
+		DomainName:       "domain",
+		TaskList:         "tl",
+		TaskType:         persistence.TaskListTypeActivity,
+		TaskListKind:     persistence.TaskListKindNormal,
+		CurrentTimeStamp: time.Unix(1000, 0),
+	}).Return(&persistence.LeaseTaskListResponse{TaskListInfo: lease}, nil)
+	// the copied task gets the first ID of the lease
+	data := *copiedTask
+	data.TaskID = 200001
+	target.task.EXPECT().CreateTasks(gomock.Any(), &persistence.CreateTasksRequest{
+		TaskListInfo:     lease,
+		Tasks:            []*persistence.CreateTaskInfo{{Data: &data, TaskID: 200001}},
+		DomainName:       "domain",
+		CurrentTimeStamp: time.Unix(1000, 0),
+	}).Return(&persistence.CreateTasksResponse{}, nil)
+
+	copied, err := copier.CopyTaskList(context.Background(), TaskList{
+		DomainID: "domain-id",
+		Name:     "tl",
+		TaskType: persistence.TaskListTypeActivity,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, copied)
+}
+
+func TestPartitionCount(t *testing.T) {
+	assert.Equal(t, 1, partitionCount(nil))
+	assert.Equal(t, 4, partitionCount(&persistence.TaskListPartitionConfig{
+		ReadPartitions:  map[int]*persistence.TaskListPartition{0: {}, 1: {}, 2: {}, 3: {}},
+		WritePartitions: map[int]*persistence.TaskListPartition{0: {}, 1: {}},
+	}))
+}
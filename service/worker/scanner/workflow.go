@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
+	"github.com/uber/cadence/service/worker/scanner/persistencemigration"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 )
@@ -96,6 +97,8 @@ func init() {
 	workflow.RegisterWithOptions(executions.CurrentFixerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsFixerWFTypeName})
 	workflow.RegisterWithOptions(timers.ScannerWorkflow, workflow.RegisterOptions{Name: timers.ScannerWFTypeName})
 	workflow.RegisterWithOptions(timers.FixerWorkflow, workflow.RegisterOptions{Name: timers.FixerWFTypeName})
+	workflow.RegisterWithOptions(persistencemigration.ScannerWorkflow, workflow.RegisterOptions{Name: persistencemigration.ScannerWFTypeName})
+	workflow.RegisterWithOptions(persistencemigration.FixerWorkflow, workflow.RegisterOptions{Name: persistencemigration.FixerWFTypeName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
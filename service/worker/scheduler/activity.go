@@ -66,9 +66,20 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 	defer func() {
 		if err != nil {
 			scope.IncCounter(metrics.SchedulerFireErrorCountPerDomain)
+			return
 		}
+		result.ActualTime = time.Now()
 	}()
 
+	// Signal actions target a single long-lived workflow rather than starting
+	// a run per fire, so there is nothing for the overlap policy to act on.
+	switch {
+	case req.SignalWorkflow != nil:
+		return signalWorkflowFire(ctx, sc.FrontendClient, scope, req)
+	case req.SignalWithStartWorkflow != nil:
+		return signalWithStartWorkflowFire(ctx, sc.FrontendClient, scope, req)
+	}
+
 	result = &ProcessFireResult{}
 
 	policy := req.OverlapPolicy
@@ -93,6 +104,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 				stillRunning = append(stillRunning, wf)
 			}
 		}
+		result.Overlapped = len(stillRunning) > 0
 		if len(stillRunning) >= int(effectiveLimit) {
 			scope.Tagged(metrics.OverlapPolicyTag(policy.String()), metrics.TriggerSourceTag(string(req.TriggerSource))).
 				IncCounter(metrics.SchedulerFireSkippedCountPerDomain)
@@ -108,6 +120,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 			return nil, err
 		}
 		if running {
+			result.Overlapped = true
 			switch policy {
 			case types.ScheduleOverlapPolicySkipNew:
 				scope.Tagged(metrics.OverlapPolicyTag(policy.String()), metrics.TriggerSourceTag(string(req.TriggerSource))).IncCounter(metrics.SchedulerFireSkippedCountPerDomain)
@@ -192,6 +205,68 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 	return result, nil
 }
 
+// signalWorkflowFire delivers a SignalWorkflow action. A target that does not
+// exist or has already closed counts as a skipped fire rather than an error,
+// so a finished entity workflow does not make every later fire retry.
+func signalWorkflowFire(ctx context.Context, client frontend.Client, scope metrics.Scope, req ProcessFireRequest) (*ProcessFireResult, error) {
+	action := req.SignalWorkflow
+	err := client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain: req.Domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: action.WorkflowID,
+			RunID:      action.RunID,
+		},
+		SignalName: action.SignalName,
+		Input:      action.Input,
+		RequestID:  generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource),
+	})
+	sourceScope := scope.Tagged(metrics.TriggerSourceTag(string(req.TriggerSource)))
+	if err != nil {
+		var completed *types.WorkflowExecutionAlreadyCompletedError
+		if isEntityNotExistsError(err) || errors.As(err, &completed) {
+			sourceScope.IncCounter(metrics.SchedulerFireSkippedCountPerDomain)
+			return &ProcessFireResult{SkippedDelta: 1}, nil
+		}
+		return nil, fmt.Errorf("failed to signal workflow: %w", err)
+	}
+	sourceScope.IncCounter(metrics.SchedulerFireSignaledCountPerDomain)
+	return &ProcessFireResult{TotalDelta: 1}, nil
+}
+
+// signalWithStartWorkflowFire delivers a SignalWithStartWorkflow action,
+// starting the target workflow when it is not running. The started run is not
+// reported back as StartedWorkflow: the target is expected to outlive any
+// single fire and must not feed into the overlap policy of a later action.
+func signalWithStartWorkflowFire(ctx context.Context, client frontend.Client, scope metrics.Scope, req ProcessFireRequest) (*ProcessFireResult, error) {
+	action := req.SignalWithStartWorkflow
+	start := action.GetStartWorkflow()
+	if start == nil {
+		return nil, fmt.Errorf("signal-with-start action has no StartWorkflow configuration")
+	}
+	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
+	_, err := client.SignalWithStartWorkflowExecution(ctx, &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              req.Domain,
+		WorkflowID:                          action.WorkflowID,
+		WorkflowType:                        start.WorkflowType,
+		TaskList:                            start.TaskList,
+		Input:                               start.Input,
+		ExecutionStartToCloseTimeoutSeconds: start.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      start.TaskStartToCloseTimeoutSeconds,
+		RequestID:                           generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource),
+		WorkflowIDReusePolicy:               &reusePolicy,
+		SignalName:                          action.SignalName,
+		SignalInput:                         action.SignalInput,
+		RetryPolicy:                         start.RetryPolicy,
+		Memo:                                start.Memo,
+		SearchAttributes:                    buildSearchAttributes(req),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to signal-with-start workflow: %w", err)
+	}
+	scope.Tagged(metrics.TriggerSourceTag(string(req.TriggerSource))).IncCounter(metrics.SchedulerFireSignaledCountPerDomain)
+	return &ProcessFireResult{TotalDelta: 1}, nil
+}
+
 // generateWorkflowID creates a deterministic workflow ID from the
 // schedule's prefix (or schedule ID) and the scheduled time.
 // Example: "my-prefix-2026-01-15T10:00:00Z"
@@ -303,8 +378,12 @@ func buildSearchAttributes(req ProcessFireRequest) *types.SearchAttributes {
 	fields := make(map[string][]byte)
 
 	// Preserve any user-provided search attributes from the action config
-	if req.Action.SearchAttributes != nil {
-		for k, v := range req.Action.SearchAttributes.IndexedFields {
+	userAttrs := req.Action.SearchAttributes
+	if req.SignalWithStartWorkflow != nil {
+		userAttrs = req.SignalWithStartWorkflow.GetStartWorkflow().GetSearchAttributes()
+	}
+	if userAttrs != nil {
+		for k, v := range userAttrs.IndexedFields {
 			fields[k] = v
 		}
 	}
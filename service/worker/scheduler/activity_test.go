@@ -259,6 +259,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				SkippedDelta:    1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
 			},
@@ -300,6 +301,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				Buffered:        true,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
 			},
@@ -361,6 +363,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					Return(&types.StartWorkflowExecutionResponse{RunID: "new-run"}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				TotalDelta:      1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "new-run"},
 			},
@@ -383,6 +386,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					Return(&types.StartWorkflowExecutionResponse{RunID: "new-run"}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				TotalDelta:      1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "new-run"},
 			},
@@ -459,6 +463,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					}, nil).Times(2)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:   true,
 				SkippedDelta: 1,
 				ActiveWorkflows: []RunningWorkflowInfo{
 					{WorkflowID: "wf-1", RunID: "run-1"},
@@ -487,6 +492,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					Return(&types.StartWorkflowExecutionResponse{RunID: "new-run"}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				TotalDelta:      1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "new-run"},
 				ActiveWorkflows: []RunningWorkflowInfo{
@@ -525,6 +531,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					Return(&types.StartWorkflowExecutionResponse{RunID: "new-run"}, nil)
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				TotalDelta:      1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "new-run"},
 				ActiveWorkflows: []RunningWorkflowInfo{
@@ -601,6 +608,7 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 					})
 			},
 			wantResult: &ProcessFireResult{
+				Overlapped:      true,
 				SkippedDelta:    1,
 				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "existing-run"},
 				ActiveWorkflows: []RunningWorkflowInfo{
@@ -647,6 +655,120 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "signal action signals the target and ignores overlap policy",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.SignalWorkflow = &types.SignalWorkflowAction{
+					WorkflowID: "entity-1",
+					SignalName: "tick",
+					Input:      []byte(`"payload"`),
+				}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, sigReq *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "test-domain", sigReq.Domain)
+						assert.Equal(t, &types.WorkflowExecution{WorkflowID: "entity-1"}, sigReq.WorkflowExecution)
+						assert.Equal(t, "tick", sigReq.SignalName)
+						assert.Equal(t, []byte(`"payload"`), sigReq.Input)
+						assert.Equal(t, generateRequestID("sched-1", scheduledTime.UnixNano(), TriggerSourceSchedule), sigReq.RequestID)
+						return nil
+					})
+			},
+			wantResult: &ProcessFireResult{TotalDelta: 1},
+		},
+		{
+			name: "signal action to a missing workflow is skipped",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.SignalWorkflow = &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "workflow execution already completed"})
+			},
+			wantResult: &ProcessFireResult{SkippedDelta: 1},
+		},
+		{
+			name: "signal action failure returns error for retry",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.SignalWorkflow = &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "signal-with-start action uses a fixed workflow ID",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.Action = types.StartWorkflowAction{}
+				r.TriggerSource = TriggerSourceBackfill
+				r.BackfillID = "bf-sws"
+				r.SignalWithStartWorkflow = &types.SignalWithStartWorkflowAction{
+					WorkflowID:  "entity-1",
+					SignalName:  "tick",
+					SignalInput: []byte(`"payload"`),
+					StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType:     &types.WorkflowType{Name: "entity-workflow"},
+						TaskList:         &types.TaskList{Name: "entity-tasklist"},
+						WorkflowIDPrefix: "ignored",
+					},
+				}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, swsReq *types.SignalWithStartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, "entity-1", swsReq.WorkflowID)
+						assert.Equal(t, "entity-workflow", swsReq.WorkflowType.Name)
+						assert.Equal(t, "entity-tasklist", swsReq.TaskList.Name)
+						assert.Equal(t, "tick", swsReq.SignalName)
+						assert.Equal(t, []byte(`"payload"`), swsReq.SignalInput)
+						assert.Equal(t, types.WorkflowIDReusePolicyAllowDuplicate, *swsReq.WorkflowIDReusePolicy)
+						var got string
+						require.NoError(t, json.Unmarshal(swsReq.SearchAttributes.IndexedFields[SearchAttrBackfillID], &got))
+						assert.Equal(t, "bf-sws", got)
+						return &types.StartWorkflowExecutionResponse{RunID: "entity-run"}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{TotalDelta: 1},
+		},
+		{
+			name: "signal-with-start action failure returns error for retry",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.SignalWithStartWorkflow = &types.SignalWithStartWorkflowAction{
+					WorkflowID:    "entity-1",
+					SignalName:    "tick",
+					StartWorkflow: &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "entity-workflow"}},
+				}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "signal-with-start action without start configuration returns error",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.SignalWithStartWorkflow = &types.SignalWithStartWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {},
+			wantErr:   true,
+		},
 		{
 			name:      "missing context returns error",
 			req:       baseReq,
@@ -678,6 +800,8 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			assert.False(t, result.ActualTime.IsZero(), "ActualTime should be stamped on every result")
+			result.ActualTime = time.Time{}
 			assert.Equal(t, tc.wantResult, result)
 		})
 	}
@@ -756,6 +880,26 @@ func TestBuildSearchAttributes(t *testing.T) {
 		assert.Contains(t, sa.IndexedFields, SearchAttrScheduleID)
 	})
 
+	t.Run("uses signal-with-start search attributes", func(t *testing.T) {
+		userVal, _ := json.Marshal("entity-value")
+		req := ProcessFireRequest{
+			ScheduleID:    "sched-1",
+			ScheduledTime: scheduledTime,
+			TriggerSource: TriggerSourceSchedule,
+			SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+				StartWorkflow: &types.StartWorkflowAction{
+					SearchAttributes: &types.SearchAttributes{
+						IndexedFields: map[string][]byte{"CustomAttr": userVal},
+					},
+				},
+			},
+		}
+		sa := buildSearchAttributes(req)
+
+		assert.Equal(t, userVal, sa.IndexedFields["CustomAttr"])
+		assert.Contains(t, sa.IndexedFields, SearchAttrScheduleID)
+	})
+
 	t.Run("scheduler attributes override conflicting user attributes", func(t *testing.T) {
 		userVal, _ := json.Marshal("user-override")
 		req := ProcessFireRequest{
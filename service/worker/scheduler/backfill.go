@@ -90,11 +90,12 @@ func handleBackfill(logger *zap.Logger, scope tally.Scope, sig BackfillSignal, s
 }
 
 // effectiveFireOverlap returns the overlap policy applied to a single fire.
-// For backfill, BackfillSignal.overlap_policy may be INVALID (0) to inherit the
-// schedule's configured policy; any other value overrides for that backfill only.
-func effectiveFireOverlap(trigger TriggerSource, backfillOverlap, scheduleOverlap types.ScheduleOverlapPolicy) types.ScheduleOverlapPolicy {
-	if trigger == TriggerSourceBackfill && backfillOverlap != types.ScheduleOverlapPolicyInvalid {
-		return backfillOverlap
+// For backfills and manual triggers, the request's overlap policy may be
+// INVALID (0) to inherit the schedule's configured policy; any other value
+// overrides for that request only. Spec-driven fires always use the schedule's.
+func effectiveFireOverlap(trigger TriggerSource, requestOverlap, scheduleOverlap types.ScheduleOverlapPolicy) types.ScheduleOverlapPolicy {
+	if trigger != TriggerSourceSchedule && requestOverlap != types.ScheduleOverlapPolicyInvalid {
+		return requestOverlap
 	}
 	return scheduleOverlap
 }
@@ -0,0 +1,410 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// MaxScheduleIntervals, MaxScheduleCalendars and MaxScheduleExcludeCalendars
+	// bound the number of entries in a ScheduleSpec. Every entry is consulted on
+	// each fire-time computation and the spec is carried in the ContinueAsNew
+	// payload, so the lists must stay small.
+	MaxScheduleIntervals        = 10
+	MaxScheduleCalendars        = 10
+	MaxScheduleExcludeCalendars = 50
+
+	// minScheduleInterval matches the one-second resolution of cron fire times.
+	minScheduleInterval = time.Second
+
+	// maxExcludedFireSkips bounds how many consecutive excluded fire times
+	// specSchedule.Next steps over before giving up. Excluded blocks are
+	// skipped as a whole (see calendarSchedule.blockEnd), so this only trips
+	// for specs whose exclusions cover practically every fire time.
+	maxExcludedFireSkips = 10000
+
+	// maxCalendarSearchSteps bounds the day/year filtering loop in
+	// calendarSchedule.Next. Each step advances by at least one day.
+	maxCalendarSearchSteps = 5000
+
+	// calendarMinYear and calendarMaxYear bound the Year field of a calendar spec.
+	calendarMinYear = 1970
+	calendarMaxYear = 2999
+
+	calendarDayOfMonthLast        = "L"
+	calendarDayOfMonthLastWeekday = "LW"
+)
+
+// specSchedule is the cron.Schedule the scheduler workflow walks for fire
+// times. It yields the union of all fire-time sources of a ScheduleSpec
+// (cron expression, intervals, calendars) minus every time that matches an
+// exclusion calendar.
+type specSchedule struct {
+	sources  []cron.Schedule
+	excludes []*calendarSchedule
+}
+
+// ValidateScheduleSpec reports whether a ScheduleSpec describes at least one
+// fire-time source, every source parses, and the spec fires at least once
+// after now. It is used by the frontend to reject bad specs before they reach
+// the scheduler workflow; the workflow itself only parses, since the
+// future-fire check depends on wall-clock time.
+func ValidateScheduleSpec(spec *types.ScheduleSpec, now time.Time) error {
+	if spec == nil {
+		return errors.New("spec is not set")
+	}
+	sched, err := parseScheduleSpec(*spec)
+	if err != nil {
+		return err
+	}
+	if sched.Next(now).IsZero() {
+		return errors.New("spec has no future fire times, maybe an impossible date")
+	}
+	return nil
+}
+
+// parseScheduleSpec builds the schedule for a ScheduleSpec. A spec with only a
+// cron expression resolves to the plain cron schedule so existing schedules
+// keep their exact fire-time semantics.
+func parseScheduleSpec(spec types.ScheduleSpec) (cron.Schedule, error) {
+	if spec.CronExpression == "" && len(spec.Intervals) == 0 && len(spec.Calendars) == 0 {
+		return nil, errors.New("spec must set at least one of CronExpression, Intervals or Calendars")
+	}
+	if len(spec.Intervals) > MaxScheduleIntervals {
+		return nil, fmt.Errorf("spec has %d intervals, at most %d are allowed", len(spec.Intervals), MaxScheduleIntervals)
+	}
+	if len(spec.Calendars) > MaxScheduleCalendars {
+		return nil, fmt.Errorf("spec has %d calendars, at most %d are allowed", len(spec.Calendars), MaxScheduleCalendars)
+	}
+	if len(spec.ExcludeCalendars) > MaxScheduleExcludeCalendars {
+		return nil, fmt.Errorf("spec has %d exclude calendars, at most %d are allowed", len(spec.ExcludeCalendars), MaxScheduleExcludeCalendars)
+	}
+
+	s := &specSchedule{}
+	if spec.CronExpression != "" {
+		sched, err := cron.ParseStandard(spec.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", spec.CronExpression, err)
+		}
+		if len(spec.Intervals) == 0 && len(spec.Calendars) == 0 && len(spec.ExcludeCalendars) == 0 {
+			return sched, nil
+		}
+		s.sources = append(s.sources, sched)
+	}
+	for i, iv := range spec.Intervals {
+		sched, err := newIntervalSchedule(iv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval %d: %w", i, err)
+		}
+		s.sources = append(s.sources, sched)
+	}
+	for i, cal := range spec.Calendars {
+		sched, err := newCalendarSchedule(cal, "0")
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar %d: %w", i, err)
+		}
+		s.sources = append(s.sources, sched)
+	}
+	for i, cal := range spec.ExcludeCalendars {
+		sched, err := newCalendarSchedule(cal, "*")
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude calendar %d: %w", i, err)
+		}
+		s.excludes = append(s.excludes, sched)
+	}
+	return s, nil
+}
+
+// Next returns the earliest fire time across all sources that is strictly
+// after t and not excluded, or the zero time if there is none.
+func (s *specSchedule) Next(t time.Time) time.Time {
+	for i := 0; i < maxExcludedFireSkips; i++ {
+		var next time.Time
+		for _, src := range s.sources {
+			candidate := src.Next(t)
+			if candidate.IsZero() {
+				continue
+			}
+			if next.IsZero() || candidate.Before(next) {
+				next = candidate
+			}
+		}
+		if next.IsZero() {
+			return next
+		}
+		blockEnd, excluded := s.excludedUntil(next)
+		if !excluded {
+			return next
+		}
+		t = blockEnd
+	}
+	return time.Time{}
+}
+
+// excludedUntil reports whether t matches an exclusion calendar and, if so,
+// the last instant of the longest excluded block containing t.
+func (s *specSchedule) excludedUntil(t time.Time) (time.Time, bool) {
+	var end time.Time
+	for _, ex := range s.excludes {
+		if !ex.matches(t) {
+			continue
+		}
+		if blockEnd := ex.blockEnd(t); blockEnd.After(end) {
+			end = blockEnd
+		}
+	}
+	return end, !end.IsZero()
+}
+
+// intervalSchedule fires at anchor + k*interval for every integer k.
+type intervalSchedule struct {
+	interval time.Duration
+	anchor   time.Time
+}
+
+func newIntervalSchedule(spec *types.ScheduleIntervalSpec) (*intervalSchedule, error) {
+	if spec == nil {
+		return nil, errors.New("interval spec is nil")
+	}
+	if spec.Interval < minScheduleInterval {
+		return nil, fmt.Errorf("interval %v is shorter than the minimum of %v", spec.Interval, minScheduleInterval)
+	}
+	if spec.Interval%time.Second != 0 {
+		return nil, fmt.Errorf("interval %v is not a whole number of seconds", spec.Interval)
+	}
+	anchor := spec.Anchor
+	if anchor.IsZero() {
+		anchor = time.Unix(0, 0)
+	}
+	return &intervalSchedule{interval: spec.Interval, anchor: anchor.Truncate(time.Second)}, nil
+}
+
+func (s *intervalSchedule) Next(t time.Time) time.Time {
+	elapsed := t.Sub(s.anchor)
+	n := elapsed / s.interval
+	if elapsed%s.interval < 0 {
+		n-- // floor division for times before the anchor
+	}
+	return s.anchor.Add((n + 1) * s.interval).In(t.Location())
+}
+
+// calendarSchedule evaluates a ScheduleCalendarSpec. The second through
+// day-of-week fields are delegated to a robfig cron SpecSchedule; the Year
+// field and the "L"/"LW" day-of-month forms, which cron does not support, are
+// applied as day-level filters on top of it.
+type calendarSchedule struct {
+	cron        *cron.SpecSchedule
+	location    *time.Location
+	years       func(year int) bool
+	maxYear     int
+	dayOfMonth  string // "", "L" or "LW"
+	blockLength time.Duration
+}
+
+var calendarParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// newCalendarSchedule parses a calendar spec. timeDefault is the value used
+// for unset Second, Minute and Hour fields: "0" for fire calendars, "*" for
+// exclusion calendars.
+func newCalendarSchedule(spec *types.ScheduleCalendarSpec, timeDefault string) (*calendarSchedule, error) {
+	if spec == nil {
+		return nil, errors.New("calendar spec is nil")
+	}
+	field := func(v, def string) string {
+		if v = strings.TrimSpace(v); v == "" {
+			return def
+		}
+		return v
+	}
+	second := field(spec.Second, timeDefault)
+	minute := field(spec.Minute, timeDefault)
+	hour := field(spec.Hour, timeDefault)
+	dom := field(spec.DayOfMonth, "*")
+
+	c := &calendarSchedule{}
+	switch strings.ToUpper(dom) {
+	case calendarDayOfMonthLast, calendarDayOfMonthLastWeekday:
+		c.dayOfMonth = strings.ToUpper(dom)
+		dom = "*"
+	}
+
+	tz := strings.TrimSpace(spec.TimeZone)
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", tz, err)
+	}
+	c.location = loc
+
+	expr := fmt.Sprintf("CRON_TZ=%s %s %s %s %s %s %s",
+		tz, second, minute, hour, dom, field(spec.Month, "*"), field(spec.DayOfWeek, "*"))
+	sched, err := calendarParser.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	c.cron = sched.(*cron.SpecSchedule)
+
+	c.years, c.maxYear, err = parseCalendarYears(field(spec.Year, "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	// The excluded block containing a matched instant extends to the end of
+	// the finest time field that is restricted; a calendar that only restricts
+	// date fields excludes whole days.
+	switch {
+	case second != "*":
+		c.blockLength = time.Second
+	case minute != "*":
+		c.blockLength = time.Minute
+	case hour != "*":
+		c.blockLength = time.Hour
+	}
+
+	return c, nil
+}
+
+func (c *calendarSchedule) Next(t time.Time) time.Time {
+	for i := 0; i < maxCalendarSearchSteps; i++ {
+		next := c.cron.Next(t)
+		if next.IsZero() {
+			return next
+		}
+		local := next.In(c.location)
+		if local.Year() > c.maxYear {
+			return time.Time{}
+		}
+		if !c.years(local.Year()) {
+			// Resume the search one second before the next year starts;
+			// cron Next returns times strictly after its argument.
+			t = time.Date(local.Year()+1, time.January, 1, 0, 0, 0, 0, c.location).Add(-time.Second)
+			continue
+		}
+		if !c.dayMatches(local) {
+			t = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, c.location).Add(-time.Second)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+// matches reports whether t, truncated to the second, is a fire time of the calendar.
+func (c *calendarSchedule) matches(t time.Time) bool {
+	t = t.Truncate(time.Second)
+	return c.Next(t.Add(-time.Second)).Equal(t)
+}
+
+// blockEnd returns the last whole second of the contiguous block the calendar
+// matches around t, assuming t matches.
+func (c *calendarSchedule) blockEnd(t time.Time) time.Time {
+	local := t.In(c.location)
+	var end time.Time
+	switch c.blockLength {
+	case time.Second:
+		return t.Truncate(time.Second)
+	case time.Minute:
+		end = time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute()+1, 0, 0, c.location)
+	case time.Hour:
+		end = time.Date(local.Year(), local.Month(), local.Day(), local.Hour()+1, 0, 0, 0, c.location)
+	default:
+		end = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, c.location)
+	}
+	return end.Add(-time.Second).In(t.Location())
+}
+
+func (c *calendarSchedule) dayMatches(local time.Time) bool {
+	switch c.dayOfMonth {
+	case calendarDayOfMonthLast:
+		return local.Day() == lastDayOfMonth(local)
+	case calendarDayOfMonthLastWeekday:
+		return local.Day() == lastWeekdayOfMonth(local)
+	}
+	return true
+}
+
+func lastDayOfMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func lastWeekdayOfMonth(t time.Time) int {
+	last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+	switch last.Weekday() {
+	case time.Saturday:
+		return last.Day() - 1
+	case time.Sunday:
+		return last.Day() - 2
+	}
+	return last.Day()
+}
+
+// parseCalendarYears parses the Year field: "*" or a comma-separated list of
+// years and inclusive year ranges. It returns a matcher and the largest year
+// that can match.
+func parseCalendarYears(expr string) (func(int) bool, int, error) {
+	if expr == "*" {
+		return func(int) bool { return true }, calendarMaxYear, nil
+	}
+	type yearRange struct{ from, to int }
+	var ranges []yearRange
+	maxYear := 0
+	for _, part := range strings.Split(expr, ",") {
+		part = strings.TrimSpace(part)
+		from, to := part, part
+		if i := strings.Index(part, "-"); i > 0 {
+			from, to = part[:i], part[i+1:]
+		}
+		lo, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid year %q", part)
+		}
+		hi, err := strconv.Atoi(to)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid year %q", part)
+		}
+		if lo < calendarMinYear || hi > calendarMaxYear || lo > hi {
+			return nil, 0, fmt.Errorf("year %q is out of range [%d, %d]", part, calendarMinYear, calendarMaxYear)
+		}
+		ranges = append(ranges, yearRange{from: lo, to: hi})
+		if hi > maxYear {
+			maxYear = hi
+		}
+	}
+	return func(year int) bool {
+		for _, r := range ranges {
+			if year >= r.from && year <= r.to {
+				return true
+			}
+		}
+		return false
+	}, maxYear, nil
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// nextFires walks sched from start and returns the first n fire times.
+func nextFires(sched cron.Schedule, start time.Time, n int) []time.Time {
+	var out []time.Time
+	t := start
+	for len(out) < n {
+		t = sched.Next(t)
+		if t.IsZero() {
+			break
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func TestParseScheduleSpec(t *testing.T) {
+	anchor := time.Date(2026, 1, 1, 0, 15, 0, 0, time.UTC)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		spec      types.ScheduleSpec
+		start     time.Time
+		n         int
+		wantFires []time.Time
+		wantErr   string
+	}{
+		{
+			name:    "empty spec",
+			spec:    types.ScheduleSpec{},
+			wantErr: "at least one of",
+		},
+		{
+			name:    "exclusions alone are not a fire source",
+			spec:    types.ScheduleSpec{ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "12"}}},
+			wantErr: "at least one of",
+		},
+		{
+			name:    "invalid cron",
+			spec:    types.ScheduleSpec{CronExpression: "not-a-cron"},
+			wantErr: "invalid cron expression",
+		},
+		{
+			name:    "interval shorter than a second",
+			spec:    types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Millisecond}}},
+			wantErr: "shorter than the minimum",
+		},
+		{
+			name:    "interval not whole seconds",
+			spec:    types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: 1500 * time.Millisecond}}},
+			wantErr: "whole number of seconds",
+		},
+		{
+			name:    "nil interval",
+			spec:    types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{nil}},
+			wantErr: "interval spec is nil",
+		},
+		{
+			name:    "invalid calendar field",
+			spec:    types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "25"}}},
+			wantErr: "invalid calendar 0",
+		},
+		{
+			name:    "invalid time zone",
+			spec:    types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{TimeZone: "Mars/Olympus"}}},
+			wantErr: "invalid time zone",
+		},
+		{
+			name:    "invalid year",
+			spec:    types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Year: "1900"}}},
+			wantErr: "out of range",
+		},
+		{
+			name: "too many intervals",
+			spec: types.ScheduleSpec{Intervals: func() []*types.ScheduleIntervalSpec {
+				out := make([]*types.ScheduleIntervalSpec, MaxScheduleIntervals+1)
+				for i := range out {
+					out[i] = &types.ScheduleIntervalSpec{Interval: time.Hour}
+				}
+				return out
+			}()},
+			wantErr: "at most",
+		},
+		{
+			name:  "cron only",
+			spec:  types.ScheduleSpec{CronExpression: "0 */6 * * *"},
+			start: start,
+			n:     2,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "every 90 minutes from an anchor",
+			spec:  types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Anchor: anchor}}},
+			start: start,
+			n:     3,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 0, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 1, 45, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 3, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "interval fires before the anchor are aligned to it",
+			spec:  types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Anchor: anchor.Add(24 * time.Hour)}}},
+			start: start,
+			n:     1,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 0, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "zero anchor aligns to the epoch",
+			spec:  types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: 5 * time.Hour}}},
+			start: start,
+			n:     1,
+			// 2026-01-01T00:00Z is 490896h after the epoch; the next multiple of 5h is 490900h.
+			wantFires: []time.Time{time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:  "calendar defaults time fields to zero",
+			spec:  types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "6", DayOfWeek: "MON-FRI"}}},
+			start: start, // Thursday
+			n:     3,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 5, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "calendar last day of month",
+			spec:  types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "23", DayOfMonth: "L"}}},
+			start: start,
+			n:     3,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 31, 23, 0, 0, 0, time.UTC),
+				time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC),
+				time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "calendar last weekday of month",
+			spec:  types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "18", DayOfMonth: "LW"}}},
+			start: start,
+			n:     3,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 30, 18, 0, 0, 0, time.UTC), // Jan 31 is a Saturday
+				time.Date(2026, 2, 27, 18, 0, 0, 0, time.UTC), // Feb 28 is a Saturday
+				time.Date(2026, 3, 31, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "calendar year restriction",
+			spec:  types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Month: "1", DayOfMonth: "1", Year: "2028,2030-2031"}}},
+			start: start,
+			n:     4,
+			wantFires: []time.Time{
+				time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "calendar time zone",
+			spec:  types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "9", TimeZone: "America/New_York"}}},
+			start: start,
+			n:     1,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "union of cron, interval and calendar deduplicates shared times",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 2 * * *",
+				Intervals:      []*types.ScheduleIntervalSpec{{Interval: 2 * time.Hour, Anchor: start}},
+				Calendars:      []*types.ScheduleCalendarSpec{{Hour: "3"}},
+			},
+			start: start,
+			n:     4,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "last business day excluding holidays",
+			spec: types.ScheduleSpec{
+				Calendars: []*types.ScheduleCalendarSpec{{Hour: "18", DayOfMonth: "LW"}},
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{
+					{Month: "2", DayOfMonth: "27", Year: "2026"},
+				},
+			},
+			start: start,
+			n:     2,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 30, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 3, 31, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "excluded day is skipped as a whole for a frequent schedule",
+			spec: types.ScheduleSpec{
+				CronExpression:   "* * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "1", DayOfMonth: "1"}},
+			},
+			start: start,
+			n:     1,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "excluded hours",
+			spec: types.ScheduleSpec{
+				Intervals:        []*types.ScheduleIntervalSpec{{Interval: 30 * time.Minute}},
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Hour: "0-7"}},
+			},
+			start: start,
+			n:     2,
+			wantFires: []time.Time{
+				time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "everything excluded",
+			spec: types.ScheduleSpec{
+				CronExpression:   "0 * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{}},
+			},
+			start: start,
+			n:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := parseScheduleSpec(tt.spec)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFires, nextFires(sched, tt.start, tt.n))
+		})
+	}
+}
+
+func TestValidateScheduleSpec(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		spec    *types.ScheduleSpec
+		wantErr bool
+	}{
+		{
+			name:    "nil spec",
+			spec:    nil,
+			wantErr: true,
+		},
+		{
+			name: "valid interval",
+			spec: &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour}}},
+		},
+		{
+			name:    "calendar only fires in the past",
+			spec:    &types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Year: "2025"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid calendar",
+			spec:    &types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{DayOfWeek: "FUNDAY"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleSpec(tt.spec, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestComputeMissedFireTimesWithStructuredSpec(t *testing.T) {
+	spec := types.ScheduleSpec{
+		CronExpression: "0 12 * * *",
+		Intervals:      []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Anchor: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		EndTime:        time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC),
+	}
+	sched, err := parseScheduleSpec(spec)
+	require.NoError(t, err)
+
+	got := computeMissedFireTimes(sched, time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), spec)
+	assert.False(t, got.truncated)
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}, got.times)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+// handleTrigger queues a TriggerSchedule request stamped with the workflow
+// time it was received at. Two triggers received at the same workflow time
+// collapse into one because they would share a WorkflowID and RequestID.
+// Returns true if the trigger was queued.
+func handleTrigger(logger *zap.Logger, scope tally.Scope, sig TriggerSignal, state *SchedulerWorkflowState, now time.Time) bool {
+	for _, existing := range state.PendingTriggers {
+		if existing.ScheduledTime.Equal(now) {
+			logger.Info("ignoring trigger: another trigger is already pending for this time",
+				zap.Time("scheduledTime", now),
+			)
+			return false
+		}
+	}
+	if len(state.PendingTriggers) >= maxPendingTriggers {
+		scope.Counter(SchedulerTriggerRejectedCountPerDomain).Inc(1)
+		logger.Warn("ignoring trigger: pending trigger queue is full",
+			zap.Int("queueSize", len(state.PendingTriggers)),
+			zap.Int("maxPendingTriggers", maxPendingTriggers),
+		)
+		return false
+	}
+	state.PendingTriggers = append(state.PendingTriggers, TriggerRequest{
+		ScheduledTime: now,
+		OverlapPolicy: sig.OverlapPolicy,
+	})
+	logger.Info("trigger queued",
+		zap.Time("scheduledTime", now),
+		zap.String("overlapPolicy", sig.OverlapPolicy.String()),
+	)
+	return true
+}
+
+// processTriggers fires every pending TriggerSchedule request. Unlike
+// backfills, triggers ignore the pause state: the caller asked for the
+// action to run now. Each trigger goes through processScheduleFire, so the
+// effective overlap policy and the BUFFER queue apply as for any other fire.
+func processTriggers(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) {
+	for len(state.PendingTriggers) > 0 {
+		tr := state.PendingTriggers[0]
+		state.PendingTriggers = state.PendingTriggers[1:]
+		overlap := effectiveFireOverlap(TriggerSourceManual, tr.OverlapPolicy, input.Policies.OverlapPolicy)
+		processScheduleFire(ctx, logger, scope, input, state, tr.ScheduledTime, TriggerSourceManual, overlap, "")
+	}
+}
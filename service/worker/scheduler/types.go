@@ -30,11 +30,13 @@ import (
 const (
 	WorkflowTypeName = "cadence-scheduler"
 	TaskListName     = "cadence-scheduler"
+	WorkflowIDPrefix = "cadence-scheduler:"
 
 	SignalNamePause    = "scheduler-pause"
 	SignalNameUnpause  = "scheduler-unpause"
 	SignalNameUpdate   = "scheduler-update"
 	SignalNameBackfill = "scheduler-backfill"
+	SignalNameTrigger  = "scheduler-trigger"
 	SignalNameDelete   = "scheduler-delete"
 
 	QueryTypeDescribe = "scheduler-describe"
@@ -83,6 +85,10 @@ const (
 	// than enqueued a second time.
 	BackfillRejectedReasonDuplicateID = "duplicate_id"
 
+	// SchedulerTriggerRejectedCountPerDomain counts trigger signals dropped by
+	// the workflow because the pending trigger queue is full.
+	SchedulerTriggerRejectedCountPerDomain = "scheduler_trigger_rejected_count_per_domain"
+
 	// MaxBufferedFiresSystemLimit caps the BUFFER overlap policy queue regardless
 	// of buffer_limit (including buffer_limit=0 meaning unlimited). It bounds the
 	// ContinueAsNew payload size: each BufferedFire is ~50 bytes JSON, so 1000
@@ -102,6 +108,7 @@ const (
 	signalTypeTagUnpause  = "unpause"
 	signalTypeTagUpdate   = "update"
 	signalTypeTagBackfill = "backfill"
+	signalTypeTagTrigger  = "trigger"
 	signalTypeTagDelete   = "delete"
 
 	// Search attribute keys set on target workflows started by the scheduler.
@@ -137,6 +144,14 @@ const (
 	// bounded by this value before ContinueAsNew.
 	maxActivitiesPerExecution = 500
 	maxPendingBackfills       = 10
+	maxPendingTriggers        = 10
+
+	// maxRecentActions caps SchedulerWorkflowState.RecentActions. Each entry
+	// is ~250 bytes JSON, so the ring adds little to the ContinueAsNew payload.
+	maxRecentActions = 10
+	// maxFutureActionTimes is the number of upcoming fire times reported by
+	// the describe query.
+	maxFutureActionTimes = 5
 
 	// maxBackfillRunsTotalCount caps the cron walk that populates
 	// BackfillRequest.RunsTotal. When a backfill range produces more fires
@@ -164,6 +179,11 @@ const (
 	watcherActivityHeartbeatTimeout = 65 * time.Second
 )
 
+// WorkflowID returns the ID of the scheduler workflow running the schedule.
+func WorkflowID(scheduleID string) string {
+	return WorkflowIDPrefix + scheduleID
+}
+
 // watcherPollInterval controls how often the watcher activity calls
 // DescribeWorkflowExecution. 5s balances drain latency against RPC load.
 var watcherPollInterval = 5 * time.Second
@@ -221,6 +241,21 @@ type SchedulerWorkflowState struct {
 	// PausedAt is the wall-clock time when the schedule was most recently paused.
 	// Zero when the schedule is not paused (or was never paused).
 	PausedAt time.Time `json:"pausedAt,omitempty"`
+	// RecentActions is a ring of the last maxRecentActions fire outcomes,
+	// oldest first. A buffered fire appears once when it is deferred and
+	// again when it is eventually started or skipped.
+	RecentActions []types.ScheduleActionResult `json:"recentActions,omitempty"`
+	// PendingTriggers holds TriggerSchedule requests received but not yet
+	// fired. Normally drained in the same decision that received them; kept
+	// in state so a ContinueAsNew in between does not lose them.
+	PendingTriggers []TriggerRequest `json:"pendingTriggers,omitempty"`
+}
+
+// TriggerRequest is a queued TriggerSchedule fire. ScheduledTime is the
+// workflow time at which the trigger signal was received.
+type TriggerRequest struct {
+	ScheduledTime time.Time                   `json:"scheduledTime"`
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
 }
 
 // BufferedFire is a schedule fire queued for sequential execution by the BUFFER
@@ -296,6 +331,12 @@ type BackfillSignal struct {
 	BackfillID    string                      `json:"backfillId,omitempty"`
 }
 
+// TriggerSignal is the payload sent with a trigger signal. OverlapPolicy
+// INVALID (0) inherits the schedule's configured policy.
+type TriggerSignal struct {
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
 // ScheduleDescription is the query result returned by the describe query handler.
 // It provides a snapshot of the schedule's current configuration and runtime state.
 type ScheduleDescription struct {
@@ -322,6 +363,11 @@ type ScheduleDescription struct {
 	// OngoingBackfills mirrors SchedulerWorkflowState.PendingBackfills at the
 	// time of the describe query.
 	OngoingBackfills []types.BackfillInfo `json:"ongoingBackfills,omitempty"`
+	// RecentActions mirrors SchedulerWorkflowState.RecentActions.
+	RecentActions []types.ScheduleActionResult `json:"recentActions,omitempty"`
+	// FutureActionTimes holds the next maxFutureActionTimes fire times starting
+	// at NextRunTime. Empty while the schedule is paused or has no future fires.
+	FutureActionTimes []time.Time `json:"futureActionTimes,omitempty"`
 }
 
 // TriggerSource identifies what caused a schedule fire, used to differentiate
@@ -332,6 +378,7 @@ type TriggerSource string
 const (
 	TriggerSourceSchedule TriggerSource = "schedule"
 	TriggerSourceBackfill TriggerSource = "backfill"
+	TriggerSourceManual   TriggerSource = "manual"
 )
 
 // fireOutcome is the result of attempting to fire a single schedule run. It
@@ -370,6 +417,11 @@ type ProcessFireRequest struct {
 	RunningWorkflows []RunningWorkflowInfo `json:"runningWorkflows,omitempty"`
 	// BackfillID is non-empty only for fires driven by a schedule backfill (matches RPC BackfillID).
 	BackfillID string `json:"backfillId,omitempty"`
+	// SignalWorkflow and SignalWithStartWorkflow carry the non-start action
+	// variants. At most one is set; when either is, the overlap policy does
+	// not apply and Action is ignored.
+	SignalWorkflow          *types.SignalWorkflowAction          `json:"signalWorkflow,omitempty"`
+	SignalWithStartWorkflow *types.SignalWithStartWorkflowAction `json:"signalWithStartWorkflow,omitempty"`
 }
 
 // ProcessFireResult is the output of processScheduleFireActivity. The workflow
@@ -386,4 +438,10 @@ type ProcessFireResult struct {
 	// ActiveWorkflows is the updated in-flight set for bounded CONCURRENT; the workflow
 	// replaces state.RunningWorkflows with it after each fire. Nil for all other policies.
 	ActiveWorkflows []RunningWorkflowInfo `json:"activeWorkflows,omitempty"`
+	// ActualTime is when the activity acted on the fire. It is recorded in the
+	// local-activity marker, so the workflow can use it without breaking replay.
+	ActualTime time.Time `json:"actualTime,omitempty"`
+	// Overlapped is true when a previous target workflow was found still
+	// running, whatever the overlap policy then did about it.
+	Overlapped bool `json:"overlapped,omitempty"`
 }
@@ -40,11 +40,12 @@ type signalChannels struct {
 	unpause  workflow.Channel
 	update   workflow.Channel
 	backfill workflow.Channel
+	trigger  workflow.Channel
 	delete   workflow.Channel
 }
 
 // SchedulerWorkflow is a long-running workflow that manages a single schedule.
-// It computes the next fire time from the cron expression, waits via a timer,
+// It computes the next fire time from the schedule spec, waits via a timer,
 // and dispatches the configured action. Signals control pause/unpause, update,
 // backfill, and deletion.
 //
@@ -95,13 +96,14 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		unpause:  workflow.GetSignalChannel(ctx, SignalNameUnpause),
 		update:   workflow.GetSignalChannel(ctx, SignalNameUpdate),
 		backfill: workflow.GetSignalChannel(ctx, SignalNameBackfill),
+		trigger:  workflow.GetSignalChannel(ctx, SignalNameTrigger),
 		delete:   workflow.GetSignalChannel(ctx, SignalNameDelete),
 	}
 
-	sched, err := cron.ParseStandard(input.Spec.CronExpression)
+	sched, err := parseScheduleSpec(input.Spec)
 	if err != nil {
-		logger.Error("invalid cron expression, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
-		return fmt.Errorf("invalid cron expression %q: %w", input.Spec.CronExpression, err)
+		logger.Error("invalid schedule spec, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
+		return fmt.Errorf("invalid schedule spec: %w", err)
 	}
 
 	// activityBudget is the per-execution ceiling for local-activity dispatches.
@@ -130,6 +132,9 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		return safeContinueAsNew(ctx, logger, scope, ContinueAsNewReasonBackfill, chs.delete, input, state)
 	}
 
+	// Fire any triggers carried over from a previous execution.
+	processTriggers(ctx, logger, scope, &input, state)
+
 	for {
 		state.Iterations++
 
@@ -215,7 +220,7 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		// so no inline upsert is needed here.
 
 		// Deleted schedules terminate the workflow here. Any further signals
-		// (pause, unpause, update, backfill) sent after this point fail with
+		// (pause, unpause, update, backfill, trigger) sent after this point fail with
 		// EntityNotExistsError at the RPC layer because the workflow is closed;
 		// the frontend normalizes that to a user-friendly "schedule not found".
 		if state.Deleted {
@@ -231,6 +236,9 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			processScheduleFire(ctx, logger, scope, &input, state, state.NextRunTime, TriggerSourceSchedule, input.Policies.OverlapPolicy, "")
 		}
 
+		// Triggers fire even while paused and do not force a ContinueAsNew.
+		processTriggers(ctx, logger, scope, &input, state)
+
 		if changed || state.Iterations >= maxIterationsBeforeContinueAsNew {
 			reason := ContinueAsNewReasonSignal
 			if !changed {
@@ -314,6 +322,15 @@ func applyAllInputs(
 		}
 	})
 
+	// Triggers only queue work for the main loop; they do not mark the state
+	// as changed because nothing about the schedule definition moved.
+	selector.AddReceive(chs.trigger, func(c workflow.Channel, more bool) {
+		var sig TriggerSignal
+		c.Receive(ctx, &sig)
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, scope, sig, state, workflow.Now(ctx))
+	})
+
 	selector.AddReceive(chs.delete, func(c workflow.Channel, more bool) {
 		c.Receive(ctx, nil)
 		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagDelete}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
@@ -389,6 +406,14 @@ func drainBufferedSignals(
 			stateChanged = true
 		}
 	}
+	for {
+		var sig TriggerSignal
+		if !chs.trigger.ReceiveAsync(&sig) {
+			break
+		}
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, scope, sig, state, now)
+	}
 
 	return stateChanged
 }
@@ -402,6 +427,15 @@ func scheduleStateFromPaused(paused bool) string {
 	return ScheduleStateActive
 }
 
+// scheduleTargetWorkflowType returns the workflow type the action may start,
+// or "" for actions that only signal an existing workflow.
+func scheduleTargetWorkflowType(action types.ScheduleAction) string {
+	if sw := action.GetSignalWithStartWorkflow(); sw != nil {
+		return sw.GetStartWorkflow().GetWorkflowType().GetName()
+	}
+	return action.GetStartWorkflow().GetWorkflowType().GetName()
+}
+
 // buildScheduleSearchAttributes returns the search attributes that describe a
 // scheduler workflow for ListSchedules: lifecycle state, cron expression, and
 // target workflow type. The state SA is always written (the boolean Paused has
@@ -414,8 +448,8 @@ func buildScheduleSearchAttributes(input *SchedulerWorkflowInput, state *Schedul
 	if cron := input.Spec.CronExpression; cron != "" {
 		sa[SearchAttrScheduleCron] = cron
 	}
-	if sw := input.Action.StartWorkflow; sw != nil && sw.WorkflowType != nil && sw.WorkflowType.Name != "" {
-		sa[SearchAttrScheduleWorkflowType] = sw.WorkflowType.Name
+	if name := scheduleTargetWorkflowType(input.Action); name != "" {
+		sa[SearchAttrScheduleWorkflowType] = name
 	}
 	if input.SearchAttributes != nil {
 		for k, v := range input.SearchAttributes.IndexedFields {
@@ -464,8 +498,8 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 	}
 	changed := false
 	if sig.Spec != nil {
-		if _, err := cron.ParseStandard(sig.Spec.CronExpression); err != nil {
-			logger.Error("ignoring update with invalid cron expression",
+		if _, err := parseScheduleSpec(*sig.Spec); err != nil {
+			logger.Error("ignoring update with invalid schedule spec",
 				zap.String("cron", sig.Spec.CronExpression), zap.Error(err))
 		} else {
 			input.Spec = *sig.Spec
@@ -550,9 +584,7 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) {
 	if overlapPolicy == types.ScheduleOverlapPolicyBuffer && len(state.BufferedFires) > 0 {
 		// Skipping tryStartFire, so advance LastRunTime here.
-		if scheduledTime.After(state.LastRunTime) {
-			state.LastRunTime = scheduledTime
-		}
+		advanceLastRunTime(state, scheduledTime, trigger)
 		enqueueBufferedFire(logger, scope, input, state, scheduledTime, trigger, overlapPolicy, backfillID)
 		return
 	}
@@ -561,45 +593,65 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.S
 	}
 }
 
+// advanceLastRunTime moves LastRunTime forward to scheduledTime. It only
+// moves forward because, under BUFFER, an older queued fire can drain after a
+// newer fire has already been processed. Manual triggers are ignored: they are
+// not spec fires, and LastRunTime feeds the catch-up watermark, so a trigger
+// while paused must not hide the spec fires missed before it.
+func advanceLastRunTime(state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource) {
+	if trigger == TriggerSourceManual {
+		return
+	}
+	if scheduledTime.After(state.LastRunTime) {
+		state.LastRunTime = scheduledTime
+	}
+}
+
 // tryStartFire runs the scheduler activity for a single fire and applies the
 // result to state, returning whether the fire was buffered. Shared by the
 // live-fire and drain-buffered-fire paths; the caller decides how to handle
 // a buffered outcome.
 func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) fireOutcome {
-	// LastRunTime moves forward only. Under BUFFER, an older queued fire can
-	// drain after a newer fire has already been processed.
-	if scheduledTime.After(state.LastRunTime) {
-		state.LastRunTime = scheduledTime
-	}
+	advanceLastRunTime(state, scheduledTime, trigger)
 
 	logger.Info("schedule fired",
 		zap.Time("scheduledTime", scheduledTime),
 	)
 
-	if input.Action.StartWorkflow == nil {
+	action := input.Action
+	if action.StartWorkflow == nil && action.SignalWorkflow == nil &&
+		(action.SignalWithStartWorkflow == nil || action.SignalWithStartWorkflow.StartWorkflow == nil) {
 		state.MissedRuns++
-		logger.Error("schedule action has no StartWorkflow configuration")
+		recordRecentAction(state, newActionResult(scheduledTime, trigger, backfillID, types.ScheduleActionOutcomeFailed))
+		logger.Error("schedule action has no StartWorkflow, SignalWorkflow or SignalWithStartWorkflow configuration")
 		return fireOutcomeDone
 	}
 
 	actCtx := workflow.WithLocalActivityOptions(ctx, defaultActivityOptions())
 
 	req := ProcessFireRequest{
-		Domain:              input.Domain,
-		ScheduleID:          input.ScheduleID,
-		Action:              *input.Action.StartWorkflow,
-		ScheduledTime:       scheduledTime,
-		TriggerSource:       trigger,
-		OverlapPolicy:       overlapPolicy,
-		LastStartedWorkflow: state.LastStartedWorkflow,
-		ConcurrencyLimit:    input.Policies.ConcurrencyLimit,
-		RunningWorkflows:    state.RunningWorkflows,
-		BackfillID:          backfillID,
+		Domain:                  input.Domain,
+		ScheduleID:              input.ScheduleID,
+		SignalWorkflow:          action.SignalWorkflow,
+		SignalWithStartWorkflow: action.SignalWithStartWorkflow,
+		ScheduledTime:           scheduledTime,
+		TriggerSource:           trigger,
+		OverlapPolicy:           overlapPolicy,
+		LastStartedWorkflow:     state.LastStartedWorkflow,
+		ConcurrencyLimit:        input.Policies.ConcurrencyLimit,
+		RunningWorkflows:        state.RunningWorkflows,
+		BackfillID:              backfillID,
+	}
+	if action.StartWorkflow != nil {
+		req.Action = *action.StartWorkflow
 	}
 
 	var result ProcessFireResult
 	if err := workflow.ExecuteLocalActivity(actCtx, processScheduleFireActivity, req).Get(ctx, &result); err != nil {
 		state.MissedRuns++
+		failed := newActionResult(scheduledTime, trigger, backfillID, types.ScheduleActionOutcomeFailed)
+		failed.ActualTime = workflow.Now(ctx)
+		recordRecentAction(state, failed)
 		logger.Error("processScheduleFireActivity failed",
 			zap.Time("scheduledTime", scheduledTime),
 			zap.Error(err),
@@ -607,10 +659,27 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 		return fireOutcomeDone
 	}
 
+	// Buffered fires are recorded by enqueueBufferedFire, once per fire rather
+	// than on every drain attempt that finds the head still blocked.
 	if result.Buffered {
 		return fireOutcomeBuffered
 	}
 
+	outcome := types.ScheduleActionOutcomeSkipped
+	if result.TotalDelta > 0 {
+		outcome = types.ScheduleActionOutcomeStarted
+		if action.StartWorkflow == nil {
+			outcome = types.ScheduleActionOutcomeSignaled
+		}
+	}
+	entry := newActionResult(scheduledTime, trigger, backfillID, outcome)
+	entry.ActualTime = result.ActualTime
+	entry.Overlapped = result.Overlapped
+	if wf := result.StartedWorkflow; wf != nil {
+		entry.WorkflowExecution = &types.WorkflowExecution{WorkflowID: wf.WorkflowID, RunID: wf.RunID}
+	}
+	recordRecentAction(state, entry)
+
 	state.TotalRuns += result.TotalDelta
 	state.SkippedRuns += result.SkippedDelta
 	if result.StartedWorkflow != nil {
@@ -625,6 +694,10 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 			zap.String("workflowId", result.StartedWorkflow.WorkflowID),
 			zap.String("runId", result.StartedWorkflow.RunID),
 		)
+	} else if result.TotalDelta > 0 {
+		logger.Info("scheduled signal delivered",
+			zap.Time("scheduledTime", scheduledTime),
+		)
 	} else if result.SkippedDelta > 0 {
 		logger.Info("schedule fire skipped",
 			zap.Time("scheduledTime", scheduledTime),
@@ -641,6 +714,9 @@ func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *Scheduler
 	effective, reason := effectiveBufferLimit(input.Policies.BufferLimit)
 	if len(state.BufferedFires) >= effective {
 		state.SkippedRuns++
+		skipped := newActionResult(scheduledTime, trigger, backfillID, types.ScheduleActionOutcomeSkipped)
+		skipped.Overlapped = true
+		recordRecentAction(state, skipped)
 		scope.Tagged(map[string]string{ReasonTag: reason}).
 			Counter(SchedulerBufferOverflowCountPerDomain).Inc(1)
 		logger.Warn("buffer cap reached; dropping fire",
@@ -659,12 +735,36 @@ func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *Scheduler
 		OverlapPolicy: overlapPolicy,
 		BackfillID:    backfillID,
 	})
+	buffered := newActionResult(scheduledTime, trigger, backfillID, types.ScheduleActionOutcomeBuffered)
+	buffered.Overlapped = true
+	recordRecentAction(state, buffered)
 	logger.Info("schedule fire buffered",
 		zap.Time("scheduledTime", scheduledTime),
 		zap.Int("bufferSize", len(state.BufferedFires)),
 	)
 }
 
+// newActionResult returns a RecentActions entry for a fire with the trigger
+// metadata filled in. Callers set the outcome-specific fields.
+func newActionResult(scheduledTime time.Time, trigger TriggerSource, backfillID string, outcome types.ScheduleActionOutcome) types.ScheduleActionResult {
+	return types.ScheduleActionResult{
+		ScheduledTime:   scheduledTime,
+		Outcome:         outcome,
+		IsBackfill:      trigger == TriggerSourceBackfill,
+		IsManualTrigger: trigger == TriggerSourceManual,
+		BackfillID:      backfillID,
+	}
+}
+
+// recordRecentAction appends an entry to state.RecentActions, dropping the
+// oldest entries beyond maxRecentActions.
+func recordRecentAction(state *SchedulerWorkflowState, entry types.ScheduleActionResult) {
+	state.RecentActions = append(state.RecentActions, entry)
+	if over := len(state.RecentActions) - maxRecentActions; over > 0 {
+		state.RecentActions = append([]types.ScheduleActionResult(nil), state.RecentActions[over:]...)
+	}
+}
+
 // effectiveBufferLimit returns the queue cap actually enforced for the BUFFER
 // overlap policy and the reason tag value to attribute drops at that cap.
 //
@@ -746,8 +846,8 @@ func defaultActivityOptions() workflow.LocalActivityOptions {
 	}
 }
 
-// computeNextRunTime determines the next fire time for the cron schedule,
-// respecting the spec's StartTime and EndTime boundaries.
+// computeNextRunTime determines the next fire time for the parsed schedule
+// spec, respecting the spec's StartTime and EndTime boundaries.
 func computeNextRunTime(sched cron.Schedule, now time.Time, spec types.ScheduleSpec) time.Time {
 	if !spec.StartTime.IsZero() && now.Before(spec.StartTime) {
 		now = spec.StartTime.Add(-time.Second)
@@ -991,7 +1091,31 @@ func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWor
 		Memo:                 input.Memo,
 		SearchAttributes:     input.SearchAttributes,
 		OngoingBackfills:     ongoing,
+		RecentActions:        state.RecentActions,
+		FutureActionTimes:    futureActionTimes(input.Spec, state),
+	}
+}
+
+// futureActionTimes returns up to maxFutureActionTimes upcoming fire times,
+// starting at state.NextRunTime. The spec is re-parsed on each call; describe
+// queries are rare and the result does not feed back into workflow state.
+func futureActionTimes(spec types.ScheduleSpec, state *SchedulerWorkflowState) []time.Time {
+	if state.Paused || state.NextRunTime.IsZero() {
+		return nil
+	}
+	sched, err := parseScheduleSpec(spec)
+	if err != nil {
+		return nil
+	}
+	times := []time.Time{state.NextRunTime}
+	for len(times) < maxFutureActionTimes {
+		next := computeNextRunTime(sched, times[len(times)-1], spec)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, next)
 	}
+	return times
 }
 
 // safeContinueAsNew drains the delete channel before performing ContinueAsNew.
@@ -404,6 +404,9 @@ func TestBuildScheduleDescription(t *testing.T) {
 				SkippedRuns:    3,
 				CreateTime:     createTime,
 				LastUpdateTime: updateTime,
+				RecentActions: []types.ScheduleActionResult{
+					{ScheduledTime: lastRun, ActualTime: lastRun.Add(time.Second), Outcome: types.ScheduleActionOutcomeStarted},
+				},
 			},
 			want: &ScheduleDescription{
 				ScheduleID: "sched-1",
@@ -422,6 +425,16 @@ func TestBuildScheduleDescription(t *testing.T) {
 				SkippedRuns:    3,
 				CreateTime:     createTime,
 				LastUpdateTime: updateTime,
+				RecentActions: []types.ScheduleActionResult{
+					{ScheduledTime: lastRun, ActualTime: lastRun.Add(time.Second), Outcome: types.ScheduleActionOutcomeStarted},
+				},
+				FutureActionTimes: []time.Time{
+					nextRun,
+					nextRun.Add(time.Hour),
+					nextRun.Add(2 * time.Hour),
+					nextRun.Add(3 * time.Hour),
+					nextRun.Add(4 * time.Hour),
+				},
 			},
 		},
 		{
@@ -987,6 +1000,111 @@ func TestHandleBackfill(t *testing.T) {
 	})
 }
 
+func TestHandleTrigger(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		pending          []TriggerRequest
+		sig              TriggerSignal
+		wantQueued       bool
+		wantPendingLen   int
+		wantRejectMetric bool
+	}{
+		"queues trigger stamped with now": {
+			sig:            TriggerSignal{OverlapPolicy: types.ScheduleOverlapPolicyConcurrent},
+			wantQueued:     true,
+			wantPendingLen: 1,
+		},
+		"second trigger at a later time is queued": {
+			pending:        []TriggerRequest{{ScheduledTime: now.Add(-time.Second)}},
+			wantQueued:     true,
+			wantPendingLen: 2,
+		},
+		"trigger at the same time as a pending one collapses": {
+			pending:        []TriggerRequest{{ScheduledTime: now}},
+			wantQueued:     false,
+			wantPendingLen: 1,
+		},
+		"trigger rejected when queue is full": {
+			pending: func() []TriggerRequest {
+				out := make([]TriggerRequest, maxPendingTriggers)
+				for i := range out {
+					out[i].ScheduledTime = now.Add(-time.Duration(i+1) * time.Second)
+				}
+				return out
+			}(),
+			wantQueued:       false,
+			wantPendingLen:   maxPendingTriggers,
+			wantRejectMetric: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := &SchedulerWorkflowState{PendingTriggers: tt.pending}
+			scope := tally.NewTestScope("", nil)
+
+			got := handleTrigger(testLogger, scope, tt.sig, state, now)
+			assert.Equal(t, tt.wantQueued, got)
+			require.Len(t, state.PendingTriggers, tt.wantPendingLen)
+			if tt.wantQueued {
+				last := state.PendingTriggers[len(state.PendingTriggers)-1]
+				assert.Equal(t, now, last.ScheduledTime)
+				assert.Equal(t, tt.sig.OverlapPolicy, last.OverlapPolicy)
+			}
+
+			_, ok := findCounter(scope.Snapshot().Counters(), SchedulerTriggerRejectedCountPerDomain, nil)
+			assert.Equal(t, tt.wantRejectMetric, ok)
+		})
+	}
+}
+
+// TestProcessTriggers verifies pending triggers are fired as manual fires
+// with their overlap override, marked as such in RecentActions, and do not
+// advance LastRunTime (the catch-up watermark). BUFFER with a non-empty queue
+// keeps the fires off the activity path so a nil ctx is safe.
+func TestProcessTriggers(t *testing.T) {
+	t0 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	input := &SchedulerWorkflowInput{
+		Spec: types.ScheduleSpec{CronExpression: "0 * * * *"},
+		Action: types.ScheduleAction{
+			StartWorkflow: &types.StartWorkflowAction{
+				WorkflowType: &types.WorkflowType{Name: "wf"},
+				TaskList:     &types.TaskList{Name: "tl"},
+			},
+		},
+		Policies: types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicySkipNew},
+	}
+	state := &SchedulerWorkflowState{
+		Paused:      true,
+		LastRunTime: t0,
+		BufferedFires: []BufferedFire{
+			{ScheduledTime: t0, TriggerSource: TriggerSourceSchedule, OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+		},
+		PendingTriggers: []TriggerRequest{
+			{ScheduledTime: t0.Add(10 * time.Minute), OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+			{ScheduledTime: t0.Add(20 * time.Minute), OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+		},
+	}
+	scope := tally.NewTestScope("", nil)
+
+	processTriggers(nil, testLogger, scope, input, state)
+
+	assert.Empty(t, state.PendingTriggers)
+	require.Len(t, state.BufferedFires, 3, "triggers fire even while paused")
+	for _, bf := range state.BufferedFires[1:] {
+		assert.Equal(t, TriggerSourceManual, bf.TriggerSource)
+		assert.Equal(t, types.ScheduleOverlapPolicyBuffer, bf.OverlapPolicy)
+	}
+	assert.Equal(t, t0, state.LastRunTime, "manual triggers must not advance LastRunTime")
+	require.Len(t, state.RecentActions, 2)
+	for _, ra := range state.RecentActions {
+		assert.True(t, ra.IsManualTrigger)
+		assert.False(t, ra.IsBackfill)
+		assert.Equal(t, types.ScheduleActionOutcomeBuffered, ra.Outcome)
+	}
+}
+
 func TestCountCronFires(t *testing.T) {
 	hourly := mustParseCron(t, "0 * * * *")
 	spec := types.ScheduleSpec{CronExpression: "0 * * * *"}
@@ -1057,6 +1175,20 @@ func TestEffectiveFireOverlap(t *testing.T) {
 			scheduleOverlap: types.ScheduleOverlapPolicyBuffer,
 			want:            types.ScheduleOverlapPolicyConcurrent,
 		},
+		{
+			name:            "manual trigger INVALID inherits schedule overlap",
+			trigger:         TriggerSourceManual,
+			backfillOverlap: types.ScheduleOverlapPolicyInvalid,
+			scheduleOverlap: types.ScheduleOverlapPolicySkipNew,
+			want:            types.ScheduleOverlapPolicySkipNew,
+		},
+		{
+			name:            "manual trigger non-invalid overrides schedule overlap",
+			trigger:         TriggerSourceManual,
+			backfillOverlap: types.ScheduleOverlapPolicyTerminatePrevious,
+			scheduleOverlap: types.ScheduleOverlapPolicySkipNew,
+			want:            types.ScheduleOverlapPolicyTerminatePrevious,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1618,6 +1750,41 @@ func TestBuildScheduleSearchAttributes(t *testing.T) {
 				SearchAttrScheduleCron:  "@hourly",
 			},
 		},
+		{
+			name: "signal-with-start action reports its start workflow type",
+			input: &SchedulerWorkflowInput{
+				Spec: types.ScheduleSpec{CronExpression: "@hourly"},
+				Action: types.ScheduleAction{
+					SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+						WorkflowID: "entity-1",
+						SignalName: "tick",
+						StartWorkflow: &types.StartWorkflowAction{
+							WorkflowType: &types.WorkflowType{Name: "entity-workflow"},
+						},
+					},
+				},
+			},
+			state: &SchedulerWorkflowState{},
+			want: map[string]interface{}{
+				SearchAttrScheduleState:        ScheduleStateActive,
+				SearchAttrScheduleCron:         "@hourly",
+				SearchAttrScheduleWorkflowType: "entity-workflow",
+			},
+		},
+		{
+			name: "signal action omits workflow type SA",
+			input: &SchedulerWorkflowInput{
+				Spec: types.ScheduleSpec{CronExpression: "@hourly"},
+				Action: types.ScheduleAction{
+					SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "entity-1", SignalName: "tick"},
+				},
+			},
+			state: &SchedulerWorkflowState{},
+			want: map[string]interface{}{
+				SearchAttrScheduleState: ScheduleStateActive,
+				SearchAttrScheduleCron:  "@hourly",
+			},
+		},
 		{
 			name: "nil workflow type omits workflow type SA",
 			input: &SchedulerWorkflowInput{
@@ -1849,6 +2016,78 @@ func TestEnqueueBufferedFire(t *testing.T) {
 	}
 }
 
+func TestRecordRecentAction(t *testing.T) {
+	t0 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	state := &SchedulerWorkflowState{}
+	for i := 0; i < maxRecentActions+3; i++ {
+		recordRecentAction(state, newActionResult(t0.Add(time.Duration(i)*time.Minute), TriggerSourceSchedule, "", types.ScheduleActionOutcomeStarted))
+	}
+
+	require.Len(t, state.RecentActions, maxRecentActions)
+	assert.Equal(t, t0.Add(3*time.Minute), state.RecentActions[0].ScheduledTime, "oldest entries are dropped first")
+	assert.Equal(t, t0.Add(time.Duration(maxRecentActions+2)*time.Minute), state.RecentActions[maxRecentActions-1].ScheduledTime)
+}
+
+func TestEnqueueBufferedFireRecordsRecentAction(t *testing.T) {
+	t0 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	input := &SchedulerWorkflowInput{
+		Policies: types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicyBuffer, BufferLimit: 1},
+	}
+	state := &SchedulerWorkflowState{}
+	scope := tally.NewTestScope("", nil)
+
+	enqueueBufferedFire(testLogger, scope, input, state, t0, TriggerSourceBackfill, types.ScheduleOverlapPolicyBuffer, "bf-1")
+	enqueueBufferedFire(testLogger, scope, input, state, t0.Add(time.Minute), TriggerSourceSchedule, types.ScheduleOverlapPolicyBuffer, "")
+
+	assert.Equal(t, []types.ScheduleActionResult{
+		{ScheduledTime: t0, Outcome: types.ScheduleActionOutcomeBuffered, Overlapped: true, IsBackfill: true, BackfillID: "bf-1"},
+		{ScheduledTime: t0.Add(time.Minute), Outcome: types.ScheduleActionOutcomeSkipped, Overlapped: true},
+	}, state.RecentActions)
+}
+
+func TestFutureActionTimes(t *testing.T) {
+	next := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		spec  types.ScheduleSpec
+		state SchedulerWorkflowState
+		want  []time.Time
+	}{
+		{
+			name:  "lists the next fires from NextRunTime",
+			spec:  types.ScheduleSpec{CronExpression: "0 * * * *"},
+			state: SchedulerWorkflowState{NextRunTime: next},
+			want: []time.Time{
+				next,
+				next.Add(time.Hour),
+				next.Add(2 * time.Hour),
+				next.Add(3 * time.Hour),
+				next.Add(4 * time.Hour),
+			},
+		},
+		{
+			name:  "stops at EndTime",
+			spec:  types.ScheduleSpec{CronExpression: "0 * * * *", EndTime: next.Add(90 * time.Minute)},
+			state: SchedulerWorkflowState{NextRunTime: next},
+			want:  []time.Time{next, next.Add(time.Hour)},
+		},
+		{
+			name:  "paused schedule has no future fires",
+			spec:  types.ScheduleSpec{CronExpression: "0 * * * *"},
+			state: SchedulerWorkflowState{NextRunTime: next, Paused: true},
+		},
+		{
+			name: "no next run time",
+			spec: types.ScheduleSpec{CronExpression: "0 * * * *"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, futureActionTimes(tt.spec, &tt.state))
+		})
+	}
+}
+
 // largeBufferedFires builds a slice of n BufferedFire entries with one-second
 // spacing starting at base.
 func largeBufferedFires(n int, base time.Time) []BufferedFire {
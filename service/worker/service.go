@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/scanner/persistencemigration"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
@@ -199,6 +200,17 @@ func NewConfig(params *resource.Params) *Config {
 		dynamicproperties.WriteVisibilityStoreName,
 	)
 
+	if params.PersistenceConfig.Migration != nil {
+		config.ScannerCfg.ShardScanners = append(config.ScannerCfg.ShardScanners, persistencemigration.ScannerConfig(dc, persistencemigration.Params{
+			Persistence:    &params.PersistenceConfig,
+			ClusterName:    params.ClusterMetadata.GetCurrentClusterName(),
+			PersistenceQPS: func() float64 { return float64(config.ScannerCfg.ScannerPersistenceMaxQPS()) },
+			DynamicConfig:  persistence.NewDynamicConfiguration(dc),
+			MetricsClient:  params.MetricsClient,
+			Logger:         params.Logger,
+		}))
+	}
+
 	if shouldStartIndexer(params, advancedVisWritingMode) {
 		config.IndexerCfg = &indexer.Config{
 			IndexerConcurrency:             dc.GetIntProperty(dynamicproperties.WorkerIndexerConcurrency),
@@ -496,6 +508,9 @@ func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 		s.Resource.GetAsyncWorkflowQueueProvider(),
 		s.GetFrontendClient(),
 		asyncworkflow.WithEnabledPropertyFn(s.config.EnableAsyncWorkflowConsumption),
+		asyncworkflow.WithQueueManager(s.GetPersistenceBean().GetAsyncWorkflowQueueManager()),
+		asyncworkflow.WithMembershipResolver(s.GetMembershipResolver()),
+		asyncworkflow.WithRequestStatusManager(s.GetPersistenceBean().GetAsyncWorkflowRequestStatusManager()),
 	)
 	cm.Start()
 	return cm
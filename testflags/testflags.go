@@ -34,10 +34,12 @@ import (
 // In order to start external dependencies, see documentation at https://github.com/uber/cadence
 
 var (
-	cassandra = "CASSANDRA"
-	mongodb   = "MONGODB"
-	mysql     = "MYSQL"
-	postgres  = "POSTGRES"
+	cassandra   = "CASSANDRA"
+	cockroachdb = "COCKROACHDB"
+	dynamodb    = "DYNAMODB"
+	mongodb     = "MONGODB"
+	mysql       = "MYSQL"
+	postgres    = "POSTGRES"
 )
 
 // NOTE: We are using environment variables instead of go testflags or go build directives, because we:
@@ -54,6 +56,10 @@ func RequirePostgres(t *testing.T) {
 	require(t, postgres)
 }
 
+func RequireCockroachDB(t *testing.T) {
+	require(t, cockroachdb)
+}
+
 func RequireMongoDB(t *testing.T) {
 	require(t, mongodb)
 }
@@ -62,6 +68,10 @@ func RequireCassandra(t *testing.T) {
 	require(t, cassandra)
 }
 
+func RequireDynamoDB(t *testing.T) {
+	require(t, dynamodb)
+}
+
 func require(t *testing.T, name string) {
 	if !checkEnv(name) {
 		t.Skip(fmt.Sprintf("Skipping test that requires %s to run - start %s and set '%s=1' environment variable to run this test.",
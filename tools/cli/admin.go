@@ -282,7 +282,8 @@ func newAdminShardManagementCommands() []*cli.Command {
 					Name: FlagTimerType,
 					Usage: "timer types: 0 - DecisionTimeoutTask, 1 - TaskTypeActivityTimeout, " +
 						"2 - TaskTypeUserTimer, 3 - TaskTypeWorkflowTimeout, 4 - TaskTypeDeleteHistoryEvent, " +
-						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer",
+						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer, 7 - TaskTypeWorkflowDeadline, " +
+						"8 - TaskTypeWorkflowDeadlineTerminate",
 					Value: cli.NewIntSlice(-1),
 				},
 				&cli.BoolFlag{
@@ -1031,6 +1032,69 @@ func newDBCommands() []*cli.Command {
 			},
 			Action: AdminDBDataDecodeThrift,
 		},
+		{
+			Name:  "migrate",
+			Usage: "copy data to the persistence migration target store configured in the server config (persistence.migration)",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "domains",
+					Usage:  "create or update all domains in the target store",
+					Flags:  getDBMigrateFlags(),
+					Action: AdminDBMigrateDomains,
+				},
+				{
+					Name:  "shards",
+					Usage: "copy shards, their pending tasks and their executions to the target store",
+					Flags: append(getDBMigrateFlags(),
+						&cli.IntFlag{
+							Name:     FlagLowerShardBound,
+							Usage:    "the first shard to copy",
+							Required: true,
+						},
+						&cli.IntFlag{
+							Name:     FlagUpperShardBound,
+							Usage:    "the last shard to copy",
+							Required: true,
+						},
+					),
+					Action: AdminDBMigrateShards,
+				},
+				{
+					Name:  "tasklists",
+					Usage: "copy the pending tasks of the task lists used by the open executions of the shards, once task lists are served by the target store",
+					Flags: append(getDBMigrateFlags(),
+						&cli.IntFlag{
+							Name:     FlagLowerShardBound,
+							Usage:    "the first shard to find task lists in",
+							Required: true,
+						},
+						&cli.IntFlag{
+							Name:     FlagUpperShardBound,
+							Usage:    "the last shard to find task lists in",
+							Required: true,
+						},
+					),
+					Action: AdminDBMigrateTaskLists,
+				},
+				{
+					Name:  "verify",
+					Usage: "compare shards between the stores and record the ones in sync, which allows them to be served by the target store",
+					Flags: append(getDBMigrateFlags(),
+						&cli.IntFlag{
+							Name:     FlagLowerShardBound,
+							Usage:    "the first shard to verify",
+							Required: true,
+						},
+						&cli.IntFlag{
+							Name:     FlagUpperShardBound,
+							Usage:    "the last shard to verify",
+							Required: true,
+						},
+					),
+					Action: AdminDBMigrateVerify,
+				},
+			},
+		},
 	}
 }
 
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/scanner/persistencemigration"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminDBMigrateDomains copies all domains to the persistence migration target store
+func AdminDBMigrateDomains(c *cli.Context) error {
+	copier, err := newMigrationCopier(c)
+	if err != nil {
+		return err
+	}
+	copied, err := copier.CopyDomains(c.Context)
+	if err != nil {
+		return commoncli.Problem("Failed to copy domains", err)
+	}
+	fmt.Printf("Copied %v domains.\n", copied)
+	return nil
+}
+
+// AdminDBMigrateShards copies the shards in the given range, including their pending tasks and executions,
+// to the persistence migration target store
+func AdminDBMigrateShards(c *cli.Context) error {
+	copier, err := newMigrationCopier(c)
+	if err != nil {
+		return err
+	}
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+	for i := startShardID; i <= endShardID; i++ {
+		if err := copier.CopyShard(c.Context, i); err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to copy shard %v. Please retry.", i), err)
+		}
+		copied, err := copier.CopyShardExecutions(c.Context, i)
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to copy executions of shard %v. Please retry.", i), err)
+		}
+		fmt.Printf("Shard %v copy operation is completed, %v executions copied.\n", i, copied)
+	}
+	return nil
+}
+
+// AdminDBMigrateTaskLists copies the pending tasks of the task lists used by the open executions of the shards
+// in the given range to the persistence migration target store
+func AdminDBMigrateTaskLists(c *cli.Context) error {
+	copier, err := newMigrationCopier(c)
+	if err != nil {
+		return err
+	}
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+	// a task list is used by executions of many shards, it's copied once
+	taskLists := make(map[persistencemigration.TaskList]struct{})
+	for i := startShardID; i <= endShardID; i++ {
+		shardTaskLists, err := copier.ShardTaskLists(c.Context, i)
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to find task lists of shard %v. Please retry.", i), err)
+		}
+		for _, taskList := range shardTaskLists {
+			taskLists[taskList] = struct{}{}
+		}
+	}
+	total := 0
+	for taskList := range taskLists {
+		copied, err := copier.CopyTaskList(c.Context, taskList)
+		total += copied
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to copy task list %v. Please retry.", taskList.Name), err)
+		}
+	}
+	fmt.Printf("Task list copy operation is completed, %v tasks of %v task lists copied.\n", total, len(taskLists))
+	return nil
+}
+
+// AdminDBMigrateVerify compares the shards in the given range between the persistence migration stores
+// and records the ones in sync as verified
+func AdminDBMigrateVerify(c *cli.Context) error {
+	copier, err := newMigrationCopier(c)
+	if err != nil {
+		return err
+	}
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+	var verified, differing []int
+	for i := startShardID; i <= endShardID; i++ {
+		diff, err := copier.VerifyShard(c.Context, i)
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to verify shard %v. Please retry.", i), err)
+		}
+		if diff != "" {
+			fmt.Printf("Shard %v differs: %v.\n", i, diff)
+			differing = append(differing, i)
+			continue
+		}
+		verified = append(verified, i)
+	}
+	if len(verified) > 0 {
+		if err := copier.RecordVerifiedShards(c.Context, verified); err != nil {
+			return commoncli.Problem("Failed to record verified shards. Please retry.", err)
+		}
+		fmt.Printf("Shards %v are verified.\n", verified)
+	}
+	if len(differing) > 0 {
+		return commoncli.Problem(fmt.Sprintf("Shards %v differ, copy them again and retry.", differing), nil)
+	}
+	return nil
+}
+
+// getDBMigrateFlags returns the db flags that apply to a migration. Both datastores come from the
+// server config, so the flags overriding the default datastore are left out.
+func getDBMigrateFlags() []cli.Flag {
+	var flags []cli.Flag
+	for _, f := range getDBFlags() {
+		switch f.Names()[0] {
+		case FlagServiceConfigDir, FlagServiceEnv, FlagServiceZone, FlagRPS:
+			flags = append(flags, f)
+		}
+	}
+	return flags
+}
+
+func newMigrationCopier(c *cli.Context) (*persistencemigration.Copier, error) {
+	cfg, err := getDeps(c).ServerConfig(c)
+	if err != nil {
+		return nil, commoncli.Problem("Failed to load server config", err)
+	}
+	if cfg.Persistence.Migration == nil {
+		return nil, commoncli.Problem("Persistence migration is not configured in the server config", nil)
+	}
+	cfg.Persistence.TransactionSizeLimit = dynamicproperties.GetIntPropertyFn(constants.DefaultTransactionSizeLimit)
+	cfg.Persistence.ErrorInjectionRate = dynamicproperties.GetFloatPropertyFn(0.0)
+
+	rps := c.Float64(FlagRPS)
+	copier, err := persistencemigration.NewCopierFromParams(persistencemigration.Params{
+		Persistence:    &cfg.Persistence,
+		ClusterName:    cfg.ClusterGroupMetadata.CurrentClusterName,
+		PersistenceQPS: func() float64 { return rps },
+		DynamicConfig: &persistence.DynamicConfiguration{
+			EnableSQLAsyncTransaction: dynamicproperties.GetBoolPropertyFn(false),
+		},
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Logger:        log.NewNoop(),
+	})
+	if err != nil {
+		return nil, commoncli.Problem("Failed to connect to persistence migration stores", err)
+	}
+	return copier, nil
+}
@@ -169,6 +169,8 @@ func AdminTimers(c *cli.Context) error {
 			persistence.TaskTypeDeleteHistoryEvent,
 			persistence.TaskTypeActivityRetryTimer,
 			persistence.TaskTypeWorkflowBackoffTimer,
+			persistence.TaskTypeWorkflowDeadline,
+			persistence.TaskTypeWorkflowDeadlineTerminate,
 		}
 	}
 
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -36,6 +37,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -461,6 +463,34 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "terminate", "-w", "wid"}))
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.PauseWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal("wid", req.GetWorkflowExecution().GetWorkflowID())
+			s.Equal("dependency down", req.GetReason())
+			s.NotEmpty(req.GetRequestID())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "dependency down"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"}))
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.serverFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -87,6 +87,10 @@ const resetTypeBadBinary = "BadBinary"
 const resetTypeDecisionCompletedTime = "DecisionCompletedTime"
 const resetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
 const resetTypeLastDecisionScheduled = "LastDecisionScheduled"
+const resetTypeLastActivityFailure = "LastActivityFailure"
+const resetTypeLastChildFailure = "LastChildFailure"
+const resetTypeByActivityType = "ByActivityType"
+const resetTypeByTimestamp = "ByTimestamp"
 
 var resetTypesMap = map[string]string{
 	resetTypeFirstDecisionCompleted: "",
@@ -96,6 +100,10 @@ var resetTypesMap = map[string]string{
 	resetTypeDecisionCompletedTime:  "",
 	resetTypeFirstDecisionScheduled: "",
 	resetTypeLastDecisionScheduled:  "",
+	resetTypeLastActivityFailure:    "",
+	resetTypeLastChildFailure:       "",
+	resetTypeByActivityType:         FlagResetActivityType,
+	resetTypeByTimestamp:            FlagLatestTime,
 }
 
 type jsonType int
@@ -170,6 +170,7 @@ const (
 	FlagDecisionOffset                 = "decision_offset"
 	FlagResetPointsOnly                = "reset_points_only"
 	FlagResetBadBinaryChecksum         = "reset_bad_binary_checksum"
+	FlagResetActivityType              = "reset_activity_type"
 	FlagSkipSignalReapply              = "skip_signal_reapply"
 	FlagListQuery                      = "query"
 	FlagExcludeWorkflowIDByQuery       = "exclude_query"
@@ -555,6 +556,22 @@ func getFlagsForTerminate() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:    FlagReason,
+		Aliases: []string{"re"},
+		Usage:   "The reason you want to unpause the workflow",
+	})
+}
+
 func getFlagsForCancel() []cli.Flag {
 	return append(flagsForExecution, &cli.StringFlag{
 		Name:    FlagReason,
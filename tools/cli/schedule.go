@@ -259,6 +259,14 @@ var (
 		},
 	}
 
+	triggerScheduleFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "Overlap policy for this trigger: SkipNew, Buffer, Concurrent, CancelPrevious, TerminatePrevious. Defaults to the schedule's policy",
+		},
+	}
+
 	deleteScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 	}
@@ -373,6 +381,20 @@ func newScheduleCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "trigger",
+			Aliases: []string{"tr"},
+			Usage:   "Run the schedule action once, now",
+			Flags:   triggerScheduleFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.TriggerSchedule(c)
+				})
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
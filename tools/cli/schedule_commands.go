@@ -22,14 +22,17 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/pborman/uuid"
 	cli "github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
 	commoncli "github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -57,52 +60,10 @@ func (sc *scheduleCLIImpl) CreateSchedule(c *cli.Context) error {
 	}
 	scheduleID := c.String(FlagScheduleID)
 	cronExpr := c.String(FlagCronExpression)
-	workflowType := c.String(FlagWorkflowType)
-	taskList := c.String(FlagTaskList)
-	executionTimeout := int32(c.Int(FlagExecutionTimeout))
-	decisionTimeout := int32(c.Int(FlagDecisionTimeout))
 
-	action := &types.StartWorkflowAction{
-		WorkflowType:                        &types.WorkflowType{Name: workflowType},
-		ExecutionStartToCloseTimeoutSeconds: &executionTimeout,
-		TaskStartToCloseTimeoutSeconds:      &decisionTimeout,
-	}
-	if taskList != "" {
-		action.TaskList = &types.TaskList{Name: taskList}
-	}
-	if inputStr := c.String(FlagInput); inputStr != "" {
-		if !json.Valid([]byte(inputStr)) {
-			return commoncli.Problem("Input is not valid JSON", nil)
-		}
-		action.Input = []byte(inputStr)
-	}
-	if c.IsSet(FlagWorkflowIDPrefix) {
-		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
-	}
-	if memoFields, err := processMemo(c); err != nil {
-		return err
-	} else if len(memoFields) > 0 {
-		action.Memo = &types.Memo{Fields: memoFields}
-	}
-	if saFields, err := processSearchAttr(c); err != nil {
+	action, err := buildStartWorkflowActionFromFlags(c)
+	if err != nil {
 		return err
-	} else if len(saFields) > 0 {
-		action.SearchAttributes = &types.SearchAttributes{IndexedFields: saFields}
-	}
-	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) || c.IsSet(FlagRetryInterval) || c.IsSet(FlagRetryBackoff) || c.IsSet(FlagRetryMaxInterval) {
-		action.RetryPolicy = &types.RetryPolicy{
-			InitialIntervalInSeconds: int32(c.Int(FlagRetryInterval)),
-			BackoffCoefficient:       c.Float64(FlagRetryBackoff),
-		}
-		if c.IsSet(FlagRetryAttempts) {
-			action.RetryPolicy.MaximumAttempts = int32(c.Int(FlagRetryAttempts))
-		}
-		if c.IsSet(FlagRetryExpiration) {
-			action.RetryPolicy.ExpirationIntervalInSeconds = int32(c.Int(FlagRetryExpiration))
-		}
-		if c.IsSet(FlagRetryMaxInterval) {
-			action.RetryPolicy.MaximumIntervalInSeconds = int32(c.Int(FlagRetryMaxInterval))
-		}
 	}
 
 	spec := &types.ScheduleSpec{CronExpression: cronExpr}
@@ -451,6 +412,54 @@ func (sc *scheduleCLIImpl) BackfillSchedule(c *cli.Context) error {
 	return nil
 }
 
+// TriggerSchedule signals the scheduler workflow directly, the same way the
+// TriggerSchedule API does, because the public IDL does not define that RPC.
+func (sc *scheduleCLIImpl) TriggerSchedule(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+
+	var signal scheduler.TriggerSignal
+	if c.IsSet(FlagOverlapPolicy) {
+		policy, err := parseOverlapPolicy(c.String(FlagOverlapPolicy))
+		if err != nil {
+			return err
+		}
+		signal.OverlapPolicy = policy
+	}
+	input, err := json.Marshal(signal)
+	if err != nil {
+		return commoncli.Problem("Failed to serialize trigger request", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	err = sc.frontendClient.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.WorkflowID(scheduleID)},
+		SignalName:        scheduler.SignalNameTrigger,
+		Input:             input,
+		RequestID:         uuid.New(),
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		var notFound *types.EntityNotExistsError
+		if errors.As(err, &notFound) {
+			return commoncli.Problem(fmt.Sprintf("Schedule %q not found in domain %q", scheduleID, domain), err)
+		}
+		return commoncli.Problem("Failed to trigger schedule", err)
+	}
+
+	fmt.Printf("Schedule %q triggered.\n", scheduleID)
+	return nil
+}
+
 func (sc *scheduleCLIImpl) ListSchedules(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
@@ -495,6 +504,58 @@ func (sc *scheduleCLIImpl) ListSchedules(c *cli.Context) error {
 	return nil
 }
 
+// buildStartWorkflowActionFromFlags builds the StartWorkflow action of the
+// schedule from the target workflow flags.
+func buildStartWorkflowActionFromFlags(c *cli.Context) (*types.StartWorkflowAction, error) {
+	taskList := c.String(FlagTaskList)
+	executionTimeout := int32(c.Int(FlagExecutionTimeout))
+	decisionTimeout := int32(c.Int(FlagDecisionTimeout))
+
+	action := &types.StartWorkflowAction{
+		WorkflowType:                        &types.WorkflowType{Name: c.String(FlagWorkflowType)},
+		ExecutionStartToCloseTimeoutSeconds: &executionTimeout,
+		TaskStartToCloseTimeoutSeconds:      &decisionTimeout,
+	}
+	if taskList != "" {
+		action.TaskList = &types.TaskList{Name: taskList}
+	}
+	if inputStr := c.String(FlagInput); inputStr != "" {
+		if !json.Valid([]byte(inputStr)) {
+			return nil, commoncli.Problem("Input is not valid JSON", nil)
+		}
+		action.Input = []byte(inputStr)
+	}
+	if c.IsSet(FlagWorkflowIDPrefix) {
+		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+	}
+	if memoFields, err := processMemo(c); err != nil {
+		return nil, err
+	} else if len(memoFields) > 0 {
+		action.Memo = &types.Memo{Fields: memoFields}
+	}
+	if saFields, err := processSearchAttr(c); err != nil {
+		return nil, err
+	} else if len(saFields) > 0 {
+		action.SearchAttributes = &types.SearchAttributes{IndexedFields: saFields}
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) || c.IsSet(FlagRetryInterval) || c.IsSet(FlagRetryBackoff) || c.IsSet(FlagRetryMaxInterval) {
+		action.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds: int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:       c.Float64(FlagRetryBackoff),
+		}
+		if c.IsSet(FlagRetryAttempts) {
+			action.RetryPolicy.MaximumAttempts = int32(c.Int(FlagRetryAttempts))
+		}
+		if c.IsSet(FlagRetryExpiration) {
+			action.RetryPolicy.ExpirationIntervalInSeconds = int32(c.Int(FlagRetryExpiration))
+		}
+		if c.IsSet(FlagRetryMaxInterval) {
+			action.RetryPolicy.MaximumIntervalInSeconds = int32(c.Int(FlagRetryMaxInterval))
+		}
+	}
+	return action, nil
+}
+
 // buildPoliciesFromFlags builds SchedulePolicies from CLI flags, starting
 // from base (nil for create, current policies for update) so unset flags
 // keep their existing value instead of resetting to zero.
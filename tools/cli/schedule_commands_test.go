@@ -615,6 +615,84 @@ func TestScheduleCLI_BackfillSchedule_InvalidTimeFormat(t *testing.T) {
 	assert.Contains(t, err.Error(), "Invalid start_time format")
 }
 
+func TestScheduleCLI_TriggerSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		extraArgs   []string
+		signalErr   error
+		wantInput   string
+		errContains string
+	}{
+		{
+			name:      "schedule policy",
+			wantInput: `{}`,
+		},
+		{
+			name:      "overlap policy override",
+			extraArgs: []string{"--" + FlagOverlapPolicy, "Concurrent"},
+			wantInput: `{"overlapPolicy":"CONCURRENT"}`,
+		},
+		{
+			name:        "invalid overlap policy",
+			extraArgs:   []string{"--" + FlagOverlapPolicy, "Sometimes"},
+			errContains: "Unknown overlap policy",
+		},
+		{
+			name:        "schedule not found",
+			signalErr:   &types.EntityNotExistsError{Message: "workflow not found"},
+			wantInput:   `{}`,
+			errContains: `Schedule "my-sched" not found in domain "test-domain"`,
+		},
+		{
+			name:        "signal failure",
+			signalErr:   &types.InternalServiceError{Message: "boom"},
+			wantInput:   `{}`,
+			errContains: "Failed to trigger schedule",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			if tt.wantInput != "" {
+				mockClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "cadence-scheduler:my-sched", req.WorkflowExecution.GetWorkflowID())
+						assert.Empty(t, req.WorkflowExecution.GetRunID())
+						assert.Equal(t, "scheduler-trigger", req.SignalName)
+						assert.JSONEq(t, tt.wantInput, string(req.Input))
+						assert.NotEmpty(t, req.RequestID)
+						return tt.signalErr
+					})
+			}
+
+			app := newScheduleTestApp(t, mockClient)
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, "", "")
+			set.String(FlagTransport, "", "")
+			set.String(FlagScheduleID, "", "")
+			set.String(FlagOverlapPolicy, "", "")
+			set.Parse(append([]string{
+				"--" + FlagDomain, "test-domain",
+				"--" + FlagTransport, grpcTransport,
+				"--" + FlagScheduleID, "my-sched",
+			}, tt.extraArgs...))
+			c := cli.NewContext(app, set, nil)
+
+			sc := &scheduleCLIImpl{frontendClient: mockClient}
+			err := sc.TriggerSchedule(c)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestScheduleCLI_ListSchedules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockClient := frontend.NewMockClient(mockCtrl)
@@ -90,6 +90,18 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForTerminate(),
 			Action:  TerminateWorkflow,
 		},
+		{
+			Name:   "pause",
+			Usage:  "pause a workflow execution: no decision or activity tasks are dispatched until it is unpaused",
+			Flags:  getFlagsForPause(),
+			Action: PauseWorkflow,
+		},
+		{
+			Name:   "unpause",
+			Usage:  "unpause a paused workflow execution",
+			Flags:  getFlagsForUnpause(),
+			Action: UnpauseWorkflow,
+		},
 		{
 			Name:    "cancel",
 			Aliases: []string{"c"},
@@ -230,6 +242,10 @@ func newWorkflowCommands() []*cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetActivityType,
+					Usage: "Activity type for resetType of ByActivityType, the workflow is reset to the decision before the first failure of an activity of this type",
+				},
 				&cli.StringFlag{
 					Name:    FlagEarliestTime,
 					Aliases: []string{"et"},
@@ -337,6 +353,10 @@ func newWorkflowCommands() []*cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetActivityType,
+					Usage: "Activity type for resetType of ByActivityType, the workflow is reset to the decision before the first failure of an activity of this type",
+				},
 				&cli.BoolFlag{
 					Name:  FlagSkipSignalReapply,
 					Usage: "whether or not skipping signals reapply after the reset point",
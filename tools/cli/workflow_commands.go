@@ -743,6 +743,92 @@ func TerminateWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = wfClient.PauseWorkflowExecution(
+		ctx,
+		&types.PauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Pause workflow failed.", err)
+	}
+	fmt.Println("Pause workflow succeeded.")
+	return nil
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	err = wfClient.UnpauseWorkflowExecution(
+		ctx,
+		&types.UnpauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Unpause workflow failed.", err)
+	}
+	fmt.Println("Unpause workflow succeeded.")
+	return nil
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -2398,6 +2484,48 @@ func getResetEventIDByType(
 		}
 		// decisionFinishID is exclusive in reset API
 		decisionFinishID++
+	case resetTypeLastActivityFailure:
+		decisionFinishID, err = getFailureDecisionCompletedID(ctx, domain, wid, rid, frontendClient, func(e *types.HistoryEvent, _ map[int64]string) (bool, bool) {
+			return isActivityFailure(e), false
+		})
+		if err != nil {
+			return
+		}
+	case resetTypeLastChildFailure:
+		decisionFinishID, err = getFailureDecisionCompletedID(ctx, domain, wid, rid, frontendClient, func(e *types.HistoryEvent, _ map[int64]string) (bool, bool) {
+			switch e.GetEventType() {
+			case types.EventTypeChildWorkflowExecutionFailed,
+				types.EventTypeChildWorkflowExecutionTimedOut,
+				types.EventTypeChildWorkflowExecutionTerminated:
+				return true, false
+			}
+			return false, false
+		})
+		if err != nil {
+			return
+		}
+	case resetTypeByActivityType:
+		activityType := c.String(FlagResetActivityType)
+		if activityType == "" {
+			return "", 0, fmt.Errorf("resetType %s requires --%s", resetTypeByActivityType, FlagResetActivityType)
+		}
+		decisionFinishID, err = getFailureDecisionCompletedID(ctx, domain, wid, rid, frontendClient, func(e *types.HistoryEvent, activityTypes map[int64]string) (bool, bool) {
+			// the first failure of an activity of this type is selected
+			matched := isActivityFailure(e) && activityTypes[getActivityScheduledEventID(e)] == activityType
+			return matched, matched
+		})
+		if err != nil {
+			return
+		}
+	case resetTypeByTimestamp:
+		latestTime, err := parseTime(c.String(FlagLatestTime), 0)
+		if err != nil {
+			return "", 0, fmt.Errorf("Get reset event id by type failed: %w", err)
+		}
+		decisionFinishID, err = getLatestDecisionID(ctx, domain, wid, rid, latestTime, frontendClient)
+		if err != nil {
+			return "", 0, fmt.Errorf("Get reset event id by type failed: %w", err)
+		}
 	default:
 		panic("not supported resetType")
 	}
@@ -2445,6 +2573,73 @@ func getFirstDecisionTaskByType(
 	return decisionFinishID, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
 }
 
+// getFailureDecisionCompletedID returns the last DecisionTaskCompleted event before a failure selected by match.
+// match is called with every event and the activity types of the activities scheduled so far,
+// it returns whether the event is a selected failure and whether the search stops at this failure.
+func getFailureDecisionCompletedID(
+	ctx context.Context,
+	domain string,
+	workflowID string,
+	runID string,
+	frontendClient frontend.Client,
+	match func(e *types.HistoryEvent, activityTypes map[int64]string) (matched bool, stop bool),
+) (decisionFinishID int64, err error) {
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: 1000,
+		NextPageToken:   nil,
+	}
+
+	var lastDecisionCompletedID int64
+	activityTypes := make(map[int64]string)
+OuterLoop:
+	for {
+		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			switch e.GetEventType() {
+			case types.EventTypeDecisionTaskCompleted:
+				lastDecisionCompletedID = e.ID
+			case types.EventTypeActivityTaskScheduled:
+				activityTypes[e.ID] = e.ActivityTaskScheduledEventAttributes.GetActivityType().GetName()
+			}
+			matched, stop := match(e, activityTypes)
+			if matched {
+				decisionFinishID = lastDecisionCompletedID
+			}
+			if stop {
+				break OuterLoop
+			}
+		}
+		if len(resp.NextPageToken) != 0 {
+			req.NextPageToken = resp.NextPageToken
+		} else {
+			break
+		}
+	}
+	if decisionFinishID == 0 {
+		return 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
+	}
+	return
+}
+
+func isActivityFailure(e *types.HistoryEvent) bool {
+	return e.GetEventType() == types.EventTypeActivityTaskFailed || e.GetEventType() == types.EventTypeActivityTaskTimedOut
+}
+
+func getActivityScheduledEventID(e *types.HistoryEvent) int64 {
+	if e.GetEventType() == types.EventTypeActivityTaskTimedOut {
+		return e.ActivityTaskTimedOutEventAttributes.GetScheduledEventID()
+	}
+	return e.ActivityTaskFailedEventAttributes.GetScheduledEventID()
+}
+
 func getCurrentRunID(ctx context.Context, domain, wid string, frontendClient frontend.Client) (string, error) {
 	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
 		Domain: domain,
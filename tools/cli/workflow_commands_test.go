@@ -2180,6 +2180,116 @@ func Test_GetResetEventIDByType_DecisionCompletedTime(t *testing.T) {
 	}
 }
 
+func Test_GetResetEventIDByType_Failures(t *testing.T) {
+	activityScheduled := func(id int64, activityType string) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        id,
+			EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityType: &types.ActivityType{Name: activityType},
+			},
+		}
+	}
+	history := &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: []*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(10)},
+				activityScheduled(5, "activity-a"),
+				activityScheduled(6, "activity-b"),
+				{
+					ID:                                7,
+					EventType:                         types.EventTypeActivityTaskFailed.Ptr(),
+					ActivityTaskFailedEventAttributes: &types.ActivityTaskFailedEventAttributes{ScheduledEventID: 6},
+				},
+				{ID: 10, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(20)},
+				{ID: 11, EventType: types.EventTypeChildWorkflowExecutionTimedOut.Ptr()},
+				{ID: 14, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(30)},
+				{
+					ID:                                  15,
+					EventType:                           types.EventTypeActivityTaskTimedOut.Ptr(),
+					ActivityTaskTimedOutEventAttributes: &types.ActivityTaskTimedOutEventAttributes{ScheduledEventID: 5},
+				},
+				{ID: 18, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(40)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		resetType    string
+		activityType string
+		latestTime   string
+		mockHistory  bool
+		wantID       int64
+		errContains  string
+	}{
+		{
+			name:        "last activity failure",
+			resetType:   resetTypeLastActivityFailure,
+			mockHistory: true,
+			wantID:      14,
+		},
+		{
+			name:        "last child failure",
+			resetType:   resetTypeLastChildFailure,
+			mockHistory: true,
+			wantID:      10,
+		},
+		{
+			name:         "first failure of activity type",
+			resetType:    resetTypeByActivityType,
+			activityType: "activity-b",
+			mockHistory:  true,
+			wantID:       4,
+		},
+		{
+			name:         "activity type without failure",
+			resetType:    resetTypeByActivityType,
+			activityType: "activity-c",
+			mockHistory:  true,
+			errContains:  "no DecisionFinishID",
+		},
+		{
+			name:        "activity type not set",
+			resetType:   resetTypeByActivityType,
+			errContains: "requires --" + FlagResetActivityType,
+		},
+		{
+			name:        "by timestamp",
+			resetType:   resetTypeByTimestamp,
+			latestTime:  "35",
+			mockHistory: true,
+			wantID:      14,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			serverFrontendClient := frontend.NewMockClient(mockCtrl)
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: serverFrontendClient,
+			})
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagResetActivityType, tt.activityType, "reset_activity_type")
+			set.String(FlagLatestTime, tt.latestTime, "latest_time")
+			c := getMockContext(t, set, app)
+			if tt.mockHistory {
+				serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(1)
+			}
+
+			runID, decisionID, err := getResetEventIDByType(context.Background(), c, tt.resetType, 0, "test-domain",
+				"test-workflow-id", "test-run-id", serverFrontendClient)
+			if tt.errContains != "" {
+				assert.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "test-run-id", runID)
+			assert.Equal(t, tt.wantID, decisionID)
+		})
+	}
+}
+
 func Test_GetResetEventIDByType_FirstDecisionScheduled_LastDecisionScheduled(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	serverFrontendClient := frontend.NewMockClient(mockCtrl)
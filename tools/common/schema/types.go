@@ -115,6 +115,14 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptProtoVersion is the cli option for protocol version
 	CLIOptProtoVersion = "protocol-version"
+	// CLIOptPrimaryRegion is the cli option for the primary region of a multi-region database
+	CLIOptPrimaryRegion = "primary-region"
+	// CLIOptRegions is the cli option for the other regions of a multi-region database
+	CLIOptRegions = "regions"
+	// CLIOptDefaultTableLocality is the cli option for the locality of tables in a multi-region database
+	CLIOptDefaultTableLocality = "default-table-locality"
+	// CLIOptTableLocality is the cli option for the locality of specific tables in a multi-region database
+	CLIOptTableLocality = "table-locality"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint
@@ -160,6 +168,14 @@ const (
 	CLIFlagQuiet = CLIOptQuiet
 	// CLIFlagProtoVersion is the cli flag for protocol version
 	CLIFlagProtoVersion = CLIOptProtoVersion
+	// CLIFlagPrimaryRegion is the cli flag for primary region
+	CLIFlagPrimaryRegion = CLIOptPrimaryRegion
+	// CLIFlagRegions is the cli flag for regions
+	CLIFlagRegions = CLIOptRegions
+	// CLIFlagDefaultTableLocality is the cli flag for default table locality
+	CLIFlagDefaultTableLocality = CLIOptDefaultTableLocality
+	// CLIFlagTableLocality is the cli flag for table locality
+	CLIFlagTableLocality = CLIOptTableLocality
 
 	// CLIFlagEnableTLS enables cassandra client TLS
 	CLIFlagEnableTLS = "tls"
@@ -22,6 +22,7 @@ package sql
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/persistence/sql"
@@ -113,6 +114,32 @@ func (c *Connection) DropDatabase(name string) error {
 	return c.adminDb.DropDatabase(name)
 }
 
+// SetDatabaseRegions makes a database multi-region, it is only supported by some plugins
+func (c *Connection) SetDatabaseRegions(name string, primaryRegion string, regions []string) error {
+	db, err := c.multiRegionAdminDB()
+	if err != nil {
+		return err
+	}
+	return db.SetDatabaseRegions(name, primaryRegion, regions)
+}
+
+// SetTableLocality sets the locality of a table of a multi-region database, it is only supported by some plugins
+func (c *Connection) SetTableLocality(table string, locality string) error {
+	db, err := c.multiRegionAdminDB()
+	if err != nil {
+		return err
+	}
+	return db.SetTableLocality(table, locality)
+}
+
+func (c *Connection) multiRegionAdminDB() (sqlplugin.MultiRegionAdminDB, error) {
+	db, ok := c.adminDb.(sqlplugin.MultiRegionAdminDB)
+	if !ok {
+		return nil, fmt.Errorf("plugin %v doesn't support multi-region databases", c.adminDb.PluginName())
+	}
+	return db, nil
+}
+
 // Close closes the sql client
 func (c *Connection) Close() {
 	if c.adminDb != nil {
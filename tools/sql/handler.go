@@ -23,13 +23,16 @@ package sql
 import (
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/common/config"
+	cockroachdb_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/cockroachdb"
 	mysql_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	postgres_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
 	sqlite_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	"github.com/uber/cadence/schema/cockroachdb"
 	"github.com/uber/cadence/schema/mysql"
 	"github.com/uber/cadence/schema/postgres"
 	"github.com/uber/cadence/schema/sqlite"
@@ -53,6 +56,8 @@ func VerifyCompatibleVersion(
 			expectedVersion = postgres.Version
 		case sqlite_db.PluginName:
 			expectedVersion = sqlite.Version
+		case cockroachdb_db.PluginName:
+			expectedVersion = cockroachdb.Version
 		}
 		err := CheckCompatibleVersion(*ds.SQL, expectedVersion)
 		if err != nil {
@@ -69,7 +74,8 @@ func VerifyCompatibleVersion(
 			expectedVersion = postgres.VisibilityVersion
 		case sqlite_db.PluginName:
 			expectedVersion = sqlite.VisibilityVersion
-
+		case cockroachdb_db.PluginName:
+			expectedVersion = cockroachdb.VisibilityVersion
 		}
 		err := CheckCompatibleVersion(*ds.SQL, expectedVersion)
 		if err != nil {
@@ -141,6 +147,40 @@ func setupSchema(cli *cli.Context) error {
 	if err := schema.Setup(cli, conn); err != nil {
 		return handleErr(err)
 	}
+	localities := cli.Generic(schema.CLIOptTableLocality).(*cliflag.StringMap)
+	if err := setTableLocalities(conn, cli.String(schema.CLIOptDefaultTableLocality), localities.Value()); err != nil {
+		return handleErr(fmt.Errorf("error setting table locality: %v", err))
+	}
+	return nil
+}
+
+// setTableLocalities sets the default locality on every table of the database,
+// then the table specific ones, which take precedence over the default
+func setTableLocalities(conn *Connection, defaultLocality string, localities map[string]string) error {
+	tableLocalities := make(map[string]string, len(localities))
+	if defaultLocality != "" {
+		tables, err := conn.ListTables()
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			tableLocalities[table] = defaultLocality
+		}
+	}
+	for table, locality := range localities {
+		tableLocalities[table] = locality
+	}
+
+	tables := make([]string, 0, len(tableLocalities))
+	for table := range tableLocalities {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		if err := conn.SetTableLocality(table, tableLocalities[table]); err != nil {
+			return fmt.Errorf("table %v: %v", table, err)
+		}
+	}
 	return nil
 }
 
@@ -172,21 +212,32 @@ func createDatabase(cli *cli.Context) error {
 	if database == "" {
 		return handleErr(schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument "))
 	}
-	err = doCreateDatabase(cfg, database)
+	primaryRegion := cli.String(schema.CLIOptPrimaryRegion)
+	regions := cli.StringSlice(schema.CLIOptRegions)
+	if primaryRegion == "" && len(regions) > 0 {
+		return handleErr(schema.NewConfigError("missing " + flag(schema.CLIOptPrimaryRegion) + " argument, it is required by " + flag(schema.CLIOptRegions)))
+	}
+	err = doCreateDatabase(cfg, database, primaryRegion, regions)
 	if err != nil {
 		return handleErr(fmt.Errorf("error creating database:%v", err))
 	}
 	return nil
 }
 
-func doCreateDatabase(cfg *config.SQL, name string) error {
+func doCreateDatabase(cfg *config.SQL, name string, primaryRegion string, regions []string) error {
 	cfg.DatabaseName = ""
 	conn, err := NewConnection(cfg)
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
-	return conn.CreateDatabase(name)
+	if err := conn.CreateDatabase(name); err != nil {
+		return err
+	}
+	if primaryRegion == "" {
+		return nil
+	}
+	return conn.SetDatabaseRegions(name, primaryRegion, regions)
 }
 
 func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
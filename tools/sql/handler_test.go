@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+func TestSetTableLocalities(t *testing.T) {
+	tests := map[string]struct {
+		defaultLocality string
+		localities      map[string]string
+		setupMock       func(db *sqlplugin.MockMultiRegionAdminDB)
+		wantErr         bool
+	}{
+		"nothing to set": {
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {},
+		},
+		"default locality for every table": {
+			defaultLocality: "REGIONAL BY ROW",
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {
+				db.EXPECT().ListTables("cadence").Return([]string{"executions", "domains"}, nil)
+				gomock.InOrder(
+					db.EXPECT().SetTableLocality("domains", "REGIONAL BY ROW").Return(nil),
+					db.EXPECT().SetTableLocality("executions", "REGIONAL BY ROW").Return(nil),
+				)
+			},
+		},
+		"table locality overrides the default one": {
+			defaultLocality: "REGIONAL BY ROW",
+			localities:      map[string]string{"domains": "GLOBAL"},
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {
+				db.EXPECT().ListTables("cadence").Return([]string{"executions", "domains"}, nil)
+				gomock.InOrder(
+					db.EXPECT().SetTableLocality("domains", "GLOBAL").Return(nil),
+					db.EXPECT().SetTableLocality("executions", "REGIONAL BY ROW").Return(nil),
+				)
+			},
+		},
+		"table locality only": {
+			localities: map[string]string{"domains": "GLOBAL"},
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {
+				db.EXPECT().SetTableLocality("domains", "GLOBAL").Return(nil)
+			},
+		},
+		"list tables error": {
+			defaultLocality: "GLOBAL",
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {
+				db.EXPECT().ListTables("cadence").Return(nil, errors.New("list error"))
+			},
+			wantErr: true,
+		},
+		"set locality error": {
+			localities: map[string]string{"domains": "GLOBAL", "executions": "GLOBAL"},
+			setupMock: func(db *sqlplugin.MockMultiRegionAdminDB) {
+				db.EXPECT().SetTableLocality("domains", "GLOBAL").Return(errors.New("locality error"))
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := sqlplugin.NewMockMultiRegionAdminDB(ctrl)
+			tc.setupMock(db)
+			conn := &Connection{dbName: "cadence", adminDb: db}
+
+			err := setTableLocalities(conn, tc.defaultLocality, tc.localities)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetTableLocalitiesUnsupportedPlugin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db := sqlplugin.NewMockAdminDB(ctrl)
+	db.EXPECT().PluginName().Return("mysql")
+	conn := &Connection{dbName: "cadence", adminDb: db}
+
+	err := setTableLocalities(conn, "", map[string]string{"domains": "GLOBAL"})
+	assert.ErrorContains(t, err, "plugin mysql doesn't support multi-region databases")
+}
@@ -166,6 +166,15 @@ func BuildCLIOptions() *cli.App {
 					Aliases: []string{"o"},
 					Usage:   "drop all existing tables before setting up new schema",
 				},
+				&cli.StringFlag{
+					Name:  schema.CLIFlagDefaultTableLocality,
+					Usage: "locality of all the tables of a multi-region database, e.g. REGIONAL BY ROW (cockroachdb only)",
+				},
+				&cli.GenericFlag{
+					Name:  schema.CLIFlagTableLocality,
+					Value: &cliflag.StringMap{},
+					Usage: "locality of specific tables of a multi-region database, overrides the default one (must be in table1=locality1,...,tableN=localityN format, e.g. domains=GLOBAL) (cockroachdb only)",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return cliHandler(c, setupSchema)
@@ -205,6 +214,14 @@ func BuildCLIOptions() *cli.App {
 					Aliases: []string{"db"},
 					Usage:   "name of the database",
 				},
+				&cli.StringFlag{
+					Name:  schema.CLIFlagPrimaryRegion,
+					Usage: "primary region of the database, makes it a multi-region database (cockroachdb only)",
+				},
+				&cli.StringSliceFlag{
+					Name:  schema.CLIFlagRegions,
+					Usage: "other regions of a multi-region database, requires the primary region (cockroachdb only)",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return cliHandler(c, createDatabase)